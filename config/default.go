@@ -16,10 +16,26 @@ const DefaultValues = `
 [Synchronizer]
 	SyncInterval = "10s"
 	SyncChunkSize = 500
+	MinSyncChunkSize = 0
+	MaxSyncChunkSize = 0
 	GenesisBlockNumber = 0
 	SyncUpToBlock = "latest"
 	BlockFinality = "finalized"
 	OverrideStorageCheck = false
+	OverrideRollupTypeCheck = false
+	MaxReorgDepth = 0
+	[Synchronizer.ParallelFetch]
+		NumWorkers = 0
+		MaxPendingRanges = 0
+	[Synchronizer.L1BlockCheck]
+		Async = false
+		TimeBetweenChecks = "5s"
+		PreCheckEnabled = false
+		PreCheckInitialBlock = "safe"
+		PreCheckEndBlock = "latest"
+	[Synchronizer.ForkIDs]
+		UnknownForkIDPolicy = "halt"
+		Ranges = []
 [Etherman]
 	L1URL = "http://localhost:8545"
 	ForkIDChunkSize = 100
@@ -29,6 +45,8 @@ const DefaultValues = `
 		GlobalExitRootManagerAddr = "0x2968D6d736178f8FE7393CC33C87f29D9C287e78"
 		RollupManagerAddr = "0xE2EF6215aDc132Df6913C8DD16487aBF118d1764"
 		ZkEVMAddr = "0x89BA0Ed947a88fe43c22Ae305C0713eC8a7Eb361"
+		ExtraRollupAddrs = []
+		ExtraRollupIDs = []
 	[Etherman.Validium]
 		Enabled = false
 		TrustedSequencerURL = ""
@@ -32,12 +32,31 @@ func TestDefault(t *testing.T) {
 			MaxConns: 10,
 		},
 		Synchronizer: syncconfig.Config{
-			SyncInterval:         types.Duration{Duration: time.Second * 10},
-			SyncChunkSize:        500,
-			GenesisBlockNumber:   0,
-			SyncUpToBlock:        "latest",
-			BlockFinality:        "finalized",
-			OverrideStorageCheck: false,
+			SyncInterval:            types.Duration{Duration: time.Second * 10},
+			SyncChunkSize:           500,
+			MinSyncChunkSize:        0,
+			MaxSyncChunkSize:        0,
+			GenesisBlockNumber:      0,
+			SyncUpToBlock:           "latest",
+			BlockFinality:           "finalized",
+			OverrideStorageCheck:    false,
+			OverrideRollupTypeCheck: false,
+			MaxReorgDepth:           0,
+			ParallelFetch: syncconfig.ParallelFetchConfig{
+				NumWorkers:       0,
+				MaxPendingRanges: 0,
+			},
+			L1BlockCheck: syncconfig.L1BlockCheckConfig{
+				Async:                false,
+				TimeBetweenChecks:    types.Duration{Duration: time.Second * 5},
+				PreCheckEnabled:      false,
+				PreCheckInitialBlock: "safe",
+				PreCheckEndBlock:     "latest",
+			},
+			ForkIDs: syncconfig.ForkIDsConfig{
+				UnknownForkIDPolicy: "halt",
+				Ranges:              []syncconfig.ForkIDRangeConfig{},
+			},
 		},
 		Etherman: etherman.Config{
 			L1URL:           "http://localhost:8545",
@@ -47,6 +66,8 @@ func TestDefault(t *testing.T) {
 				GlobalExitRootManagerAddr: common.HexToAddress("0x2968D6d736178f8FE7393CC33C87f29D9C287e78"),
 				RollupManagerAddr:         common.HexToAddress("0xE2EF6215aDc132Df6913C8DD16487aBF118d1764"),
 				ZkEVMAddr:                 common.HexToAddress("0x89BA0Ed947a88fe43c22Ae305C0713eC8a7Eb361"),
+				ExtraRollupAddrs:          []common.Address{},
+				ExtraRollupIDs:            []uint32{},
 			},
 			Validium: etherman.ValidiumConfig{
 				Enabled:             false,
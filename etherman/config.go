@@ -28,4 +28,8 @@ type ContractConfig struct {
 	GlobalExitRootManagerAddr common.Address
 	RollupManagerAddr         common.Address
 	ZkEVMAddr                 common.Address
+	// ExtraRollupAddrs are the addresses of other rollups attached to the same RollupManager to synchronize
+	ExtraRollupAddrs []common.Address
+	// ExtraRollupIDs are the rollupIDs of other rollups attached to the same RollupManager to synchronize
+	ExtraRollupIDs []uint32
 }
@@ -1,7 +1,10 @@
 package etherman
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strings"
 )
 
 var (
@@ -21,4 +24,38 @@ var (
 	ErrNoSigner = errors.New("no signer to authorize the transaction with")
 	// ErrMissingTrieNode means that a node is missing on the trie
 	ErrMissingTrieNode = errors.New("missing trie node")
+	// ErrFilterLogsRangeTooLarge the L1 node rejects the eth_getLogs request because the range have too many results
+	// or exceeds the range limit. A smaller range must be requested
+	ErrFilterLogsRangeTooLarge = errors.New("eth_getLogs range too large")
+	// ErrSubscriptionNotSupported the L1 endpoint doesn't support subscriptions (it's not a websocket)
+	ErrSubscriptionNotSupported = errors.New("L1 endpoint doesn't support subscriptions")
 )
+
+// filterLogsRangeTooLargeMessages are the errors returned by the L1 providers when the eth_getLogs range
+// have too many results or exceeds the range limit, so it must be reduced
+var filterLogsRangeTooLargeMessages = []string{
+	"query returned more than",
+	"log response size exceeded",
+	"response size should not greater than",
+	"exceed maximum block range",
+	"block range is too wide",
+	"block range limit exceeded",
+	"range is too large",
+	"is limited to a 10,000 range",
+}
+
+// translateFilterLogsError wraps the error with ErrFilterLogsRangeTooLarge if the L1 provider asks to reduce the
+// range requested. Other errors (e.g. rate limits or timeouts) are returned as they are because they are not
+// a problem of the range. If ctx is done it's not translated
+func translateFilterLogsError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() != nil {
+		return err
+	}
+	msg := strings.ToLower(err.Error())
+	for _, rangeTooLargeMsg := range filterLogsRangeTooLargeMessages {
+		if strings.Contains(msg, rangeTooLargeMsg) {
+			return fmt.Errorf("%w: %w", ErrFilterLogsRangeTooLarge, err)
+		}
+	}
+	return err
+}
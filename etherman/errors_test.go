@@ -0,0 +1,40 @@
+package etherman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateFilterLogsError(t *testing.T) {
+	ctx := context.TODO()
+	tcs := []struct {
+		err         error
+		rangeTooBig bool
+	}{
+		{fmt.Errorf("query returned more than 10000 results"), true},
+		{fmt.Errorf("Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range"), true},
+		{fmt.Errorf("exceed maximum block range: 5000"), true},
+		{fmt.Errorf("block range is too wide"), true},
+		{fmt.Errorf("request timed out"), false},
+		{fmt.Errorf("rate limit exceeded"), false},
+		{fmt.Errorf("daily request count exceeded, request rate limited"), false},
+		{context.DeadlineExceeded, false},
+		{fmt.Errorf("connection refused"), false},
+	}
+	for _, tc := range tcs {
+		err := translateFilterLogsError(ctx, tc.err)
+		require.Equal(t, tc.rangeTooBig, errors.Is(err, ErrFilterLogsRangeTooLarge), tc.err.Error())
+		require.ErrorIs(t, err, tc.err)
+	}
+}
+
+func TestTranslateFilterLogsErrorCtxDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	err := translateFilterLogsError(ctx, context.Canceled)
+	require.NotErrorIs(t, err, ErrFilterLogsRangeTooLarge)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonrollupmanager"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonzkevm"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonzkevmglobalexitroot"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/proxy"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -99,12 +100,27 @@ const (
 	TrustedVerifyBatchOrder EventOrder = "TrustedVerifyBatch"
 	// VerifyBatchOrder identifies a VerifyBatch event
 	VerifyBatchOrder EventOrder = "VerifyBatch"
+	// VerifyBatchPreEtrogOrder identifies a VerifyBatches event of the rollup contract, before LxLy it's
+	// emitted for the non-trusted verifications. After LxLy it's emitted with the event of the RollupManager
+	VerifyBatchPreEtrogOrder EventOrder = "VerifyBatchPreEtrog"
 	// SequenceForceBatchesOrder identifies a SequenceForceBatches event
 	SequenceForceBatchesOrder EventOrder = "SequenceForceBatches"
 	// ForkIDsOrder identifies an updateZkevmVersion event
 	ForkIDsOrder EventOrder = "forkIDs"
 	// InitialSequenceBatchesOrder identifies a VerifyBatch event
 	InitialSequenceBatchesOrder EventOrder = "InitialSequenceBatches"
+	// GovernanceEventsOrder identifies a change of a parameter of a rollup or of the RollupManager
+	GovernanceEventsOrder EventOrder = "GovernanceEvents"
+	// RollupTypesOrder identifies an AddNewRollupType event
+	RollupTypesOrder EventOrder = "RollupTypes"
+	// ObsoleteRollupTypesOrder identifies an ObsoleteRollupType event
+	ObsoleteRollupTypesOrder EventOrder = "ObsoleteRollupTypes"
+	// RollupsOrder identifies a CreateNewRollup, AddExistingRollup or UpdateRollup event
+	RollupsOrder EventOrder = "Rollups"
+	// AuditEventsOrder identifies a change of the implementation or of the access control of a contract
+	AuditEventsOrder EventOrder = "AuditEvents"
+	// PendingStateEventsOrder identifies a consolidation, override or proof of non-determinism of the pending states
+	PendingStateEventsOrder EventOrder = "PendingStateEvents"
 )
 
 type ethereumClient interface {
@@ -149,9 +165,14 @@ type Client struct {
 	RollupManager            *polygonrollupmanager.Polygonrollupmanager
 	GlobalExitRootManager    *polygonzkevmglobalexitroot.Polygonzkevmglobalexitroot
 	OldGlobalExitRootManager *oldpolygonzkevmglobalexitroot.Oldpolygonzkevmglobalexitroot
+	Proxy                    *proxy.Proxy
 	SCAddresses              []common.Address
 	SequenceBatchesDecoders  []SequenceBatchesDecoder
 	RollupID                 uint32
+	// RollupIDs are all the rollups synchronized, RollupID is the first one
+	RollupIDs []uint32
+
+	rollupAddrToID map[common.Address]uint32
 
 	GasProviders externalGasProviders
 
@@ -159,6 +180,12 @@ type Client struct {
 	auth map[common.Address]bind.TransactOpts // empty in case of read-only client
 
 	validium *EthermanValidium
+
+	// rollupTypeReader is the source of the rollup types synchronized, nil if there isn't
+	rollupTypeReader RollupTypeReader
+
+	// eventHandlers decodes the logs, by signature of the event
+	eventHandlers map[common.Hash]eventHandler
 }
 
 // NewClient creates a new etherman.
@@ -218,6 +245,12 @@ func NewClient(cfg Config) (*Client, error) {
 		return nil, err
 	}
 
+	proxyContract, err := proxy.NewProxy(cfg.Contracts.RollupManagerAddr, ethClient)
+	if err != nil {
+		log.Errorf("error creating NewProxy client (%s). Error: %w", cfg.Contracts.RollupManagerAddr.String(), err)
+		return nil, err
+	}
+
 	var scAddresses []common.Address
 	scAddresses = append(scAddresses, cfg.Contracts.ZkEVMAddr, cfg.Contracts.RollupManagerAddr, cfg.Contracts.GlobalExitRootManagerAddr)
 
@@ -229,6 +262,16 @@ func NewClient(cfg Config) (*Client, error) {
 		return nil, err
 	}
 	log.Debug("rollupID: ", rollupID)
+	rollupIDs, rollupAddrToID, err := resolveTrackedRollups(rollupManager, cfg.Contracts, rollupID)
+	if err != nil {
+		log.Errorf("error resolving extra rollups. Error: %w", err)
+		return nil, err
+	}
+	for addr := range rollupAddrToID {
+		if addr != cfg.Contracts.ZkEVMAddr {
+			scAddresses = append(scAddresses, addr)
+		}
+	}
 	var validium *EthermanValidium
 
 	decodeEtrog, err := NewDecodeSequenceBatchesEtrog()
@@ -250,6 +293,8 @@ func NewClient(cfg Config) (*Client, error) {
 			log.Errorf("error creating NewEthermanValidium client. Error: %w", err)
 			return nil, err
 		}
+		// The events of the DA protocol are only used for the audit trail
+		scAddresses = append(scAddresses, validium.DataAvailabilityProtocolAddress)
 
 		decodeEtrogValidium, err := NewDecodeSequenceBatchesEtrogValidium(validium.DataAvailabilityClient)
 		if err != nil {
@@ -273,9 +318,11 @@ func NewClient(cfg Config) (*Client, error) {
 		RollupManager:            rollupManager,
 		GlobalExitRootManager:    globalExitRoot,
 		OldGlobalExitRootManager: oldGlobalExitRoot,
+		Proxy:                    proxyContract,
 		SCAddresses:              scAddresses,
 		RollupID:                 rollupID,
-		SequenceBatchesDecoders:  batchDecoders,
+		RollupIDs:                rollupIDs,
+		rollupAddrToID:           rollupAddrToID,
 		cfg:                      cfg,
 		auth:                     map[common.Address]bind.TransactOpts{},
 		validium:                 validium,
@@ -288,10 +335,37 @@ func NewClient(cfg Config) (*Client, error) {
 		}
 		client.validium = validium
 	}
+	for _, decoder := range batchDecoders {
+		client.AddSequenceBatchesDecoder(decoder)
+	}
+	if err := client.registerDefaultEventHandlers(); err != nil {
+		log.Errorf("error registering the event handlers. Error: %w", err)
+		return nil, err
+	}
 
 	return client, nil
 }
 
+// Close closes the connection with L1
+func (etherMan *Client) Close() {
+	if closer, ok := etherMan.EthClient.(interface{ Close() }); ok {
+		closer.Close()
+	}
+}
+
+// SubscribeNewHead subscribes to the new L1 blocks, it's only supported if L1URL is a websocket endpoint
+func (etherMan *Client) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	if !isWebsocketURL(etherMan.cfg.L1URL) {
+		return nil, ErrSubscriptionNotSupported
+	}
+	return etherMan.EthClient.SubscribeNewHead(ctx, ch)
+}
+
+func isWebsocketURL(url string) bool {
+	url = strings.ToLower(url)
+	return strings.HasPrefix(url, "ws://") || strings.HasPrefix(url, "wss://")
+}
+
 // GetRollupID returns the rollup ID
 func (etherMan *Client) GetRollupID() uint {
 	return uint(etherMan.RollupID)
@@ -430,7 +504,7 @@ func (etherMan *Client) GetForks(ctx context.Context, genBlockNumber uint64, las
 			if err != nil {
 				return []ForkIDInterval{}, err
 			}
-			if etherMan.RollupID != updateRollupEvent.RollupID {
+			if !etherMan.IsRollupTracked(updateRollupEvent.RollupID) {
 				continue
 			}
 			// Query to get the forkID
@@ -447,7 +521,7 @@ func (etherMan *Client) GetForks(ctx context.Context, genBlockNumber uint64, las
 			if err != nil {
 				return []ForkIDInterval{}, err
 			}
-			if etherMan.RollupID != addExistingRollupEvent.RollupID {
+			if !etherMan.IsRollupTracked(addExistingRollupEvent.RollupID) {
 				continue
 			}
 			zkevmVersion.ForkID = addExistingRollupEvent.ForkID
@@ -459,7 +533,7 @@ func (etherMan *Client) GetForks(ctx context.Context, genBlockNumber uint64, las
 			if err != nil {
 				return []ForkIDInterval{}, err
 			}
-			if etherMan.RollupID != createNewRollupEvent.RollupID {
+			if !etherMan.IsRollupTracked(createNewRollupEvent.RollupID) {
 				continue
 			}
 			// Query to get the forkID
@@ -592,7 +666,7 @@ func (etherMan *Client) readEvents(ctx context.Context, query ethereum.FilterQue
 	logs, err := etherMan.EthClient.FilterLogs(ctx, query)
 	metrics.GetEventsTime(time.Since(start))
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, translateFilterLogsError(ctx, err)
 	}
 	var blocks []Block
 	var blocksRetrieved map[common.Hash]Block
@@ -624,11 +698,23 @@ func (etherMan *Client) readEvents(ctx context.Context, query ethereum.FilterQue
 			return nil, nil, err
 		}
 	}
+	setBlocksNumLogs(blocks, logs)
 	metrics.ProcessAllEventTime(time.Since(startProcess))
 	metrics.ReadAndProcessAllEventsTime(time.Since(start))
 	return blocks, blocksOrder, nil
 }
 
+// setBlocksNumLogs sets the number of logs received of each block
+func setBlocksNumLogs(blocks []Block, logs []types.Log) {
+	numLogs := make(map[common.Hash]int)
+	for _, vLog := range logs {
+		numLogs[vLog.BlockHash]++
+	}
+	for i := range blocks {
+		blocks[i].NumLogs = numLogs[blocks[i].BlockHash]
+	}
+}
+
 func logEvents(logs []types.Log) {
 	log.Debug("Events detected: ", len(logs))
 	for _, vLog := range logs {
@@ -638,135 +724,8 @@ func logEvents(logs []types.Log) {
 }
 
 func (etherMan *Client) processEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
-	switch vLog.Topics[0] {
-	case sequenceBatchesSignatureHash:
-		return etherMan.sequencedBatchesEvent(ctx, vLog, blocks, blocksOrder)
-	case sequenceBatchesPreEtrogSignatureHash:
-		return etherMan.sequencedBatchesPreEtrogEvent(ctx, vLog, blocks, blocksOrder)
-	case updateGlobalExitRootSignatureHash:
-		return etherMan.updateGlobalExitRootEvent(ctx, vLog, blocks, blocksOrder)
-	case updateL1InfoTreeSignatureHash:
-		return etherMan.updateL1InfoTreeEvent(ctx, vLog, blocks, blocksOrder)
-	case forceBatchSignatureHash:
-		return etherMan.forcedBatchEvent(ctx, vLog, blocks, blocksOrder)
-	case initialSequenceBatchesSignatureHash:
-		return etherMan.initialSequenceBatches(ctx, vLog, blocks, blocksOrder)
-	case updateEtrogSequenceSignatureHash:
-		return etherMan.updateEtrogSequence(ctx, vLog, blocks, blocksOrder)
-	case verifyBatchesTrustedAggregatorSignatureHash:
-		log.Debug("VerifyBatchesTrustedAggregator event detected. Ignoring...")
-		return nil
-	case rollupManagerVerifyBatchesSignatureHash:
-		log.Debug("RollupManagerVerifyBatches event detected. Ignoring...")
-		return nil
-	case oldVerifyBatchesTrustedAggregatorSignatureHash:
-		return etherMan.oldVerifyBatchesTrustedAggregatorEvent(ctx, vLog, blocks, blocksOrder)
-	case verifyBatchesSignatureHash:
-		log.Debug("verifyBatchesSignatureHash event detected. Ignoring...")
-		return nil
-	case sequenceForceBatchesSignatureHash:
-		return etherMan.forceSequencedBatchesEvent(ctx, vLog, blocks, blocksOrder)
-	case setTrustedSequencerURLSignatureHash:
-		log.Debug("SetTrustedSequencerURL event detected. Ignoring...")
-		return nil
-	case setTrustedSequencerSignatureHash:
-		log.Debug("SetTrustedSequencer event detected. Ignoring...")
-		return nil
-	case initializedSignatureHash:
-		log.Debug("Initialized event detected. Ignoring...")
-		return nil
-	case initializedProxySignatureHash:
-		log.Debug("InitializedProxy event detected. Ignoring...")
-		return nil
-	case adminChangedSignatureHash:
-		log.Debug("AdminChanged event detected. Ignoring...")
-		return nil
-	case beaconUpgradedSignatureHash:
-		log.Debug("BeaconUpgraded event detected. Ignoring...")
-		return nil
-	case upgradedSignatureHash:
-		log.Debug("Upgraded event detected. Ignoring...")
-		return nil
-	case transferOwnershipSignatureHash:
-		log.Debug("TransferOwnership event detected. Ignoring...")
-		return nil
-	case emergencyStateActivatedSignatureHash:
-		log.Debug("EmergencyStateActivated event detected. Ignoring...")
-		return nil
-	case emergencyStateDeactivatedSignatureHash:
-		log.Debug("EmergencyStateDeactivated event detected. Ignoring...")
-		return nil
-	case updateZkEVMVersionSignatureHash:
-		return etherMan.updateZkevmVersion(ctx, vLog, blocks, blocksOrder)
-	case consolidatePendingStateSignatureHash:
-		log.Debug("ConsolidatePendingState event detected. Ignoring...")
-		return nil
-	case oldConsolidatePendingStateSignatureHash:
-		log.Debug("OldConsolidatePendingState event detected. Ignoring...")
-		return nil
-	case setTrustedAggregatorTimeoutSignatureHash:
-		log.Debug("SetTrustedAggregatorTimeout event detected. Ignoring...")
-		return nil
-	case setTrustedAggregatorSignatureHash:
-		log.Debug("SetTrustedAggregator event detected. Ignoring...")
-		return nil
-	case setPendingStateTimeoutSignatureHash:
-		log.Debug("SetPendingStateTimeout event detected. Ignoring...")
-		return nil
-	case setMultiplierBatchFeeSignatureHash:
-		log.Debug("SetMultiplierBatchFee event detected. Ignoring...")
-		return nil
-	case setVerifyBatchTimeTargetSignatureHash:
-		log.Debug("SetVerifyBatchTimeTarget event detected. Ignoring...")
-		return nil
-	case setForceBatchTimeoutSignatureHash:
-		log.Debug("SetForceBatchTimeout event detected. Ignoring...")
-		return nil
-	case setForceBatchAddressSignatureHash:
-		log.Debug("SetForceBatchAddress event detected. Ignoring...")
-		return nil
-	case transferAdminRoleSignatureHash:
-		log.Debug("TransferAdminRole event detected. Ignoring...")
-		return nil
-	case acceptAdminRoleSignatureHash:
-		log.Debug("AcceptAdminRole event detected. Ignoring...")
-		return nil
-	case proveNonDeterministicPendingStateSignatureHash:
-		log.Debug("ProveNonDeterministicPendingState event detected. Ignoring...")
-		return nil
-	case overridePendingStateSignatureHash:
-		log.Debug("OverridePendingState event detected. Ignoring...")
-		return nil
-	case oldOverridePendingStateSignatureHash:
-		log.Debug("OldOverridePendingState event detected. Ignoring...")
-		return nil
-	case roleAdminChangedSignatureHash:
-		log.Debug("RoleAdminChanged event detected. Ignoring...")
-		return nil
-	case roleGrantedSignatureHash:
-		log.Debug("RoleGranted event detected. Ignoring...")
-		return nil
-	case roleRevokedSignatureHash:
-		log.Debug("RoleRevoked event detected. Ignoring...")
-		return nil
-	case onSequenceBatchesSignatureHash:
-		log.Debug("OnSequenceBatches event detected. Ignoring...")
-		return nil
-	case updateRollupSignatureHash:
-		return etherMan.updateRollup(ctx, vLog, blocks, blocksOrder)
-	case addExistingRollupSignatureHash:
-		return etherMan.addExistingRollup(ctx, vLog, blocks, blocksOrder)
-	case createNewRollupSignatureHash:
-		return etherMan.createNewRollup(ctx, vLog, blocks, blocksOrder)
-	case obsoleteRollupTypeSignatureHash:
-		log.Debug("ObsoleteRollupType event detected. Ignoring...")
-		return nil
-	case addNewRollupTypeSignatureHash:
-		log.Debug("addNewRollupType event detected but not implemented. Ignoring...")
-		return nil
-	case setBatchFeeSignatureHash:
-		log.Debug("SetBatchFee event detected. Ignoring...")
-		return nil
+	if handler, ok := etherMan.eventHandlers[vLog.Topics[0]]; ok {
+		return handler(ctx, vLog, blocks, blocksOrder)
 	}
 	log.Warnf("Event not registered: %+v", vLog)
 	return nil
@@ -789,11 +748,21 @@ func (etherMan *Client) updateRollup(ctx context.Context, vLog types.Log, blocks
 		log.Error("error parsing UpdateRollup event. Error: ", err)
 		return err
 	}
-	rollupType, err := etherMan.RollupManager.RollupTypeMap(&bind.CallOpts{Pending: false}, updateRollup.NewRollupTypeID)
+	forkID, err := etherMan.getRollupTypeForkID(ctx, updateRollup.NewRollupTypeID, blocks)
+	if err != nil {
+		return err
+	}
+	err = etherMan.addRollup(ctx, vLog, blocks, blocksOrder, Rollup{
+		RollupID:                       updateRollup.RollupID,
+		Action:                         RollupActionUpdate,
+		RollupTypeID:                   updateRollup.NewRollupTypeID,
+		ForkID:                         forkID,
+		LastVerifiedBatchBeforeUpgrade: updateRollup.LastVerifiedBatchBeforeUpgrade,
+	})
 	if err != nil {
 		return err
 	}
-	return etherMan.updateForkId(ctx, vLog, blocks, blocksOrder, updateRollup.LastVerifiedBatchBeforeUpgrade, rollupType.ForkID, "", updateRollup.RollupID)
+	return etherMan.updateForkId(ctx, vLog, blocks, blocksOrder, updateRollup.LastVerifiedBatchBeforeUpgrade, forkID, "", updateRollup.RollupID)
 }
 
 func (etherMan *Client) createNewRollup(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
@@ -803,11 +772,23 @@ func (etherMan *Client) createNewRollup(ctx context.Context, vLog types.Log, blo
 		log.Error("error parsing createNewRollup event. Error: ", err)
 		return err
 	}
-	rollupType, err := etherMan.RollupManager.RollupTypeMap(&bind.CallOpts{Pending: false}, createRollup.RollupTypeID)
+	forkID, err := etherMan.getRollupTypeForkID(ctx, createRollup.RollupTypeID, blocks)
 	if err != nil {
 		return err
 	}
-	return etherMan.updateForkId(ctx, vLog, blocks, blocksOrder, 0, rollupType.ForkID, "", createRollup.RollupID)
+	err = etherMan.addRollup(ctx, vLog, blocks, blocksOrder, Rollup{
+		RollupID:        createRollup.RollupID,
+		Action:          RollupActionCreate,
+		RollupAddress:   createRollup.RollupAddress,
+		ChainID:         createRollup.ChainID,
+		GasTokenAddress: createRollup.GasTokenAddress,
+		RollupTypeID:    createRollup.RollupTypeID,
+		ForkID:          forkID,
+	})
+	if err != nil {
+		return err
+	}
+	return etherMan.updateForkId(ctx, vLog, blocks, blocksOrder, 0, forkID, "", createRollup.RollupID)
 }
 
 func (etherMan *Client) addExistingRollup(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
@@ -817,7 +798,17 @@ func (etherMan *Client) addExistingRollup(ctx context.Context, vLog types.Log, b
 		log.Error("error parsing createNewRollup event. Error: ", err)
 		return err
 	}
-
+	err = etherMan.addRollup(ctx, vLog, blocks, blocksOrder, Rollup{
+		RollupID:                       addExistingRollup.RollupID,
+		Action:                         RollupActionAddExisting,
+		RollupAddress:                  addExistingRollup.RollupAddress,
+		ChainID:                        addExistingRollup.ChainID,
+		ForkID:                         addExistingRollup.ForkID,
+		LastVerifiedBatchBeforeUpgrade: addExistingRollup.LastVerifiedBatchBeforeUpgrade,
+	})
+	if err != nil {
+		return err
+	}
 	return etherMan.updateForkId(ctx, vLog, blocks, blocksOrder, addExistingRollup.LastVerifiedBatchBeforeUpgrade, addExistingRollup.ForkID, "", addExistingRollup.RollupID)
 }
 
@@ -848,6 +839,7 @@ func (etherMan *Client) updateEtrogSequence(ctx context.Context, vLog types.Log,
 
 	log.Info("update Etrog transaction sequence...")
 	sequence := UpdateEtrogSequence{
+		RollupID:      etherMan.rollupIDFromLog(vLog),
 		BatchNumber:   updateEtrogSequence.NumBatch,
 		SequencerAddr: updateEtrogSequence.Sequencer,
 		TxHash:        vLog.TxHash,
@@ -906,6 +898,7 @@ func (etherMan *Client) initialSequenceBatches(ctx context.Context, vLog types.L
 	var sequences []SequencedBatch
 	log.Info("initial transaction sequence...")
 	sequences = append(sequences, SequencedBatch{
+		RollupID:      etherMan.rollupIDFromLog(vLog),
 		BatchNumber:   1,
 		SequencerAddr: initialSequenceBatches.Sequencer,
 		TxHash:        vLog.TxHash,
@@ -936,11 +929,12 @@ func (etherMan *Client) initialSequenceBatches(ctx context.Context, vLog types.L
 	return nil
 }
 func (etherMan *Client) updateForkId(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order, batchNum, forkID uint64, version string, affectedRollupID uint32) error {
-	if etherMan.RollupID != affectedRollupID {
-		log.Debug("ignoring this event because it is related to another rollup %d, we are rollupID %d", affectedRollupID, etherMan.RollupID)
+	if !etherMan.IsRollupTracked(affectedRollupID) {
+		log.Debugf("ignoring this event because it is related to another rollup %d, we are tracking rollupIDs %v", affectedRollupID, etherMan.RollupIDs)
 		return nil
 	}
 	fork := ForkID{
+		RollupID:    affectedRollupID,
 		BatchNumber: batchNum,
 		ForkID:      forkID,
 		Version:     version,
@@ -1138,6 +1132,7 @@ func (etherMan *Client) forcedBatchEvent(ctx context.Context, vLog types.Log, bl
 		return err
 	}
 	var forcedBatch ForcedBatch
+	forcedBatch.RollupID = etherMan.rollupIDFromLog(vLog)
 	forcedBatch.BlockNumber = vLog.BlockNumber
 	forcedBatch.ForcedBatchNumber = fb.ForceBatchNum
 	forcedBatch.GlobalExitRoot = fb.LastGlobalExitRoot
@@ -1242,6 +1237,7 @@ func (etherMan *Client) sequencedBatchesEvent(ctx context.Context, vLog types.Lo
 			Nonce:         msg.Nonce,
 		})
 	}
+	setSequencedBatchesRollupID(sequences, etherMan.rollupIDFromLog(vLog))
 
 	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
 		fullBlock, err := etherMan.EthClient.BlockByHash(ctx, vLog.BlockHash)
@@ -1301,6 +1297,7 @@ func (etherMan *Client) sequencedBatchesPreEtrogEvent(ctx context.Context, vLog
 	if err != nil {
 		return fmt.Errorf("error decoding the sequences: %v", err)
 	}
+	setSequencedBatchesRollupID(sequences, etherMan.RollupID)
 
 	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
 		fullBlock, err := etherMan.EthClient.BlockByHash(ctx, vLog.BlockHash)
@@ -1324,6 +1321,12 @@ func (etherMan *Client) sequencedBatchesPreEtrogEvent(ctx context.Context, vLog
 	return nil
 }
 
+func setSequencedBatchesRollupID(sequences []SequencedBatch, rollupID uint32) {
+	for i := range sequences {
+		sequences[i].RollupID = rollupID
+	}
+}
+
 func decodeSequencesPreEtrog(txData []byte, lastBatchNumber uint64, sequencer common.Address, txHash common.Hash, nonce uint64) ([]SequencedBatch, error) {
 	// Extract coded txs.
 	// Load contract ABI
@@ -1378,7 +1381,37 @@ func (etherMan *Client) oldVerifyBatchesTrustedAggregatorEvent(ctx context.Conte
 		log.Error("error parsing TrustedVerifyBatches event. Error: ", err)
 		return err
 	}
-	return etherMan.verifyBatches(ctx, vLog, blocks, blocksOrder, vb.NumBatch, vb.StateRoot, vb.Aggregator, TrustedVerifyBatchOrder)
+	return etherMan.verifyBatches(ctx, vLog, blocks, blocksOrder, etherMan.rollupIDFromLog(vLog), vb.NumBatch, vb.StateRoot, common.Hash{}, vb.Aggregator, TrustedVerifyBatchOrder)
+}
+
+func (etherMan *Client) verifyBatchesPreEtrogEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("VerifyBatches event detected")
+	vb, err := etherMan.OldZkEVM.ParseVerifyBatches(vLog)
+	if err != nil {
+		log.Error("error parsing VerifyBatches event. Error: ", err)
+		return err
+	}
+	return etherMan.verifyBatches(ctx, vLog, blocks, blocksOrder, etherMan.rollupIDFromLog(vLog), vb.NumBatch, vb.StateRoot, common.Hash{}, vb.Aggregator, VerifyBatchPreEtrogOrder)
+}
+
+func (etherMan *Client) verifyBatchesTrustedAggregatorEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("VerifyBatchesTrustedAggregator event detected")
+	vb, err := etherMan.RollupManager.ParseVerifyBatchesTrustedAggregator(vLog)
+	if err != nil {
+		log.Error("error parsing VerifyBatchesTrustedAggregator event. Error: ", err)
+		return err
+	}
+	return etherMan.verifyBatches(ctx, vLog, blocks, blocksOrder, vb.RollupID, vb.NumBatch, vb.StateRoot, vb.ExitRoot, vb.Aggregator, TrustedVerifyBatchOrder)
+}
+
+func (etherMan *Client) rollupManagerVerifyBatchesEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("RollupManagerVerifyBatches event detected")
+	vb, err := etherMan.RollupManager.ParseVerifyBatches(vLog)
+	if err != nil {
+		log.Error("error parsing RollupManagerVerifyBatches event. Error: ", err)
+		return err
+	}
+	return etherMan.verifyBatches(ctx, vLog, blocks, blocksOrder, vb.RollupID, vb.NumBatch, vb.StateRoot, vb.ExitRoot, vb.Aggregator, VerifyBatchOrder)
 }
 
 func (etherMan *Client) verifyBatches(
@@ -1386,15 +1419,23 @@ func (etherMan *Client) verifyBatches(
 	vLog types.Log,
 	blocks *[]Block,
 	blocksOrder *map[common.Hash][]Order,
+	rollupID uint32,
 	numBatch uint64,
 	stateRoot common.Hash,
+	exitRoot common.Hash,
 	aggregator common.Address,
 	orderName EventOrder) error {
+	if !etherMan.IsRollupTracked(rollupID) {
+		log.Debugf("ignoring this event because it is related to another rollup %d, we are tracking rollupIDs %v", rollupID, etherMan.RollupIDs)
+		return nil
+	}
 	var verifyBatch VerifiedBatch
+	verifyBatch.RollupID = rollupID
 	verifyBatch.BlockNumber = vLog.BlockNumber
 	verifyBatch.BatchNumber = numBatch
 	verifyBatch.TxHash = vLog.TxHash
 	verifyBatch.StateRoot = stateRoot
+	verifyBatch.ExitRoot = exitRoot
 	verifyBatch.Aggregator = aggregator
 
 	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
@@ -1447,6 +1488,9 @@ func (etherMan *Client) forceSequencedBatchesEvent(ctx context.Context, vLog typ
 	if err != nil {
 		return err
 	}
+	for i := range sequencedForceBatch {
+		sequencedForceBatch[i].RollupID = etherMan.rollupIDFromLog(vLog)
+	}
 
 	if len(*blocks) == 0 || ((*blocks)[len(*blocks)-1].BlockHash != vLog.BlockHash || (*blocks)[len(*blocks)-1].BlockNumber != vLog.BlockNumber) {
 		block := prepareBlock(vLog, time.Unix(int64(fullBlock.Time()), 0), fullBlock)
@@ -1478,7 +1522,12 @@ func decodeSequencedForceBatches(txData []byte, lastBatchNumber uint64, sequence
 	// Recover Method from signature and ABI
 	method, err := abi.MethodById(txData[:4])
 	if err != nil {
-		return nil, err
+		// It could be a sequenceForceBatches previous to Etrog
+		sequencedForcedBatches, errPreEtrog := decodeSequencedForceBatchesPreEtrog(txData, lastBatchNumber, sequencer, txHash, block, nonce)
+		if errPreEtrog != nil {
+			return nil, err
+		}
+		return sequencedForcedBatches, nil
 	}
 
 	// Unpack method inputs
@@ -1512,6 +1561,50 @@ func decodeSequencedForceBatches(txData []byte, lastBatchNumber uint64, sequence
 	return sequencedForcedBatches, nil
 }
 
+// decodeSequencedForceBatchesPreEtrog decodes a sequenceForceBatches of the PolygonZkEVM contract, the forced
+// data is returned as PolygonRollupBaseEtrogBatchData to keep the same output than Etrog
+func decodeSequencedForceBatchesPreEtrog(txData []byte, lastBatchNumber uint64, sequencer common.Address, txHash common.Hash, block *types.Block, nonce uint64) ([]SequencedForceBatch, error) {
+	smcAbi, err := abi.JSON(strings.NewReader(oldpolygonzkevm.OldpolygonzkevmABI))
+	if err != nil {
+		return nil, err
+	}
+	method, err := smcAbi.MethodById(txData[:4])
+	if err != nil {
+		return nil, err
+	}
+	data, err := method.Inputs.Unpack(txData[4:])
+	if err != nil {
+		return nil, err
+	}
+	var forceBatches []oldpolygonzkevm.PolygonZkEVMForcedBatchData
+	bytedata, err := json.Marshal(data[0])
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(bytedata, &forceBatches)
+	if err != nil {
+		return nil, err
+	}
+
+	sequencedForcedBatches := make([]SequencedForceBatch, len(forceBatches))
+	for i, force := range forceBatches {
+		bn := lastBatchNumber - uint64(len(forceBatches)-(i+1))
+		sequencedForcedBatches[i] = SequencedForceBatch{
+			BatchNumber: bn,
+			Coinbase:    sequencer,
+			TxHash:      txHash,
+			Timestamp:   time.Unix(int64(block.Time()), 0),
+			Nonce:       nonce,
+			PolygonRollupBaseEtrogBatchData: polygonzkevm.PolygonRollupBaseEtrogBatchData{
+				Transactions:         force.Transactions,
+				ForcedGlobalExitRoot: force.GlobalExitRoot,
+				ForcedTimestamp:      force.MinForcedTimestamp,
+			},
+		}
+	}
+	return sequencedForcedBatches, nil
+}
+
 func prepareBlock(vLog types.Log, t time.Time, fullBlock *types.Block) Block {
 	var block Block
 	block.BlockNumber = vLog.BlockNumber
@@ -0,0 +1,102 @@
+package etherman
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// decodeAuditEvent returns the AuditEvent of the log, the fields of the block are not set
+func (etherMan *Client) decodeAuditEvent(vLog types.Log) (*AuditEvent, error) {
+	res := &AuditEvent{
+		BlockNumber: vLog.BlockNumber,
+		TxHash:      vLog.TxHash,
+		Contract:    vLog.Address,
+	}
+	switch vLog.Topics[0] {
+	case adminChangedSignatureHash:
+		event, err := etherMan.Proxy.ParseAdminChanged(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.EventType = AuditAdminChanged
+		res.PreviousAccount = event.PreviousAdmin
+		res.Account = event.NewAdmin
+	case upgradedSignatureHash:
+		event, err := etherMan.Proxy.ParseUpgraded(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.EventType = AuditUpgraded
+		res.Implementation = event.Implementation
+	case beaconUpgradedSignatureHash:
+		event, err := etherMan.Proxy.ParseBeaconUpgraded(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.EventType = AuditBeaconUpgraded
+		res.Implementation = event.Beacon
+	// The events of AccessControl are decoded with the ABI of the RollupManager
+	case roleGrantedSignatureHash:
+		event, err := etherMan.RollupManager.ParseRoleGranted(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.EventType = AuditRoleGranted
+		res.Role = event.Role
+		res.Account = event.Account
+		res.Sender = event.Sender
+	case roleRevokedSignatureHash:
+		event, err := etherMan.RollupManager.ParseRoleRevoked(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.EventType = AuditRoleRevoked
+		res.Role = event.Role
+		res.Account = event.Account
+		res.Sender = event.Sender
+	case roleAdminChangedSignatureHash:
+		event, err := etherMan.RollupManager.ParseRoleAdminChanged(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.EventType = AuditRoleAdminChanged
+		res.Role = event.Role
+		res.PreviousAdminRole = event.PreviousAdminRole
+		res.AdminRole = event.NewAdminRole
+	// The events of Ownable are decoded with the ABI of PolygonZkEVM previous to LxLy
+	case transferOwnershipSignatureHash:
+		event, err := etherMan.OldZkEVM.ParseOwnershipTransferred(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.EventType = AuditOwnershipTransferred
+		res.PreviousAccount = event.PreviousOwner
+		res.Account = event.NewOwner
+	default:
+		return nil, fmt.Errorf("log %s is not an audit event", vLog.Topics[0].String())
+	}
+	return res, nil
+}
+
+func (etherMan *Client) auditEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	auditEvent, err := etherMan.decodeAuditEvent(vLog)
+	if err != nil {
+		log.Errorf("error parsing audit event %s. Error: %v", translateSignatureHash(vLog.Topics[0]), err)
+		return err
+	}
+	log.Debugf("%s event detected. Contract: %s", auditEvent.EventType, auditEvent.Contract.String())
+	block, err := etherMan.getBlockForEvent(ctx, vLog, blocks)
+	if err != nil {
+		return err
+	}
+	block.AuditEvents = append(block.AuditEvents, *auditEvent)
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
+		Name: AuditEventsOrder,
+		Pos:  len(block.AuditEvents) - 1,
+	})
+	return nil
+}
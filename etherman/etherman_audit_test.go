@@ -0,0 +1,72 @@
+package etherman
+
+import (
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/proxy"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeAuditEventUpgraded(t *testing.T) {
+	client := newClientForTest(t)
+	implementation := common.HexToAddress("0x1234")
+	vLog := types.Log{
+		Address:     common.HexToAddress("0x100"),
+		BlockNumber: 10,
+		Topics:      []common.Hash{upgradedSignatureHash, common.BytesToHash(implementation.Bytes())},
+	}
+
+	auditEvent, err := client.decodeAuditEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, AuditEvent{BlockNumber: 10, Contract: common.HexToAddress("0x100"), EventType: AuditUpgraded, Implementation: implementation}, *auditEvent)
+}
+
+func TestDecodeAuditEventRoleGranted(t *testing.T) {
+	client := newClientForTest(t)
+	role := common.HexToHash("0xab")
+	account := common.HexToAddress("0x1234")
+	sender := common.HexToAddress("0x5678")
+	vLog := types.Log{
+		Address: common.HexToAddress("0x100"),
+		Topics:  []common.Hash{roleGrantedSignatureHash, role, common.BytesToHash(account.Bytes()), common.BytesToHash(sender.Bytes())},
+	}
+
+	auditEvent, err := client.decodeAuditEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, AuditRoleGranted, auditEvent.EventType)
+	require.Equal(t, role, auditEvent.Role)
+	require.Equal(t, account, auditEvent.Account)
+	require.Equal(t, sender, auditEvent.Sender)
+}
+
+func TestDecodeAuditEventOwnershipTransferred(t *testing.T) {
+	client := newClientForTest(t)
+	previousOwner := common.HexToAddress("0x1234")
+	newOwner := common.HexToAddress("0x5678")
+	vLog := types.Log{
+		Address: common.HexToAddress("0x200"),
+		Topics:  []common.Hash{transferOwnershipSignatureHash, common.BytesToHash(previousOwner.Bytes()), common.BytesToHash(newOwner.Bytes())},
+	}
+
+	auditEvent, err := client.decodeAuditEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, AuditOwnershipTransferred, auditEvent.EventType)
+	require.Equal(t, previousOwner, auditEvent.PreviousAccount)
+	require.Equal(t, newOwner, auditEvent.Account)
+}
+
+func TestDecodeAuditEventAdminChanged(t *testing.T) {
+	client := newClientForTest(t)
+	vLog := newEventLogForTest(t, proxy.ProxyABI, "AdminChanged", common.HexToAddress("0x1"), common.HexToAddress("0x2"))
+
+	auditEvent, err := client.decodeAuditEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, AuditAdminChanged, auditEvent.EventType)
+	require.Equal(t, common.HexToAddress("0x1"), auditEvent.PreviousAccount)
+	require.Equal(t, common.HexToAddress("0x2"), auditEvent.Account)
+
+	_, err = client.decodeAuditEvent(types.Log{Topics: []common.Hash{sequenceBatchesSignatureHash}})
+	require.Error(t, err)
+}
@@ -0,0 +1,135 @@
+package etherman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrEventAlreadyRegistered is returned when there is a handler for the signature of the event
+	ErrEventAlreadyRegistered = errors.New("there is already a handler for the event")
+)
+
+// eventHandler decodes a log and adds it to its block and to the order of the events of the block
+type eventHandler func(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error
+
+// LogParser decodes a log of a custom event, the result is stored in Block.CustomEvents
+type LogParser func(ctx context.Context, vLog types.Log) (interface{}, error)
+
+// EventLogParser is a custom event (e.g. of a fork of the rollup contracts) that is decoded by Parser
+// and is processed by the L1EventProcessor that supports Order
+type EventLogParser struct {
+	// Signature is the hash of the event (Topics[0] of the log)
+	Signature common.Hash
+	// Order is the name of the event on the Block order, it must be different from the built-in ones
+	Order EventOrder
+	// Parser decodes the log
+	Parser LogParser
+}
+
+// RegisterEventLogParser adds a custom event. It must be called before starting the synchronization
+func (etherMan *Client) RegisterEventLogParser(parser EventLogParser) error {
+	if parser.Parser == nil || parser.Order == "" {
+		return fmt.Errorf("event log parser for %s must have Order and Parser", parser.Signature.String())
+	}
+	return etherMan.registerEventHandler(parser.Signature, func(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+		data, err := parser.Parser(ctx, vLog)
+		if err != nil {
+			log.Errorf("error parsing %s event. Error: %v", parser.Order, err)
+			return err
+		}
+		log.Debugf("%s event detected", parser.Order)
+		block, err := etherMan.getBlockForEvent(ctx, vLog, blocks)
+		if err != nil {
+			return err
+		}
+		block.CustomEvents = append(block.CustomEvents, CustomEvent{Order: parser.Order, Log: vLog, Data: data})
+		(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
+			Name: parser.Order,
+			Pos:  len(block.CustomEvents) - 1,
+		})
+		return nil
+	})
+}
+
+// AddSequenceBatchesDecoder adds a decoder for the calldata of the sequences, they are tried in
+// the order that they are added. It must be called before starting the synchronization
+func (etherMan *Client) AddSequenceBatchesDecoder(decoder SequenceBatchesDecoder) {
+	etherMan.SequenceBatchesDecoders = append(etherMan.SequenceBatchesDecoders, decoder)
+}
+
+func (etherMan *Client) registerEventHandler(signature common.Hash, handler eventHandler) error {
+	if etherMan.eventHandlers == nil {
+		etherMan.eventHandlers = make(map[common.Hash]eventHandler)
+	}
+	if _, ok := etherMan.eventHandlers[signature]; ok {
+		return fmt.Errorf("signature %s (%s). Err: %w", signature.String(), translateSignatureHash(signature), ErrEventAlreadyRegistered)
+	}
+	etherMan.eventHandlers[signature] = handler
+	return nil
+}
+
+func ignoreEvent(name string) eventHandler {
+	return func(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+		log.Debugf("%s event detected. Ignoring...", name)
+		return nil
+	}
+}
+
+// registerDefaultEventHandlers registers the events of the zkEVM contracts
+func (etherMan *Client) registerDefaultEventHandlers() error {
+	handlers := map[common.Hash]eventHandler{
+		sequenceBatchesSignatureHash:                   etherMan.sequencedBatchesEvent,
+		sequenceBatchesPreEtrogSignatureHash:           etherMan.sequencedBatchesPreEtrogEvent,
+		updateGlobalExitRootSignatureHash:              etherMan.updateGlobalExitRootEvent,
+		updateL1InfoTreeSignatureHash:                  etherMan.updateL1InfoTreeEvent,
+		forceBatchSignatureHash:                        etherMan.forcedBatchEvent,
+		initialSequenceBatchesSignatureHash:            etherMan.initialSequenceBatches,
+		updateEtrogSequenceSignatureHash:               etherMan.updateEtrogSequence,
+		verifyBatchesTrustedAggregatorSignatureHash:    etherMan.verifyBatchesTrustedAggregatorEvent,
+		rollupManagerVerifyBatchesSignatureHash:        etherMan.rollupManagerVerifyBatchesEvent,
+		oldVerifyBatchesTrustedAggregatorSignatureHash: etherMan.oldVerifyBatchesTrustedAggregatorEvent,
+		verifyBatchesSignatureHash:                     etherMan.verifyBatchesPreEtrogEvent,
+		sequenceForceBatchesSignatureHash:              etherMan.forceSequencedBatchesEvent,
+		initializedSignatureHash:                       ignoreEvent("Initialized"),
+		initializedProxySignatureHash:                  ignoreEvent("InitializedProxy"),
+		committeeUpdatedSignatureHash:                  ignoreEvent("CommitteeUpdated"),
+		onSequenceBatchesSignatureHash:                 ignoreEvent("OnSequenceBatches"),
+		updateZkEVMVersionSignatureHash:                etherMan.updateZkevmVersion,
+		updateRollupSignatureHash:                      etherMan.updateRollup,
+		addExistingRollupSignatureHash:                 etherMan.addExistingRollup,
+		createNewRollupSignatureHash:                   etherMan.createNewRollup,
+		obsoleteRollupTypeSignatureHash:                etherMan.obsoleteRollupType,
+		addNewRollupTypeSignatureHash:                  etherMan.addNewRollupType,
+	}
+	for _, signature := range []common.Hash{setTrustedSequencerURLSignatureHash, setTrustedSequencerSignatureHash,
+		emergencyStateActivatedSignatureHash, emergencyStateDeactivatedSignatureHash,
+		setTrustedAggregatorTimeoutSignatureHash, setTrustedAggregatorSignatureHash,
+		setPendingStateTimeoutSignatureHash, setMultiplierBatchFeeSignatureHash,
+		setVerifyBatchTimeTargetSignatureHash, setForceBatchTimeoutSignatureHash,
+		setForceBatchAddressSignatureHash, transferAdminRoleSignatureHash,
+		acceptAdminRoleSignatureHash, setBatchFeeSignatureHash} {
+		handlers[signature] = etherMan.governanceEvent
+	}
+	for _, signature := range []common.Hash{adminChangedSignatureHash, beaconUpgradedSignatureHash,
+		upgradedSignatureHash, transferOwnershipSignatureHash,
+		roleAdminChangedSignatureHash, roleGrantedSignatureHash, roleRevokedSignatureHash} {
+		handlers[signature] = etherMan.auditEvent
+	}
+	for _, signature := range []common.Hash{consolidatePendingStateSignatureHash, oldConsolidatePendingStateSignatureHash,
+		proveNonDeterministicPendingStateSignatureHash, overridePendingStateSignatureHash,
+		oldOverridePendingStateSignatureHash} {
+		handlers[signature] = etherMan.pendingStateEvent
+	}
+	for signature, handler := range handlers {
+		if err := etherMan.registerEventHandler(signature, handler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
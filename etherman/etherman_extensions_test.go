@@ -0,0 +1,95 @@
+package etherman
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+const customEventOrderForTest EventOrder = "CustomSequenceBatches"
+
+var customEventSignatureForTest = common.HexToHash("0x1234")
+
+func TestRegisterEventLogParserStoresTheCustomEvent(t *testing.T) {
+	client := newClientForTest(t)
+	require.NoError(t, client.registerDefaultEventHandlers())
+	err := client.RegisterEventLogParser(EventLogParser{
+		Signature: customEventSignatureForTest,
+		Order:     customEventOrderForTest,
+		Parser: func(ctx context.Context, vLog types.Log) (interface{}, error) {
+			return common.BytesToHash(vLog.Data).Big().Uint64(), nil
+		},
+	})
+	require.NoError(t, err)
+
+	blockHash := common.HexToHash("0xb1")
+	blocks := []Block{{BlockNumber: 10, BlockHash: blockHash}}
+	blocksOrder := map[common.Hash][]Order{}
+	vLog := types.Log{Topics: []common.Hash{customEventSignatureForTest}, Data: common.BigToHash(common.Big3).Bytes(),
+		BlockNumber: 10, BlockHash: blockHash}
+	require.NoError(t, client.processEvent(context.TODO(), vLog, &blocks, &blocksOrder))
+	require.NoError(t, client.processEvent(context.TODO(), vLog, &blocks, &blocksOrder))
+
+	require.Len(t, blocks, 1)
+	require.Len(t, blocks[0].CustomEvents, 2)
+	require.Equal(t, CustomEvent{Order: customEventOrderForTest, Log: vLog, Data: uint64(3)}, blocks[0].CustomEvents[1])
+	require.True(t, blocks[0].HasEvents())
+	require.Equal(t, []Order{{Name: customEventOrderForTest, Pos: 0}, {Name: customEventOrderForTest, Pos: 1}}, blocksOrder[blockHash])
+}
+
+func TestRegisterEventLogParserErrors(t *testing.T) {
+	client := newClientForTest(t)
+	require.NoError(t, client.registerDefaultEventHandlers())
+	parser := func(ctx context.Context, vLog types.Log) (interface{}, error) { return nil, nil }
+
+	err := client.RegisterEventLogParser(EventLogParser{Signature: sequenceBatchesSignatureHash, Order: customEventOrderForTest, Parser: parser})
+	require.ErrorIs(t, err, ErrEventAlreadyRegistered, "the built-in events can't be registered again")
+	err = client.RegisterEventLogParser(EventLogParser{Signature: customEventSignatureForTest, Order: customEventOrderForTest})
+	require.Error(t, err, "the parser is mandatory")
+
+	parseErr := errors.New("parse error")
+	err = client.RegisterEventLogParser(EventLogParser{Signature: customEventSignatureForTest, Order: customEventOrderForTest,
+		Parser: func(ctx context.Context, vLog types.Log) (interface{}, error) { return nil, parseErr }})
+	require.NoError(t, err)
+	blocks := []Block{}
+	blocksOrder := map[common.Hash][]Order{}
+	err = client.processEvent(context.TODO(), types.Log{Topics: []common.Hash{customEventSignatureForTest}}, &blocks, &blocksOrder)
+	require.ErrorIs(t, err, parseErr)
+	require.Empty(t, blocks)
+}
+
+type sequenceBatchesDecoderForTest struct {
+	methodID []byte
+}
+
+func (d *sequenceBatchesDecoderForTest) MatchMethodId(methodId []byte) bool {
+	return string(methodId) == string(d.methodID)
+}
+
+func (d *sequenceBatchesDecoderForTest) NameMethodID(methodId []byte) string {
+	if d.MatchMethodId(methodId) {
+		return "customSequenceBatches"
+	}
+	return ""
+}
+
+func (d *sequenceBatchesDecoderForTest) DecodeSequenceBatches(txData []byte, lastBatchNumber uint64, sequencer common.Address, txHash common.Hash, nonce uint64, l1InfoRoot common.Hash) ([]SequencedBatch, error) {
+	return []SequencedBatch{{BatchNumber: lastBatchNumber, SequencerAddr: sequencer, TxHash: txHash, Nonce: nonce, L1InfoRoot: &l1InfoRoot}}, nil
+}
+
+func TestAddSequenceBatchesDecoder(t *testing.T) {
+	client := newClientForTest(t)
+	txData := []byte{0xca, 0xfe, 0xca, 0xfe, 0x01}
+	_, err := client.decodeSequenceBatches(txData, 5, common.HexToAddress("0x5"), common.HexToHash("0x6"), 7, common.HexToHash("0x8"))
+	require.Error(t, err, "unknown methodId")
+
+	client.AddSequenceBatchesDecoder(&sequenceBatchesDecoderForTest{methodID: txData[:4]})
+	sequences, err := client.decodeSequenceBatches(txData, 5, common.HexToAddress("0x5"), common.HexToHash("0x6"), 7, common.HexToHash("0x8"))
+	require.NoError(t, err)
+	require.Len(t, sequences, 1)
+	require.Equal(t, uint64(5), sequences[0].BatchNumber)
+}
@@ -0,0 +1,132 @@
+package etherman
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// decodeGovernanceEvent returns the parameter changed by the log and its new value. isRollupParameter is true if the
+// parameter belongs to the rollup that emits the event, otherwise it belongs to the RollupManager
+func (etherMan *Client) decodeGovernanceEvent(vLog types.Log) (parameter GovernanceParameter, value string, isRollupParameter bool, err error) {
+	// The events of the rollup contracts and of PolygonZkEVM previous to LxLy have the same signature,
+	// so they are decoded with the ABI of the rollup contract
+	switch vLog.Topics[0] {
+	case setTrustedSequencerSignatureHash:
+		event, err := etherMan.ZkEVM.ParseSetTrustedSequencer(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernanceTrustedSequencer, event.NewTrustedSequencer.String(), true, nil
+	case setTrustedSequencerURLSignatureHash:
+		event, err := etherMan.ZkEVM.ParseSetTrustedSequencerURL(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernanceTrustedSequencerURL, event.NewTrustedSequencerURL, true, nil
+	case setForceBatchAddressSignatureHash:
+		event, err := etherMan.ZkEVM.ParseSetForceBatchAddress(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernanceForceBatchAddress, event.NewForceBatchAddress.String(), true, nil
+	case setForceBatchTimeoutSignatureHash:
+		event, err := etherMan.ZkEVM.ParseSetForceBatchTimeout(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernanceForceBatchTimeout, strconv.FormatUint(event.NewforceBatchTimeout, 10), true, nil
+	case transferAdminRoleSignatureHash:
+		event, err := etherMan.ZkEVM.ParseTransferAdminRole(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernancePendingAdmin, event.NewPendingAdmin.String(), true, nil
+	case acceptAdminRoleSignatureHash:
+		event, err := etherMan.ZkEVM.ParseAcceptAdminRole(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernanceAdmin, event.NewAdmin.String(), true, nil
+	// The events of the RollupManager and of PolygonZkEVM previous to LxLy have the same signature,
+	// so they are decoded with the ABI of the RollupManager
+	case emergencyStateActivatedSignatureHash:
+		_, err := etherMan.RollupManager.ParseEmergencyStateActivated(vLog)
+		return GovernanceEmergencyState, strconv.FormatBool(true), false, err
+	case emergencyStateDeactivatedSignatureHash:
+		_, err := etherMan.RollupManager.ParseEmergencyStateDeactivated(vLog)
+		return GovernanceEmergencyState, strconv.FormatBool(false), false, err
+	case setBatchFeeSignatureHash:
+		event, err := etherMan.RollupManager.ParseSetBatchFee(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernanceBatchFee, event.NewBatchFee.String(), false, nil
+	case setMultiplierBatchFeeSignatureHash:
+		event, err := etherMan.RollupManager.ParseSetMultiplierBatchFee(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernanceMultiplierBatchFee, strconv.FormatUint(uint64(event.NewMultiplierBatchFee), 10), false, nil
+	case setVerifyBatchTimeTargetSignatureHash:
+		event, err := etherMan.RollupManager.ParseSetVerifyBatchTimeTarget(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernanceVerifyBatchTimeTarget, strconv.FormatUint(event.NewVerifyBatchTimeTarget, 10), false, nil
+	case setTrustedAggregatorSignatureHash:
+		event, err := etherMan.RollupManager.ParseSetTrustedAggregator(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernanceTrustedAggregator, event.NewTrustedAggregator.String(), false, nil
+	case setTrustedAggregatorTimeoutSignatureHash:
+		event, err := etherMan.RollupManager.ParseSetTrustedAggregatorTimeout(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernanceTrustedAggregatorTimeout, strconv.FormatUint(event.NewTrustedAggregatorTimeout, 10), false, nil
+	case setPendingStateTimeoutSignatureHash:
+		event, err := etherMan.RollupManager.ParseSetPendingStateTimeout(vLog)
+		if err != nil {
+			return "", "", false, err
+		}
+		return GovernancePendingStateTimeout, strconv.FormatUint(event.NewPendingStateTimeout, 10), false, nil
+	}
+	return "", "", false, fmt.Errorf("log %s is not a governance event", vLog.Topics[0].String())
+}
+
+func (etherMan *Client) governanceEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	parameter, value, isRollupParameter, err := etherMan.decodeGovernanceEvent(vLog)
+	if err != nil {
+		log.Errorf("error parsing governance event %s. Error: %v", translateSignatureHash(vLog.Topics[0]), err)
+		return err
+	}
+	log.Debugf("Governance event detected. Parameter: %s, Value: %s", parameter, value)
+	governanceEvent := GovernanceEvent{
+		RollupID:    GovernanceRollupManagerID,
+		BlockNumber: vLog.BlockNumber,
+		TxHash:      vLog.TxHash,
+		Contract:    vLog.Address,
+		Parameter:   parameter,
+		Value:       value,
+	}
+	if isRollupParameter {
+		governanceEvent.RollupID = etherMan.rollupIDFromLog(vLog)
+	}
+
+	block, err := etherMan.getBlockForEvent(ctx, vLog, blocks)
+	if err != nil {
+		return err
+	}
+	block.GovernanceEvents = append(block.GovernanceEvents, governanceEvent)
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
+		Name: GovernanceEventsOrder,
+		Pos:  len(block.GovernanceEvents) - 1,
+	})
+	return nil
+}
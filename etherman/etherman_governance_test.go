@@ -0,0 +1,53 @@
+package etherman
+
+import (
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonrollupmanager"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonzkevm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeGovernanceEventRollupParameter(t *testing.T) {
+	client := newClientForTest(t)
+	vLog := newEventLogForTest(t, polygonzkevm.PolygonzkevmABI, "SetTrustedSequencerURL", "http://sequencer:8123")
+
+	parameter, value, isRollupParameter, err := client.decodeGovernanceEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, GovernanceTrustedSequencerURL, parameter)
+	require.Equal(t, "http://sequencer:8123", value)
+	require.True(t, isRollupParameter)
+
+	vLog = newEventLogForTest(t, polygonzkevm.PolygonzkevmABI, "SetForceBatchTimeout", uint64(3600))
+	parameter, value, isRollupParameter, err = client.decodeGovernanceEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, GovernanceForceBatchTimeout, parameter)
+	require.Equal(t, "3600", value)
+	require.True(t, isRollupParameter)
+}
+
+func TestDecodeGovernanceEventRollupManagerParameter(t *testing.T) {
+	client := newClientForTest(t)
+	vLog := newEventLogForTest(t, polygonrollupmanager.PolygonrollupmanagerABI, "EmergencyStateActivated")
+
+	parameter, value, isRollupParameter, err := client.decodeGovernanceEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, GovernanceEmergencyState, parameter)
+	require.Equal(t, "true", value)
+	require.False(t, isRollupParameter)
+
+	vLog = newEventLogForTest(t, polygonrollupmanager.PolygonrollupmanagerABI, "SetTrustedAggregator", common.HexToAddress("0x1234"))
+	parameter, value, isRollupParameter, err = client.decodeGovernanceEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, GovernanceTrustedAggregator, parameter)
+	require.Equal(t, common.HexToAddress("0x1234").String(), value)
+	require.False(t, isRollupParameter)
+}
+
+func TestDecodeGovernanceEventUnknown(t *testing.T) {
+	client := newClientForTest(t)
+	_, _, _, err := client.decodeGovernanceEvent(types.Log{Topics: []common.Hash{sequenceBatchesSignatureHash}})
+	require.Error(t, err)
+}
@@ -0,0 +1,47 @@
+package etherman
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/oldpolygonzkevm"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonrollupmanager"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonzkevm"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/proxy"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// newClientForTest returns a client without L1 connection that can decode the logs of the tracked rollups (0x1, 0x2)
+// and of the RollupManager (0x100)
+func newClientForTest(t *testing.T) *Client {
+	zkevm, err := polygonzkevm.NewPolygonzkevm(common.HexToAddress("0x1"), nil)
+	require.NoError(t, err)
+	rollupManager, err := polygonrollupmanager.NewPolygonrollupmanager(common.HexToAddress("0x100"), nil)
+	require.NoError(t, err)
+	proxyContract, err := proxy.NewProxy(common.HexToAddress("0x100"), nil)
+	require.NoError(t, err)
+	oldZkevm, err := oldpolygonzkevm.NewOldpolygonzkevm(common.HexToAddress("0x100"), nil)
+	require.NoError(t, err)
+	return &Client{
+		ZkEVM:          zkevm,
+		OldZkEVM:       oldZkevm,
+		RollupManager:  rollupManager,
+		Proxy:          proxyContract,
+		RollupID:       1,
+		RollupIDs:      []uint32{1, 2},
+		rollupAddrToID: map[common.Address]uint32{common.HexToAddress("0x1"): 1, common.HexToAddress("0x2"): 2},
+	}
+}
+
+// newEventLogForTest returns a log of the event of the contract with the non-indexed args packed as data
+func newEventLogForTest(t *testing.T, contractABI string, eventName string, args ...interface{}) types.Log {
+	parsed, err := abi.JSON(strings.NewReader(contractABI))
+	require.NoError(t, err)
+	event := parsed.Events[eventName]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	require.NoError(t, err)
+	return types.Log{Topics: []common.Hash{event.ID}, Data: data}
+}
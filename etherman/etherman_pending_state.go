@@ -0,0 +1,97 @@
+package etherman
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// decodePendingStateEvent returns the PendingStateEvent of the log
+func (etherMan *Client) decodePendingStateEvent(vLog types.Log) (*PendingStateEvent, error) {
+	res := &PendingStateEvent{
+		BlockNumber: vLog.BlockNumber,
+		TxHash:      vLog.TxHash,
+	}
+	switch vLog.Topics[0] {
+	case consolidatePendingStateSignatureHash:
+		event, err := etherMan.RollupManager.ParseConsolidatePendingState(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.RollupID = event.RollupID
+		res.Action = PendingStateConsolidated
+		res.BatchNumber = event.NumBatch
+		res.StateRoot = event.StateRoot
+		res.ExitRoot = event.ExitRoot
+		res.PendingStateNum = event.PendingStateNum
+	case oldConsolidatePendingStateSignatureHash:
+		event, err := etherMan.OldZkEVM.ParseConsolidatePendingState(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.RollupID = etherMan.rollupIDFromLog(vLog)
+		res.Action = PendingStateConsolidated
+		res.BatchNumber = event.NumBatch
+		res.StateRoot = event.StateRoot
+		res.PendingStateNum = event.PendingStateNum
+	case overridePendingStateSignatureHash:
+		event, err := etherMan.RollupManager.ParseOverridePendingState(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.RollupID = event.RollupID
+		res.Action = PendingStateOverridden
+		res.BatchNumber = event.NumBatch
+		res.StateRoot = event.StateRoot
+		res.ExitRoot = event.ExitRoot
+		res.Aggregator = event.Aggregator
+	case oldOverridePendingStateSignatureHash:
+		event, err := etherMan.OldZkEVM.ParseOverridePendingState(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.RollupID = etherMan.rollupIDFromLog(vLog)
+		res.Action = PendingStateOverridden
+		res.BatchNumber = event.NumBatch
+		res.StateRoot = event.StateRoot
+		res.Aggregator = event.Aggregator
+	// The event doesn't include the rollup, it's the same for PolygonZkEVM previous to LxLy and for the RollupManager
+	case proveNonDeterministicPendingStateSignatureHash:
+		event, err := etherMan.RollupManager.ParseProveNonDeterministicPendingState(vLog)
+		if err != nil {
+			return nil, err
+		}
+		res.Action = PendingStateProvedNonDeterministic
+		res.StoredStateRoot = event.StoredStateRoot
+		res.ProvedStateRoot = event.ProvedStateRoot
+	default:
+		return nil, fmt.Errorf("log %s is not a pending state event", vLog.Topics[0].String())
+	}
+	return res, nil
+}
+
+func (etherMan *Client) pendingStateEvent(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	pendingStateEvent, err := etherMan.decodePendingStateEvent(vLog)
+	if err != nil {
+		log.Errorf("error parsing pending state event %s. Error: %v", translateSignatureHash(vLog.Topics[0]), err)
+		return err
+	}
+	if pendingStateEvent.Action != PendingStateProvedNonDeterministic && !etherMan.IsRollupTracked(pendingStateEvent.RollupID) {
+		log.Debugf("ignoring this event because it is related to another rollup %d, we are tracking rollupIDs %v", pendingStateEvent.RollupID, etherMan.RollupIDs)
+		return nil
+	}
+	log.Debugf("PendingState %s event detected. RollupID: %d BatchNumber: %d", pendingStateEvent.Action, pendingStateEvent.RollupID, pendingStateEvent.BatchNumber)
+	block, err := etherMan.getBlockForEvent(ctx, vLog, blocks)
+	if err != nil {
+		return err
+	}
+	block.PendingStateEvents = append(block.PendingStateEvents, *pendingStateEvent)
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
+		Name: PendingStateEventsOrder,
+		Pos:  len(block.PendingStateEvents) - 1,
+	})
+	return nil
+}
@@ -0,0 +1,50 @@
+package etherman
+
+import (
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/oldpolygonzkevm"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonrollupmanager"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodePendingStateEventConsolidate(t *testing.T) {
+	client := newClientForTest(t)
+	stateRoot := common.HexToHash("0xab")
+	exitRoot := common.HexToHash("0xcd")
+	vLog := newEventLogForTest(t, polygonrollupmanager.PolygonrollupmanagerABI, "ConsolidatePendingState", uint64(100), stateRoot, exitRoot, uint64(3))
+	vLog.Topics = append(vLog.Topics, common.BigToHash(common.Big2))
+	vLog.BlockNumber = 10
+
+	pendingStateEvent, err := client.decodePendingStateEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, PendingStateEvent{RollupID: 2, BlockNumber: 10, Action: PendingStateConsolidated, BatchNumber: 100,
+		StateRoot: stateRoot, ExitRoot: exitRoot, PendingStateNum: 3}, *pendingStateEvent)
+}
+
+func TestDecodePendingStateEventOldOverride(t *testing.T) {
+	client := newClientForTest(t)
+	stateRoot := common.HexToHash("0xab")
+	aggregator := common.HexToAddress("0x1234")
+	vLog := newEventLogForTest(t, oldpolygonzkevm.OldpolygonzkevmABI, "OverridePendingState", stateRoot)
+	vLog.Topics = append(vLog.Topics, common.BigToHash(common.Big3), common.BytesToHash(aggregator.Bytes()))
+	vLog.Address = common.HexToAddress("0x2")
+
+	pendingStateEvent, err := client.decodePendingStateEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, PendingStateEvent{RollupID: 2, Action: PendingStateOverridden, BatchNumber: 3,
+		StateRoot: stateRoot, Aggregator: aggregator}, *pendingStateEvent)
+}
+
+func TestDecodePendingStateEventProveNonDeterministic(t *testing.T) {
+	client := newClientForTest(t)
+	storedStateRoot := common.HexToHash("0xab")
+	provedStateRoot := common.HexToHash("0xcd")
+	vLog := newEventLogForTest(t, polygonrollupmanager.PolygonrollupmanagerABI, "ProveNonDeterministicPendingState", storedStateRoot, provedStateRoot)
+
+	pendingStateEvent, err := client.decodePendingStateEvent(vLog)
+	require.NoError(t, err)
+	require.Equal(t, PendingStateEvent{Action: PendingStateProvedNonDeterministic,
+		StoredStateRoot: storedStateRoot, ProvedStateRoot: provedStateRoot}, *pendingStateEvent)
+}
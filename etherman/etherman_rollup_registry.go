@@ -0,0 +1,168 @@
+package etherman
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// RollupData is the current data of a rollup on the RollupManager
+type RollupData struct {
+	RollupID      uint32
+	RollupAddress common.Address
+	ChainID       uint64
+	Verifier      common.Address
+	ForkID        uint64
+	// RollupTypeID is 0 if the rollup have been added with AddExistingRollup and not updated since
+	RollupTypeID          uint64
+	RollupCompatibilityID uint8
+}
+
+// RollupTypeReader gives access to the rollup types already synchronized, so the forkID of a rollup type
+// doesn't have to be requested to the RollupManager for each rollup created or updated
+type RollupTypeReader interface {
+	// GetRollupTypeForkID returns the forkID of the rollup type, found is false if it's not synchronized
+	GetRollupTypeForkID(ctx context.Context, rollupTypeID uint64) (forkID uint64, found bool, err error)
+}
+
+// SetRollupTypeReader sets the source of the rollup types already synchronized
+func (etherMan *Client) SetRollupTypeReader(reader RollupTypeReader) {
+	etherMan.rollupTypeReader = reader
+}
+
+// HasRollupManager returns true if there is a RollupManager contract configured
+func (etherMan *Client) HasRollupManager() bool {
+	return etherMan.RollupManager != nil && etherMan.cfg.Contracts.RollupManagerAddr != (common.Address{})
+}
+
+// GetRollupData returns the current data of the rollup from the RollupManager
+func (etherMan *Client) GetRollupData(ctx context.Context, rollupID uint32) (*RollupData, error) {
+	data, err := etherMan.RollupManager.RollupIDToRollupData(&bind.CallOpts{Pending: false, Context: ctx}, rollupID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the data of rollupID %d from RollupManager. Error: %w", rollupID, err)
+	}
+	return &RollupData{
+		RollupID:              rollupID,
+		RollupAddress:         data.RollupContract,
+		ChainID:               data.ChainID,
+		Verifier:              data.Verifier,
+		ForkID:                data.ForkID,
+		RollupTypeID:          data.RollupTypeID,
+		RollupCompatibilityID: data.RollupCompatibilityID,
+	}, nil
+}
+
+// getRollupTypeForkID returns the forkID of the rollup type. It's searched on the blocks of the range being
+// processed, then on the rollup types synchronized and, if it's not found (e.g. the type have been added before
+// the genesis block), it's requested to the RollupManager
+func (etherMan *Client) getRollupTypeForkID(ctx context.Context, rollupTypeID uint32, blocks *[]Block) (uint64, error) {
+	for i := len(*blocks) - 1; i >= 0; i-- {
+		for _, rollupType := range (*blocks)[i].RollupTypes {
+			if rollupType.RollupTypeID == rollupTypeID {
+				return rollupType.ForkID, nil
+			}
+		}
+	}
+	if etherMan.rollupTypeReader != nil {
+		forkID, found, err := etherMan.rollupTypeReader.GetRollupTypeForkID(ctx, uint64(rollupTypeID))
+		if err != nil {
+			return 0, fmt.Errorf("error getting the synchronized rollupTypeID %d. Error: %w", rollupTypeID, err)
+		}
+		if found {
+			return forkID, nil
+		}
+	}
+	log.Debugf("RollupTypeID %d is not synchronized, requesting it to the RollupManager", rollupTypeID)
+	rollupType, err := etherMan.RollupManager.RollupTypeMap(&bind.CallOpts{Pending: false, Context: ctx}, rollupTypeID)
+	if err != nil {
+		return 0, fmt.Errorf("error getting rollupTypeID %d from RollupManager. Error: %w", rollupTypeID, err)
+	}
+	return rollupType.ForkID, nil
+}
+
+func (etherMan *Client) addNewRollupType(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("AddNewRollupType event detected")
+	event, err := etherMan.RollupManager.ParseAddNewRollupType(vLog)
+	if err != nil {
+		log.Error("error parsing AddNewRollupType event. Error: ", err)
+		return err
+	}
+	rollupType := RollupType{
+		RollupTypeID:            event.RollupTypeID,
+		BlockNumber:             vLog.BlockNumber,
+		TxHash:                  vLog.TxHash,
+		ConsensusImplementation: event.ConsensusImplementation,
+		Verifier:                event.Verifier,
+		ForkID:                  event.ForkID,
+		RollupCompatibilityID:   event.RollupCompatibilityID,
+		Genesis:                 event.Genesis,
+		Description:             event.Description,
+	}
+	block, err := etherMan.getBlockForEvent(ctx, vLog, blocks)
+	if err != nil {
+		return err
+	}
+	block.RollupTypes = append(block.RollupTypes, rollupType)
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
+		Name: RollupTypesOrder,
+		Pos:  len(block.RollupTypes) - 1,
+	})
+	return nil
+}
+
+func (etherMan *Client) obsoleteRollupType(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order) error {
+	log.Debug("ObsoleteRollupType event detected")
+	event, err := etherMan.RollupManager.ParseObsoleteRollupType(vLog)
+	if err != nil {
+		log.Error("error parsing ObsoleteRollupType event. Error: ", err)
+		return err
+	}
+	block, err := etherMan.getBlockForEvent(ctx, vLog, blocks)
+	if err != nil {
+		return err
+	}
+	block.ObsoleteRollupTypes = append(block.ObsoleteRollupTypes, ObsoleteRollupType{
+		RollupTypeID: event.RollupTypeID,
+		BlockNumber:  vLog.BlockNumber,
+		TxHash:       vLog.TxHash,
+	})
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
+		Name: ObsoleteRollupTypesOrder,
+		Pos:  len(block.ObsoleteRollupTypes) - 1,
+	})
+	return nil
+}
+
+// addRollup adds to the registry a rollup created, added or updated. All the rollups are added, not only the tracked ones
+func (etherMan *Client) addRollup(ctx context.Context, vLog types.Log, blocks *[]Block, blocksOrder *map[common.Hash][]Order, rollup Rollup) error {
+	rollup.BlockNumber = vLog.BlockNumber
+	rollup.TxHash = vLog.TxHash
+	block, err := etherMan.getBlockForEvent(ctx, vLog, blocks)
+	if err != nil {
+		return err
+	}
+	block.Rollups = append(block.Rollups, rollup)
+	(*blocksOrder)[block.BlockHash] = append((*blocksOrder)[block.BlockHash], Order{
+		Name: RollupsOrder,
+		Pos:  len(block.Rollups) - 1,
+	})
+	return nil
+}
+
+// getBlockForEvent returns the block of the log, it's the last one of blocks or a new one appended to blocks
+func (etherMan *Client) getBlockForEvent(ctx context.Context, vLog types.Log, blocks *[]Block) (*Block, error) {
+	if isheadBlockInArray(blocks, vLog.BlockHash, vLog.BlockNumber) {
+		return &(*blocks)[len(*blocks)-1], nil
+	}
+	fullBlock, err := etherMan.EthClient.BlockByHash(ctx, vLog.BlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("error getting hashParent. BlockNumber: %d. Error: %w", vLog.BlockNumber, err)
+	}
+	*blocks = append(*blocks, prepareBlock(vLog, time.Unix(int64(fullBlock.Time()), 0), fullBlock))
+	return &(*blocks)[len(*blocks)-1], nil
+}
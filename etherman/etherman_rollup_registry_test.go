@@ -0,0 +1,94 @@
+package etherman
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonrollupmanager"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddNewRollupTypeAndObsoleteRollupTypeOnSameBlock(t *testing.T) {
+	client := newClientForTest(t)
+	blockHash := common.HexToHash("0xabcd")
+	blocks := []Block{{BlockNumber: 100, BlockHash: blockHash}}
+	blocksOrder := map[common.Hash][]Order{}
+
+	vLog := newEventLogForTest(t, polygonrollupmanager.PolygonrollupmanagerABI, "AddNewRollupType",
+		common.HexToAddress("0x10"), common.HexToAddress("0x11"), uint64(9), uint8(0), common.HexToHash("0x12"), "type fork9")
+	vLog.Topics = append(vLog.Topics, common.BigToHash(big.NewInt(3)))
+	vLog.BlockNumber = 100
+	vLog.BlockHash = blockHash
+	vLog.TxHash = common.HexToHash("0x99")
+	require.NoError(t, client.addNewRollupType(context.Background(), vLog, &blocks, &blocksOrder))
+
+	vLog = newEventLogForTest(t, polygonrollupmanager.PolygonrollupmanagerABI, "ObsoleteRollupType")
+	vLog.Topics = append(vLog.Topics, common.BigToHash(big.NewInt(2)))
+	vLog.BlockNumber = 100
+	vLog.BlockHash = blockHash
+	require.NoError(t, client.obsoleteRollupType(context.Background(), vLog, &blocks, &blocksOrder))
+
+	require.Len(t, blocks, 1)
+	require.Equal(t, []RollupType{{
+		RollupTypeID:            3,
+		BlockNumber:             100,
+		TxHash:                  common.HexToHash("0x99"),
+		ConsensusImplementation: common.HexToAddress("0x10"),
+		Verifier:                common.HexToAddress("0x11"),
+		ForkID:                  9,
+		Genesis:                 common.HexToHash("0x12"),
+		Description:             "type fork9",
+	}}, blocks[0].RollupTypes)
+	require.Equal(t, []ObsoleteRollupType{{RollupTypeID: 2, BlockNumber: 100}}, blocks[0].ObsoleteRollupTypes)
+	require.Equal(t, []Order{{Name: RollupTypesOrder, Pos: 0}, {Name: ObsoleteRollupTypesOrder, Pos: 0}}, blocksOrder[blockHash])
+	require.True(t, blocks[0].HasEvents())
+}
+
+func TestAddRollupFillsBlockData(t *testing.T) {
+	client := newClientForTest(t)
+	blockHash := common.HexToHash("0xabcd")
+	blocks := []Block{{BlockNumber: 100, BlockHash: blockHash}}
+	blocksOrder := map[common.Hash][]Order{}
+	vLog := newEventLogForTest(t, polygonrollupmanager.PolygonrollupmanagerABI, "ObsoleteRollupType")
+	vLog.BlockNumber = 100
+	vLog.BlockHash = blockHash
+	vLog.TxHash = common.HexToHash("0x99")
+
+	err := client.addRollup(context.Background(), vLog, &blocks, &blocksOrder, Rollup{RollupID: 5, Action: RollupActionUpdate, RollupTypeID: 3, ForkID: 9})
+	require.NoError(t, err)
+	require.Equal(t, []Rollup{{RollupID: 5, BlockNumber: 100, TxHash: common.HexToHash("0x99"), Action: RollupActionUpdate, RollupTypeID: 3, ForkID: 9}},
+		blocks[0].Rollups)
+	require.Equal(t, []Order{{Name: RollupsOrder, Pos: 0}}, blocksOrder[blockHash])
+}
+
+type rollupTypeReaderForTest map[uint64]uint64
+
+func (r rollupTypeReaderForTest) GetRollupTypeForkID(ctx context.Context, rollupTypeID uint64) (uint64, bool, error) {
+	if rollupTypeID == 0 {
+		return 0, false, errors.New("db error")
+	}
+	forkID, found := r[rollupTypeID]
+	return forkID, found, nil
+}
+
+func TestGetRollupTypeForkIDWithoutRequestingRollupManager(t *testing.T) {
+	client := newClientForTest(t)
+	client.SetRollupTypeReader(rollupTypeReaderForTest{2: 8})
+	blocks := []Block{{BlockNumber: 100, RollupTypes: []RollupType{{RollupTypeID: 3, ForkID: 9}}}}
+
+	// Added on the range being processed
+	forkID, err := client.getRollupTypeForkID(context.Background(), 3, &blocks)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), forkID)
+
+	// Already synchronized
+	forkID, err = client.getRollupTypeForkID(context.Background(), 2, &blocks)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), forkID)
+
+	_, err = client.getRollupTypeForkID(context.Background(), 0, &blocks)
+	require.Error(t, err)
+}
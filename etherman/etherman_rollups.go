@@ -0,0 +1,98 @@
+package etherman
+
+import (
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonrollupmanager"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// rollupManagerRollupQuerier is the subset of RollupManager used to resolve the rollups to track
+type rollupManagerRollupQuerier interface {
+	RollupAddressToID(opts *bind.CallOpts, rollupAddress common.Address) (uint32, error)
+	RollupIDToRollupData(opts *bind.CallOpts, rollupID uint32) (struct {
+		RollupContract                 common.Address
+		ChainID                        uint64
+		Verifier                       common.Address
+		ForkID                         uint64
+		LastLocalExitRoot              [32]byte
+		LastBatchSequenced             uint64
+		LastVerifiedBatch              uint64
+		LastPendingState               uint64
+		LastPendingStateConsolidated   uint64
+		LastVerifiedBatchBeforeUpgrade uint64
+		RollupTypeID                   uint64
+		RollupCompatibilityID          uint8
+	}, error)
+}
+
+var _ rollupManagerRollupQuerier = (*polygonrollupmanager.PolygonrollupmanagerCaller)(nil)
+
+// resolveTrackedRollups returns the list of rollupIDs to synchronize (the main one is the first)
+// and a map from rollup contract address to rollupID
+func resolveTrackedRollups(rollupManager rollupManagerRollupQuerier, contracts ContractConfig, mainRollupID uint32) ([]uint32, map[common.Address]uint32, error) {
+	rollupIDs := []uint32{mainRollupID}
+	addrToID := map[common.Address]uint32{contracts.ZkEVMAddr: mainRollupID}
+	for _, addr := range contracts.ExtraRollupAddrs {
+		rollupID, err := rollupManager.RollupAddressToID(&bind.CallOpts{Pending: false}, addr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error rollupManager.RollupAddressToID(%s). Error: %w", addr.String(), err)
+		}
+		if rollupID == 0 {
+			return nil, nil, fmt.Errorf("rollup address %s is not attached to RollupManager %s", addr.String(), contracts.RollupManagerAddr.String())
+		}
+		rollupIDs, addrToID = addTrackedRollup(rollupIDs, addrToID, rollupID, addr)
+	}
+	for _, rollupID := range contracts.ExtraRollupIDs {
+		rollupData, err := rollupManager.RollupIDToRollupData(&bind.CallOpts{Pending: false}, rollupID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error rollupManager.RollupIDToRollupData(%d). Error: %w", rollupID, err)
+		}
+		if rollupData.RollupContract == (common.Address{}) {
+			return nil, nil, fmt.Errorf("rollupID %d is not attached to RollupManager %s", rollupID, contracts.RollupManagerAddr.String())
+		}
+		rollupIDs, addrToID = addTrackedRollup(rollupIDs, addrToID, rollupID, rollupData.RollupContract)
+	}
+	return rollupIDs, addrToID, nil
+}
+
+func addTrackedRollup(rollupIDs []uint32, addrToID map[common.Address]uint32, rollupID uint32, addr common.Address) ([]uint32, map[common.Address]uint32) {
+	if _, ok := addrToID[addr]; ok {
+		log.Warnf("rollup %d (%s) is configured twice, ignoring duplicate", rollupID, addr.String())
+		return rollupIDs, addrToID
+	}
+	log.Infof("tracking extra rollupID: %d address: %s", rollupID, addr.String())
+	addrToID[addr] = rollupID
+	return append(rollupIDs, rollupID), addrToID
+}
+
+// GetRollupIDs returns all the tracked rollupIDs, the first one is the main rollup (ZkEVMAddr)
+func (etherMan *Client) GetRollupIDs() []uint {
+	res := make([]uint, len(etherMan.RollupIDs))
+	for i, rollupID := range etherMan.RollupIDs {
+		res[i] = uint(rollupID)
+	}
+	return res
+}
+
+// IsRollupTracked returns true if the rollupID is one of the rollups being synchronized
+func (etherMan *Client) IsRollupTracked(rollupID uint32) bool {
+	for _, id := range etherMan.RollupIDs {
+		if id == rollupID {
+			return true
+		}
+	}
+	return false
+}
+
+// rollupIDFromLog returns the rollupID of the contract that emitted the log.
+// If the emitter is not a rollup contract (e.g. pre-LxLy zkEVM events) it returns the main rollupID
+func (etherMan *Client) rollupIDFromLog(vLog types.Log) uint32 {
+	if rollupID, ok := etherMan.rollupAddrToID[vLog.Address]; ok {
+		return rollupID
+	}
+	return etherMan.RollupID
+}
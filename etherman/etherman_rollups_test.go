@@ -0,0 +1,105 @@
+package etherman
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+type rollupManagerRollupQuerierFake struct {
+	addrToID map[common.Address]uint32
+	idToAddr map[uint32]common.Address
+}
+
+func (f *rollupManagerRollupQuerierFake) RollupAddressToID(opts *bind.CallOpts, rollupAddress common.Address) (uint32, error) {
+	if rollupAddress == (common.Address{}) {
+		return 0, fmt.Errorf("invalid address")
+	}
+	return f.addrToID[rollupAddress], nil
+}
+
+func (f *rollupManagerRollupQuerierFake) RollupIDToRollupData(opts *bind.CallOpts, rollupID uint32) (struct {
+	RollupContract                 common.Address
+	ChainID                        uint64
+	Verifier                       common.Address
+	ForkID                         uint64
+	LastLocalExitRoot              [32]byte
+	LastBatchSequenced             uint64
+	LastVerifiedBatch              uint64
+	LastPendingState               uint64
+	LastPendingStateConsolidated   uint64
+	LastVerifiedBatchBeforeUpgrade uint64
+	RollupTypeID                   uint64
+	RollupCompatibilityID          uint8
+}, error) {
+	res := struct {
+		RollupContract                 common.Address
+		ChainID                        uint64
+		Verifier                       common.Address
+		ForkID                         uint64
+		LastLocalExitRoot              [32]byte
+		LastBatchSequenced             uint64
+		LastVerifiedBatch              uint64
+		LastPendingState               uint64
+		LastPendingStateConsolidated   uint64
+		LastVerifiedBatchBeforeUpgrade uint64
+		RollupTypeID                   uint64
+		RollupCompatibilityID          uint8
+	}{}
+	res.RollupContract = f.idToAddr[rollupID]
+	return res, nil
+}
+
+func newRollupManagerRollupQuerierFake() *rollupManagerRollupQuerierFake {
+	return &rollupManagerRollupQuerierFake{
+		addrToID: map[common.Address]uint32{
+			common.HexToAddress("0x1"): 1,
+			common.HexToAddress("0x2"): 2,
+			common.HexToAddress("0x3"): 3,
+		},
+		idToAddr: map[uint32]common.Address{
+			1: common.HexToAddress("0x1"),
+			2: common.HexToAddress("0x2"),
+			3: common.HexToAddress("0x3"),
+		},
+	}
+}
+
+func TestResolveTrackedRollups(t *testing.T) {
+	contracts := ContractConfig{
+		ZkEVMAddr:        common.HexToAddress("0x1"),
+		ExtraRollupAddrs: []common.Address{common.HexToAddress("0x2")},
+		ExtraRollupIDs:   []uint32{3, 2},
+	}
+	rollupIDs, addrToID, err := resolveTrackedRollups(newRollupManagerRollupQuerierFake(), contracts, 1)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{1, 2, 3}, rollupIDs)
+	require.Equal(t, uint32(3), addrToID[common.HexToAddress("0x3")])
+
+	client := Client{RollupID: 1, RollupIDs: rollupIDs, rollupAddrToID: addrToID}
+	require.Equal(t, []uint{1, 2, 3}, client.GetRollupIDs())
+	require.True(t, client.IsRollupTracked(3))
+	require.False(t, client.IsRollupTracked(4))
+	require.Equal(t, uint32(2), client.rollupIDFromLog(types.Log{Address: common.HexToAddress("0x2")}))
+	require.Equal(t, uint32(1), client.rollupIDFromLog(types.Log{Address: common.HexToAddress("0x99")}))
+}
+
+func TestResolveTrackedRollupsNotAttached(t *testing.T) {
+	contracts := ContractConfig{
+		ZkEVMAddr:        common.HexToAddress("0x1"),
+		ExtraRollupAddrs: []common.Address{common.HexToAddress("0x99")},
+	}
+	_, _, err := resolveTrackedRollups(newRollupManagerRollupQuerierFake(), contracts, 1)
+	require.Error(t, err)
+
+	contracts = ContractConfig{
+		ZkEVMAddr:      common.HexToAddress("0x1"),
+		ExtraRollupIDs: []uint32{99},
+	}
+	_, _, err = resolveTrackedRollups(newRollupManagerRollupQuerierFake(), contracts, 1)
+	require.Error(t, err)
+}
@@ -62,6 +62,9 @@ var (
 	beaconUpgradedSignatureHash   = crypto.Keccak256Hash([]byte("BeaconUpgraded(address)"))
 	upgradedSignatureHash         = crypto.Keccak256Hash([]byte("Upgraded(address)"))
 
+	// DataAvailabilityProtocol events
+	committeeUpdatedSignatureHash = crypto.Keccak256Hash([]byte("CommitteeUpdated(bytes32)"))
+
 	// methodIDSequenceBatchesEtrog: MethodID for sequenceBatches in Etrog
 	methodIDSequenceBatchesEtrog = []byte{0xec, 0xef, 0x3f, 0x99} // 0xecef3f99
 	// methodIDSequenceBatchesElderberry: MethodID for sequenceBatches in Elderberry
@@ -116,5 +119,6 @@ var (
 		"AdminChanged(address,address)",
 		"BeaconUpgraded(address)",
 		"Upgraded(address)",
+		"CommitteeUpdated(bytes32)",
 	}
 )
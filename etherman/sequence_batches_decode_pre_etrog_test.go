@@ -0,0 +1,59 @@
+package etherman
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/oldpolygonzkevm"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func packPreEtrogTxData(t *testing.T, method string, args ...interface{}) []byte {
+	smcAbi, err := abi.JSON(strings.NewReader(oldpolygonzkevm.OldpolygonzkevmABI))
+	require.NoError(t, err)
+	txData, err := smcAbi.Pack(method, args...)
+	require.NoError(t, err)
+	return txData
+}
+
+func TestSequencedBatchPreEtrogDecode(t *testing.T) {
+	batches := []oldpolygonzkevm.PolygonZkEVMBatchData{
+		{Transactions: []byte{0x01}, GlobalExitRoot: common.HexToHash("0x1234"), Timestamp: 1000},
+		{Transactions: []byte{0x02}, GlobalExitRoot: common.HexToHash("0x5678"), Timestamp: 1001, MinForcedTimestamp: 900},
+	}
+	coinbase := common.HexToAddress("0x761d53b47334bEe6612c0Bd1467FB881435375B2")
+	txData := packPreEtrogTxData(t, "sequenceBatches", batches, coinbase)
+	txHash := common.HexToHash("0x4cfe3c40423272d4c7e9e62ef04fe0daf4f93b7c74fd2ce85681439540fed351")
+
+	res, err := decodeSequencesPreEtrog(txData, 11, coinbase, txHash, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res))
+	require.Equal(t, uint64(10), res[0].BatchNumber)
+	require.Equal(t, uint64(11), res[1].BatchNumber)
+	require.Equal(t, coinbase, res[1].Coinbase)
+	require.Nil(t, res[1].PolygonRollupBaseEtrogBatchData)
+	require.Equal(t, batches[1], *res[1].PolygonZkEVMBatchData)
+	require.Equal(t, []byte{0x02}, res[1].BatchL2Data())
+}
+
+func TestSequencedForceBatchesPreEtrogDecode(t *testing.T) {
+	batches := []oldpolygonzkevm.PolygonZkEVMForcedBatchData{
+		{Transactions: []byte{0x01, 0x02}, GlobalExitRoot: common.HexToHash("0x1234"), MinForcedTimestamp: 900},
+	}
+	txData := packPreEtrogTxData(t, "sequenceForceBatches", batches)
+	sequencer := common.HexToAddress("0x761d53b47334bEe6612c0Bd1467FB881435375B2")
+	txHash := common.HexToHash("0x4cfe3c40423272d4c7e9e62ef04fe0daf4f93b7c74fd2ce85681439540fed351")
+	block := types.NewBlockWithHeader(&types.Header{Time: 1000})
+
+	res, err := decodeSequencedForceBatches(txData, 20, sequencer, txHash, block, 5)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res))
+	require.Equal(t, uint64(20), res[0].BatchNumber)
+	require.Equal(t, []byte{0x01, 0x02}, res[0].Transactions)
+	require.Equal(t, common.HexToHash("0x1234"), common.Hash(res[0].ForcedGlobalExitRoot))
+	require.Equal(t, uint64(900), res[0].ForcedTimestamp)
+	require.Equal(t, int64(1000), res[0].Timestamp.Unix())
+}
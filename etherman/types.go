@@ -8,6 +8,7 @@ import (
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/oldpolygonzkevm"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonzkevm"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 // Block struct
@@ -22,13 +23,34 @@ type Block struct {
 	SequencedForceBatches [][]SequencedForceBatch
 	ForkIDs               []ForkID
 	ReceivedAt            time.Time
+	// NumLogs is the number of logs of the block returned by eth_getLogs, including the ones that are not decoded
+	NumLogs int
 	// GER data
 	GlobalExitRoots, L1InfoTree []GlobalExitRoot
+	GovernanceEvents            []GovernanceEvent
+	// RollupManager registry data
+	RollupTypes         []RollupType
+	ObsoleteRollupTypes []ObsoleteRollupType
+	Rollups             []Rollup
+	AuditEvents         []AuditEvent
+	PendingStateEvents  []PendingStateEvent
+	// CustomEvents are the events decoded by the parsers added with RegisterEventLogParser
+	CustomEvents []CustomEvent
 }
 
 func (b *Block) HasEvents() bool {
 	return len(b.ForcedBatches) > 0 || len(b.SequencedBatches) > 0 || b.UpdateEtrogSequence.BatchNumber > 0 ||
-		len(b.VerifiedBatches) > 0 || len(b.SequencedForceBatches) > 0 || len(b.ForkIDs) > 0 || len(b.GlobalExitRoots) > 0 || len(b.L1InfoTree) > 0
+		len(b.VerifiedBatches) > 0 || len(b.SequencedForceBatches) > 0 || len(b.ForkIDs) > 0 || len(b.GlobalExitRoots) > 0 || len(b.L1InfoTree) > 0 ||
+		len(b.GovernanceEvents) > 0 || len(b.RollupTypes) > 0 || len(b.ObsoleteRollupTypes) > 0 || len(b.Rollups) > 0 ||
+		len(b.AuditEvents) > 0 || len(b.PendingStateEvents) > 0 || len(b.CustomEvents) > 0
+}
+
+// CustomEvent is an event decoded by a LogParser
+type CustomEvent struct {
+	Order EventOrder
+	Log   types.Log
+	// Data is the value returned by the LogParser
+	Data interface{}
 }
 
 // GlobalExitRoot struct
@@ -41,6 +63,168 @@ type GlobalExitRoot struct {
 	PreviousBlockHash common.Hash
 }
 
+// GovernanceParameter is a parameter of a rollup or of the RollupManager that is changed by a governance event
+type GovernanceParameter = string
+
+const (
+	// GovernanceTrustedSequencer is changed by SetTrustedSequencer
+	GovernanceTrustedSequencer GovernanceParameter = "TrustedSequencer"
+	// GovernanceTrustedSequencerURL is changed by SetTrustedSequencerURL
+	GovernanceTrustedSequencerURL GovernanceParameter = "TrustedSequencerURL"
+	// GovernanceForceBatchAddress is changed by SetForceBatchAddress
+	GovernanceForceBatchAddress GovernanceParameter = "ForceBatchAddress"
+	// GovernanceForceBatchTimeout is changed by SetForceBatchTimeout
+	GovernanceForceBatchTimeout GovernanceParameter = "ForceBatchTimeout"
+	// GovernancePendingAdmin is changed by TransferAdminRole
+	GovernancePendingAdmin GovernanceParameter = "PendingAdmin"
+	// GovernanceAdmin is changed by AcceptAdminRole
+	GovernanceAdmin GovernanceParameter = "Admin"
+	// GovernanceEmergencyState is changed by EmergencyStateActivated and EmergencyStateDeactivated
+	GovernanceEmergencyState GovernanceParameter = "EmergencyState"
+	// GovernanceBatchFee is changed by SetBatchFee
+	GovernanceBatchFee GovernanceParameter = "BatchFee"
+	// GovernanceMultiplierBatchFee is changed by SetMultiplierBatchFee
+	GovernanceMultiplierBatchFee GovernanceParameter = "MultiplierBatchFee"
+	// GovernanceVerifyBatchTimeTarget is changed by SetVerifyBatchTimeTarget
+	GovernanceVerifyBatchTimeTarget GovernanceParameter = "VerifyBatchTimeTarget"
+	// GovernanceTrustedAggregator is changed by SetTrustedAggregator
+	GovernanceTrustedAggregator GovernanceParameter = "TrustedAggregator"
+	// GovernanceTrustedAggregatorTimeout is changed by SetTrustedAggregatorTimeout
+	GovernanceTrustedAggregatorTimeout GovernanceParameter = "TrustedAggregatorTimeout"
+	// GovernancePendingStateTimeout is changed by SetPendingStateTimeout
+	GovernancePendingStateTimeout GovernanceParameter = "PendingStateTimeout"
+
+	// GovernanceRollupManagerID is the RollupID of the governance events of the RollupManager, they
+	// are shared by all the rollups. Before LxLy they were emitted by the zkEVM contract
+	GovernanceRollupManagerID uint32 = 0
+)
+
+// GovernanceEvent is a change of a parameter of a rollup or of the RollupManager
+type GovernanceEvent struct {
+	RollupID    uint32 // GovernanceRollupManagerID for the parameters of the RollupManager
+	BlockNumber uint64
+	TxHash      common.Hash
+	Contract    common.Address
+	Parameter   GovernanceParameter
+	// Value is the new value: addresses in hex, numbers in decimal and "true"/"false" for the emergency state
+	Value string
+}
+
+// RollupType is a type of rollup registered on the RollupManager by AddNewRollupType
+type RollupType struct {
+	RollupTypeID            uint32
+	BlockNumber             uint64
+	TxHash                  common.Hash
+	ConsensusImplementation common.Address
+	Verifier                common.Address
+	ForkID                  uint64
+	RollupCompatibilityID   uint8
+	Genesis                 common.Hash
+	Description             string
+}
+
+// ObsoleteRollupType is a type of rollup marked as obsolete by ObsoleteRollupType, no new rollups can use it
+type ObsoleteRollupType struct {
+	RollupTypeID uint32
+	BlockNumber  uint64
+	TxHash       common.Hash
+}
+
+// RollupAction is the event of the RollupManager that have created or changed a rollup
+type RollupAction = string
+
+const (
+	// RollupActionCreate is a new rollup created by CreateNewRollup
+	RollupActionCreate RollupAction = "CreateNewRollup"
+	// RollupActionAddExisting is a rollup deployed previously and added by AddExistingRollup
+	RollupActionAddExisting RollupAction = "AddExistingRollup"
+	// RollupActionUpdate is a rollup upgraded to a new rollup type by UpdateRollup
+	RollupActionUpdate RollupAction = "UpdateRollup"
+)
+
+// Rollup is a rollup created, added or updated on the RollupManager
+type Rollup struct {
+	RollupID    uint32
+	BlockNumber uint64
+	TxHash      common.Hash
+	Action      RollupAction
+	// RollupAddress, ChainID and GasTokenAddress are not set for RollupActionUpdate
+	RollupAddress   common.Address
+	ChainID         uint64
+	GasTokenAddress common.Address
+	// RollupTypeID is 0 for RollupActionAddExisting, the rollups added are not created from a rollup type
+	RollupTypeID uint32
+	ForkID       uint64
+	// LastVerifiedBatchBeforeUpgrade is only set for RollupActionAddExisting and RollupActionUpdate
+	LastVerifiedBatchBeforeUpgrade uint64
+}
+
+// AuditEventType is the event of a contract that changes its implementation or its access control
+type AuditEventType = string
+
+const (
+	// AuditAdminChanged is the change of the admin of a proxy. Fields: PreviousAccount, Account
+	AuditAdminChanged AuditEventType = "AdminChanged"
+	// AuditUpgraded is the upgrade of the implementation of a proxy. Fields: Implementation
+	AuditUpgraded AuditEventType = "Upgraded"
+	// AuditBeaconUpgraded is the upgrade of the beacon of a proxy. Fields: Implementation (the beacon)
+	AuditBeaconUpgraded AuditEventType = "BeaconUpgraded"
+	// AuditRoleGranted is a role granted to an account. Fields: Role, Account, Sender
+	AuditRoleGranted AuditEventType = "RoleGranted"
+	// AuditRoleRevoked is a role revoked to an account. Fields: Role, Account, Sender
+	AuditRoleRevoked AuditEventType = "RoleRevoked"
+	// AuditRoleAdminChanged is the change of the role that administers a role. Fields: Role, PreviousAdminRole, AdminRole
+	AuditRoleAdminChanged AuditEventType = "RoleAdminChanged"
+	// AuditOwnershipTransferred is the change of the owner of an Ownable contract. Fields: PreviousAccount, Account
+	AuditOwnershipTransferred AuditEventType = "OwnershipTransferred"
+)
+
+// AuditEvent is a change of the implementation or of the access control of a contract, only the fields
+// of its EventType are set
+type AuditEvent struct {
+	BlockNumber       uint64
+	TxHash            common.Hash
+	Contract          common.Address // Contract that emits the event
+	EventType         AuditEventType
+	Implementation    common.Address
+	Role              common.Hash
+	PreviousAdminRole common.Hash
+	AdminRole         common.Hash
+	PreviousAccount   common.Address
+	Account           common.Address
+	Sender            common.Address
+}
+
+// PendingStateAction is the kind of change of the pending states of a rollup
+type PendingStateAction = string
+
+const (
+	// PendingStateConsolidated the pending states up to BatchNumber are consolidated (ConsolidatePendingState)
+	PendingStateConsolidated PendingStateAction = "Consolidated"
+	// PendingStateOverridden the trusted aggregator have proved a different StateRoot for BatchNumber and
+	// the pending states are discarded (OverridePendingState)
+	PendingStateOverridden PendingStateAction = "Overridden"
+	// PendingStateProvedNonDeterministic a pending state with StoredStateRoot have been proved to be
+	// non deterministic (ProveNonDeterministicPendingState)
+	PendingStateProvedNonDeterministic PendingStateAction = "ProvedNonDeterministic"
+)
+
+// PendingStateEvent is a change of the pending states of a rollup, only the fields of its Action are set.
+// The events previous to LxLy don't have ExitRoot
+type PendingStateEvent struct {
+	RollupID        uint32 // Not set for PendingStateProvedNonDeterministic
+	BlockNumber     uint64
+	TxHash          common.Hash
+	Action          PendingStateAction
+	BatchNumber     uint64
+	StateRoot       common.Hash
+	ExitRoot        common.Hash
+	PendingStateNum uint64         // PendingStateConsolidated
+	Aggregator      common.Address // PendingStateOverridden
+	StoredStateRoot common.Hash    // PendingStateProvedNonDeterministic
+	ProvedStateRoot common.Hash    // PendingStateProvedNonDeterministic
+}
+
 // SequencedBatchElderberryData represents an Elderberry sequenced batch data
 type SequencedBatchElderberryData struct {
 	MaxSequenceTimestamp     uint64
@@ -77,6 +261,7 @@ func (s *SequencedBatchMetadata) String() string {
 
 // SequencedBatch represents virtual batch
 type SequencedBatch struct {
+	RollupID      uint32
 	BatchNumber   uint64
 	L1InfoRoot    *common.Hash
 	SequencerAddr common.Address
@@ -141,6 +326,7 @@ func (s *SequencedBatch) BatchL2Data() []byte {
 
 // UpdateEtrogSequence represents the first etrog sequence
 type UpdateEtrogSequence struct {
+	RollupID      uint32
 	BatchNumber   uint64
 	SequencerAddr common.Address
 	TxHash        common.Hash
@@ -151,6 +337,7 @@ type UpdateEtrogSequence struct {
 
 // ForcedBatch represents a ForcedBatch
 type ForcedBatch struct {
+	RollupID          uint32
 	BlockNumber       uint64
 	ForcedBatchNumber uint64
 	Sequencer         common.Address
@@ -161,15 +348,18 @@ type ForcedBatch struct {
 
 // VerifiedBatch represents a VerifiedBatch
 type VerifiedBatch struct {
+	RollupID    uint32
 	BlockNumber uint64
 	BatchNumber uint64
 	Aggregator  common.Address
 	StateRoot   common.Hash
+	ExitRoot    common.Hash // Not set previous to LxLy
 	TxHash      common.Hash
 }
 
 // SequencedForceBatch is a sturct to track the ForceSequencedBatches event.
 type SequencedForceBatch struct {
+	RollupID    uint32
 	BatchNumber uint64
 	Coinbase    common.Address
 	TxHash      common.Hash
@@ -180,6 +370,7 @@ type SequencedForceBatch struct {
 
 // ForkID is a sturct to track the ForkID event.
 type ForkID struct {
+	RollupID    uint32
 	BatchNumber uint64
 	ForkID      uint64
 	Version     string
@@ -0,0 +1,69 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AuditEvent is a change on L1 of the proxy, the roles or the owner of a contract
+// (e.g. Upgraded, RoleGranted, OwnershipTransferred)
+type AuditEvent struct {
+	BlockNumber       uint64 // Linked to sync.block table
+	TxHash            common.Hash
+	Contract          common.Address // Contract that emits the event
+	EventType         string
+	Implementation    common.Address // Upgraded and BeaconUpgraded
+	Role              common.Hash    // RoleGranted, RoleRevoked and RoleAdminChanged
+	PreviousAdminRole common.Hash    // RoleAdminChanged
+	AdminRole         common.Hash    // RoleAdminChanged
+	PreviousAccount   common.Address // Previous admin (AdminChanged) or owner (OwnershipTransferred)
+	Account           common.Address // Account of the role, new admin or new owner
+	Sender            common.Address // RoleGranted and RoleRevoked
+	ReceivedAt        time.Time
+}
+
+func (a *AuditEvent) IsEqual(o interface{}) bool {
+	other, ok := o.(*AuditEvent)
+	if !ok {
+		return false
+	}
+	if a == other {
+		return true
+	}
+	if a == nil || other == nil {
+		return false
+	}
+	return a.BlockNumber == other.BlockNumber && a.TxHash == other.TxHash && a.Contract == other.Contract &&
+		a.EventType == other.EventType && a.Implementation == other.Implementation && a.Role == other.Role &&
+		a.PreviousAdminRole == other.PreviousAdminRole && a.AdminRole == other.AdminRole &&
+		a.PreviousAccount == other.PreviousAccount && a.Account == other.Account && a.Sender == other.Sender
+}
+
+func (a *AuditEvent) String() string {
+	if a == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("BlockNumber: %d, TxHash: %s, Contract: %s, EventType: %s, Implementation: %s, Role: %s, PreviousAdminRole: %s, AdminRole: %s, PreviousAccount: %s, Account: %s, Sender: %s, ReceivedAt: %s",
+		a.BlockNumber, a.TxHash.String(), a.Contract.String(), a.EventType, a.Implementation.String(), a.Role.String(),
+		a.PreviousAdminRole.String(), a.AdminRole.String(), a.PreviousAccount.String(), a.Account.String(), a.Sender.String(), a.ReceivedAt.String())
+}
+
+func NewAuditEventFromL1(ethAuditEvent etherman.AuditEvent) *AuditEvent {
+	return &AuditEvent{
+		BlockNumber:       ethAuditEvent.BlockNumber,
+		TxHash:            ethAuditEvent.TxHash,
+		Contract:          ethAuditEvent.Contract,
+		EventType:         ethAuditEvent.EventType,
+		Implementation:    ethAuditEvent.Implementation,
+		Role:              ethAuditEvent.Role,
+		PreviousAdminRole: ethAuditEvent.PreviousAdminRole,
+		AdminRole:         ethAuditEvent.AdminRole,
+		PreviousAccount:   ethAuditEvent.PreviousAccount,
+		Account:           ethAuditEvent.Account,
+		Sender:            ethAuditEvent.Sender,
+		ReceivedAt:        time.Now(),
+	}
+}
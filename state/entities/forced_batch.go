@@ -0,0 +1,155 @@
+package entities
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ForcedBatch is a batch forced by a user on L1 (ForceBatch event)
+type ForcedBatch struct {
+	RollupID          uint64
+	ForcedBatchNumber uint64
+	BlockNumber       uint64 // Linked to sync.block table
+	GlobalExitRoot    common.Hash
+	RawTxsData        []byte
+	ForcerAddr        common.Address
+	ForcedAt          time.Time
+	// SequencedBatchNumber is the batch that have sequenced this forced batch, nil if it's pending
+	SequencedBatchNumber *uint64
+}
+
+func (f *ForcedBatch) IsEqual(o interface{}) bool {
+	other, ok := o.(*ForcedBatch)
+	if !ok {
+		return false
+	}
+	if f == other {
+		return true
+	}
+	if f == nil || other == nil {
+		return false
+	}
+	return f.RollupID == other.RollupID && f.ForcedBatchNumber == other.ForcedBatchNumber && f.BlockNumber == other.BlockNumber &&
+		f.GlobalExitRoot == other.GlobalExitRoot && bytes.Equal(f.RawTxsData, other.RawTxsData) && f.ForcerAddr == other.ForcerAddr &&
+		f.ForcedAt.Equal(other.ForcedAt)
+}
+
+func (f *ForcedBatch) Key() uint64 {
+	return f.ForcedBatchNumber
+}
+
+// IsPending returns true if the forced batch have not been sequenced yet
+func (f *ForcedBatch) IsPending() bool {
+	return f.SequencedBatchNumber == nil
+}
+
+func (f *ForcedBatch) String() string {
+	if f == nil {
+		return "nil"
+	}
+	sequenced := "pending"
+	if f.SequencedBatchNumber != nil {
+		sequenced = fmt.Sprintf("%d", *f.SequencedBatchNumber)
+	}
+	return fmt.Sprintf("RollupID: %d, ForcedBatchNumber: %d, BlockNumber: %d, GlobalExitRoot: %s, ForcerAddr: %s, ForcedAt: %s, RawTxsData: %d bytes, SequencedBatchNumber: %s",
+		f.RollupID, f.ForcedBatchNumber, f.BlockNumber, f.GlobalExitRoot.String(), f.ForcerAddr.String(), f.ForcedAt.String(), len(f.RawTxsData), sequenced)
+}
+
+func NewForcedBatchFromL1(ethForcedBatch etherman.ForcedBatch) *ForcedBatch {
+	return &ForcedBatch{
+		RollupID:          uint64(ethForcedBatch.RollupID),
+		ForcedBatchNumber: ethForcedBatch.ForcedBatchNumber,
+		BlockNumber:       ethForcedBatch.BlockNumber,
+		GlobalExitRoot:    ethForcedBatch.GlobalExitRoot,
+		RawTxsData:        ethForcedBatch.RawTxsData,
+		ForcerAddr:        ethForcedBatch.Sequencer,
+		ForcedAt:          ethForcedBatch.ForcedAt,
+	}
+}
+
+// SequencedForceBatch is a batch sequenced on L1 that includes a forced batch, it can be sequenced
+// by the trusted sequencer (SequenceBatches) or by anyone after the timeout (SequenceForceBatches)
+type SequencedForceBatch struct {
+	RollupID    uint64
+	BatchNumber uint64
+	// ForcedBatchNumber is the forced batch included, the forced batches are sequenced in order.
+	// It's nil if the forced batch is unknown (e.g. it was forced before the first block synced)
+	ForcedBatchNumber    *uint64
+	BlockNumber          uint64 // Linked to sync.block table
+	TxHash               common.Hash
+	Coinbase             common.Address
+	ForcedGlobalExitRoot common.Hash
+	ForcedTimestamp      uint64
+	ForcedBlockHashL1    common.Hash
+	Source               string // Event that have sequenced it
+}
+
+func (s *SequencedForceBatch) String() string {
+	if s == nil {
+		return "nil"
+	}
+	forcedBatchNumber := "unknown"
+	if s.ForcedBatchNumber != nil {
+		forcedBatchNumber = fmt.Sprintf("%d", *s.ForcedBatchNumber)
+	}
+	return fmt.Sprintf("RollupID: %d, BatchNumber: %d, ForcedBatchNumber: %s, BlockNumber: %d, TxHash: %s, Coinbase: %s, ForcedGlobalExitRoot: %s, ForcedTimestamp: %d, ForcedBlockHashL1: %s, Source: %s",
+		s.RollupID, s.BatchNumber, forcedBatchNumber, s.BlockNumber, s.TxHash.String(), s.Coinbase.String(), s.ForcedGlobalExitRoot.String(),
+		s.ForcedTimestamp, s.ForcedBlockHashL1.String(), s.Source)
+}
+
+func NewSequencedForceBatchFromL1(l1BlockNumber uint64, ethSeqForceBatch etherman.SequencedForceBatch, source string) *SequencedForceBatch {
+	return &SequencedForceBatch{
+		RollupID:             uint64(ethSeqForceBatch.RollupID),
+		BatchNumber:          ethSeqForceBatch.BatchNumber,
+		BlockNumber:          l1BlockNumber,
+		TxHash:               ethSeqForceBatch.TxHash,
+		Coinbase:             ethSeqForceBatch.Coinbase,
+		ForcedGlobalExitRoot: ethSeqForceBatch.ForcedGlobalExitRoot,
+		ForcedTimestamp:      ethSeqForceBatch.ForcedTimestamp,
+		ForcedBlockHashL1:    ethSeqForceBatch.ForcedBlockHashL1,
+		Source:               source,
+	}
+}
+
+// NewSequencedForceBatchFromSequencedBatch returns nil if the batch doesn't include a forced batch
+func NewSequencedForceBatchFromSequencedBatch(l1BlockNumber uint64, ethSeqBatch etherman.SequencedBatch, source string) *SequencedForceBatch {
+	if ethSeqBatch.PolygonZkEVMBatchData != nil {
+		return newSequencedForceBatchFromPreEtrogBatch(l1BlockNumber, ethSeqBatch, source)
+	}
+	if ethSeqBatch.PolygonRollupBaseEtrogBatchData == nil || ethSeqBatch.PolygonRollupBaseEtrogBatchData.ForcedTimestamp == 0 {
+		return nil
+	}
+	return &SequencedForceBatch{
+		RollupID:             uint64(ethSeqBatch.RollupID),
+		BatchNumber:          ethSeqBatch.BatchNumber,
+		BlockNumber:          l1BlockNumber,
+		TxHash:               ethSeqBatch.TxHash,
+		Coinbase:             ethSeqBatch.Coinbase,
+		ForcedGlobalExitRoot: ethSeqBatch.PolygonRollupBaseEtrogBatchData.ForcedGlobalExitRoot,
+		ForcedTimestamp:      ethSeqBatch.PolygonRollupBaseEtrogBatchData.ForcedTimestamp,
+		ForcedBlockHashL1:    ethSeqBatch.PolygonRollupBaseEtrogBatchData.ForcedBlockHashL1,
+		Source:               source,
+	}
+}
+
+// newSequencedForceBatchFromPreEtrogBatch before Etrog a batch includes a forced batch if it have MinForcedTimestamp,
+// that is the timestamp of the forced batch, and the GlobalExitRoot of the batch is the one of the forced batch
+func newSequencedForceBatchFromPreEtrogBatch(l1BlockNumber uint64, ethSeqBatch etherman.SequencedBatch, source string) *SequencedForceBatch {
+	if ethSeqBatch.PolygonZkEVMBatchData.MinForcedTimestamp == 0 {
+		return nil
+	}
+	return &SequencedForceBatch{
+		RollupID:             uint64(ethSeqBatch.RollupID),
+		BatchNumber:          ethSeqBatch.BatchNumber,
+		BlockNumber:          l1BlockNumber,
+		TxHash:               ethSeqBatch.TxHash,
+		Coinbase:             ethSeqBatch.Coinbase,
+		ForcedGlobalExitRoot: ethSeqBatch.PolygonZkEVMBatchData.GlobalExitRoot,
+		ForcedTimestamp:      ethSeqBatch.PolygonZkEVMBatchData.MinForcedTimestamp,
+		Source:               source,
+	}
+}
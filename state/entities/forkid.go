@@ -17,6 +17,7 @@ const (
 
 // ForkIDInterval is a fork id interval
 type ForkIDInterval struct {
+	RollupID        uint64
 	FromBatchNumber uint64
 	ToBatchNumber   uint64
 	ForkId          uint64
@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GlobalExitRoot is an update of the GlobalExitRoot on L1 previous to LxLy (UpdateGlobalExitRoot event),
+// after LxLy the updates are leaves of L1InfoTree
+type GlobalExitRoot struct {
+	BlockNumber     uint64 // Linked to sync.block table
+	GlobalExitRoot  common.Hash
+	MainnetExitRoot common.Hash
+	RollupExitRoot  common.Hash
+	Timestamp       time.Time // Timestamp of the L1 block
+}
+
+func (g *GlobalExitRoot) IsEqual(o interface{}) bool {
+	other, ok := o.(*GlobalExitRoot)
+	if !ok {
+		return false
+	}
+	if g == other {
+		return true
+	}
+	if g == nil || other == nil {
+		return false
+	}
+	return g.BlockNumber == other.BlockNumber && g.GlobalExitRoot == other.GlobalExitRoot && g.MainnetExitRoot == other.MainnetExitRoot &&
+		g.RollupExitRoot == other.RollupExitRoot && g.Timestamp.Equal(other.Timestamp)
+}
+
+func (g *GlobalExitRoot) String() string {
+	if g == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("BlockNumber: %d, GlobalExitRoot: %s, MainnetExitRoot: %s, RollupExitRoot: %s, Timestamp: %s",
+		g.BlockNumber, g.GlobalExitRoot.String(), g.MainnetExitRoot.String(), g.RollupExitRoot.String(), g.Timestamp.String())
+}
+
+func NewGlobalExitRootFromL1(ethGlobalExitRoot etherman.GlobalExitRoot) *GlobalExitRoot {
+	return &GlobalExitRoot{
+		BlockNumber:     ethGlobalExitRoot.BlockNumber,
+		GlobalExitRoot:  ethGlobalExitRoot.GlobalExitRoot,
+		MainnetExitRoot: ethGlobalExitRoot.MainnetExitRoot,
+		RollupExitRoot:  ethGlobalExitRoot.RollupExitRoot,
+		Timestamp:       ethGlobalExitRoot.Timestamp,
+	}
+}
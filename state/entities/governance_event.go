@@ -0,0 +1,56 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GovernanceEvent is a change on L1 of a parameter of a rollup or of the RollupManager
+// (e.g. SetTrustedSequencerURL, EmergencyStateActivated)
+type GovernanceEvent struct {
+	RollupID    uint64 // 0 for the parameters of the RollupManager
+	BlockNumber uint64 // Linked to sync.block table
+	TxHash      common.Hash
+	Contract    common.Address // Contract that emits the event
+	Parameter   string
+	Value       string // New value: addresses in hex, numbers in decimal and true/false for the emergency state
+	ReceivedAt  time.Time
+}
+
+func (g *GovernanceEvent) IsEqual(o interface{}) bool {
+	other, ok := o.(*GovernanceEvent)
+	if !ok {
+		return false
+	}
+	if g == other {
+		return true
+	}
+	if g == nil || other == nil {
+		return false
+	}
+	return g.RollupID == other.RollupID && g.BlockNumber == other.BlockNumber && g.TxHash == other.TxHash &&
+		g.Contract == other.Contract && g.Parameter == other.Parameter && g.Value == other.Value
+}
+
+func (g *GovernanceEvent) String() string {
+	if g == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("RollupID: %d, BlockNumber: %d, TxHash: %s, Contract: %s, Parameter: %s, Value: %s, ReceivedAt: %s",
+		g.RollupID, g.BlockNumber, g.TxHash.String(), g.Contract.String(), g.Parameter, g.Value, g.ReceivedAt.String())
+}
+
+func NewGovernanceEventFromL1(ethGovernanceEvent etherman.GovernanceEvent) *GovernanceEvent {
+	return &GovernanceEvent{
+		RollupID:    uint64(ethGovernanceEvent.RollupID),
+		BlockNumber: ethGovernanceEvent.BlockNumber,
+		TxHash:      ethGovernanceEvent.TxHash,
+		Contract:    ethGovernanceEvent.Contract,
+		Parameter:   ethGovernanceEvent.Parameter,
+		Value:       ethGovernanceEvent.Value,
+		ReceivedAt:  time.Now(),
+	}
+}
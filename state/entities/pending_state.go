@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PendingState is a verification of batches by a non trusted aggregator, it's not consolidated
+// until the pending state timeout is reached (ConsolidatePendingState) or the trusted aggregator
+// verifies the batches
+type PendingState struct {
+	RollupID    uint64
+	BatchNumber uint64 // Linked to sync.verified_batch table
+	BlockNumber uint64 // Block of the verification, linked to sync.block table
+	TxHash      common.Hash
+	StateRoot   common.Hash
+	ExitRoot    common.Hash // Not set previous to LxLy
+	Aggregator  common.Address
+	// ConsolidatedBlockNumber is the block that have consolidated the pending state, nil if it's not consolidated
+	ConsolidatedBlockNumber *uint64
+	// OverriddenBlockNumber is the block of the OverridePendingState that have discarded the pending state, nil if it's not overridden
+	OverriddenBlockNumber *uint64
+	// OverrideStateRoot is the StateRoot proved by the trusted aggregator, it's only set for the overridden batch
+	OverrideStateRoot *common.Hash
+	// NonDeterministicBlockNumber is the block that have proved that the StateRoot is non deterministic, nil if it's not proved
+	NonDeterministicBlockNumber *uint64
+	// ProvedStateRoot is the StateRoot proved for the same batch, set with NonDeterministicBlockNumber
+	ProvedStateRoot *common.Hash
+	ReceivedAt      time.Time
+}
+
+// IsConsolidated returns true if the pending state have been consolidated
+func (p *PendingState) IsConsolidated() bool {
+	return p.ConsolidatedBlockNumber != nil
+}
+
+// IsOverridden returns true if the pending state have been discarded by an OverridePendingState
+func (p *PendingState) IsOverridden() bool {
+	return p.OverriddenBlockNumber != nil
+}
+
+// IsNonDeterministic returns true if the StateRoot of the pending state have been proved to be non deterministic
+func (p *PendingState) IsNonDeterministic() bool {
+	return p.NonDeterministicBlockNumber != nil
+}
+
+func (p *PendingState) IsEqual(o interface{}) bool {
+	other, ok := o.(*PendingState)
+	if !ok {
+		return false
+	}
+	if p == other {
+		return true
+	}
+	if p == nil || other == nil {
+		return false
+	}
+	return p.RollupID == other.RollupID && p.BatchNumber == other.BatchNumber && p.BlockNumber == other.BlockNumber &&
+		p.TxHash == other.TxHash && p.StateRoot == other.StateRoot && p.ExitRoot == other.ExitRoot && p.Aggregator == other.Aggregator &&
+		equalPtr(p.ConsolidatedBlockNumber, other.ConsolidatedBlockNumber) && equalPtr(p.OverriddenBlockNumber, other.OverriddenBlockNumber) &&
+		equalPtr(p.OverrideStateRoot, other.OverrideStateRoot) && equalPtr(p.NonDeterministicBlockNumber, other.NonDeterministicBlockNumber) &&
+		equalPtr(p.ProvedStateRoot, other.ProvedStateRoot)
+}
+
+func (p *PendingState) String() string {
+	if p == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("RollupID: %d, BatchNumber: %d, BlockNumber: %d, TxHash: %s, StateRoot: %s, ExitRoot: %s, Aggregator: %s, Consolidated: %t, Overridden: %t, NonDeterministic: %t, ReceivedAt: %s",
+		p.RollupID, p.BatchNumber, p.BlockNumber, p.TxHash.String(), p.StateRoot.String(), p.ExitRoot.String(), p.Aggregator.String(),
+		p.IsConsolidated(), p.IsOverridden(), p.IsNonDeterministic(), p.ReceivedAt.String())
+}
+
+func NewPendingStateFromL1(ethVerifiedBatch etherman.VerifiedBatch) *PendingState {
+	return &PendingState{
+		RollupID:    uint64(ethVerifiedBatch.RollupID),
+		BatchNumber: ethVerifiedBatch.BatchNumber,
+		BlockNumber: ethVerifiedBatch.BlockNumber,
+		TxHash:      ethVerifiedBatch.TxHash,
+		StateRoot:   ethVerifiedBatch.StateRoot,
+		ExitRoot:    ethVerifiedBatch.ExitRoot,
+		Aggregator:  ethVerifiedBatch.Aggregator,
+		ReceivedAt:  time.Now(),
+	}
+}
+
+// VerificationFrontier is the last batch of a rollup with a consolidated verification and
+// the pending states after it
+type VerificationFrontier struct {
+	RollupID                uint64
+	ConsolidatedBatchNumber uint64
+	// PendingBatchNumber is the last batch verified, it's equal to ConsolidatedBatchNumber if there are no pending states
+	PendingBatchNumber uint64
+	PendingStates      []PendingState // Ordered by BatchNumber
+}
+
+func (v *VerificationFrontier) String() string {
+	if v == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("RollupID: %d, ConsolidatedBatchNumber: %d, PendingBatchNumber: %d, PendingStates: %d",
+		v.RollupID, v.ConsolidatedBatchNumber, v.PendingBatchNumber, len(v.PendingStates))
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
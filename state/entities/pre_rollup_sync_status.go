@@ -0,0 +1,21 @@
+package entities
+
+import "fmt"
+
+// PreRollupSyncStatus is the result of synchronizing the L1InfoTree events previous to the rollup genesis
+type PreRollupSyncStatus struct {
+	// LxLyUpgradeBlockNumber is the first block synchronized (the LxLy upgrade)
+	LxLyUpgradeBlockNumber uint64
+	// GenesisBlockNumber is the rollup genesis, the blocks previous to it have been synchronized
+	GenesisBlockNumber uint64
+	// Skipped is true if it have not been synchronized because the storage already had blocks after the
+	// rollup genesis, so the L1InfoTree leaves previous to the genesis are missing
+	Skipped bool
+}
+
+func (s *PreRollupSyncStatus) String() string {
+	if s == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("{LxLyUpgradeBlockNumber: %d, GenesisBlockNumber: %d, Skipped: %t}", s.LxLyUpgradeBlockNumber, s.GenesisBlockNumber, s.Skipped)
+}
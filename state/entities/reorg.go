@@ -0,0 +1,81 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BatchNumberRange is a range of batches of a rollup, both included
+type BatchNumberRange struct {
+	RollupID        uint64
+	FromBatchNumber uint64
+	ToBatchNumber   uint64
+}
+
+// L1InfoTreeIndexRange is a range of L1InfoTree leaves, both included
+type L1InfoTreeIndexRange struct {
+	FromIndex uint32
+	ToIndex   uint32
+}
+
+// ReorgImpact is the data deleted from storage by a reorg
+type ReorgImpact struct {
+	// FirstL1BlockNumberToKeep is the last block kept, the following ones are deleted
+	FirstL1BlockNumberToKeep uint64
+	// LastL1BlockNumber is the highest block deleted, 0 if there are no blocks to delete
+	LastL1BlockNumber uint64
+	Blocks            uint64
+	// CheckedBlocks are the blocks deleted that were already finalized
+	CheckedBlocks    uint64
+	SequencedBatches uint64
+	VirtualBatches   uint64
+	L1InfoTreeLeaves uint64
+	// FirstDeletedBlockNumber and FirstDeletedBlockHash are the first block deleted, zero if there are no blocks to delete
+	FirstDeletedBlockNumber uint64
+	FirstDeletedBlockHash   common.Hash
+	// VirtualBatchRanges are the virtual batches deleted per rollup
+	VirtualBatchRanges []BatchNumberRange
+	// L1InfoTreeIndexRange are the L1InfoTree leaves deleted, nil if there are no leaves to delete
+	L1InfoTreeIndexRange *L1InfoTreeIndexRange
+}
+
+// Depth returns the number of L1 blocks reverted
+func (r *ReorgImpact) Depth() uint64 {
+	if r.LastL1BlockNumber <= r.FirstL1BlockNumberToKeep {
+		return 0
+	}
+	return r.LastL1BlockNumber - r.FirstL1BlockNumberToKeep
+}
+
+func (r *ReorgImpact) String() string {
+	if r == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("{FirstL1BlockNumberToKeep: %d, LastL1BlockNumber: %d, Blocks: %d, CheckedBlocks: %d, SequencedBatches: %d, VirtualBatches: %d, L1InfoTreeLeaves: %d}",
+		r.FirstL1BlockNumberToKeep, r.LastL1BlockNumber, r.Blocks, r.CheckedBlocks, r.SequencedBatches, r.VirtualBatches, r.L1InfoTreeLeaves)
+}
+
+// ReorgLogEntry is a reorg executed on storage (table sync.reorg_log)
+type ReorgLogEntry struct {
+	ReorgID   uint64
+	Timestamp time.Time
+	// FirstL1BlockNumberKept is the last block kept, the following ones were deleted
+	FirstL1BlockNumberKept  uint64
+	Reason                  string
+	DeletedBlocks           uint64
+	DeletedVirtualBatches   uint64
+	DeletedL1InfoTreeLeaves uint64
+	Duration                time.Duration
+	SyncVersion             string
+}
+
+func (r *ReorgLogEntry) String() string {
+	if r == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("{ReorgID: %d, Timestamp: %s, FirstL1BlockNumberKept: %d, Reason: %s, DeletedBlocks: %d, DeletedVirtualBatches: %d, DeletedL1InfoTreeLeaves: %d, Duration: %s, SyncVersion: %s}",
+		r.ReorgID, r.Timestamp.String(), r.FirstL1BlockNumberKept, r.Reason, r.DeletedBlocks, r.DeletedVirtualBatches, r.DeletedL1InfoTreeLeaves,
+		r.Duration.String(), r.SyncVersion)
+}
@@ -0,0 +1,114 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RollupType is a type of rollup registered on the RollupManager (AddNewRollupType)
+type RollupType struct {
+	RollupTypeID            uint64
+	BlockNumber             uint64 // Linked to sync.block table
+	TxHash                  common.Hash
+	ConsensusImplementation common.Address
+	Verifier                common.Address
+	ForkID                  uint64
+	RollupCompatibilityID   uint64
+	Genesis                 common.Hash
+	Description             string
+	// ObsoleteBlockNumber is the block that have marked the type as obsolete (ObsoleteRollupType), nil if it's not obsolete
+	ObsoleteBlockNumber *uint64
+	ReceivedAt          time.Time
+}
+
+// IsObsolete returns true if no new rollups can be created with this type
+func (r *RollupType) IsObsolete() bool {
+	return r.ObsoleteBlockNumber != nil
+}
+
+func (r *RollupType) String() string {
+	if r == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("RollupTypeID: %d, BlockNumber: %d, TxHash: %s, ConsensusImplementation: %s, Verifier: %s, ForkID: %d, RollupCompatibilityID: %d, Genesis: %s, Description: %s, Obsolete: %t",
+		r.RollupTypeID, r.BlockNumber, r.TxHash.String(), r.ConsensusImplementation.String(), r.Verifier.String(), r.ForkID,
+		r.RollupCompatibilityID, r.Genesis.String(), r.Description, r.IsObsolete())
+}
+
+func NewRollupTypeFromL1(ethRollupType etherman.RollupType) *RollupType {
+	return &RollupType{
+		RollupTypeID:            uint64(ethRollupType.RollupTypeID),
+		BlockNumber:             ethRollupType.BlockNumber,
+		TxHash:                  ethRollupType.TxHash,
+		ConsensusImplementation: ethRollupType.ConsensusImplementation,
+		Verifier:                ethRollupType.Verifier,
+		ForkID:                  ethRollupType.ForkID,
+		RollupCompatibilityID:   uint64(ethRollupType.RollupCompatibilityID),
+		Genesis:                 ethRollupType.Genesis,
+		Description:             ethRollupType.Description,
+		ReceivedAt:              time.Now(),
+	}
+}
+
+// Rollup is a rollup created (CreateNewRollup) or added (AddExistingRollup) on the RollupManager
+type Rollup struct {
+	RollupID        uint64
+	BlockNumber     uint64 // Block of the creation, linked to sync.block table
+	TxHash          common.Hash
+	RollupAddress   common.Address
+	ChainID         uint64
+	GasTokenAddress common.Address
+	// RollupTypeID and ForkID are the current type of the rollup, the last UpdateRollup or the initial one.
+	// RollupTypeID is 0 if the rollup have been added with AddExistingRollup and not updated since
+	RollupTypeID uint64
+	ForkID       uint64
+	ReceivedAt   time.Time
+}
+
+func (r *Rollup) String() string {
+	if r == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("RollupID: %d, BlockNumber: %d, TxHash: %s, RollupAddress: %s, ChainID: %d, GasTokenAddress: %s, RollupTypeID: %d, ForkID: %d",
+		r.RollupID, r.BlockNumber, r.TxHash.String(), r.RollupAddress.String(), r.ChainID, r.GasTokenAddress.String(), r.RollupTypeID, r.ForkID)
+}
+
+// RollupUpdate is an upgrade of a rollup to a new rollup type (UpdateRollup)
+type RollupUpdate struct {
+	RollupID                       uint64
+	BlockNumber                    uint64 // Linked to sync.block table
+	TxHash                         common.Hash
+	RollupTypeID                   uint64
+	ForkID                         uint64
+	LastVerifiedBatchBeforeUpgrade uint64
+	ReceivedAt                     time.Time
+}
+
+// NewRollupFromL1 returns the Rollup for RollupActionCreate and RollupActionAddExisting and the RollupUpdate for RollupActionUpdate
+func NewRollupFromL1(ethRollup etherman.Rollup) (*Rollup, *RollupUpdate) {
+	if ethRollup.Action == etherman.RollupActionUpdate {
+		return nil, &RollupUpdate{
+			RollupID:                       uint64(ethRollup.RollupID),
+			BlockNumber:                    ethRollup.BlockNumber,
+			TxHash:                         ethRollup.TxHash,
+			RollupTypeID:                   uint64(ethRollup.RollupTypeID),
+			ForkID:                         ethRollup.ForkID,
+			LastVerifiedBatchBeforeUpgrade: ethRollup.LastVerifiedBatchBeforeUpgrade,
+			ReceivedAt:                     time.Now(),
+		}
+	}
+	return &Rollup{
+		RollupID:        uint64(ethRollup.RollupID),
+		BlockNumber:     ethRollup.BlockNumber,
+		TxHash:          ethRollup.TxHash,
+		RollupAddress:   ethRollup.RollupAddress,
+		ChainID:         ethRollup.ChainID,
+		GasTokenAddress: ethRollup.GasTokenAddress,
+		RollupTypeID:    uint64(ethRollup.RollupTypeID),
+		ForkID:          ethRollup.ForkID,
+		ReceivedAt:      time.Now(),
+	}, nil
+}
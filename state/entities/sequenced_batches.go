@@ -7,6 +7,7 @@ import (
 )
 
 type SequencedBatches struct {
+	RollupID        uint64
 	FromBatchNumber uint64
 	ToBatchNumber   uint64
 	L1BlockNumber   uint64
@@ -32,9 +33,10 @@ func (s *SequencedBatches) Key() uint64 {
 	return s.FromBatchNumber
 }
 
-func NewSequencedBatches(fromBatchNumber, toBatchNumber, l1BlockNumber, forkID uint64,
+func NewSequencedBatches(rollupID, fromBatchNumber, toBatchNumber, l1BlockNumber, forkID uint64,
 	timestamp time.Time, receivedAt time.Time, l1InfoRoot common.Hash, source string) *SequencedBatches {
 	return &SequencedBatches{
+		RollupID:        rollupID,
 		FromBatchNumber: fromBatchNumber,
 		ToBatchNumber:   toBatchNumber,
 		L1BlockNumber:   l1BlockNumber,
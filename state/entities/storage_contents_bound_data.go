@@ -1,12 +1,34 @@
 package entities
 
-import "fmt"
+import (
+	"fmt"
+	"slices"
+)
 
 type StorageContentsBoundData struct {
 	RollupID  uint64
 	L1ChainID uint64
+	// RollupIDs are all the rollups synchronized in storage, the first one is RollupID
+	RollupIDs []uint64 `json:",omitempty"`
 }
 
 func (s *StorageContentsBoundData) String() string {
-	return fmt.Sprintf("{RollupID: %d, L1ChainID: %d}", s.RollupID, s.L1ChainID)
+	return fmt.Sprintf("{RollupID: %d, L1ChainID: %d, RollupIDs: %v}", s.RollupID, s.L1ChainID, s.getRollupIDs())
+}
+
+// IsEqual returns true if both contents are the same. Data stored by previous
+// versions don't have RollupIDs, so in that case means that only RollupID is synchronized
+func (s *StorageContentsBoundData) IsEqual(other *StorageContentsBoundData) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	return s.RollupID == other.RollupID && s.L1ChainID == other.L1ChainID &&
+		slices.Equal(s.getRollupIDs(), other.getRollupIDs())
+}
+
+func (s *StorageContentsBoundData) getRollupIDs() []uint64 {
+	if len(s.RollupIDs) == 0 {
+		return []uint64{s.RollupID}
+	}
+	return s.RollupIDs
 }
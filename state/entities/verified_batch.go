@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// VerifiedBatch is a verification of batches on L1. A verification of BatchNumber
+// implies that all the previous batches of the rollup are also verified
+type VerifiedBatch struct {
+	RollupID    uint64
+	BatchNumber uint64 // Last batch verified
+	BlockNumber uint64 // Linked to sync.block table
+	StateRoot   common.Hash
+	Aggregator  common.Address
+	TxHash      common.Hash // Hash of tx inside L1Block that emit this log
+	IsTrusted   bool        // Verified by the trusted aggregator
+	ReceivedAt  time.Time
+}
+
+func (v *VerifiedBatch) IsEqual(o interface{}) bool {
+	other, ok := o.(*VerifiedBatch)
+	if !ok {
+		return false
+	}
+	if v == other {
+		return true
+	}
+	if v == nil || other == nil {
+		return false
+	}
+	return v.RollupID == other.RollupID && v.BatchNumber == other.BatchNumber && v.BlockNumber == other.BlockNumber &&
+		v.StateRoot == other.StateRoot && v.Aggregator == other.Aggregator && v.TxHash == other.TxHash && v.IsTrusted == other.IsTrusted
+}
+
+func (v *VerifiedBatch) Key() uint64 {
+	return v.BatchNumber
+}
+
+func (v *VerifiedBatch) String() string {
+	if v == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("RollupID: %d, BatchNumber: %d, BlockNumber: %d, StateRoot: %s, Aggregator: %s, TxHash: %s, IsTrusted: %t, ReceivedAt: %s",
+		v.RollupID, v.BatchNumber, v.BlockNumber, v.StateRoot.String(), v.Aggregator.String(), v.TxHash.String(), v.IsTrusted, v.ReceivedAt.String())
+}
+
+func NewVerifiedBatchFromL1(ethVerifiedBatch etherman.VerifiedBatch, isTrusted bool) *VerifiedBatch {
+	return &VerifiedBatch{
+		RollupID:    uint64(ethVerifiedBatch.RollupID),
+		BatchNumber: ethVerifiedBatch.BatchNumber,
+		BlockNumber: ethVerifiedBatch.BlockNumber,
+		StateRoot:   ethVerifiedBatch.StateRoot,
+		Aggregator:  ethVerifiedBatch.Aggregator,
+		TxHash:      ethVerifiedBatch.TxHash,
+		IsTrusted:   isTrusted,
+		ReceivedAt:  time.Now(),
+	}
+}
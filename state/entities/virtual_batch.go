@@ -9,6 +9,7 @@ import (
 )
 
 type VirtualBatch struct {
+	RollupID                uint64
 	BatchNumber             uint64
 	ForkID                  uint64
 	BatchL2Data             []byte
@@ -21,6 +22,7 @@ type VirtualBatch struct {
 	ReceivedAt              time.Time
 	BatchTimestamp          *time.Time // This is optional depend on ForkID
 	ExtraInfo               *string
+	GlobalExitRoot          *common.Hash // Only for forkIDs previous to Etrog, the next ones use L1InfoRoot
 }
 
 type BatchExtraInfo struct {
@@ -46,16 +48,20 @@ func (b *VirtualBatch) String() string {
 	if b == nil {
 		return "nil"
 	}
-	res := fmt.Sprintf("BatchNumber: %d, ForkID: %d, BatchL2Data: %s, TxHash: %s, Coinbase: %s, SequencerAddr: %s, BlockNumber: %d, L1InfoRoot: %s, ReceivedAt: %s, BatchTimestamp: %s,",
-		b.BatchNumber, b.ForkID, string(b.BatchL2Data), b.VlogTxHash.String(), b.Coinbase.String(), b.SequencerAddr.String(), b.BlockNumber, b.L1InfoRoot.String(), b.ReceivedAt.String(), b.BatchTimestamp.String())
+	res := fmt.Sprintf("RollupID: %d, BatchNumber: %d, ForkID: %d, BatchL2Data: %s, TxHash: %s, Coinbase: %s, SequencerAddr: %s, BlockNumber: %d, L1InfoRoot: %s, ReceivedAt: %s, BatchTimestamp: %s,",
+		b.RollupID, b.BatchNumber, b.ForkID, string(b.BatchL2Data), b.VlogTxHash.String(), b.Coinbase.String(), b.SequencerAddr.String(), b.BlockNumber, b.L1InfoRoot.String(), b.ReceivedAt.String(), b.BatchTimestamp.String())
 	if b.ExtraInfo != nil {
 		res += fmt.Sprintf(", ExtraInfo: %s", *b.ExtraInfo)
 	}
+	if b.GlobalExitRoot != nil {
+		res += fmt.Sprintf(", GlobalExitRoot: %s", b.GlobalExitRoot.String())
+	}
 	return res
 }
 
 func NewVirtualBatchFromL1(l1BlockNumber, seqFromBatchNumber, forkID uint64, ethSeqBatch etherman.SequencedBatch) *VirtualBatch {
 	res := &VirtualBatch{
+		RollupID:                uint64(ethSeqBatch.RollupID),
 		BatchNumber:             ethSeqBatch.BatchNumber,
 		ForkID:                  forkID,
 		BatchL2Data:             ethSeqBatch.BatchL2Data(),
@@ -71,5 +77,11 @@ func NewVirtualBatchFromL1(l1BlockNumber, seqFromBatchNumber, forkID uint64, eth
 		tstamp := time.Unix(int64(ethSeqBatch.SequencedBatchElderberryData.MaxSequenceTimestamp), 0)
 		res.BatchTimestamp = &tstamp
 	}
+	if ethSeqBatch.PolygonZkEVMBatchData != nil {
+		tstamp := time.Unix(int64(ethSeqBatch.PolygonZkEVMBatchData.Timestamp), 0)
+		res.BatchTimestamp = &tstamp
+		globalExitRoot := common.Hash(ethSeqBatch.PolygonZkEVMBatchData.GlobalExitRoot)
+		res.GlobalExitRoot = &globalExitRoot
+	}
 	return res
 }
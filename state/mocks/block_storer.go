@@ -370,6 +370,63 @@ func (_c *BlockStorer_GetUncheckedBlocks_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// GetUncheckedBlocksCount provides a mock function with given fields: ctx, dbTx
+func (_m *BlockStorer) GetUncheckedBlocksCount(ctx context.Context, dbTx entities.Tx) (uint64, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUncheckedBlocksCount")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (uint64, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) uint64); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStorer_GetUncheckedBlocksCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUncheckedBlocksCount'
+type BlockStorer_GetUncheckedBlocksCount_Call struct {
+	*mock.Call
+}
+
+// GetUncheckedBlocksCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *BlockStorer_Expecter) GetUncheckedBlocksCount(ctx interface{}, dbTx interface{}) *BlockStorer_GetUncheckedBlocksCount_Call {
+	return &BlockStorer_GetUncheckedBlocksCount_Call{Call: _e.mock.On("GetUncheckedBlocksCount", ctx, dbTx)}
+}
+
+func (_c *BlockStorer_GetUncheckedBlocksCount_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *BlockStorer_GetUncheckedBlocksCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *BlockStorer_GetUncheckedBlocksCount_Call) Return(_a0 uint64, _a1 error) *BlockStorer_GetUncheckedBlocksCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStorer_GetUncheckedBlocksCount_Call) RunAndReturn(run func(context.Context, entities.Tx) (uint64, error)) *BlockStorer_GetUncheckedBlocksCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCheckedBlockByNumber provides a mock function with given fields: ctx, blockNumber, newCheckedStatus, dbTx
 func (_m *BlockStorer) UpdateCheckedBlockByNumber(ctx context.Context, blockNumber uint64, newCheckedStatus bool, dbTx entities.Tx) error {
 	ret := _m.Called(ctx, blockNumber, newCheckedStatus, dbTx)
@@ -7,6 +7,8 @@ import (
 
 	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // reorgStorer is an autogenerated mock type for the reorgStorer type
@@ -22,6 +24,175 @@ func (_m *reorgStorer) EXPECT() *reorgStorer_Expecter {
 	return &reorgStorer_Expecter{mock: &_m.Mock}
 }
 
+// AddReorgLog provides a mock function with given fields: ctx, entry, dbTx
+func (_m *reorgStorer) AddReorgLog(ctx context.Context, entry *entities.ReorgLogEntry, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, entry, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReorgLog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ReorgLogEntry, entities.Tx) error); ok {
+		r0 = rf(ctx, entry, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// reorgStorer_AddReorgLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReorgLog'
+type reorgStorer_AddReorgLog_Call struct {
+	*mock.Call
+}
+
+// AddReorgLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *entities.ReorgLogEntry
+//   - dbTx entities.Tx
+func (_e *reorgStorer_Expecter) AddReorgLog(ctx interface{}, entry interface{}, dbTx interface{}) *reorgStorer_AddReorgLog_Call {
+	return &reorgStorer_AddReorgLog_Call{Call: _e.mock.On("AddReorgLog", ctx, entry, dbTx)}
+}
+
+func (_c *reorgStorer_AddReorgLog_Call) Run(run func(ctx context.Context, entry *entities.ReorgLogEntry, dbTx entities.Tx)) *reorgStorer_AddReorgLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ReorgLogEntry), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *reorgStorer_AddReorgLog_Call) Return(_a0 error) *reorgStorer_AddReorgLog_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *reorgStorer_AddReorgLog_Call) RunAndReturn(run func(context.Context, *entities.ReorgLogEntry, entities.Tx) error) *reorgStorer_AddReorgLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReorgImpact provides a mock function with given fields: ctx, firstBlockNumberToKeep, dbTx
+func (_m *reorgStorer) GetReorgImpact(ctx context.Context, firstBlockNumberToKeep uint64, dbTx entities.Tx) (*entities.ReorgImpact, error) {
+	ret := _m.Called(ctx, firstBlockNumberToKeep, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReorgImpact")
+	}
+
+	var r0 *entities.ReorgImpact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.ReorgImpact, error)); ok {
+		return rf(ctx, firstBlockNumberToKeep, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.ReorgImpact); ok {
+		r0 = rf(ctx, firstBlockNumberToKeep, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReorgImpact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, firstBlockNumberToKeep, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// reorgStorer_GetReorgImpact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReorgImpact'
+type reorgStorer_GetReorgImpact_Call struct {
+	*mock.Call
+}
+
+// GetReorgImpact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - firstBlockNumberToKeep uint64
+//   - dbTx entities.Tx
+func (_e *reorgStorer_Expecter) GetReorgImpact(ctx interface{}, firstBlockNumberToKeep interface{}, dbTx interface{}) *reorgStorer_GetReorgImpact_Call {
+	return &reorgStorer_GetReorgImpact_Call{Call: _e.mock.On("GetReorgImpact", ctx, firstBlockNumberToKeep, dbTx)}
+}
+
+func (_c *reorgStorer_GetReorgImpact_Call) Run(run func(ctx context.Context, firstBlockNumberToKeep uint64, dbTx entities.Tx)) *reorgStorer_GetReorgImpact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *reorgStorer_GetReorgImpact_Call) Return(_a0 *entities.ReorgImpact, _a1 error) *reorgStorer_GetReorgImpact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *reorgStorer_GetReorgImpact_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.ReorgImpact, error)) *reorgStorer_GetReorgImpact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReorgLogs provides a mock function with given fields: ctx, fromTimestamp, limit, dbTx
+func (_m *reorgStorer) GetReorgLogs(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx entities.Tx) ([]entities.ReorgLogEntry, error) {
+	ret := _m.Called(ctx, fromTimestamp, limit, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReorgLogs")
+	}
+
+	var r0 []entities.ReorgLogEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64, entities.Tx) ([]entities.ReorgLogEntry, error)); ok {
+		return rf(ctx, fromTimestamp, limit, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64, entities.Tx) []entities.ReorgLogEntry); ok {
+		r0 = rf(ctx, fromTimestamp, limit, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ReorgLogEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, fromTimestamp, limit, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// reorgStorer_GetReorgLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReorgLogs'
+type reorgStorer_GetReorgLogs_Call struct {
+	*mock.Call
+}
+
+// GetReorgLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromTimestamp time.Time
+//   - limit uint64
+//   - dbTx entities.Tx
+func (_e *reorgStorer_Expecter) GetReorgLogs(ctx interface{}, fromTimestamp interface{}, limit interface{}, dbTx interface{}) *reorgStorer_GetReorgLogs_Call {
+	return &reorgStorer_GetReorgLogs_Call{Call: _e.mock.On("GetReorgLogs", ctx, fromTimestamp, limit, dbTx)}
+}
+
+func (_c *reorgStorer_GetReorgLogs_Call) Run(run func(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx entities.Tx)) *reorgStorer_GetReorgLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *reorgStorer_GetReorgLogs_Call) Return(_a0 []entities.ReorgLogEntry, _a1 error) *reorgStorer_GetReorgLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *reorgStorer_GetReorgLogs_Call) RunAndReturn(run func(context.Context, time.Time, uint64, entities.Tx) ([]entities.ReorgLogEntry, error)) *reorgStorer_GetReorgLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResetToL1BlockNumber provides a mock function with given fields: ctx, firstBlockNumberToKeep, dbTx
 func (_m *reorgStorer) ResetToL1BlockNumber(ctx context.Context, firstBlockNumberToKeep uint64, dbTx entities.Tx) error {
 	ret := _m.Called(ctx, firstBlockNumberToKeep, dbTx)
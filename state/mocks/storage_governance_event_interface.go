@@ -0,0 +1,208 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_state
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StorageGovernanceEventInterface is an autogenerated mock type for the StorageGovernanceEventInterface type
+type StorageGovernanceEventInterface struct {
+	mock.Mock
+}
+
+type StorageGovernanceEventInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StorageGovernanceEventInterface) EXPECT() *StorageGovernanceEventInterface_Expecter {
+	return &StorageGovernanceEventInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddGovernanceEvent provides a mock function with given fields: ctx, governanceEvent, dbTx
+func (_m *StorageGovernanceEventInterface) AddGovernanceEvent(ctx context.Context, governanceEvent *entities.GovernanceEvent, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, governanceEvent, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGovernanceEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.GovernanceEvent, entities.Tx) error); ok {
+		r0 = rf(ctx, governanceEvent, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageGovernanceEventInterface_AddGovernanceEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGovernanceEvent'
+type StorageGovernanceEventInterface_AddGovernanceEvent_Call struct {
+	*mock.Call
+}
+
+// AddGovernanceEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - governanceEvent *entities.GovernanceEvent
+//   - dbTx entities.Tx
+func (_e *StorageGovernanceEventInterface_Expecter) AddGovernanceEvent(ctx interface{}, governanceEvent interface{}, dbTx interface{}) *StorageGovernanceEventInterface_AddGovernanceEvent_Call {
+	return &StorageGovernanceEventInterface_AddGovernanceEvent_Call{Call: _e.mock.On("AddGovernanceEvent", ctx, governanceEvent, dbTx)}
+}
+
+func (_c *StorageGovernanceEventInterface_AddGovernanceEvent_Call) Run(run func(ctx context.Context, governanceEvent *entities.GovernanceEvent, dbTx entities.Tx)) *StorageGovernanceEventInterface_AddGovernanceEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.GovernanceEvent), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageGovernanceEventInterface_AddGovernanceEvent_Call) Return(_a0 error) *StorageGovernanceEventInterface_AddGovernanceEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageGovernanceEventInterface_AddGovernanceEvent_Call) RunAndReturn(run func(context.Context, *entities.GovernanceEvent, entities.Tx) error) *StorageGovernanceEventInterface_AddGovernanceEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGovernanceEventAtBlock provides a mock function with given fields: ctx, rollupID, parameter, blockNumber, dbTx
+func (_m *StorageGovernanceEventInterface) GetGovernanceEventAtBlock(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx entities.Tx) (*entities.GovernanceEvent, error) {
+	ret := _m.Called(ctx, rollupID, parameter, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGovernanceEventAtBlock")
+	}
+
+	var r0 *entities.GovernanceEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, entities.Tx) (*entities.GovernanceEvent, error)); ok {
+		return rf(ctx, rollupID, parameter, blockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, entities.Tx) *entities.GovernanceEvent); ok {
+		r0 = rf(ctx, rollupID, parameter, blockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GovernanceEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, parameter, blockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageGovernanceEventInterface_GetGovernanceEventAtBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGovernanceEventAtBlock'
+type StorageGovernanceEventInterface_GetGovernanceEventAtBlock_Call struct {
+	*mock.Call
+}
+
+// GetGovernanceEventAtBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - parameter string
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StorageGovernanceEventInterface_Expecter) GetGovernanceEventAtBlock(ctx interface{}, rollupID interface{}, parameter interface{}, blockNumber interface{}, dbTx interface{}) *StorageGovernanceEventInterface_GetGovernanceEventAtBlock_Call {
+	return &StorageGovernanceEventInterface_GetGovernanceEventAtBlock_Call{Call: _e.mock.On("GetGovernanceEventAtBlock", ctx, rollupID, parameter, blockNumber, dbTx)}
+}
+
+func (_c *StorageGovernanceEventInterface_GetGovernanceEventAtBlock_Call) Run(run func(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx entities.Tx)) *StorageGovernanceEventInterface_GetGovernanceEventAtBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(uint64), args[4].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageGovernanceEventInterface_GetGovernanceEventAtBlock_Call) Return(_a0 *entities.GovernanceEvent, _a1 error) *StorageGovernanceEventInterface_GetGovernanceEventAtBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageGovernanceEventInterface_GetGovernanceEventAtBlock_Call) RunAndReturn(run func(context.Context, uint64, string, uint64, entities.Tx) (*entities.GovernanceEvent, error)) *StorageGovernanceEventInterface_GetGovernanceEventAtBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGovernanceEvents provides a mock function with given fields: ctx, rollupID, parameter, dbTx
+func (_m *StorageGovernanceEventInterface) GetGovernanceEvents(ctx context.Context, rollupID uint64, parameter string, dbTx entities.Tx) ([]entities.GovernanceEvent, error) {
+	ret := _m.Called(ctx, rollupID, parameter, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGovernanceEvents")
+	}
+
+	var r0 []entities.GovernanceEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, entities.Tx) ([]entities.GovernanceEvent, error)); ok {
+		return rf(ctx, rollupID, parameter, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, entities.Tx) []entities.GovernanceEvent); ok {
+		r0 = rf(ctx, rollupID, parameter, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.GovernanceEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, parameter, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageGovernanceEventInterface_GetGovernanceEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGovernanceEvents'
+type StorageGovernanceEventInterface_GetGovernanceEvents_Call struct {
+	*mock.Call
+}
+
+// GetGovernanceEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - parameter string
+//   - dbTx entities.Tx
+func (_e *StorageGovernanceEventInterface_Expecter) GetGovernanceEvents(ctx interface{}, rollupID interface{}, parameter interface{}, dbTx interface{}) *StorageGovernanceEventInterface_GetGovernanceEvents_Call {
+	return &StorageGovernanceEventInterface_GetGovernanceEvents_Call{Call: _e.mock.On("GetGovernanceEvents", ctx, rollupID, parameter, dbTx)}
+}
+
+func (_c *StorageGovernanceEventInterface_GetGovernanceEvents_Call) Run(run func(ctx context.Context, rollupID uint64, parameter string, dbTx entities.Tx)) *StorageGovernanceEventInterface_GetGovernanceEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageGovernanceEventInterface_GetGovernanceEvents_Call) Return(_a0 []entities.GovernanceEvent, _a1 error) *StorageGovernanceEventInterface_GetGovernanceEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageGovernanceEventInterface_GetGovernanceEvents_Call) RunAndReturn(run func(context.Context, uint64, string, entities.Tx) ([]entities.GovernanceEvent, error)) *StorageGovernanceEventInterface_GetGovernanceEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStorageGovernanceEventInterface creates a new instance of StorageGovernanceEventInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStorageGovernanceEventInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StorageGovernanceEventInterface {
+	mock := &StorageGovernanceEventInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
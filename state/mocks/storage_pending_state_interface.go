@@ -0,0 +1,481 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_state
+
+import (
+	context "context"
+
+	common "github.com/ethereum/go-ethereum/common"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StoragePendingStateInterface is an autogenerated mock type for the StoragePendingStateInterface type
+type StoragePendingStateInterface struct {
+	mock.Mock
+}
+
+type StoragePendingStateInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StoragePendingStateInterface) EXPECT() *StoragePendingStateInterface_Expecter {
+	return &StoragePendingStateInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddPendingState provides a mock function with given fields: ctx, pendingState, dbTx
+func (_m *StoragePendingStateInterface) AddPendingState(ctx context.Context, pendingState *entities.PendingState, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, pendingState, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPendingState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.PendingState, entities.Tx) error); ok {
+		r0 = rf(ctx, pendingState, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoragePendingStateInterface_AddPendingState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPendingState'
+type StoragePendingStateInterface_AddPendingState_Call struct {
+	*mock.Call
+}
+
+// AddPendingState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pendingState *entities.PendingState
+//   - dbTx entities.Tx
+func (_e *StoragePendingStateInterface_Expecter) AddPendingState(ctx interface{}, pendingState interface{}, dbTx interface{}) *StoragePendingStateInterface_AddPendingState_Call {
+	return &StoragePendingStateInterface_AddPendingState_Call{Call: _e.mock.On("AddPendingState", ctx, pendingState, dbTx)}
+}
+
+func (_c *StoragePendingStateInterface_AddPendingState_Call) Run(run func(ctx context.Context, pendingState *entities.PendingState, dbTx entities.Tx)) *StoragePendingStateInterface_AddPendingState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.PendingState), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_AddPendingState_Call) Return(_a0 error) *StoragePendingStateInterface_AddPendingState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_AddPendingState_Call) RunAndReturn(run func(context.Context, *entities.PendingState, entities.Tx) error) *StoragePendingStateInterface_AddPendingState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsolidatePendingStates provides a mock function with given fields: ctx, rollupID, batchNumber, blockNumber, dbTx
+func (_m *StoragePendingStateInterface) ConsolidatePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupID, batchNumber, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsolidatePendingStates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupID, batchNumber, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoragePendingStateInterface_ConsolidatePendingStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsolidatePendingStates'
+type StoragePendingStateInterface_ConsolidatePendingStates_Call struct {
+	*mock.Call
+}
+
+// ConsolidatePendingStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StoragePendingStateInterface_Expecter) ConsolidatePendingStates(ctx interface{}, rollupID interface{}, batchNumber interface{}, blockNumber interface{}, dbTx interface{}) *StoragePendingStateInterface_ConsolidatePendingStates_Call {
+	return &StoragePendingStateInterface_ConsolidatePendingStates_Call{Call: _e.mock.On("ConsolidatePendingStates", ctx, rollupID, batchNumber, blockNumber, dbTx)}
+}
+
+func (_c *StoragePendingStateInterface_ConsolidatePendingStates_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx entities.Tx)) *StoragePendingStateInterface_ConsolidatePendingStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(uint64), args[4].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_ConsolidatePendingStates_Call) Return(_a0 error) *StoragePendingStateInterface_ConsolidatePendingStates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_ConsolidatePendingStates_Call) RunAndReturn(run func(context.Context, uint64, uint64, uint64, entities.Tx) error) *StoragePendingStateInterface_ConsolidatePendingStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConsolidatedBatchNumber provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *StoragePendingStateInterface) GetConsolidatedBatchNumber(ctx context.Context, rollupID uint64, dbTx entities.Tx) (uint64, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsolidatedBatchNumber")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (uint64, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) uint64); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StoragePendingStateInterface_GetConsolidatedBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConsolidatedBatchNumber'
+type StoragePendingStateInterface_GetConsolidatedBatchNumber_Call struct {
+	*mock.Call
+}
+
+// GetConsolidatedBatchNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *StoragePendingStateInterface_Expecter) GetConsolidatedBatchNumber(ctx interface{}, rollupID interface{}, dbTx interface{}) *StoragePendingStateInterface_GetConsolidatedBatchNumber_Call {
+	return &StoragePendingStateInterface_GetConsolidatedBatchNumber_Call{Call: _e.mock.On("GetConsolidatedBatchNumber", ctx, rollupID, dbTx)}
+}
+
+func (_c *StoragePendingStateInterface_GetConsolidatedBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *StoragePendingStateInterface_GetConsolidatedBatchNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_GetConsolidatedBatchNumber_Call) Return(_a0 uint64, _a1 error) *StoragePendingStateInterface_GetConsolidatedBatchNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_GetConsolidatedBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (uint64, error)) *StoragePendingStateInterface_GetConsolidatedBatchNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGovernanceEventAtBlock provides a mock function with given fields: ctx, rollupID, parameter, blockNumber, dbTx
+func (_m *StoragePendingStateInterface) GetGovernanceEventAtBlock(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx entities.Tx) (*entities.GovernanceEvent, error) {
+	ret := _m.Called(ctx, rollupID, parameter, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGovernanceEventAtBlock")
+	}
+
+	var r0 *entities.GovernanceEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, entities.Tx) (*entities.GovernanceEvent, error)); ok {
+		return rf(ctx, rollupID, parameter, blockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, entities.Tx) *entities.GovernanceEvent); ok {
+		r0 = rf(ctx, rollupID, parameter, blockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GovernanceEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, parameter, blockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StoragePendingStateInterface_GetGovernanceEventAtBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGovernanceEventAtBlock'
+type StoragePendingStateInterface_GetGovernanceEventAtBlock_Call struct {
+	*mock.Call
+}
+
+// GetGovernanceEventAtBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - parameter string
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StoragePendingStateInterface_Expecter) GetGovernanceEventAtBlock(ctx interface{}, rollupID interface{}, parameter interface{}, blockNumber interface{}, dbTx interface{}) *StoragePendingStateInterface_GetGovernanceEventAtBlock_Call {
+	return &StoragePendingStateInterface_GetGovernanceEventAtBlock_Call{Call: _e.mock.On("GetGovernanceEventAtBlock", ctx, rollupID, parameter, blockNumber, dbTx)}
+}
+
+func (_c *StoragePendingStateInterface_GetGovernanceEventAtBlock_Call) Run(run func(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx entities.Tx)) *StoragePendingStateInterface_GetGovernanceEventAtBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(uint64), args[4].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_GetGovernanceEventAtBlock_Call) Return(_a0 *entities.GovernanceEvent, _a1 error) *StoragePendingStateInterface_GetGovernanceEventAtBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_GetGovernanceEventAtBlock_Call) RunAndReturn(run func(context.Context, uint64, string, uint64, entities.Tx) (*entities.GovernanceEvent, error)) *StoragePendingStateInterface_GetGovernanceEventAtBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingState provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *StoragePendingStateInterface) GetPendingState(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.PendingState, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingState")
+	}
+
+	var r0 *entities.PendingState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.PendingState, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.PendingState); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PendingState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StoragePendingStateInterface_GetPendingState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingState'
+type StoragePendingStateInterface_GetPendingState_Call struct {
+	*mock.Call
+}
+
+// GetPendingState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - dbTx entities.Tx
+func (_e *StoragePendingStateInterface_Expecter) GetPendingState(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *StoragePendingStateInterface_GetPendingState_Call {
+	return &StoragePendingStateInterface_GetPendingState_Call{Call: _e.mock.On("GetPendingState", ctx, rollupID, batchNumber, dbTx)}
+}
+
+func (_c *StoragePendingStateInterface_GetPendingState_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *StoragePendingStateInterface_GetPendingState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_GetPendingState_Call) Return(_a0 *entities.PendingState, _a1 error) *StoragePendingStateInterface_GetPendingState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_GetPendingState_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.PendingState, error)) *StoragePendingStateInterface_GetPendingState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingStates provides a mock function with given fields: ctx, rollupID, fromBatchNumber, dbTx
+func (_m *StoragePendingStateInterface) GetPendingStates(ctx context.Context, rollupID uint64, fromBatchNumber uint64, dbTx entities.Tx) ([]entities.PendingState, error) {
+	ret := _m.Called(ctx, rollupID, fromBatchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingStates")
+	}
+
+	var r0 []entities.PendingState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) ([]entities.PendingState, error)); ok {
+		return rf(ctx, rollupID, fromBatchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) []entities.PendingState); ok {
+		r0 = rf(ctx, rollupID, fromBatchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.PendingState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, fromBatchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StoragePendingStateInterface_GetPendingStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingStates'
+type StoragePendingStateInterface_GetPendingStates_Call struct {
+	*mock.Call
+}
+
+// GetPendingStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - fromBatchNumber uint64
+//   - dbTx entities.Tx
+func (_e *StoragePendingStateInterface_Expecter) GetPendingStates(ctx interface{}, rollupID interface{}, fromBatchNumber interface{}, dbTx interface{}) *StoragePendingStateInterface_GetPendingStates_Call {
+	return &StoragePendingStateInterface_GetPendingStates_Call{Call: _e.mock.On("GetPendingStates", ctx, rollupID, fromBatchNumber, dbTx)}
+}
+
+func (_c *StoragePendingStateInterface_GetPendingStates_Call) Run(run func(ctx context.Context, rollupID uint64, fromBatchNumber uint64, dbTx entities.Tx)) *StoragePendingStateInterface_GetPendingStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_GetPendingStates_Call) Return(_a0 []entities.PendingState, _a1 error) *StoragePendingStateInterface_GetPendingStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_GetPendingStates_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) ([]entities.PendingState, error)) *StoragePendingStateInterface_GetPendingStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OverridePendingStates provides a mock function with given fields: ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx
+func (_m *StoragePendingStateInterface) OverridePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OverridePendingStates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, common.Hash, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoragePendingStateInterface_OverridePendingStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OverridePendingStates'
+type StoragePendingStateInterface_OverridePendingStates_Call struct {
+	*mock.Call
+}
+
+// OverridePendingStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - stateRoot common.Hash
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StoragePendingStateInterface_Expecter) OverridePendingStates(ctx interface{}, rollupID interface{}, batchNumber interface{}, stateRoot interface{}, blockNumber interface{}, dbTx interface{}) *StoragePendingStateInterface_OverridePendingStates_Call {
+	return &StoragePendingStateInterface_OverridePendingStates_Call{Call: _e.mock.On("OverridePendingStates", ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)}
+}
+
+func (_c *StoragePendingStateInterface_OverridePendingStates_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx entities.Tx)) *StoragePendingStateInterface_OverridePendingStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(common.Hash), args[4].(uint64), args[5].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_OverridePendingStates_Call) Return(_a0 error) *StoragePendingStateInterface_OverridePendingStates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_OverridePendingStates_Call) RunAndReturn(run func(context.Context, uint64, uint64, common.Hash, uint64, entities.Tx) error) *StoragePendingStateInterface_OverridePendingStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPendingStateNonDeterministic provides a mock function with given fields: ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx
+func (_m *StoragePendingStateInterface) SetPendingStateNonDeterministic(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPendingStateNonDeterministic")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, common.Hash, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoragePendingStateInterface_SetPendingStateNonDeterministic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPendingStateNonDeterministic'
+type StoragePendingStateInterface_SetPendingStateNonDeterministic_Call struct {
+	*mock.Call
+}
+
+// SetPendingStateNonDeterministic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - storedStateRoot common.Hash
+//   - provedStateRoot common.Hash
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StoragePendingStateInterface_Expecter) SetPendingStateNonDeterministic(ctx interface{}, storedStateRoot interface{}, provedStateRoot interface{}, blockNumber interface{}, dbTx interface{}) *StoragePendingStateInterface_SetPendingStateNonDeterministic_Call {
+	return &StoragePendingStateInterface_SetPendingStateNonDeterministic_Call{Call: _e.mock.On("SetPendingStateNonDeterministic", ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)}
+}
+
+func (_c *StoragePendingStateInterface_SetPendingStateNonDeterministic_Call) Run(run func(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx entities.Tx)) *StoragePendingStateInterface_SetPendingStateNonDeterministic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Hash), args[2].(common.Hash), args[3].(uint64), args[4].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_SetPendingStateNonDeterministic_Call) Return(_a0 error) *StoragePendingStateInterface_SetPendingStateNonDeterministic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StoragePendingStateInterface_SetPendingStateNonDeterministic_Call) RunAndReturn(run func(context.Context, common.Hash, common.Hash, uint64, entities.Tx) error) *StoragePendingStateInterface_SetPendingStateNonDeterministic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStoragePendingStateInterface creates a new instance of StoragePendingStateInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStoragePendingStateInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StoragePendingStateInterface {
+	mock := &StoragePendingStateInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
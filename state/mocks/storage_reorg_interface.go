@@ -22,6 +22,54 @@ func (_m *StorageReorgInterface) EXPECT() *StorageReorgInterface_Expecter {
 	return &StorageReorgInterface_Expecter{mock: &_m.Mock}
 }
 
+// AddReorgLog provides a mock function with given fields: ctx, entry, dbTx
+func (_m *StorageReorgInterface) AddReorgLog(ctx context.Context, entry *entities.ReorgLogEntry, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, entry, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReorgLog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ReorgLogEntry, entities.Tx) error); ok {
+		r0 = rf(ctx, entry, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageReorgInterface_AddReorgLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReorgLog'
+type StorageReorgInterface_AddReorgLog_Call struct {
+	*mock.Call
+}
+
+// AddReorgLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *entities.ReorgLogEntry
+//   - dbTx entities.Tx
+func (_e *StorageReorgInterface_Expecter) AddReorgLog(ctx interface{}, entry interface{}, dbTx interface{}) *StorageReorgInterface_AddReorgLog_Call {
+	return &StorageReorgInterface_AddReorgLog_Call{Call: _e.mock.On("AddReorgLog", ctx, entry, dbTx)}
+}
+
+func (_c *StorageReorgInterface_AddReorgLog_Call) Run(run func(ctx context.Context, entry *entities.ReorgLogEntry, dbTx entities.Tx)) *StorageReorgInterface_AddReorgLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ReorgLogEntry), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageReorgInterface_AddReorgLog_Call) Return(_a0 error) *StorageReorgInterface_AddReorgLog_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageReorgInterface_AddReorgLog_Call) RunAndReturn(run func(context.Context, *entities.ReorgLogEntry, entities.Tx) error) *StorageReorgInterface_AddReorgLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLastBlock provides a mock function with given fields: ctx, dbTx
 func (_m *StorageReorgInterface) GetLastBlock(ctx context.Context, dbTx entities.Tx) (*entities.L1Block, error) {
 	ret := _m.Called(ctx, dbTx)
@@ -81,6 +129,66 @@ func (_c *StorageReorgInterface_GetLastBlock_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetReorgImpact provides a mock function with given fields: ctx, firstBlockNumberToKeep, dbTx
+func (_m *StorageReorgInterface) GetReorgImpact(ctx context.Context, firstBlockNumberToKeep uint64, dbTx entities.Tx) (*entities.ReorgImpact, error) {
+	ret := _m.Called(ctx, firstBlockNumberToKeep, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReorgImpact")
+	}
+
+	var r0 *entities.ReorgImpact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.ReorgImpact, error)); ok {
+		return rf(ctx, firstBlockNumberToKeep, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.ReorgImpact); ok {
+		r0 = rf(ctx, firstBlockNumberToKeep, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReorgImpact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, firstBlockNumberToKeep, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageReorgInterface_GetReorgImpact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReorgImpact'
+type StorageReorgInterface_GetReorgImpact_Call struct {
+	*mock.Call
+}
+
+// GetReorgImpact is a helper method to define mock.On call
+//   - ctx context.Context
+//   - firstBlockNumberToKeep uint64
+//   - dbTx entities.Tx
+func (_e *StorageReorgInterface_Expecter) GetReorgImpact(ctx interface{}, firstBlockNumberToKeep interface{}, dbTx interface{}) *StorageReorgInterface_GetReorgImpact_Call {
+	return &StorageReorgInterface_GetReorgImpact_Call{Call: _e.mock.On("GetReorgImpact", ctx, firstBlockNumberToKeep, dbTx)}
+}
+
+func (_c *StorageReorgInterface_GetReorgImpact_Call) Run(run func(ctx context.Context, firstBlockNumberToKeep uint64, dbTx entities.Tx)) *StorageReorgInterface_GetReorgImpact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageReorgInterface_GetReorgImpact_Call) Return(_a0 *entities.ReorgImpact, _a1 error) *StorageReorgInterface_GetReorgImpact_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageReorgInterface_GetReorgImpact_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.ReorgImpact, error)) *StorageReorgInterface_GetReorgImpact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResetToL1BlockNumber provides a mock function with given fields: ctx, firstBlockNumberToKeep, dbTx
 func (_m *StorageReorgInterface) ResetToL1BlockNumber(ctx context.Context, firstBlockNumberToKeep uint64, dbTx entities.Tx) error {
 	ret := _m.Called(ctx, firstBlockNumberToKeep, dbTx)
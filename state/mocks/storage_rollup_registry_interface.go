@@ -0,0 +1,468 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_state
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StorageRollupRegistryInterface is an autogenerated mock type for the StorageRollupRegistryInterface type
+type StorageRollupRegistryInterface struct {
+	mock.Mock
+}
+
+type StorageRollupRegistryInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StorageRollupRegistryInterface) EXPECT() *StorageRollupRegistryInterface_Expecter {
+	return &StorageRollupRegistryInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddRollup provides a mock function with given fields: ctx, rollup, dbTx
+func (_m *StorageRollupRegistryInterface) AddRollup(ctx context.Context, rollup *entities.Rollup, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollup, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Rollup, entities.Tx) error); ok {
+		r0 = rf(ctx, rollup, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageRollupRegistryInterface_AddRollup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollup'
+type StorageRollupRegistryInterface_AddRollup_Call struct {
+	*mock.Call
+}
+
+// AddRollup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollup *entities.Rollup
+//   - dbTx entities.Tx
+func (_e *StorageRollupRegistryInterface_Expecter) AddRollup(ctx interface{}, rollup interface{}, dbTx interface{}) *StorageRollupRegistryInterface_AddRollup_Call {
+	return &StorageRollupRegistryInterface_AddRollup_Call{Call: _e.mock.On("AddRollup", ctx, rollup, dbTx)}
+}
+
+func (_c *StorageRollupRegistryInterface_AddRollup_Call) Run(run func(ctx context.Context, rollup *entities.Rollup, dbTx entities.Tx)) *StorageRollupRegistryInterface_AddRollup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.Rollup), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_AddRollup_Call) Return(_a0 error) *StorageRollupRegistryInterface_AddRollup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_AddRollup_Call) RunAndReturn(run func(context.Context, *entities.Rollup, entities.Tx) error) *StorageRollupRegistryInterface_AddRollup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRollupType provides a mock function with given fields: ctx, rollupType, dbTx
+func (_m *StorageRollupRegistryInterface) AddRollupType(ctx context.Context, rollupType *entities.RollupType, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupType, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollupType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RollupType, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupType, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageRollupRegistryInterface_AddRollupType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollupType'
+type StorageRollupRegistryInterface_AddRollupType_Call struct {
+	*mock.Call
+}
+
+// AddRollupType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupType *entities.RollupType
+//   - dbTx entities.Tx
+func (_e *StorageRollupRegistryInterface_Expecter) AddRollupType(ctx interface{}, rollupType interface{}, dbTx interface{}) *StorageRollupRegistryInterface_AddRollupType_Call {
+	return &StorageRollupRegistryInterface_AddRollupType_Call{Call: _e.mock.On("AddRollupType", ctx, rollupType, dbTx)}
+}
+
+func (_c *StorageRollupRegistryInterface_AddRollupType_Call) Run(run func(ctx context.Context, rollupType *entities.RollupType, dbTx entities.Tx)) *StorageRollupRegistryInterface_AddRollupType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.RollupType), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_AddRollupType_Call) Return(_a0 error) *StorageRollupRegistryInterface_AddRollupType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_AddRollupType_Call) RunAndReturn(run func(context.Context, *entities.RollupType, entities.Tx) error) *StorageRollupRegistryInterface_AddRollupType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRollupUpdate provides a mock function with given fields: ctx, rollupUpdate, dbTx
+func (_m *StorageRollupRegistryInterface) AddRollupUpdate(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupUpdate, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollupUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RollupUpdate, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupUpdate, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageRollupRegistryInterface_AddRollupUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollupUpdate'
+type StorageRollupRegistryInterface_AddRollupUpdate_Call struct {
+	*mock.Call
+}
+
+// AddRollupUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupUpdate *entities.RollupUpdate
+//   - dbTx entities.Tx
+func (_e *StorageRollupRegistryInterface_Expecter) AddRollupUpdate(ctx interface{}, rollupUpdate interface{}, dbTx interface{}) *StorageRollupRegistryInterface_AddRollupUpdate_Call {
+	return &StorageRollupRegistryInterface_AddRollupUpdate_Call{Call: _e.mock.On("AddRollupUpdate", ctx, rollupUpdate, dbTx)}
+}
+
+func (_c *StorageRollupRegistryInterface_AddRollupUpdate_Call) Run(run func(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx entities.Tx)) *StorageRollupRegistryInterface_AddRollupUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.RollupUpdate), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_AddRollupUpdate_Call) Return(_a0 error) *StorageRollupRegistryInterface_AddRollupUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_AddRollupUpdate_Call) RunAndReturn(run func(context.Context, *entities.RollupUpdate, entities.Tx) error) *StorageRollupRegistryInterface_AddRollupUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRollup provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *StorageRollupRegistryInterface) GetRollup(ctx context.Context, rollupID uint64, dbTx entities.Tx) (*entities.Rollup, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollup")
+	}
+
+	var r0 *entities.Rollup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.Rollup, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.Rollup); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Rollup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageRollupRegistryInterface_GetRollup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollup'
+type StorageRollupRegistryInterface_GetRollup_Call struct {
+	*mock.Call
+}
+
+// GetRollup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *StorageRollupRegistryInterface_Expecter) GetRollup(ctx interface{}, rollupID interface{}, dbTx interface{}) *StorageRollupRegistryInterface_GetRollup_Call {
+	return &StorageRollupRegistryInterface_GetRollup_Call{Call: _e.mock.On("GetRollup", ctx, rollupID, dbTx)}
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollup_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *StorageRollupRegistryInterface_GetRollup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollup_Call) Return(_a0 *entities.Rollup, _a1 error) *StorageRollupRegistryInterface_GetRollup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollup_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.Rollup, error)) *StorageRollupRegistryInterface_GetRollup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRollupType provides a mock function with given fields: ctx, rollupTypeID, dbTx
+func (_m *StorageRollupRegistryInterface) GetRollupType(ctx context.Context, rollupTypeID uint64, dbTx entities.Tx) (*entities.RollupType, error) {
+	ret := _m.Called(ctx, rollupTypeID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollupType")
+	}
+
+	var r0 *entities.RollupType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.RollupType, error)); ok {
+		return rf(ctx, rollupTypeID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.RollupType); ok {
+		r0 = rf(ctx, rollupTypeID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.RollupType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupTypeID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageRollupRegistryInterface_GetRollupType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollupType'
+type StorageRollupRegistryInterface_GetRollupType_Call struct {
+	*mock.Call
+}
+
+// GetRollupType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupTypeID uint64
+//   - dbTx entities.Tx
+func (_e *StorageRollupRegistryInterface_Expecter) GetRollupType(ctx interface{}, rollupTypeID interface{}, dbTx interface{}) *StorageRollupRegistryInterface_GetRollupType_Call {
+	return &StorageRollupRegistryInterface_GetRollupType_Call{Call: _e.mock.On("GetRollupType", ctx, rollupTypeID, dbTx)}
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollupType_Call) Run(run func(ctx context.Context, rollupTypeID uint64, dbTx entities.Tx)) *StorageRollupRegistryInterface_GetRollupType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollupType_Call) Return(_a0 *entities.RollupType, _a1 error) *StorageRollupRegistryInterface_GetRollupType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollupType_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.RollupType, error)) *StorageRollupRegistryInterface_GetRollupType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRollupTypes provides a mock function with given fields: ctx, dbTx
+func (_m *StorageRollupRegistryInterface) GetRollupTypes(ctx context.Context, dbTx entities.Tx) ([]entities.RollupType, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollupTypes")
+	}
+
+	var r0 []entities.RollupType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.RollupType, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.RollupType); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RollupType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageRollupRegistryInterface_GetRollupTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollupTypes'
+type StorageRollupRegistryInterface_GetRollupTypes_Call struct {
+	*mock.Call
+}
+
+// GetRollupTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *StorageRollupRegistryInterface_Expecter) GetRollupTypes(ctx interface{}, dbTx interface{}) *StorageRollupRegistryInterface_GetRollupTypes_Call {
+	return &StorageRollupRegistryInterface_GetRollupTypes_Call{Call: _e.mock.On("GetRollupTypes", ctx, dbTx)}
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollupTypes_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *StorageRollupRegistryInterface_GetRollupTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollupTypes_Call) Return(_a0 []entities.RollupType, _a1 error) *StorageRollupRegistryInterface_GetRollupTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollupTypes_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.RollupType, error)) *StorageRollupRegistryInterface_GetRollupTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRollups provides a mock function with given fields: ctx, dbTx
+func (_m *StorageRollupRegistryInterface) GetRollups(ctx context.Context, dbTx entities.Tx) ([]entities.Rollup, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollups")
+	}
+
+	var r0 []entities.Rollup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.Rollup, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.Rollup); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Rollup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageRollupRegistryInterface_GetRollups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollups'
+type StorageRollupRegistryInterface_GetRollups_Call struct {
+	*mock.Call
+}
+
+// GetRollups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *StorageRollupRegistryInterface_Expecter) GetRollups(ctx interface{}, dbTx interface{}) *StorageRollupRegistryInterface_GetRollups_Call {
+	return &StorageRollupRegistryInterface_GetRollups_Call{Call: _e.mock.On("GetRollups", ctx, dbTx)}
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollups_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *StorageRollupRegistryInterface_GetRollups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollups_Call) Return(_a0 []entities.Rollup, _a1 error) *StorageRollupRegistryInterface_GetRollups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_GetRollups_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.Rollup, error)) *StorageRollupRegistryInterface_GetRollups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRollupTypeObsolete provides a mock function with given fields: ctx, rollupTypeID, blockNumber, dbTx
+func (_m *StorageRollupRegistryInterface) SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupTypeID, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRollupTypeObsolete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupTypeID, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageRollupRegistryInterface_SetRollupTypeObsolete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRollupTypeObsolete'
+type StorageRollupRegistryInterface_SetRollupTypeObsolete_Call struct {
+	*mock.Call
+}
+
+// SetRollupTypeObsolete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupTypeID uint64
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StorageRollupRegistryInterface_Expecter) SetRollupTypeObsolete(ctx interface{}, rollupTypeID interface{}, blockNumber interface{}, dbTx interface{}) *StorageRollupRegistryInterface_SetRollupTypeObsolete_Call {
+	return &StorageRollupRegistryInterface_SetRollupTypeObsolete_Call{Call: _e.mock.On("SetRollupTypeObsolete", ctx, rollupTypeID, blockNumber, dbTx)}
+}
+
+func (_c *StorageRollupRegistryInterface_SetRollupTypeObsolete_Call) Run(run func(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx entities.Tx)) *StorageRollupRegistryInterface_SetRollupTypeObsolete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_SetRollupTypeObsolete_Call) Return(_a0 error) *StorageRollupRegistryInterface_SetRollupTypeObsolete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageRollupRegistryInterface_SetRollupTypeObsolete_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) error) *StorageRollupRegistryInterface_SetRollupTypeObsolete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStorageRollupRegistryInterface creates a new instance of StorageRollupRegistryInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStorageRollupRegistryInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StorageRollupRegistryInterface {
+	mock := &StorageRollupRegistryInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
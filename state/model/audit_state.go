@@ -0,0 +1,48 @@
+package model
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type AuditEvent = entities.AuditEvent
+
+type StorageAuditEventInterface interface {
+	AddAuditEvent(ctx context.Context, auditEvent *AuditEvent, dbTx storageTxType) error
+	GetAuditEvents(ctx context.Context, contract *common.Address, fromBlockNumber uint64, dbTx storageTxType) ([]AuditEvent, error)
+}
+
+// AuditState keeps the audit trail of the proxies, the roles and the owners of the contracts
+type AuditState struct {
+	store  StorageAuditEventInterface
+	events *EventsState
+}
+
+func NewAuditState(store StorageAuditEventInterface, events *EventsState) *AuditState {
+	return &AuditState{
+		store:  store,
+		events: events,
+	}
+}
+
+// AddAuditEvent a proxy, a role or an owner have been changed on L1, add to local database
+func (s *AuditState) AddAuditEvent(ctx context.Context, auditEvent *AuditEvent, dbTx stateTxType) error {
+	err := s.store.AddAuditEvent(ctx, auditEvent, dbTx)
+	if err == nil {
+		auditEventCopy := *auditEvent
+		s.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventNewAuditEvent, AuditEvent: &auditEventCopy})
+	}
+	return err
+}
+
+// GetAuditTrail returns the entries of the audit trail of all the contracts from fromBlockNumber, oldest first
+func (s *AuditState) GetAuditTrail(ctx context.Context, fromBlockNumber uint64, dbTx stateTxType) ([]AuditEvent, error) {
+	return s.store.GetAuditEvents(ctx, nil, fromBlockNumber, dbTx)
+}
+
+// GetContractAuditTrail returns the entries of the audit trail of the contract from fromBlockNumber, oldest first
+func (s *AuditState) GetContractAuditTrail(ctx context.Context, contract common.Address, fromBlockNumber uint64, dbTx stateTxType) ([]AuditEvent, error) {
+	return s.store.GetAuditEvents(ctx, &contract, fromBlockNumber, dbTx)
+}
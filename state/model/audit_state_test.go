@@ -0,0 +1,30 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	mock_model "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetContractAuditTrail(t *testing.T) {
+	mockStorage := mock_model.NewStorageAuditEventInterface(t)
+	sut := model.NewAuditState(mockStorage, nil)
+	ctx := context.TODO()
+	contract := common.HexToAddress("0x1234")
+	expected := []entities.AuditEvent{{BlockNumber: 123, Contract: contract, EventType: etherman.AuditRoleGranted}}
+	mockStorage.EXPECT().GetAuditEvents(ctx, &contract, uint64(100), nil).Return(expected, nil).Once()
+	mockStorage.EXPECT().GetAuditEvents(ctx, (*common.Address)(nil), uint64(100), nil).Return(expected, nil).Once()
+
+	trail, err := sut.GetContractAuditTrail(ctx, contract, 100, nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, trail)
+	trail, err = sut.GetAuditTrail(ctx, 100, nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, trail)
+}
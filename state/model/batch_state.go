@@ -17,32 +17,54 @@ type SequenceOfBatches struct {
 
 type StorageVirtualBatchInterface interface {
 	AddVirtualBatch(ctx context.Context, virtualBatch *VirtualBatch, dbTx dbTxType) error
-	GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber uint64, dbTx dbTxType) (*VirtualBatch, error)
+	GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx dbTxType) (*VirtualBatch, error)
 	AddSequencedBatches(ctx context.Context, sequence *SequencedBatches, dbTx dbTxType) error
-	GetSequenceByBatchNumber(ctx context.Context, batchNumber uint64, dbTx dbTxType) (*SequencedBatches, error)
+	GetSequenceByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx dbTxType) (*SequencedBatches, error)
 }
 
 type BatchState struct {
-	store StorageVirtualBatchInterface
+	store  StorageVirtualBatchInterface
+	events *EventsState
 }
 
-func NewBatchState(store StorageVirtualBatchInterface) *BatchState {
+func NewBatchState(store StorageVirtualBatchInterface, events *EventsState) *BatchState {
 	return &BatchState{
-		store: store,
+		store:  store,
+		events: events,
 	}
 }
 
 // OnSequencedBatchesOnL1 a new sequenceBatch call have been found on L1, add to local database
 func (b *BatchState) OnSequencedBatchesOnL1(ctx context.Context, seq SequenceOfBatches, dbTx dbTxType) error {
-
+	rollupID := seq.Sequence.RollupID
 	err := SetStorageHelper[*SequencedBatches](ctx, &seq.Sequence, dbTx,
-		b.store.AddSequencedBatches, b.store.GetSequenceByBatchNumber)
+		func(ctx context.Context, sequence *SequencedBatches, dbTx dbTxType) error {
+			err := b.store.AddSequencedBatches(ctx, sequence, dbTx)
+			if err == nil {
+				seqCopy := *sequence
+				b.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventNewSequencedBatches, SequencedBatches: &seqCopy})
+			}
+			return err
+		},
+		func(ctx context.Context, batchNumber uint64, dbTx dbTxType) (*SequencedBatches, error) {
+			return b.store.GetSequenceByBatchNumber(ctx, rollupID, batchNumber, dbTx)
+		})
 	if err != nil {
 		return err
 	}
 	for _, batch := range seq.Batches {
 		err := SetStorageHelper[*VirtualBatch](ctx, batch, dbTx,
-			b.store.AddVirtualBatch, b.store.GetVirtualBatchByBatchNumber)
+			func(ctx context.Context, batch *VirtualBatch, dbTx dbTxType) error {
+				err := b.store.AddVirtualBatch(ctx, batch, dbTx)
+				if err == nil {
+					batchCopy := *batch
+					b.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventNewVirtualBatch, VirtualBatch: &batchCopy})
+				}
+				return err
+			},
+			func(ctx context.Context, batchNumber uint64, dbTx dbTxType) (*VirtualBatch, error) {
+				return b.store.GetVirtualBatchByBatchNumber(ctx, batch.RollupID, batchNumber, dbTx)
+			})
 		if err != nil {
 			return err
 		}
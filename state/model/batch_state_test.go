@@ -13,7 +13,7 @@ import (
 
 func TestOnSequencedBatchesOnL1HappyPath(t *testing.T) {
 	mockStorage := mock_model.NewStorageVirtualBatchInterface(t)
-	sut := model.NewBatchState(mockStorage)
+	sut := model.NewBatchState(mockStorage, nil)
 	ctx := context.TODO()
 	dbTx := mock_entities.NewTx(t)
 
@@ -30,7 +30,7 @@ func TestOnSequencedBatchesOnL1HappyPath(t *testing.T) {
 
 func TestOnSequencedBatchesOnFailStoreSeq(t *testing.T) {
 	mockStorage := mock_model.NewStorageVirtualBatchInterface(t)
-	sut := model.NewBatchState(mockStorage)
+	sut := model.NewBatchState(mockStorage, nil)
 	ctx := context.TODO()
 	dbTx := mock_entities.NewTx(t)
 
@@ -39,14 +39,14 @@ func TestOnSequencedBatchesOnFailStoreSeq(t *testing.T) {
 		Batches:  []*entities.VirtualBatch{},
 	}
 	mockStorage.EXPECT().AddSequencedBatches(ctx, &seq.Sequence, dbTx).Return(entities.ErrAlreadyExists)
-	mockStorage.EXPECT().GetSequenceByBatchNumber(ctx, seq.Sequence.FromBatchNumber, dbTx).Return(nil, entities.ErrNotFound)
+	mockStorage.EXPECT().GetSequenceByBatchNumber(ctx, seq.Sequence.RollupID, seq.Sequence.FromBatchNumber, dbTx).Return(nil, entities.ErrNotFound)
 	err := sut.OnSequencedBatchesOnL1(ctx, seq, dbTx)
 	require.Error(t, err)
 }
 
 func TestOnSequencedBatchesOnSameDataOnDB(t *testing.T) {
 	mockStorage := mock_model.NewStorageVirtualBatchInterface(t)
-	sut := model.NewBatchState(mockStorage)
+	sut := model.NewBatchState(mockStorage, nil)
 	ctx := context.TODO()
 	dbTx := mock_entities.NewTx(t)
 
@@ -55,7 +55,7 @@ func TestOnSequencedBatchesOnSameDataOnDB(t *testing.T) {
 		Batches:  []*entities.VirtualBatch{},
 	}
 	mockStorage.EXPECT().AddSequencedBatches(ctx, &seq.Sequence, dbTx).Return(entities.ErrAlreadyExists)
-	mockStorage.EXPECT().GetSequenceByBatchNumber(ctx, seq.Sequence.FromBatchNumber, dbTx).Return(&seq.Sequence, nil)
+	mockStorage.EXPECT().GetSequenceByBatchNumber(ctx, seq.Sequence.RollupID, seq.Sequence.FromBatchNumber, dbTx).Return(&seq.Sequence, nil)
 	err := sut.OnSequencedBatchesOnL1(ctx, seq, dbTx)
 	require.NoError(t, err)
 }
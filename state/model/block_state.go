@@ -0,0 +1,60 @@
+package model
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+)
+
+type StorageBlockInterface interface {
+	AddBlock(ctx context.Context, block *entities.L1Block, dbTx storageTxType) error
+	UpdateCheckedBlockByNumber(ctx context.Context, blockNumber uint64, newCheckedStatus bool, dbTx storageTxType) error
+	GetLastBlock(ctx context.Context, dbTx storageTxType) (*entities.L1Block, error)
+	GetBlockByNumber(ctx context.Context, blockNumber uint64, dbTx storageTxType) (*entities.L1Block, error)
+	GetPreviousBlock(ctx context.Context, offset uint64, dbTx storageTxType) (*entities.L1Block, error)
+	GetFirstUncheckedBlock(ctx context.Context, fromBlockNumber uint64, dbTx storageTxType) (*entities.L1Block, error)
+	GetUncheckedBlocks(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx storageTxType) (*[]entities.L1Block, error)
+}
+
+// BlockState manages the L1 blocks, it notifies when a block is marked as checked
+type BlockState struct {
+	StorageBlockInterface
+	events *EventsState
+}
+
+func NewBlockState(storage StorageBlockInterface, events *EventsState) *BlockState {
+	return &BlockState{
+		StorageBlockInterface: storage,
+		events:                events,
+	}
+}
+
+// AddBlock stores a new L1 block, if it's already checked is notified
+func (s *BlockState) AddBlock(ctx context.Context, block *entities.L1Block, dbTx stateTxType) error {
+	err := s.StorageBlockInterface.AddBlock(ctx, block, dbTx)
+	if err != nil {
+		return err
+	}
+	if block.Checked {
+		blockCopy := *block
+		s.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventBlockChecked, L1Block: &blockCopy})
+	}
+	return nil
+}
+
+// UpdateCheckedBlockByNumber updates the checked flag of a block, if it becomes checked is notified
+func (s *BlockState) UpdateCheckedBlockByNumber(ctx context.Context, blockNumber uint64, newCheckedStatus bool, dbTx stateTxType) error {
+	err := s.StorageBlockInterface.UpdateCheckedBlockByNumber(ctx, blockNumber, newCheckedStatus, dbTx)
+	if err != nil || !newCheckedStatus || s.events == nil {
+		return err
+	}
+	block, err := s.StorageBlockInterface.GetBlockByNumber(ctx, blockNumber, dbTx)
+	if err != nil {
+		// The update is done, so it's not an error of this call
+		log.Warnf("error getting block %d to notify that is checked. Error: %v", blockNumber, err)
+		return nil
+	}
+	s.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventBlockChecked, L1Block: block})
+	return nil
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type PendingState = entities.PendingState
+type VerificationFrontier = entities.VerificationFrontier
+
+type StoragePendingStateInterface interface {
+	AddPendingState(ctx context.Context, pendingState *PendingState, dbTx storageTxType) error
+	ConsolidatePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx storageTxType) error
+	OverridePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx storageTxType) error
+	SetPendingStateNonDeterministic(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx storageTxType) error
+	GetPendingState(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx storageTxType) (*PendingState, error)
+	GetPendingStates(ctx context.Context, rollupID uint64, fromBatchNumber uint64, dbTx storageTxType) ([]PendingState, error)
+	GetConsolidatedBatchNumber(ctx context.Context, rollupID uint64, dbTx storageTxType) (uint64, error)
+	GetGovernanceEventAtBlock(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx storageTxType) (*GovernanceEvent, error)
+}
+
+// ConsolidationState keeps the pending states of the verifications of the non trusted aggregators until
+// they are consolidated or overridden
+type ConsolidationState struct {
+	store StoragePendingStateInterface
+}
+
+func NewConsolidationState(store StoragePendingStateInterface) *ConsolidationState {
+	return &ConsolidationState{
+		store: store,
+	}
+}
+
+// AddPendingState a non trusted aggregator have verified batches on L1, add to local database. The verification
+// must be stored. If the pending state timeout is 0 the verification is consolidated on the same block
+func (s *ConsolidationState) AddPendingState(ctx context.Context, pendingState *PendingState, dbTx stateTxType) error {
+	timeout, err := s.store.GetGovernanceEventAtBlock(ctx, uint64(etherman.GovernanceRollupManagerID), etherman.GovernancePendingStateTimeout,
+		pendingState.BlockNumber, dbTx)
+	if err != nil && !errors.Is(err, entities.ErrNotFound) {
+		return err
+	}
+	if timeout != nil && timeout.Value == "0" {
+		blockNumber := pendingState.BlockNumber
+		pendingState.ConsolidatedBlockNumber = &blockNumber
+	}
+	return s.store.AddPendingState(ctx, pendingState, dbTx)
+}
+
+// ConsolidatePendingStates the pending states of the rollup up to batchNumber have been consolidated on L1. It returns
+// ErrNotFound if there are no pending states on local database (e.g. they have been verified before the genesis block)
+func (s *ConsolidationState) ConsolidatePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx stateTxType) error {
+	return s.store.ConsolidatePendingStates(ctx, rollupID, batchNumber, blockNumber, dbTx)
+}
+
+// OverridePendingStates the trusted aggregator have proved stateRoot for batchNumber on L1 and the pending states of
+// the rollup are discarded. It returns ErrNotFound if there are no pending states on local database
+func (s *ConsolidationState) OverridePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx stateTxType) error {
+	return s.store.OverridePendingStates(ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)
+}
+
+// SetPendingStateNonDeterministic the pending state with storedStateRoot have been proved to be non deterministic on L1.
+// It returns ErrNotFound if there is no pending state with storedStateRoot on local database
+func (s *ConsolidationState) SetPendingStateNonDeterministic(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx stateTxType) error {
+	return s.store.SetPendingStateNonDeterministic(ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)
+}
+
+// GetPendingState returns the pending state of the verification of batchNumber, nil if it's not found
+func (s *ConsolidationState) GetPendingState(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx stateTxType) (*PendingState, error) {
+	res, err := s.store.GetPendingState(ctx, rollupID, batchNumber, dbTx)
+	if errors.Is(err, entities.ErrNotFound) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// GetVerificationFrontier returns the last consolidated batch of the rollup and the pending states after it
+func (s *ConsolidationState) GetVerificationFrontier(ctx context.Context, rollupID uint64, dbTx stateTxType) (*VerificationFrontier, error) {
+	consolidated, err := s.store.GetConsolidatedBatchNumber(ctx, rollupID, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	pendingStates, err := s.store.GetPendingStates(ctx, rollupID, consolidated, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	res := &VerificationFrontier{
+		RollupID:                rollupID,
+		ConsolidatedBatchNumber: consolidated,
+		PendingBatchNumber:      consolidated,
+		PendingStates:           pendingStates,
+	}
+	if len(pendingStates) > 0 {
+		res.PendingBatchNumber = pendingStates[len(pendingStates)-1].BatchNumber
+	}
+	return res, nil
+}
@@ -0,0 +1,57 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	mock_model "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddPendingStateConsolidatedIfTimeoutIsZero(t *testing.T) {
+	mockStorage := mock_model.NewStoragePendingStateInterface(t)
+	sut := model.NewConsolidationState(mockStorage)
+	ctx := context.TODO()
+	mockStorage.EXPECT().GetGovernanceEventAtBlock(ctx, uint64(0), etherman.GovernancePendingStateTimeout, uint64(123), nil).
+		Return(nil, entities.ErrNotFound).Once()
+	mockStorage.EXPECT().AddPendingState(ctx, mock.Anything, nil).Return(nil)
+
+	pendingState := &entities.PendingState{RollupID: 1, BatchNumber: 10, BlockNumber: 123}
+	err := sut.AddPendingState(ctx, pendingState, nil)
+	require.NoError(t, err)
+	require.False(t, pendingState.IsConsolidated())
+
+	mockStorage.EXPECT().GetGovernanceEventAtBlock(ctx, uint64(0), etherman.GovernancePendingStateTimeout, uint64(124), nil).
+		Return(&entities.GovernanceEvent{Parameter: etherman.GovernancePendingStateTimeout, Value: "0"}, nil).Once()
+	pendingState = &entities.PendingState{RollupID: 1, BatchNumber: 20, BlockNumber: 124}
+	err = sut.AddPendingState(ctx, pendingState, nil)
+	require.NoError(t, err)
+	require.True(t, pendingState.IsConsolidated())
+	require.Equal(t, uint64(124), *pendingState.ConsolidatedBlockNumber)
+}
+
+func TestGetVerificationFrontier(t *testing.T) {
+	mockStorage := mock_model.NewStoragePendingStateInterface(t)
+	sut := model.NewConsolidationState(mockStorage)
+	ctx := context.TODO()
+	mockStorage.EXPECT().GetConsolidatedBatchNumber(ctx, uint64(1), nil).Return(uint64(10), nil).Once()
+	mockStorage.EXPECT().GetPendingStates(ctx, uint64(1), uint64(10), nil).Return([]entities.PendingState{}, nil).Once()
+
+	frontier, err := sut.GetVerificationFrontier(ctx, 1, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), frontier.ConsolidatedBatchNumber)
+	require.Equal(t, uint64(10), frontier.PendingBatchNumber)
+
+	mockStorage.EXPECT().GetConsolidatedBatchNumber(ctx, uint64(1), nil).Return(uint64(10), nil).Once()
+	mockStorage.EXPECT().GetPendingStates(ctx, uint64(1), uint64(10), nil).
+		Return([]entities.PendingState{{RollupID: 1, BatchNumber: 15}, {RollupID: 1, BatchNumber: 20}}, nil).Once()
+	frontier, err = sut.GetVerificationFrontier(ctx, 1, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), frontier.ConsolidatedBatchNumber)
+	require.Equal(t, uint64(20), frontier.PendingBatchNumber)
+	require.Len(t, frontier.PendingStates, 2)
+}
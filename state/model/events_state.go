@@ -0,0 +1,120 @@
+package model
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+)
+
+// StateEventType is the kind of data that have been stored on the state
+type StateEventType int
+
+const (
+	// StateEventNewL1InfoTreeLeaf a new leaf have been added to L1InfoTree
+	StateEventNewL1InfoTreeLeaf StateEventType = iota
+	// StateEventNewSequencedBatches a new sequence have been stored
+	StateEventNewSequencedBatches
+	// StateEventNewVirtualBatch a new virtual batch have been stored
+	StateEventNewVirtualBatch
+	// StateEventNewForkID a new forkID have been stored
+	StateEventNewForkID
+	// StateEventBlockChecked a L1 block have been marked as checked (is finalized)
+	StateEventBlockChecked
+	// StateEventNewVerifiedBatch a new verification of batches have been stored
+	StateEventNewVerifiedBatch
+	// StateEventNewForcedBatch a new forced batch have been stored
+	StateEventNewForcedBatch
+	// StateEventNewGlobalExitRoot a new update of GlobalExitRoot previous to LxLy have been stored
+	StateEventNewGlobalExitRoot
+	// StateEventNewGovernanceEvent a change of a parameter of a rollup or of the RollupManager have been stored
+	StateEventNewGovernanceEvent
+	// StateEventNewAuditEvent a change of the proxy, the roles or the owner of a contract have been stored
+	StateEventNewAuditEvent
+)
+
+func (t StateEventType) String() string {
+	switch t {
+	case StateEventNewL1InfoTreeLeaf:
+		return "NewL1InfoTreeLeaf"
+	case StateEventNewSequencedBatches:
+		return "NewSequencedBatches"
+	case StateEventNewVirtualBatch:
+		return "NewVirtualBatch"
+	case StateEventNewForkID:
+		return "NewForkID"
+	case StateEventBlockChecked:
+		return "BlockChecked"
+	case StateEventNewVerifiedBatch:
+		return "NewVerifiedBatch"
+	case StateEventNewForcedBatch:
+		return "NewForcedBatch"
+	case StateEventNewGlobalExitRoot:
+		return "NewGlobalExitRoot"
+	case StateEventNewGovernanceEvent:
+		return "NewGovernanceEvent"
+	case StateEventNewAuditEvent:
+		return "NewAuditEvent"
+	}
+	return fmt.Sprintf("Unknown(%d)", int(t))
+}
+
+// StateEvent is the data stored on the state, only the field related to Type is set
+type StateEvent struct {
+	Type             StateEventType
+	L1InfoTreeLeaf   *L1InfoTreeLeaf
+	SequencedBatches *SequencedBatches
+	VirtualBatch     *VirtualBatch
+	ForkID           *ForkIDInterval
+	L1Block          *entities.L1Block
+	VerifiedBatch    *VerifiedBatch
+	ForcedBatch      *ForcedBatch
+	GlobalExitRoot   *GlobalExitRoot
+	GovernanceEvent  *GovernanceEvent
+	AuditEvent       *AuditEvent
+}
+
+type StateEventCallbackType = func(StateEvent)
+
+// EventsState notifies the new data stored on the state once the dbTx is committed
+type EventsState struct {
+	mutex     sync.RWMutex
+	callbacks []StateEventCallbackType
+}
+
+func NewEventsState() *EventsState {
+	return &EventsState{}
+}
+
+// AddOnStateEventCallback adds a callback that is called for each new data committed on the state
+func (s *EventsState) AddOnStateEventCallback(f StateEventCallbackType) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.callbacks = append(s.callbacks, f)
+}
+
+// NotifyOnCommit launches the event when dbTx is committed successfully.
+// If dbTx is nil the data is already stored, so it's launched immediately
+func (s *EventsState) NotifyOnCommit(dbTx stateTxType, event StateEvent) {
+	if s == nil {
+		return
+	}
+	if dbTx == nil {
+		s.notify(event)
+		return
+	}
+	dbTx.AddCommitCallback(func(tx entities.Tx, err error) {
+		if err == nil {
+			s.notify(event)
+		}
+	})
+}
+
+func (s *EventsState) notify(event StateEvent) {
+	s.mutex.RLock()
+	callbacks := s.callbacks
+	s.mutex.RUnlock()
+	for _, f := range callbacks {
+		f(event)
+	}
+}
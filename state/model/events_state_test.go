@@ -0,0 +1,211 @@
+package model_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock_entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities/mocks"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	mock_model "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func captureCommitCallbacks(dbTx *mock_entities.Tx) *[]entities.TxCallbackType {
+	callbacks := []entities.TxCallbackType{}
+	dbTx.EXPECT().AddCommitCallback(mock.Anything).Run(func(cb entities.TxCallbackType) {
+		callbacks = append(callbacks, cb)
+	}).Maybe()
+	return &callbacks
+}
+
+func TestEventsStateNotifyAfterCommit(t *testing.T) {
+	sut := model.NewEventsState()
+	dbTx := mock_entities.NewTx(t)
+	callbacks := captureCommitCallbacks(dbTx)
+	var received []model.StateEvent
+	sut.AddOnStateEventCallback(func(event model.StateEvent) {
+		received = append(received, event)
+	})
+
+	sut.NotifyOnCommit(dbTx, model.StateEvent{Type: model.StateEventNewForkID})
+	require.Equal(t, 0, len(received))
+	for _, cb := range *callbacks {
+		cb(dbTx, nil)
+	}
+	require.Equal(t, 1, len(received))
+	require.Equal(t, model.StateEventNewForkID, received[0].Type)
+}
+
+func TestEventsStateNoNotifyIfCommitFails(t *testing.T) {
+	sut := model.NewEventsState()
+	dbTx := mock_entities.NewTx(t)
+	callbacks := captureCommitCallbacks(dbTx)
+	called := false
+	sut.AddOnStateEventCallback(func(event model.StateEvent) {
+		called = true
+	})
+
+	sut.NotifyOnCommit(dbTx, model.StateEvent{Type: model.StateEventNewForkID})
+	for _, cb := range *callbacks {
+		cb(dbTx, fmt.Errorf("commit error"))
+	}
+	require.False(t, called)
+}
+
+func TestEventsStateNotifyWithoutTxIsImmediate(t *testing.T) {
+	sut := model.NewEventsState()
+	called := false
+	sut.AddOnStateEventCallback(func(event model.StateEvent) {
+		called = true
+	})
+	sut.NotifyOnCommit(nil, model.StateEvent{Type: model.StateEventBlockChecked})
+	require.True(t, called)
+}
+
+// The data added with a dbTx is notified only when the dbTx is committed
+func TestAddNotifiesOnCommit(t *testing.T) {
+	ctx := context.TODO()
+	verifiedBatch := &entities.VerifiedBatch{RollupID: 1, BatchNumber: 10, StateRoot: common.HexToHash("0x1234")}
+	forcedBatch := &entities.ForcedBatch{RollupID: 1, ForcedBatchNumber: 3}
+	globalExitRoot := &entities.GlobalExitRoot{BlockNumber: 123, GlobalExitRoot: common.HexToHash("0x1234")}
+	governanceEvent := &entities.GovernanceEvent{RollupID: 1, BlockNumber: 123, Parameter: etherman.GovernanceTrustedSequencerURL, Value: "http://sequencer"}
+	auditEvent := &entities.AuditEvent{BlockNumber: 123, Contract: common.HexToAddress("0x1234"), EventType: etherman.AuditUpgraded,
+		Implementation: common.HexToAddress("0x5678")}
+	tests := []struct {
+		name string
+		// add stores the data on a state that notifies to events
+		add       func(t *testing.T, events *model.EventsState, dbTx entities.Tx) error
+		eventType model.StateEventType
+		checkData func(t *testing.T, event model.StateEvent)
+	}{
+		{
+			name: "verified batch",
+			add: func(t *testing.T, events *model.EventsState, dbTx entities.Tx) error {
+				mockStorage := mock_model.NewStorageVerifiedBatchInterface(t)
+				mockStorage.EXPECT().AddVerifiedBatch(ctx, verifiedBatch, dbTx).Return(nil)
+				return model.NewVerifiedBatchState(mockStorage, events).AddVerifiedBatch(ctx, verifiedBatch, dbTx)
+			},
+			eventType: model.StateEventNewVerifiedBatch,
+			checkData: func(t *testing.T, event model.StateEvent) { require.Equal(t, *verifiedBatch, *event.VerifiedBatch) },
+		},
+		{
+			name: "forced batch",
+			add: func(t *testing.T, events *model.EventsState, dbTx entities.Tx) error {
+				mockStorage := mock_model.NewStorageForcedBatchInterface(t)
+				mockStorage.EXPECT().AddForcedBatch(ctx, forcedBatch, dbTx).Return(nil)
+				return model.NewForcedBatchState(mockStorage, events).AddForcedBatch(ctx, forcedBatch, dbTx)
+			},
+			eventType: model.StateEventNewForcedBatch,
+			checkData: func(t *testing.T, event model.StateEvent) { require.Equal(t, *forcedBatch, *event.ForcedBatch) },
+		},
+		{
+			name: "global exit root",
+			add: func(t *testing.T, events *model.EventsState, dbTx entities.Tx) error {
+				mockStorage := mock_model.NewStorageGlobalExitRootInterface(t)
+				mockStorage.EXPECT().AddGlobalExitRoot(ctx, globalExitRoot, dbTx).Return(nil)
+				return model.NewGlobalExitRootState(mockStorage, events).AddGlobalExitRoot(ctx, globalExitRoot, dbTx)
+			},
+			eventType: model.StateEventNewGlobalExitRoot,
+			checkData: func(t *testing.T, event model.StateEvent) { require.Equal(t, *globalExitRoot, *event.GlobalExitRoot) },
+		},
+		{
+			name: "governance event",
+			add: func(t *testing.T, events *model.EventsState, dbTx entities.Tx) error {
+				mockStorage := mock_model.NewStorageGovernanceEventInterface(t)
+				mockStorage.EXPECT().AddGovernanceEvent(ctx, governanceEvent, dbTx).Return(nil)
+				return model.NewGovernanceState(mockStorage, events).AddGovernanceEvent(ctx, governanceEvent, dbTx)
+			},
+			eventType: model.StateEventNewGovernanceEvent,
+			checkData: func(t *testing.T, event model.StateEvent) { require.Equal(t, *governanceEvent, *event.GovernanceEvent) },
+		},
+		{
+			name: "audit event",
+			add: func(t *testing.T, events *model.EventsState, dbTx entities.Tx) error {
+				mockStorage := mock_model.NewStorageAuditEventInterface(t)
+				mockStorage.EXPECT().AddAuditEvent(ctx, auditEvent, dbTx).Return(nil)
+				return model.NewAuditState(mockStorage, events).AddAuditEvent(ctx, auditEvent, dbTx)
+			},
+			eventType: model.StateEventNewAuditEvent,
+			checkData: func(t *testing.T, event model.StateEvent) { require.Equal(t, *auditEvent, *event.AuditEvent) },
+		},
+	}
+	for _, tt := range tests {
+		for _, commitErr := range []error{nil, fmt.Errorf("commit error")} {
+			t.Run(fmt.Sprintf("%s commitErr=%v", tt.name, commitErr), func(t *testing.T) {
+				events := model.NewEventsState()
+				var received []model.StateEvent
+				events.AddOnStateEventCallback(func(event model.StateEvent) { received = append(received, event) })
+				dbTx := mock_entities.NewTx(t)
+				callbacks := captureCommitCallbacks(dbTx)
+
+				require.NoError(t, tt.add(t, events, dbTx))
+				require.Empty(t, received, "nothing is notified before the commit")
+				require.NotEmpty(t, *callbacks)
+				for _, cb := range *callbacks {
+					cb(dbTx, commitErr)
+				}
+				if commitErr != nil {
+					require.Empty(t, received, "nothing is notified if the commit fails")
+					return
+				}
+				require.Len(t, received, 1)
+				require.Equal(t, tt.eventType, received[0].Type)
+				tt.checkData(t, received[0])
+			})
+		}
+	}
+}
+
+func TestOnSequencedBatchesOnL1NotifiesSequenceAndBatches(t *testing.T) {
+	mockStorage := mock_model.NewStorageVirtualBatchInterface(t)
+	events := model.NewEventsState()
+	sut := model.NewBatchState(mockStorage, events)
+	ctx := context.TODO()
+	dbTx := mock_entities.NewTx(t)
+	callbacks := captureCommitCallbacks(dbTx)
+	var received []model.StateEventType
+	events.AddOnStateEventCallback(func(event model.StateEvent) {
+		received = append(received, event.Type)
+	})
+
+	seq := model.SequenceOfBatches{
+		Sequence: entities.SequencedBatches{FromBatchNumber: 1, ToBatchNumber: 1},
+		Batches:  []*entities.VirtualBatch{{BatchNumber: 1}},
+	}
+	mockStorage.EXPECT().AddSequencedBatches(ctx, &seq.Sequence, dbTx).Return(nil)
+	mockStorage.EXPECT().AddVirtualBatch(ctx, seq.Batches[0], dbTx).Return(nil)
+	err := sut.OnSequencedBatchesOnL1(ctx, seq, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(received))
+	for _, cb := range *callbacks {
+		cb(dbTx, nil)
+	}
+	require.Equal(t, []model.StateEventType{model.StateEventNewSequencedBatches, model.StateEventNewVirtualBatch}, received)
+}
+
+func TestBlockStateNotifiesOnlyCheckedBlocks(t *testing.T) {
+	mockStorage := mock_model.NewStorageBlockInterface(t)
+	events := model.NewEventsState()
+	sut := model.NewBlockState(mockStorage, events)
+	ctx := context.TODO()
+	var received []*entities.L1Block
+	events.AddOnStateEventCallback(func(event model.StateEvent) {
+		received = append(received, event.L1Block)
+	})
+	block := &entities.L1Block{BlockNumber: 10, Checked: false}
+	mockStorage.EXPECT().AddBlock(ctx, block, nil).Return(nil)
+	require.NoError(t, sut.AddBlock(ctx, block, nil))
+	require.Equal(t, 0, len(received))
+
+	checkedBlock := &entities.L1Block{BlockNumber: 10, Checked: true}
+	mockStorage.EXPECT().UpdateCheckedBlockByNumber(ctx, uint64(10), true, nil).Return(nil)
+	mockStorage.EXPECT().GetBlockByNumber(ctx, uint64(10), nil).Return(checkedBlock, nil)
+	require.NoError(t, sut.UpdateCheckedBlockByNumber(ctx, uint64(10), true, nil))
+	require.Equal(t, 1, len(received))
+	require.Equal(t, uint64(10), received[0].BlockNumber)
+}
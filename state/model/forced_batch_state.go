@@ -0,0 +1,111 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+)
+
+type ForcedBatch = entities.ForcedBatch
+type SequencedForceBatch = entities.SequencedForceBatch
+
+type StorageForcedBatchInterface interface {
+	AddForcedBatch(ctx context.Context, forcedBatch *ForcedBatch, dbTx storageTxType) error
+	GetForcedBatch(ctx context.Context, rollupID uint64, forcedBatchNumber uint64, dbTx storageTxType) (*ForcedBatch, error)
+	GetPendingForcedBatches(ctx context.Context, rollupID uint64, dbTx storageTxType) ([]ForcedBatch, error)
+	AddSequencedForceBatch(ctx context.Context, sequencedForceBatch *SequencedForceBatch, dbTx storageTxType) error
+	GetLastSequencedForcedBatchNumber(ctx context.Context, rollupID uint64, dbTx storageTxType) (uint64, error)
+}
+
+type ForcedBatchState struct {
+	store  StorageForcedBatchInterface
+	events *EventsState
+}
+
+func NewForcedBatchState(store StorageForcedBatchInterface, events *EventsState) *ForcedBatchState {
+	return &ForcedBatchState{
+		store:  store,
+		events: events,
+	}
+}
+
+// AddForcedBatch a new forced batch have been found on L1, add to local database
+func (s *ForcedBatchState) AddForcedBatch(ctx context.Context, forcedBatch *ForcedBatch, dbTx stateTxType) error {
+	rollupID := forcedBatch.RollupID
+	return SetStorageHelper[*ForcedBatch](ctx, forcedBatch, dbTx,
+		func(ctx context.Context, forcedBatch *ForcedBatch, dbTx dbTxType) error {
+			err := s.store.AddForcedBatch(ctx, forcedBatch, dbTx)
+			if err == nil {
+				forcedBatchCopy := *forcedBatch
+				s.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventNewForcedBatch, ForcedBatch: &forcedBatchCopy})
+			}
+			return err
+		},
+		func(ctx context.Context, forcedBatchNumber uint64, dbTx dbTxType) (*ForcedBatch, error) {
+			return s.store.GetForcedBatch(ctx, rollupID, forcedBatchNumber, dbTx)
+		})
+}
+
+// OnSequencedForceBatchesOnL1 stores the batches sequenced that include forced batches. The forced batches
+// are sequenced in order, so each one is linked to the next forced batch of the rollup
+func (s *ForcedBatchState) OnSequencedForceBatchesOnL1(ctx context.Context, sequencedForceBatches []*SequencedForceBatch, dbTx stateTxType) error {
+	for _, sequencedForceBatch := range sequencedForceBatches {
+		forcedBatchNumber, err := s.nextForcedBatchNumberToSequence(ctx, sequencedForceBatch, dbTx)
+		if err != nil {
+			return err
+		}
+		if forcedBatchNumber == nil {
+			log.Warnf("RollupID: %d batch %d includes a forced batch previous to the first block synced, it can't be linked to its forced batch",
+				sequencedForceBatch.RollupID, sequencedForceBatch.BatchNumber)
+		}
+		sequencedForceBatch.ForcedBatchNumber = forcedBatchNumber
+		err = s.store.AddSequencedForceBatch(ctx, sequencedForceBatch, dbTx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetForcedBatch returns the forced batch of the rollup, nil if it's not found
+func (s *ForcedBatchState) GetForcedBatch(ctx context.Context, rollupID uint64, forcedBatchNumber uint64, dbTx stateTxType) (*ForcedBatch, error) {
+	res, err := s.store.GetForcedBatch(ctx, rollupID, forcedBatchNumber, dbTx)
+	if errors.Is(err, entities.ErrNotFound) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// GetPendingForcedBatches returns the forced batches of the rollup that have not been sequenced yet, oldest first
+func (s *ForcedBatchState) GetPendingForcedBatches(ctx context.Context, rollupID uint64, dbTx stateTxType) ([]ForcedBatch, error) {
+	return s.store.GetPendingForcedBatches(ctx, rollupID, dbTx)
+}
+
+func (s *ForcedBatchState) nextForcedBatchNumberToSequence(ctx context.Context, sequencedForceBatch *SequencedForceBatch, dbTx stateTxType) (*uint64, error) {
+	rollupID := sequencedForceBatch.RollupID
+	lastSequenced, err := s.store.GetLastSequencedForcedBatchNumber(ctx, rollupID, dbTx)
+	if err == nil {
+		next := lastSequenced + 1
+		return &next, nil
+	}
+	if !errors.Is(err, entities.ErrNotFound) {
+		return nil, err
+	}
+	// It's the first one sequenced since the synchronization started, it's the oldest pending
+	// if the data matches, otherwise it was forced before the first block synced
+	pending, err := s.store.GetPendingForcedBatches(ctx, rollupID, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	if len(pending) == 0 || !isSequencedForceBatchOf(sequencedForceBatch, &pending[0]) {
+		return nil, nil
+	}
+	return &pending[0].ForcedBatchNumber, nil
+}
+
+func isSequencedForceBatchOf(sequencedForceBatch *SequencedForceBatch, forcedBatch *ForcedBatch) bool {
+	return sequencedForceBatch.ForcedGlobalExitRoot == forcedBatch.GlobalExitRoot &&
+		sequencedForceBatch.ForcedTimestamp == uint64(forcedBatch.ForcedAt.Unix())
+}
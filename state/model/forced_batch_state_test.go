@@ -0,0 +1,66 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	mock_model "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnSequencedForceBatchesFollowsLastSequenced(t *testing.T) {
+	mockStorage := mock_model.NewStorageForcedBatchInterface(t)
+	sut := model.NewForcedBatchState(mockStorage, nil)
+	ctx := context.TODO()
+	batch := &entities.SequencedForceBatch{RollupID: 1, BatchNumber: 100}
+	mockStorage.EXPECT().GetLastSequencedForcedBatchNumber(ctx, uint64(1), nil).Return(uint64(4), nil)
+	mockStorage.EXPECT().AddSequencedForceBatch(ctx, batch, nil).Return(nil)
+
+	err := sut.OnSequencedForceBatchesOnL1(ctx, []*entities.SequencedForceBatch{batch}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, batch.ForcedBatchNumber)
+	require.Equal(t, uint64(5), *batch.ForcedBatchNumber)
+}
+
+// The first one sequenced is the oldest pending forced batch if the data matches
+func TestOnSequencedForceBatchesFirstIsOldestPending(t *testing.T) {
+	mockStorage := mock_model.NewStorageForcedBatchInterface(t)
+	sut := model.NewForcedBatchState(mockStorage, nil)
+	ctx := context.TODO()
+	ger := common.HexToHash("0x1234")
+	forcedAt := time.Unix(1700000000, 0)
+	batch := &entities.SequencedForceBatch{RollupID: 1, BatchNumber: 100, ForcedGlobalExitRoot: ger, ForcedTimestamp: uint64(forcedAt.Unix())}
+	mockStorage.EXPECT().GetLastSequencedForcedBatchNumber(ctx, uint64(1), nil).Return(uint64(0), entities.ErrNotFound)
+	mockStorage.EXPECT().GetPendingForcedBatches(ctx, uint64(1), nil).Return([]entities.ForcedBatch{
+		{RollupID: 1, ForcedBatchNumber: 3, GlobalExitRoot: ger, ForcedAt: forcedAt},
+		{RollupID: 1, ForcedBatchNumber: 4, GlobalExitRoot: ger, ForcedAt: forcedAt},
+	}, nil)
+	mockStorage.EXPECT().AddSequencedForceBatch(ctx, batch, nil).Return(nil)
+
+	err := sut.OnSequencedForceBatchesOnL1(ctx, []*entities.SequencedForceBatch{batch}, nil)
+	require.NoError(t, err)
+	require.NotNil(t, batch.ForcedBatchNumber)
+	require.Equal(t, uint64(3), *batch.ForcedBatchNumber)
+}
+
+// The forced batch was forced before the first block synced, so it's stored without link
+func TestOnSequencedForceBatchesUnknownForcedBatch(t *testing.T) {
+	mockStorage := mock_model.NewStorageForcedBatchInterface(t)
+	sut := model.NewForcedBatchState(mockStorage, nil)
+	ctx := context.TODO()
+	batch := &entities.SequencedForceBatch{RollupID: 1, BatchNumber: 100, ForcedGlobalExitRoot: common.HexToHash("0x1234"), ForcedTimestamp: 1}
+	mockStorage.EXPECT().GetLastSequencedForcedBatchNumber(ctx, uint64(1), nil).Return(uint64(0), entities.ErrNotFound)
+	mockStorage.EXPECT().GetPendingForcedBatches(ctx, uint64(1), nil).Return([]entities.ForcedBatch{
+		{RollupID: 1, ForcedBatchNumber: 3, GlobalExitRoot: common.HexToHash("0x5678"), ForcedAt: time.Unix(2, 0)},
+	}, nil)
+	mockStorage.EXPECT().AddSequencedForceBatch(ctx, mock.Anything, nil).Return(nil)
+
+	err := sut.OnSequencedForceBatchesOnL1(ctx, []*entities.SequencedForceBatch{batch}, nil)
+	require.NoError(t, err)
+	require.Nil(t, batch.ForcedBatchNumber)
+}
@@ -23,7 +23,7 @@ const FORKID_ZERO = entities.FORKID_ZERO
 
 type StorageForkIdInterface interface {
 	AddForkID(ctx context.Context, forkID pgstorage.ForkIDInterval, dbTx storageTxType) error
-	GetForkIDs(ctx context.Context, dbTx storageTxType) ([]pgstorage.ForkIDInterval, error)
+	GetForkIDs(ctx context.Context, rollupID uint64, dbTx storageTxType) ([]pgstorage.ForkIDInterval, error)
 	UpdateForkID(ctx context.Context, forkID pgstorage.ForkIDInterval, dbTx storageTxType) error
 }
 
@@ -31,19 +31,21 @@ type ForkIdState struct {
 	storage StorageForkIdInterface
 	// cacheForkId[forkid] = ForkIDInterval
 	cacheForkId *utils.Cache[uint64, ForkIDInterval]
+	events      *EventsState
 }
 
 // NewForkIdState creates a new ForkIdState that manage forksIds
-func NewForkIdState(storage StorageForkIdInterface) *ForkIdState {
+func NewForkIdState(storage StorageForkIdInterface, events *EventsState) *ForkIdState {
 	return &ForkIdState{
 		storage:     storage,
 		cacheForkId: utils.NewCache[uint64, ForkIDInterval](utils.DefaultTimeProvider{}, utils.InfiniteTimeOfLiveItems),
+		events:      events,
 	}
 }
 
-// AddForkIDInterval updates the forkID intervals
+// AddForkIDInterval updates the forkID intervals of the rollup newForkID.RollupID
 func (s *ForkIdState) AddForkID(ctx context.Context, newForkID ForkIDInterval, dbTx stateTxType) error {
-	currentForksIDs, err := s.GetForkIDs(ctx, dbTx)
+	currentForksIDs, err := s.GetForkIDs(ctx, newForkID.RollupID, dbTx)
 	if err != nil {
 		return err
 	}
@@ -68,10 +70,13 @@ func (s *ForkIdState) AddForkID(ctx context.Context, newForkID ForkIDInterval, d
 	if err != nil {
 		return err
 	}
+	s.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventNewForkID, ForkID: &newForkID})
 	return err
 }
-func (p *ForkIdState) GetForkIDs(ctx context.Context, dbTx stateTxType) ([]ForkIDInterval, error) {
-	currentForksId, err := p.storage.GetForkIDs(ctx, dbTx)
+
+// GetForkIDs returns the forkIDs of a rollup
+func (p *ForkIdState) GetForkIDs(ctx context.Context, rollupID uint64, dbTx stateTxType) ([]ForkIDInterval, error) {
+	currentForksId, err := p.storage.GetForkIDs(ctx, rollupID, dbTx)
 	if errors.Is(err, entities.ErrNotFound) {
 		// It's ok, it's the first forkId
 		return nil, nil
@@ -86,9 +91,9 @@ func (p *ForkIdState) GetForkIDs(ctx context.Context, dbTx stateTxType) ([]ForkI
 	return res, nil
 }
 
-// GetForkIDByBatchNumber returns the fork id for a given batch number
-func (s *ForkIdState) GetForkIDByBatchNumber(ctx context.Context, batchNumber uint64, dbTx stateTxType) uint64 {
-	forks, err := s.GetForkIDs(ctx, dbTx)
+// GetForkIDByBatchNumber returns the fork id for a given batch number of a rollup
+func (s *ForkIdState) GetForkIDByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx stateTxType) uint64 {
+	forks, err := s.GetForkIDs(ctx, rollupID, dbTx)
 	if err != nil {
 		log.Warnf("error getting forkIDs. Error: %v", err)
 		return FORKID_ZERO
@@ -102,26 +107,26 @@ func (s *ForkIdState) GetForkIDByBatchNumber(ctx context.Context, batchNumber ui
 			maxForId = v.ForkId
 		}
 	}
-	log.Warnf("error can't match batch: %d in current forkids. Returning last one: %d", batchNumber, maxForId)
+	log.Warnf("error can't match batch: %d in current forkids of rollupID %d. Returning last one: %d", batchNumber, rollupID, maxForId)
 	return maxForId
 }
 
-// GetForkIDByBlockNumber returns the fork id for a given block number
-func (s *ForkIdState) GetForkIDByBlockNumber(ctx context.Context, blockNumber uint64, dbTx stateTxType) uint64 {
-	forks, err := s.GetForkIDs(ctx, dbTx)
+// GetForkIDByBlockNumber returns the fork id for a given block number of a rollup, that is the last
+// forkID activated on a block previous or equal to blockNumber. FORKID_ZERO if there is no forkID yet
+func (s *ForkIdState) GetForkIDByBlockNumber(ctx context.Context, rollupID uint64, blockNumber uint64, dbTx stateTxType) uint64 {
+	forks, err := s.GetForkIDs(ctx, rollupID, dbTx)
 	if err != nil {
 		log.Warnf("error getting forkIDs. Error: %v", err)
 		return FORKID_ZERO
 	}
-	maxForId := uint64(0)
+	forkID := FORKID_ZERO
 	for _, v := range forks {
-		if v.BlockNumber >= blockNumber {
-			maxForId = v.ForkId
-		} else {
-			return maxForId
+		if v.BlockNumber > blockNumber {
+			break
 		}
+		forkID = v.ForkId
 	}
-	return maxForId
+	return forkID
 }
 
 func (s *ForkIdState) checkValidNewForkID(newForkID ForkIDInterval, currentForksIDs []ForkIDInterval) error {
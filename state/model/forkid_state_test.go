@@ -0,0 +1,31 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	mock_model "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetForkIDByBlockNumber(t *testing.T) {
+	mockStorage := mock_model.NewStorageForkIdInterface(t)
+	sut := model.NewForkIdState(mockStorage, nil)
+	ctx := context.TODO()
+	forks := []entities.ForkIDInterval{
+		{RollupID: 1, FromBatchNumber: 1, ToBatchNumber: 99, ForkId: 1, BlockNumber: 100},
+		{RollupID: 1, FromBatchNumber: 100, ToBatchNumber: 199, ForkId: 5, BlockNumber: 200},
+		{RollupID: 1, FromBatchNumber: 200, ToBatchNumber: 299, ForkId: 6, BlockNumber: 300},
+		{RollupID: 1, FromBatchNumber: 300, ToBatchNumber: 1000, ForkId: 7, BlockNumber: 400},
+	}
+	mockStorage.EXPECT().GetForkIDs(ctx, uint64(1), nil).Return(forks, nil)
+
+	require.Equal(t, model.FORKID_ZERO, sut.GetForkIDByBlockNumber(ctx, 1, 99, nil))
+	require.Equal(t, uint64(1), sut.GetForkIDByBlockNumber(ctx, 1, 100, nil))
+	require.Equal(t, uint64(1), sut.GetForkIDByBlockNumber(ctx, 1, 199, nil))
+	require.Equal(t, uint64(5), sut.GetForkIDByBlockNumber(ctx, 1, 200, nil))
+	require.Equal(t, uint64(6), sut.GetForkIDByBlockNumber(ctx, 1, 399, nil))
+	require.Equal(t, uint64(7), sut.GetForkIDByBlockNumber(ctx, 1, 1000, nil))
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type GlobalExitRoot = entities.GlobalExitRoot
+
+type StorageGlobalExitRootInterface interface {
+	AddGlobalExitRoot(ctx context.Context, globalExitRoot *GlobalExitRoot, dbTx storageTxType) error
+	GetLatestGlobalExitRoot(ctx context.Context, dbTx storageTxType) (*GlobalExitRoot, error)
+	GetGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash, dbTx storageTxType) (*GlobalExitRoot, error)
+}
+
+// GlobalExitRootState keeps the updates of GlobalExitRoot previous to LxLy, the next ones are leaves of L1InfoTree
+type GlobalExitRootState struct {
+	store  StorageGlobalExitRootInterface
+	events *EventsState
+}
+
+func NewGlobalExitRootState(store StorageGlobalExitRootInterface, events *EventsState) *GlobalExitRootState {
+	return &GlobalExitRootState{
+		store:  store,
+		events: events,
+	}
+}
+
+// AddGlobalExitRoot a new update of GlobalExitRoot have been found on L1, add to local database
+func (s *GlobalExitRootState) AddGlobalExitRoot(ctx context.Context, globalExitRoot *GlobalExitRoot, dbTx stateTxType) error {
+	err := s.store.AddGlobalExitRoot(ctx, globalExitRoot, dbTx)
+	if err == nil {
+		globalExitRootCopy := *globalExitRoot
+		s.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventNewGlobalExitRoot, GlobalExitRoot: &globalExitRootCopy})
+	}
+	return err
+}
+
+// GetLatestGlobalExitRoot returns the last update of GlobalExitRoot, nil if there are no updates
+func (s *GlobalExitRootState) GetLatestGlobalExitRoot(ctx context.Context, dbTx stateTxType) (*GlobalExitRoot, error) {
+	res, err := s.store.GetLatestGlobalExitRoot(ctx, dbTx)
+	if errors.Is(err, entities.ErrNotFound) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// GetGlobalExitRoot returns the update to globalExitRoot, nil if it's not found
+func (s *GlobalExitRootState) GetGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash, dbTx stateTxType) (*GlobalExitRoot, error) {
+	res, err := s.store.GetGlobalExitRoot(ctx, globalExitRoot, dbTx)
+	if errors.Is(err, entities.ErrNotFound) {
+		return nil, nil
+	}
+	return res, err
+}
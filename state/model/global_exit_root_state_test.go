@@ -0,0 +1,27 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	mock_model "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGlobalExitRootNotFoundReturnsNil(t *testing.T) {
+	mockStorage := mock_model.NewStorageGlobalExitRootInterface(t)
+	sut := model.NewGlobalExitRootState(mockStorage, nil)
+	ctx := context.TODO()
+	mockStorage.EXPECT().GetLatestGlobalExitRoot(ctx, nil).Return(nil, entities.ErrNotFound)
+	mockStorage.EXPECT().GetGlobalExitRoot(ctx, common.HexToHash("0x1234"), nil).Return(nil, entities.ErrNotFound)
+
+	latest, err := sut.GetLatestGlobalExitRoot(ctx, nil)
+	require.NoError(t, err)
+	require.Nil(t, latest)
+	globalExitRoot, err := sut.GetGlobalExitRoot(ctx, common.HexToHash("0x1234"), nil)
+	require.NoError(t, err)
+	require.Nil(t, globalExitRoot)
+}
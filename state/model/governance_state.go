@@ -0,0 +1,72 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+)
+
+type GovernanceEvent = entities.GovernanceEvent
+
+// GovernanceLastBlock is the blockNumber to use to get the current value of a parameter
+const GovernanceLastBlock = uint64(math.MaxInt64)
+
+type StorageGovernanceEventInterface interface {
+	AddGovernanceEvent(ctx context.Context, governanceEvent *GovernanceEvent, dbTx storageTxType) error
+	GetGovernanceEventAtBlock(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx storageTxType) (*GovernanceEvent, error)
+	GetGovernanceEvents(ctx context.Context, rollupID uint64, parameter string, dbTx storageTxType) ([]GovernanceEvent, error)
+}
+
+// GovernanceState keeps the timeline of the changes of the parameters of the rollups and of the RollupManager
+type GovernanceState struct {
+	store  StorageGovernanceEventInterface
+	events *EventsState
+}
+
+func NewGovernanceState(store StorageGovernanceEventInterface, events *EventsState) *GovernanceState {
+	return &GovernanceState{
+		store:  store,
+		events: events,
+	}
+}
+
+// AddGovernanceEvent a parameter have been changed on L1, add to local database
+func (s *GovernanceState) AddGovernanceEvent(ctx context.Context, governanceEvent *GovernanceEvent, dbTx stateTxType) error {
+	err := s.store.AddGovernanceEvent(ctx, governanceEvent, dbTx)
+	if err == nil {
+		governanceEventCopy := *governanceEvent
+		s.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventNewGovernanceEvent, GovernanceEvent: &governanceEventCopy})
+	}
+	return err
+}
+
+// GetGovernanceEventAtBlock returns the change that set the value of the parameter as of blockNumber, nil if
+// the parameter have not been changed yet. Use GovernanceLastBlock to get the current value
+func (s *GovernanceState) GetGovernanceEventAtBlock(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx stateTxType) (*GovernanceEvent, error) {
+	if blockNumber > GovernanceLastBlock {
+		blockNumber = GovernanceLastBlock
+	}
+	res, err := s.store.GetGovernanceEventAtBlock(ctx, rollupID, parameter, blockNumber, dbTx)
+	if errors.Is(err, entities.ErrNotFound) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// GetGovernanceHistory returns all the changes of the parameter, oldest first
+func (s *GovernanceState) GetGovernanceHistory(ctx context.Context, rollupID uint64, parameter string, dbTx stateTxType) ([]GovernanceEvent, error) {
+	return s.store.GetGovernanceEvents(ctx, rollupID, parameter, dbTx)
+}
+
+// IsEmergencyState returns true if the last change of the emergency state of the RollupManager have activated it
+func (s *GovernanceState) IsEmergencyState(ctx context.Context, dbTx stateTxType) (bool, error) {
+	last, err := s.GetGovernanceEventAtBlock(ctx, uint64(etherman.GovernanceRollupManagerID), etherman.GovernanceEmergencyState, GovernanceLastBlock, dbTx)
+	if err != nil || last == nil {
+		return false, err
+	}
+	return strconv.ParseBool(last.Value)
+}
@@ -0,0 +1,30 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	mock_model "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsEmergencyState(t *testing.T) {
+	mockStorage := mock_model.NewStorageGovernanceEventInterface(t)
+	sut := model.NewGovernanceState(mockStorage, nil)
+	ctx := context.TODO()
+	mockStorage.EXPECT().GetGovernanceEventAtBlock(ctx, uint64(0), etherman.GovernanceEmergencyState, model.GovernanceLastBlock, nil).
+		Return(nil, entities.ErrNotFound).Once()
+
+	isEmergency, err := sut.IsEmergencyState(ctx, nil)
+	require.NoError(t, err)
+	require.False(t, isEmergency)
+
+	mockStorage.EXPECT().GetGovernanceEventAtBlock(ctx, uint64(0), etherman.GovernanceEmergencyState, model.GovernanceLastBlock, nil).
+		Return(&entities.GovernanceEvent{Parameter: etherman.GovernanceEmergencyState, Value: "true"}, nil).Once()
+	isEmergency, err = sut.IsEmergencyState(ctx, nil)
+	require.NoError(t, err)
+	require.True(t, isEmergency)
+}
@@ -29,11 +29,13 @@ type StorageL1InfoTreeInterface interface {
 type L1InfoTreeState struct {
 	storage    StorageL1InfoTreeInterface
 	l1InfoTree *l1infotree.L1InfoTree
+	events     *EventsState
 }
 
-func NewL1InfoTreeManager(storage StorageL1InfoTreeInterface) *L1InfoTreeState {
+func NewL1InfoTreeManager(storage StorageL1InfoTreeInterface, events *EventsState) *L1InfoTreeState {
 	return &L1InfoTreeState{
 		storage: storage,
+		events:  events,
 	}
 }
 
@@ -102,6 +104,8 @@ func (s *L1InfoTreeState) AddL1InfoTreeLeafAndAssignIndex(ctx context.Context, e
 		return nil, err
 	}
 	tmp := L1InfoTreeLeaf(entry)
+	leafCopy := tmp
+	s.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventNewL1InfoTreeLeaf, L1InfoTreeLeaf: &leafCopy})
 	return &tmp, nil
 }
 
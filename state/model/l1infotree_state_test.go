@@ -17,7 +17,7 @@ func TestGetL1InfoTreeLeaves(t *testing.T) {
 	mockStorage := mock_model.NewStorageL1InfoTreeInterface(t)
 
 	// Create a new instance of L1InfoTreeState
-	state := model.NewL1InfoTreeManager(mockStorage)
+	state := model.NewL1InfoTreeManager(mockStorage, nil)
 
 	// Define the expected result
 	expectedResult := map[uint32]L1InfoTreeLeaf{
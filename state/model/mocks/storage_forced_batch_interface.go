@@ -0,0 +1,312 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_model
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StorageForcedBatchInterface is an autogenerated mock type for the StorageForcedBatchInterface type
+type StorageForcedBatchInterface struct {
+	mock.Mock
+}
+
+type StorageForcedBatchInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StorageForcedBatchInterface) EXPECT() *StorageForcedBatchInterface_Expecter {
+	return &StorageForcedBatchInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddForcedBatch provides a mock function with given fields: ctx, forcedBatch, dbTx
+func (_m *StorageForcedBatchInterface) AddForcedBatch(ctx context.Context, forcedBatch *entities.ForcedBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, forcedBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddForcedBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ForcedBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, forcedBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageForcedBatchInterface_AddForcedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddForcedBatch'
+type StorageForcedBatchInterface_AddForcedBatch_Call struct {
+	*mock.Call
+}
+
+// AddForcedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - forcedBatch *entities.ForcedBatch
+//   - dbTx entities.Tx
+func (_e *StorageForcedBatchInterface_Expecter) AddForcedBatch(ctx interface{}, forcedBatch interface{}, dbTx interface{}) *StorageForcedBatchInterface_AddForcedBatch_Call {
+	return &StorageForcedBatchInterface_AddForcedBatch_Call{Call: _e.mock.On("AddForcedBatch", ctx, forcedBatch, dbTx)}
+}
+
+func (_c *StorageForcedBatchInterface_AddForcedBatch_Call) Run(run func(ctx context.Context, forcedBatch *entities.ForcedBatch, dbTx entities.Tx)) *StorageForcedBatchInterface_AddForcedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ForcedBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageForcedBatchInterface_AddForcedBatch_Call) Return(_a0 error) *StorageForcedBatchInterface_AddForcedBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageForcedBatchInterface_AddForcedBatch_Call) RunAndReturn(run func(context.Context, *entities.ForcedBatch, entities.Tx) error) *StorageForcedBatchInterface_AddForcedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddSequencedForceBatch provides a mock function with given fields: ctx, sequencedForceBatch, dbTx
+func (_m *StorageForcedBatchInterface) AddSequencedForceBatch(ctx context.Context, sequencedForceBatch *entities.SequencedForceBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, sequencedForceBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSequencedForceBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.SequencedForceBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, sequencedForceBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageForcedBatchInterface_AddSequencedForceBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSequencedForceBatch'
+type StorageForcedBatchInterface_AddSequencedForceBatch_Call struct {
+	*mock.Call
+}
+
+// AddSequencedForceBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sequencedForceBatch *entities.SequencedForceBatch
+//   - dbTx entities.Tx
+func (_e *StorageForcedBatchInterface_Expecter) AddSequencedForceBatch(ctx interface{}, sequencedForceBatch interface{}, dbTx interface{}) *StorageForcedBatchInterface_AddSequencedForceBatch_Call {
+	return &StorageForcedBatchInterface_AddSequencedForceBatch_Call{Call: _e.mock.On("AddSequencedForceBatch", ctx, sequencedForceBatch, dbTx)}
+}
+
+func (_c *StorageForcedBatchInterface_AddSequencedForceBatch_Call) Run(run func(ctx context.Context, sequencedForceBatch *entities.SequencedForceBatch, dbTx entities.Tx)) *StorageForcedBatchInterface_AddSequencedForceBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.SequencedForceBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageForcedBatchInterface_AddSequencedForceBatch_Call) Return(_a0 error) *StorageForcedBatchInterface_AddSequencedForceBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageForcedBatchInterface_AddSequencedForceBatch_Call) RunAndReturn(run func(context.Context, *entities.SequencedForceBatch, entities.Tx) error) *StorageForcedBatchInterface_AddSequencedForceBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForcedBatch provides a mock function with given fields: ctx, rollupID, forcedBatchNumber, dbTx
+func (_m *StorageForcedBatchInterface) GetForcedBatch(ctx context.Context, rollupID uint64, forcedBatchNumber uint64, dbTx entities.Tx) (*entities.ForcedBatch, error) {
+	ret := _m.Called(ctx, rollupID, forcedBatchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForcedBatch")
+	}
+
+	var r0 *entities.ForcedBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.ForcedBatch, error)); ok {
+		return rf(ctx, rollupID, forcedBatchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.ForcedBatch); ok {
+		r0 = rf(ctx, rollupID, forcedBatchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ForcedBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, forcedBatchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageForcedBatchInterface_GetForcedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForcedBatch'
+type StorageForcedBatchInterface_GetForcedBatch_Call struct {
+	*mock.Call
+}
+
+// GetForcedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - forcedBatchNumber uint64
+//   - dbTx entities.Tx
+func (_e *StorageForcedBatchInterface_Expecter) GetForcedBatch(ctx interface{}, rollupID interface{}, forcedBatchNumber interface{}, dbTx interface{}) *StorageForcedBatchInterface_GetForcedBatch_Call {
+	return &StorageForcedBatchInterface_GetForcedBatch_Call{Call: _e.mock.On("GetForcedBatch", ctx, rollupID, forcedBatchNumber, dbTx)}
+}
+
+func (_c *StorageForcedBatchInterface_GetForcedBatch_Call) Run(run func(ctx context.Context, rollupID uint64, forcedBatchNumber uint64, dbTx entities.Tx)) *StorageForcedBatchInterface_GetForcedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageForcedBatchInterface_GetForcedBatch_Call) Return(_a0 *entities.ForcedBatch, _a1 error) *StorageForcedBatchInterface_GetForcedBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageForcedBatchInterface_GetForcedBatch_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.ForcedBatch, error)) *StorageForcedBatchInterface_GetForcedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastSequencedForcedBatchNumber provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *StorageForcedBatchInterface) GetLastSequencedForcedBatchNumber(ctx context.Context, rollupID uint64, dbTx entities.Tx) (uint64, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastSequencedForcedBatchNumber")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (uint64, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) uint64); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageForcedBatchInterface_GetLastSequencedForcedBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastSequencedForcedBatchNumber'
+type StorageForcedBatchInterface_GetLastSequencedForcedBatchNumber_Call struct {
+	*mock.Call
+}
+
+// GetLastSequencedForcedBatchNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *StorageForcedBatchInterface_Expecter) GetLastSequencedForcedBatchNumber(ctx interface{}, rollupID interface{}, dbTx interface{}) *StorageForcedBatchInterface_GetLastSequencedForcedBatchNumber_Call {
+	return &StorageForcedBatchInterface_GetLastSequencedForcedBatchNumber_Call{Call: _e.mock.On("GetLastSequencedForcedBatchNumber", ctx, rollupID, dbTx)}
+}
+
+func (_c *StorageForcedBatchInterface_GetLastSequencedForcedBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *StorageForcedBatchInterface_GetLastSequencedForcedBatchNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageForcedBatchInterface_GetLastSequencedForcedBatchNumber_Call) Return(_a0 uint64, _a1 error) *StorageForcedBatchInterface_GetLastSequencedForcedBatchNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageForcedBatchInterface_GetLastSequencedForcedBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (uint64, error)) *StorageForcedBatchInterface_GetLastSequencedForcedBatchNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingForcedBatches provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *StorageForcedBatchInterface) GetPendingForcedBatches(ctx context.Context, rollupID uint64, dbTx entities.Tx) ([]entities.ForcedBatch, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingForcedBatches")
+	}
+
+	var r0 []entities.ForcedBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) ([]entities.ForcedBatch, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) []entities.ForcedBatch); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ForcedBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageForcedBatchInterface_GetPendingForcedBatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingForcedBatches'
+type StorageForcedBatchInterface_GetPendingForcedBatches_Call struct {
+	*mock.Call
+}
+
+// GetPendingForcedBatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *StorageForcedBatchInterface_Expecter) GetPendingForcedBatches(ctx interface{}, rollupID interface{}, dbTx interface{}) *StorageForcedBatchInterface_GetPendingForcedBatches_Call {
+	return &StorageForcedBatchInterface_GetPendingForcedBatches_Call{Call: _e.mock.On("GetPendingForcedBatches", ctx, rollupID, dbTx)}
+}
+
+func (_c *StorageForcedBatchInterface_GetPendingForcedBatches_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *StorageForcedBatchInterface_GetPendingForcedBatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageForcedBatchInterface_GetPendingForcedBatches_Call) Return(_a0 []entities.ForcedBatch, _a1 error) *StorageForcedBatchInterface_GetPendingForcedBatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageForcedBatchInterface_GetPendingForcedBatches_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) ([]entities.ForcedBatch, error)) *StorageForcedBatchInterface_GetPendingForcedBatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStorageForcedBatchInterface creates a new instance of StorageForcedBatchInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStorageForcedBatchInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StorageForcedBatchInterface {
+	mock := &StorageForcedBatchInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
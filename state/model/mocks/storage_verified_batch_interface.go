@@ -0,0 +1,206 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_model
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StorageVerifiedBatchInterface is an autogenerated mock type for the StorageVerifiedBatchInterface type
+type StorageVerifiedBatchInterface struct {
+	mock.Mock
+}
+
+type StorageVerifiedBatchInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StorageVerifiedBatchInterface) EXPECT() *StorageVerifiedBatchInterface_Expecter {
+	return &StorageVerifiedBatchInterface_Expecter{mock: &_m.Mock}
+}
+
+// AddVerifiedBatch provides a mock function with given fields: ctx, verifiedBatch, dbTx
+func (_m *StorageVerifiedBatchInterface) AddVerifiedBatch(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, verifiedBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddVerifiedBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.VerifiedBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, verifiedBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorageVerifiedBatchInterface_AddVerifiedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVerifiedBatch'
+type StorageVerifiedBatchInterface_AddVerifiedBatch_Call struct {
+	*mock.Call
+}
+
+// AddVerifiedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - verifiedBatch *entities.VerifiedBatch
+//   - dbTx entities.Tx
+func (_e *StorageVerifiedBatchInterface_Expecter) AddVerifiedBatch(ctx interface{}, verifiedBatch interface{}, dbTx interface{}) *StorageVerifiedBatchInterface_AddVerifiedBatch_Call {
+	return &StorageVerifiedBatchInterface_AddVerifiedBatch_Call{Call: _e.mock.On("AddVerifiedBatch", ctx, verifiedBatch, dbTx)}
+}
+
+func (_c *StorageVerifiedBatchInterface_AddVerifiedBatch_Call) Run(run func(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx)) *StorageVerifiedBatchInterface_AddVerifiedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.VerifiedBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageVerifiedBatchInterface_AddVerifiedBatch_Call) Return(_a0 error) *StorageVerifiedBatchInterface_AddVerifiedBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StorageVerifiedBatchInterface_AddVerifiedBatch_Call) RunAndReturn(run func(context.Context, *entities.VerifiedBatch, entities.Tx) error) *StorageVerifiedBatchInterface_AddVerifiedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastVerifiedBatch provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *StorageVerifiedBatchInterface) GetLastVerifiedBatch(ctx context.Context, rollupID uint64, dbTx entities.Tx) (*entities.VerifiedBatch, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastVerifiedBatch")
+	}
+
+	var r0 *entities.VerifiedBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.VerifiedBatch, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.VerifiedBatch); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VerifiedBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageVerifiedBatchInterface_GetLastVerifiedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastVerifiedBatch'
+type StorageVerifiedBatchInterface_GetLastVerifiedBatch_Call struct {
+	*mock.Call
+}
+
+// GetLastVerifiedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *StorageVerifiedBatchInterface_Expecter) GetLastVerifiedBatch(ctx interface{}, rollupID interface{}, dbTx interface{}) *StorageVerifiedBatchInterface_GetLastVerifiedBatch_Call {
+	return &StorageVerifiedBatchInterface_GetLastVerifiedBatch_Call{Call: _e.mock.On("GetLastVerifiedBatch", ctx, rollupID, dbTx)}
+}
+
+func (_c *StorageVerifiedBatchInterface_GetLastVerifiedBatch_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *StorageVerifiedBatchInterface_GetLastVerifiedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageVerifiedBatchInterface_GetLastVerifiedBatch_Call) Return(_a0 *entities.VerifiedBatch, _a1 error) *StorageVerifiedBatchInterface_GetLastVerifiedBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageVerifiedBatchInterface_GetLastVerifiedBatch_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.VerifiedBatch, error)) *StorageVerifiedBatchInterface_GetLastVerifiedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVerifiedBatchByNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *StorageVerifiedBatchInterface) GetVerifiedBatchByNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.VerifiedBatch, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerifiedBatchByNumber")
+	}
+
+	var r0 *entities.VerifiedBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.VerifiedBatch, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.VerifiedBatch); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VerifiedBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageVerifiedBatchInterface_GetVerifiedBatchByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerifiedBatchByNumber'
+type StorageVerifiedBatchInterface_GetVerifiedBatchByNumber_Call struct {
+	*mock.Call
+}
+
+// GetVerifiedBatchByNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - dbTx entities.Tx
+func (_e *StorageVerifiedBatchInterface_Expecter) GetVerifiedBatchByNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *StorageVerifiedBatchInterface_GetVerifiedBatchByNumber_Call {
+	return &StorageVerifiedBatchInterface_GetVerifiedBatchByNumber_Call{Call: _e.mock.On("GetVerifiedBatchByNumber", ctx, rollupID, batchNumber, dbTx)}
+}
+
+func (_c *StorageVerifiedBatchInterface_GetVerifiedBatchByNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *StorageVerifiedBatchInterface_GetVerifiedBatchByNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageVerifiedBatchInterface_GetVerifiedBatchByNumber_Call) Return(_a0 *entities.VerifiedBatch, _a1 error) *StorageVerifiedBatchInterface_GetVerifiedBatchByNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageVerifiedBatchInterface_GetVerifiedBatchByNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.VerifiedBatch, error)) *StorageVerifiedBatchInterface_GetVerifiedBatchByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStorageVerifiedBatchInterface creates a new instance of StorageVerifiedBatchInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStorageVerifiedBatchInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StorageVerifiedBatchInterface {
+	mock := &StorageVerifiedBatchInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
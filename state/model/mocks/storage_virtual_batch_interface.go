@@ -118,9 +118,9 @@ func (_c *StorageVirtualBatchInterface_AddVirtualBatch_Call) RunAndReturn(run fu
 	return _c
 }
 
-// GetSequenceByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *StorageVirtualBatchInterface) GetSequenceByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) (*entities.SequencedBatches, error) {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetSequenceByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *StorageVirtualBatchInterface) GetSequenceByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.SequencedBatches, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetSequenceByBatchNumber")
@@ -128,19 +128,19 @@ func (_m *StorageVirtualBatchInterface) GetSequenceByBatchNumber(ctx context.Con
 
 	var r0 *entities.SequencedBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.SequencedBatches, error)); ok {
-		return rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.SequencedBatches, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.SequencedBatches); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.SequencedBatches); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.SequencedBatches)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -155,15 +155,16 @@ type StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call struct {
 
 // GetSequenceByBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *StorageVirtualBatchInterface_Expecter) GetSequenceByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call {
-	return &StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call{Call: _e.mock.On("GetSequenceByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *StorageVirtualBatchInterface_Expecter) GetSequenceByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call {
+	return &StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call{Call: _e.mock.On("GetSequenceByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call {
+func (_c *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
@@ -173,14 +174,14 @@ func (_c *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call) Return(_a0
 	return _c
 }
 
-func (_c *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.SequencedBatches, error)) *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call {
+func (_c *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.SequencedBatches, error)) *StorageVirtualBatchInterface_GetSequenceByBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetVirtualBatchByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *StorageVirtualBatchInterface) GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) (*entities.VirtualBatch, error) {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetVirtualBatchByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *StorageVirtualBatchInterface) GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.VirtualBatch, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetVirtualBatchByBatchNumber")
@@ -188,19 +189,19 @@ func (_m *StorageVirtualBatchInterface) GetVirtualBatchByBatchNumber(ctx context
 
 	var r0 *entities.VirtualBatch
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.VirtualBatch, error)); ok {
-		return rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.VirtualBatch, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.VirtualBatch); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.VirtualBatch); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.VirtualBatch)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -215,15 +216,16 @@ type StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call struct {
 
 // GetVirtualBatchByBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *StorageVirtualBatchInterface_Expecter) GetVirtualBatchByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
-	return &StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call{Call: _e.mock.On("GetVirtualBatchByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *StorageVirtualBatchInterface_Expecter) GetVirtualBatchByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
+	return &StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call{Call: _e.mock.On("GetVirtualBatchByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
+func (_c *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
@@ -233,7 +235,7 @@ func (_c *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call) Return
 	return _c
 }
 
-func (_c *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.VirtualBatch, error)) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
+func (_c *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.VirtualBatch, error)) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+)
+
+type preRollupSyncStatus = entities.PreRollupSyncStatus
+
+// PreRollupSyncState keeps track of the synchronization of the events previous to the rollup genesis
+type PreRollupSyncState struct {
+	storage storageKVInterface
+}
+
+func NewPreRollupSyncState(storage storageKVInterface) *PreRollupSyncState {
+	return &PreRollupSyncState{
+		storage: storage,
+	}
+}
+
+// GetPreRollupSyncStatus returns nil if the pre rollup synchronization have not been completed
+func (s *PreRollupSyncState) GetPreRollupSyncStatus(ctx context.Context, dbTx storageTxType) (*preRollupSyncStatus, error) {
+	return GetKVHelper[preRollupSyncStatus](ctx, keyPreRollupSyncStatus, s.storage, dbTx)
+}
+
+// SetPreRollupSyncStatus marks the pre rollup synchronization as completed
+func (s *PreRollupSyncState) SetPreRollupSyncStatus(ctx context.Context, status preRollupSyncStatus, dbTx storageTxType) error {
+	return s.storage.KVSetJson(ctx, keyPreRollupSyncStatus, status, nil, dbTx)
+}
@@ -2,21 +2,47 @@ package model
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
 )
 
+var (
+	// ErrReorgTooDeep is returned when the reorg reverts more L1 blocks than ReorgRequest.MaxDepth
+	ErrReorgTooDeep = errors.New("reorg exceeds the maximum depth")
+	// ErrReorgCheckedBlocks is returned when the reorg deletes blocks already finalized (Checked)
+	ErrReorgCheckedBlocks = errors.New("reorg deletes checked blocks")
+)
+
+// ReorgRejectedError is returned when a reorg is not executed because it breaks the guards,
+// Impact is the data that would be deleted
+type ReorgRejectedError struct {
+	Err    error
+	Impact entities.ReorgImpact
+}
+
+func (e *ReorgRejectedError) Error() string {
+	return fmt.Sprintf("reorg rejected: %s. Impact: %s", e.Err.Error(), e.Impact.String())
+}
+
+func (e *ReorgRejectedError) Unwrap() error {
+	return e.Err
+}
+
 // ReorgRequest is a struct that contains the information needed to execute a reorg
 type ReorgRequest struct {
 	FirstL1BlockNumberToKeep uint64
 	ReasonError              error
+	// MaxDepth is the maximum number of L1 blocks that can be reverted, 0 means no limit
+	MaxDepth uint64
 }
 
 func (r *ReorgRequest) String() string {
-	return fmt.Sprintf("FirstL1BlockNumberToKeep: %d, ReasonError: %s", r.FirstL1BlockNumberToKeep, r.ReasonError)
+	return fmt.Sprintf("FirstL1BlockNumberToKeep: %d, ReasonError: %s, MaxDepth: %d", r.FirstL1BlockNumberToKeep, r.ReasonError, r.MaxDepth)
 }
 
 // ReorgExecutionResult is a struct that contains the information of the reorg execution
@@ -26,6 +52,8 @@ type ReorgExecutionResult struct {
 	ExecutionError    error
 	ExecutionTime     time.Time
 	ExecutionDuration time.Duration
+	// Impact is the data deleted, or the data that would be deleted if the reorg has been rejected
+	Impact *entities.ReorgImpact
 }
 
 func (r ReorgExecutionResult) IsSuccess() bool {
@@ -42,6 +70,8 @@ type ReorgCallbackType = func(ReorgExecutionResult)
 type StorageReorgInterface interface {
 	ResetToL1BlockNumber(ctx context.Context, firstBlockNumberToKeep uint64, dbTx storageTxType) error
 	GetLastBlock(ctx context.Context, dbTx stateTxType) (*entities.L1Block, error)
+	GetReorgImpact(ctx context.Context, firstBlockNumberToKeep uint64, dbTx storageTxType) (*entities.ReorgImpact, error)
+	AddReorgLog(ctx context.Context, entry *entities.ReorgLogEntry, dbTx storageTxType) error
 }
 
 type ReorgState struct {
@@ -65,13 +95,71 @@ func (s *ReorgState) AddOnReorgCallback(f ReorgCallbackType) {
 
 func (s *ReorgState) ExecuteReorg(ctx context.Context, reorgRequest ReorgRequest, dbTx storageTxType) ReorgExecutionResult {
 	startTime := time.Now()
-	err := s.storage.ResetToL1BlockNumber(ctx, reorgRequest.FirstL1BlockNumberToKeep, dbTx)
-	res := s.createNewResult(reorgRequest, err, startTime)
+	impact, err := s.executeReorgAndLog(ctx, reorgRequest, startTime, dbTx)
+	res := s.createNewResult(reorgRequest, impact, err, startTime)
 	dbTx.AddCommitCallback(s.onTxCommit)
 	dbTx.AddCommitCallback(s.onTxRollback)
 	return res
 }
 
+// PreviewReorg returns the data that a reorg to firstL1BlockNumberToKeep would delete, without deleting it
+func (s *ReorgState) PreviewReorg(ctx context.Context, firstL1BlockNumberToKeep uint64, dbTx storageTxType) (*entities.ReorgImpact, error) {
+	impact, err := s.storage.GetReorgImpact(ctx, firstL1BlockNumberToKeep, dbTx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting reorg impact. Err: %w", err)
+	}
+	return impact, nil
+}
+
+// CheckReorgGuards returns a ReorgRejectedError if the reorg can't be executed because of its impact
+func CheckReorgGuards(reorgRequest ReorgRequest, impact *entities.ReorgImpact) error {
+	var err error
+	if impact.CheckedBlocks > 0 {
+		err = fmt.Errorf("%w: %d checked blocks after block %d", ErrReorgCheckedBlocks, impact.CheckedBlocks, impact.FirstL1BlockNumberToKeep)
+	} else if reorgRequest.MaxDepth > 0 && impact.Depth() > reorgRequest.MaxDepth {
+		err = fmt.Errorf("%w: depth %d > max %d", ErrReorgTooDeep, impact.Depth(), reorgRequest.MaxDepth)
+	}
+	if err != nil {
+		return &ReorgRejectedError{Err: err, Impact: *impact}
+	}
+	return nil
+}
+
+// executeReorgAndLog deletes the data after FirstL1BlockNumberToKeep and stores the reorg in the reorg log
+// in the same dbTx, so the log is only kept if the reorg is committed. If the guards are not satisfied
+// nothing is deleted
+func (s *ReorgState) executeReorgAndLog(ctx context.Context, reorgRequest ReorgRequest, startTime time.Time, dbTx storageTxType) (*entities.ReorgImpact, error) {
+	impact, err := s.PreviewReorg(ctx, reorgRequest.FirstL1BlockNumberToKeep, dbTx)
+	if err != nil {
+		return nil, err
+	}
+	err = CheckReorgGuards(reorgRequest, impact)
+	if err != nil {
+		return impact, err
+	}
+	err = s.storage.ResetToL1BlockNumber(ctx, reorgRequest.FirstL1BlockNumberToKeep, dbTx)
+	if err != nil {
+		return impact, err
+	}
+	entry := &entities.ReorgLogEntry{
+		Timestamp:               startTime,
+		FirstL1BlockNumberKept:  reorgRequest.FirstL1BlockNumberToKeep,
+		DeletedBlocks:           impact.Blocks,
+		DeletedVirtualBatches:   impact.VirtualBatches,
+		DeletedL1InfoTreeLeaves: impact.L1InfoTreeLeaves,
+		Duration:                time.Since(startTime),
+	}
+	if reorgRequest.ReasonError != nil {
+		entry.Reason = reorgRequest.ReasonError.Error()
+	}
+	err = s.storage.AddReorgLog(ctx, entry, dbTx)
+	if err != nil {
+		return impact, fmt.Errorf("error adding reorg log. Err: %w", err)
+	}
+	log.Infof("Reorg executed and logged: %s", entry.String())
+	return impact, nil
+}
+
 func (s *ReorgState) onTxCommit(dbTx storageTxType, err error) {
 	if err == nil {
 		for _, f := range s.onReorgCallbacks {
@@ -83,7 +171,7 @@ func (s *ReorgState) onTxCommit(dbTx storageTxType, err error) {
 func (s *ReorgState) onTxRollback(dbTx storageTxType, err error) {
 }
 
-func (s *ReorgState) createNewResult(reorgRequest ReorgRequest, err error, startTime time.Time) ReorgExecutionResult {
+func (s *ReorgState) createNewResult(reorgRequest ReorgRequest, impact *entities.ReorgImpact, err error, startTime time.Time) ReorgExecutionResult {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 	res := ReorgExecutionResult{
@@ -92,6 +180,7 @@ func (s *ReorgState) createNewResult(reorgRequest ReorgRequest, err error, start
 		ExecutionError:    err,
 		ExecutionTime:     startTime,
 		ExecutionDuration: time.Since(startTime),
+		Impact:            impact,
 	}
 	if s.lastReorgResult != nil {
 		res.ExecutionCounter = s.lastReorgResult.ExecutionCounter + 1
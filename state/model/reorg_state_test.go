@@ -0,0 +1,92 @@
+package model_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock_entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities/mocks"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	mock_model "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteReorgStoresReorgLog(t *testing.T) {
+	ctx := context.Background()
+	mockStorage := mock_model.NewStorageReorgInterface(t)
+	dbTx := mock_entities.NewTx(t)
+	sut := model.NewReorgState(mockStorage)
+	reasonErr := fmt.Errorf("hash mismatch")
+	impact := &entities.ReorgImpact{FirstL1BlockNumberToKeep: 100, Blocks: 5, VirtualBatches: 3, L1InfoTreeLeaves: 2}
+	mockStorage.EXPECT().GetReorgImpact(ctx, uint64(100), dbTx).Return(impact, nil)
+	mockStorage.EXPECT().ResetToL1BlockNumber(ctx, uint64(100), dbTx).Return(nil)
+	var entryStored *entities.ReorgLogEntry
+	mockStorage.EXPECT().AddReorgLog(ctx, mock.Anything, dbTx).Run(func(ctx context.Context, entry *entities.ReorgLogEntry, dbTx entities.Tx) {
+		entryStored = entry
+	}).Return(nil)
+	dbTx.EXPECT().AddCommitCallback(mock.Anything)
+
+	res := sut.ExecuteReorg(ctx, model.ReorgRequest{FirstL1BlockNumberToKeep: 100, ReasonError: reasonErr}, dbTx)
+	require.True(t, res.IsSuccess())
+	require.NotNil(t, entryStored)
+	require.Equal(t, uint64(100), entryStored.FirstL1BlockNumberKept)
+	require.Equal(t, reasonErr.Error(), entryStored.Reason)
+	require.Equal(t, uint64(5), entryStored.DeletedBlocks)
+	require.Equal(t, uint64(3), entryStored.DeletedVirtualBatches)
+	require.Equal(t, uint64(2), entryStored.DeletedL1InfoTreeLeaves)
+	require.Equal(t, res.ExecutionTime, entryStored.Timestamp)
+}
+
+func TestExecuteReorgFailsIfReorgLogFails(t *testing.T) {
+	ctx := context.Background()
+	mockStorage := mock_model.NewStorageReorgInterface(t)
+	dbTx := mock_entities.NewTx(t)
+	sut := model.NewReorgState(mockStorage)
+	errLog := fmt.Errorf("error")
+	mockStorage.EXPECT().GetReorgImpact(ctx, uint64(100), dbTx).Return(&entities.ReorgImpact{FirstL1BlockNumberToKeep: 100}, nil)
+	mockStorage.EXPECT().ResetToL1BlockNumber(ctx, uint64(100), dbTx).Return(nil)
+	mockStorage.EXPECT().AddReorgLog(ctx, mock.Anything, dbTx).Return(errLog)
+	dbTx.EXPECT().AddCommitCallback(mock.Anything)
+
+	res := sut.ExecuteReorg(ctx, model.ReorgRequest{FirstL1BlockNumberToKeep: 100}, dbTx)
+	require.False(t, res.IsSuccess())
+	require.ErrorIs(t, res.ExecutionError, errLog)
+}
+
+func TestExecuteReorgRejectsCheckedBlocks(t *testing.T) {
+	ctx := context.Background()
+	mockStorage := mock_model.NewStorageReorgInterface(t)
+	dbTx := mock_entities.NewTx(t)
+	sut := model.NewReorgState(mockStorage)
+	impact := &entities.ReorgImpact{FirstL1BlockNumberToKeep: 100, LastL1BlockNumber: 110, Blocks: 5, CheckedBlocks: 1}
+	mockStorage.EXPECT().GetReorgImpact(ctx, uint64(100), dbTx).Return(impact, nil)
+	dbTx.EXPECT().AddCommitCallback(mock.Anything)
+
+	res := sut.ExecuteReorg(ctx, model.ReorgRequest{FirstL1BlockNumberToKeep: 100}, dbTx)
+	require.ErrorIs(t, res.ExecutionError, model.ErrReorgCheckedBlocks)
+	var reorgRejected *model.ReorgRejectedError
+	require.ErrorAs(t, res.ExecutionError, &reorgRejected)
+	require.Equal(t, *impact, reorgRejected.Impact)
+	require.Equal(t, impact, res.Impact)
+}
+
+func TestExecuteReorgRejectsTooDeep(t *testing.T) {
+	ctx := context.Background()
+	mockStorage := mock_model.NewStorageReorgInterface(t)
+	dbTx := mock_entities.NewTx(t)
+	sut := model.NewReorgState(mockStorage)
+	impact := &entities.ReorgImpact{FirstL1BlockNumberToKeep: 100, LastL1BlockNumber: 165, Blocks: 5}
+	mockStorage.EXPECT().GetReorgImpact(ctx, uint64(100), dbTx).Return(impact, nil).Twice()
+	dbTx.EXPECT().AddCommitCallback(mock.Anything)
+
+	res := sut.ExecuteReorg(ctx, model.ReorgRequest{FirstL1BlockNumberToKeep: 100, MaxDepth: 64}, dbTx)
+	require.ErrorIs(t, res.ExecutionError, model.ErrReorgTooDeep)
+
+	// The depth is the number of L1 blocks reverted, so 65 blocks is allowed with MaxDepth 65
+	mockStorage.EXPECT().ResetToL1BlockNumber(ctx, uint64(100), dbTx).Return(nil)
+	mockStorage.EXPECT().AddReorgLog(ctx, mock.Anything, dbTx).Return(nil)
+	res = sut.ExecuteReorg(ctx, model.ReorgRequest{FirstL1BlockNumberToKeep: 100, MaxDepth: 65}, dbTx)
+	require.True(t, res.IsSuccess())
+}
@@ -0,0 +1,83 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+)
+
+type RollupType = entities.RollupType
+type Rollup = entities.Rollup
+type RollupUpdate = entities.RollupUpdate
+
+type StorageRollupRegistryInterface interface {
+	AddRollupType(ctx context.Context, rollupType *RollupType, dbTx storageTxType) error
+	SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx storageTxType) error
+	GetRollupType(ctx context.Context, rollupTypeID uint64, dbTx storageTxType) (*RollupType, error)
+	GetRollupTypes(ctx context.Context, dbTx storageTxType) ([]RollupType, error)
+	AddRollup(ctx context.Context, rollup *Rollup, dbTx storageTxType) error
+	AddRollupUpdate(ctx context.Context, rollupUpdate *RollupUpdate, dbTx storageTxType) error
+	GetRollup(ctx context.Context, rollupID uint64, dbTx storageTxType) (*Rollup, error)
+	GetRollups(ctx context.Context, dbTx storageTxType) ([]Rollup, error)
+}
+
+// RollupRegistryState keeps the rollup types and the rollups registered on the RollupManager
+type RollupRegistryState struct {
+	store StorageRollupRegistryInterface
+}
+
+func NewRollupRegistryState(store StorageRollupRegistryInterface) *RollupRegistryState {
+	return &RollupRegistryState{
+		store: store,
+	}
+}
+
+// AddRollupType a new rollup type have been registered on L1, add to local database
+func (s *RollupRegistryState) AddRollupType(ctx context.Context, rollupType *RollupType, dbTx stateTxType) error {
+	return s.store.AddRollupType(ctx, rollupType, dbTx)
+}
+
+// SetRollupTypeObsolete a rollup type have been marked as obsolete on L1. It returns ErrNotFound if the type
+// is not on local database (e.g. it have been registered before the genesis block)
+func (s *RollupRegistryState) SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx stateTxType) error {
+	return s.store.SetRollupTypeObsolete(ctx, rollupTypeID, blockNumber, dbTx)
+}
+
+// GetRollupType returns the rollup type, nil if it's not found
+func (s *RollupRegistryState) GetRollupType(ctx context.Context, rollupTypeID uint64, dbTx stateTxType) (*RollupType, error) {
+	res, err := s.store.GetRollupType(ctx, rollupTypeID, dbTx)
+	if errors.Is(err, entities.ErrNotFound) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// GetRollupTypes returns all the rollup types ordered by rollupTypeID
+func (s *RollupRegistryState) GetRollupTypes(ctx context.Context, dbTx stateTxType) ([]RollupType, error) {
+	return s.store.GetRollupTypes(ctx, dbTx)
+}
+
+// AddRollup a new rollup have been created or added on L1, add to local database
+func (s *RollupRegistryState) AddRollup(ctx context.Context, rollup *Rollup, dbTx stateTxType) error {
+	return s.store.AddRollup(ctx, rollup, dbTx)
+}
+
+// UpdateRollup a rollup have been upgraded to a new rollup type on L1, add to local database
+func (s *RollupRegistryState) UpdateRollup(ctx context.Context, rollupUpdate *RollupUpdate, dbTx stateTxType) error {
+	return s.store.AddRollupUpdate(ctx, rollupUpdate, dbTx)
+}
+
+// GetRollup returns the rollup with its current type, nil if it's not found
+func (s *RollupRegistryState) GetRollup(ctx context.Context, rollupID uint64, dbTx stateTxType) (*Rollup, error) {
+	res, err := s.store.GetRollup(ctx, rollupID, dbTx)
+	if errors.Is(err, entities.ErrNotFound) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// GetRollups returns all the rollups with its current type ordered by rollupID
+func (s *RollupRegistryState) GetRollups(ctx context.Context, dbTx stateTxType) ([]Rollup, error) {
+	return s.store.GetRollups(ctx, dbTx)
+}
@@ -0,0 +1,37 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	mock_model "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRollupTypeNotFoundReturnsNil(t *testing.T) {
+	mockStorage := mock_model.NewStorageRollupRegistryInterface(t)
+	sut := model.NewRollupRegistryState(mockStorage)
+	ctx := context.TODO()
+	mockStorage.EXPECT().GetRollupType(ctx, uint64(1), nil).Return(nil, entities.ErrNotFound).Once()
+
+	rollupType, err := sut.GetRollupType(ctx, 1, nil)
+	require.NoError(t, err)
+	require.Nil(t, rollupType)
+
+	mockStorage.EXPECT().GetRollup(ctx, uint64(1), nil).Return(nil, entities.ErrNotFound).Once()
+	rollup, err := sut.GetRollup(ctx, 1, nil)
+	require.NoError(t, err)
+	require.Nil(t, rollup)
+}
+
+func TestUpdateRollupStoresTheUpdate(t *testing.T) {
+	mockStorage := mock_model.NewStorageRollupRegistryInterface(t)
+	sut := model.NewRollupRegistryState(mockStorage)
+	ctx := context.TODO()
+	update := &entities.RollupUpdate{RollupID: 1, BlockNumber: 123, RollupTypeID: 3, ForkID: 9}
+	mockStorage.EXPECT().AddRollupUpdate(ctx, update, nil).Return(nil).Once()
+
+	require.NoError(t, sut.UpdateRollup(ctx, update, nil))
+}
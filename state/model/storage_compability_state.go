@@ -74,7 +74,7 @@ func (s *StorageCompatibilityState) compareData(runBoundData storageContentsBoun
 		log.Debug("No Sanity storage data in storage")
 		return nil
 	}
-	if runBoundData != *storageData {
+	if !runBoundData.IsEqual(storageData) {
 		err := fmt.Errorf("sanity storage data mismatch: run: %s, storage: %s  err:%w", runBoundData.String(), storageData.String(), entities.ErrMismatchStorageAndExecutionEnvironment)
 		log.Warnf(err.Error())
 		return err
@@ -0,0 +1,73 @@
+package model
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+)
+
+type VerifiedBatch = entities.VerifiedBatch
+
+type StorageVerifiedBatchInterface interface {
+	AddVerifiedBatch(ctx context.Context, verifiedBatch *VerifiedBatch, dbTx storageTxType) error
+	GetLastVerifiedBatch(ctx context.Context, rollupID uint64, dbTx storageTxType) (*VerifiedBatch, error)
+	GetVerifiedBatchByNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx storageTxType) (*VerifiedBatch, error)
+}
+
+type VerifiedBatchState struct {
+	store  StorageVerifiedBatchInterface
+	events *EventsState
+}
+
+func NewVerifiedBatchState(store StorageVerifiedBatchInterface, events *EventsState) *VerifiedBatchState {
+	return &VerifiedBatchState{
+		store:  store,
+		events: events,
+	}
+}
+
+// AddVerifiedBatch a new verification of batches have been found on L1, add to local database
+func (s *VerifiedBatchState) AddVerifiedBatch(ctx context.Context, verifiedBatch *VerifiedBatch, dbTx stateTxType) error {
+	rollupID := verifiedBatch.RollupID
+	return SetStorageHelper[*VerifiedBatch](ctx, verifiedBatch, dbTx,
+		func(ctx context.Context, verifiedBatch *VerifiedBatch, dbTx dbTxType) error {
+			err := s.store.AddVerifiedBatch(ctx, verifiedBatch, dbTx)
+			if err == nil {
+				verifiedBatchCopy := *verifiedBatch
+				s.events.NotifyOnCommit(dbTx, StateEvent{Type: StateEventNewVerifiedBatch, VerifiedBatch: &verifiedBatchCopy})
+			}
+			return err
+		},
+		func(ctx context.Context, batchNumber uint64, dbTx dbTxType) (*VerifiedBatch, error) {
+			return s.store.GetVerifiedBatchByNumber(ctx, rollupID, batchNumber, dbTx)
+		})
+}
+
+// GetLastVerifiedBatch returns the last verification of the rollup, nil if there are no verifications
+func (s *VerifiedBatchState) GetLastVerifiedBatch(ctx context.Context, rollupID uint64, dbTx stateTxType) (*VerifiedBatch, error) {
+	res, err := s.store.GetLastVerifiedBatch(ctx, rollupID, dbTx)
+	if errors.Is(err, entities.ErrNotFound) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// GetVerifiedBatchByNumber returns the verification that includes batchNumber, nil if it's not verified yet.
+// The StateRoot of the result belongs to its BatchNumber, that can be greater than batchNumber
+func (s *VerifiedBatchState) GetVerifiedBatchByNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx stateTxType) (*VerifiedBatch, error) {
+	res, err := s.store.GetVerifiedBatchByNumber(ctx, rollupID, batchNumber, dbTx)
+	if errors.Is(err, entities.ErrNotFound) {
+		return nil, nil
+	}
+	return res, err
+}
+
+// IsBatchVerified returns true if batchNumber of the rollup have been verified on L1
+func (s *VerifiedBatchState) IsBatchVerified(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx stateTxType) (bool, error) {
+	last, err := s.GetLastVerifiedBatch(ctx, rollupID, dbTx)
+	if err != nil {
+		return false, err
+	}
+	return last != nil && last.BatchNumber >= batchNumber, nil
+}
@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock_entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities/mocks"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	mock_model "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddVerifiedBatchAlreadyStoredIsTheSame(t *testing.T) {
+	mockStorage := mock_model.NewStorageVerifiedBatchInterface(t)
+	sut := model.NewVerifiedBatchState(mockStorage, nil)
+	ctx := context.TODO()
+	dbTx := mock_entities.NewTx(t)
+	verifiedBatch := &entities.VerifiedBatch{RollupID: 1, BatchNumber: 10, StateRoot: common.HexToHash("0x1234")}
+	mockStorage.EXPECT().AddVerifiedBatch(ctx, verifiedBatch, dbTx).Return(entities.ErrAlreadyExists)
+	mockStorage.EXPECT().GetVerifiedBatchByNumber(ctx, uint64(1), uint64(10), dbTx).Return(verifiedBatch, nil)
+
+	err := sut.AddVerifiedBatch(ctx, verifiedBatch, dbTx)
+	require.NoError(t, err)
+}
+
+func TestAddVerifiedBatchAlreadyStoredIsDifferent(t *testing.T) {
+	mockStorage := mock_model.NewStorageVerifiedBatchInterface(t)
+	sut := model.NewVerifiedBatchState(mockStorage, nil)
+	ctx := context.TODO()
+	dbTx := mock_entities.NewTx(t)
+	verifiedBatch := &entities.VerifiedBatch{RollupID: 1, BatchNumber: 10, StateRoot: common.HexToHash("0x1234")}
+	mockStorage.EXPECT().AddVerifiedBatch(ctx, verifiedBatch, dbTx).Return(entities.ErrAlreadyExists)
+	mockStorage.EXPECT().GetVerifiedBatchByNumber(ctx, uint64(1), uint64(10), dbTx).
+		Return(&entities.VerifiedBatch{RollupID: 1, BatchNumber: 10, StateRoot: common.HexToHash("0x5678")}, nil)
+
+	err := sut.AddVerifiedBatch(ctx, verifiedBatch, dbTx)
+	require.ErrorIs(t, err, entities.ErrAlreadyExists)
+}
+
+func TestIsBatchVerified(t *testing.T) {
+	mockStorage := mock_model.NewStorageVerifiedBatchInterface(t)
+	sut := model.NewVerifiedBatchState(mockStorage, nil)
+	ctx := context.TODO()
+	mockStorage.EXPECT().GetLastVerifiedBatch(ctx, uint64(1), nil).Return(&entities.VerifiedBatch{RollupID: 1, BatchNumber: 10}, nil)
+	mockStorage.EXPECT().GetLastVerifiedBatch(ctx, uint64(2), nil).Return(nil, entities.ErrNotFound)
+
+	verified, err := sut.IsBatchVerified(ctx, 1, 10, nil)
+	require.NoError(t, err)
+	require.True(t, verified)
+	verified, err = sut.IsBatchVerified(ctx, 1, 11, nil)
+	require.NoError(t, err)
+	require.False(t, verified)
+	verified, err = sut.IsBatchVerified(ctx, 2, 1, nil)
+	require.NoError(t, err)
+	require.False(t, verified)
+}
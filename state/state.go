@@ -10,21 +10,39 @@ type State struct {
 	*model.ForkIdState
 	*model.L1InfoTreeState
 	*model.BatchState
+	*model.VerifiedBatchState
+	*model.ForcedBatchState
+	*model.GlobalExitRootState
+	*model.GovernanceState
+	*model.RollupRegistryState
+	*model.AuditState
+	*model.ConsolidationState
 	*model.ReorgState
 	*model.StorageCompatibilityState
-	storage.BlockStorer
+	*model.PreRollupSyncState
+	*model.BlockState
+	*model.EventsState
 }
 
 func NewState(storageImpl storage.Storer) *State {
-
+	events := model.NewEventsState()
 	res := &State{
 		model.NewTxManager(storageImpl),
-		model.NewForkIdState(storageImpl),
-		model.NewL1InfoTreeManager(storageImpl),
-		model.NewBatchState(storageImpl),
+		model.NewForkIdState(storageImpl, events),
+		model.NewL1InfoTreeManager(storageImpl, events),
+		model.NewBatchState(storageImpl, events),
+		model.NewVerifiedBatchState(storageImpl, events),
+		model.NewForcedBatchState(storageImpl, events),
+		model.NewGlobalExitRootState(storageImpl, events),
+		model.NewGovernanceState(storageImpl, events),
+		model.NewRollupRegistryState(storageImpl),
+		model.NewAuditState(storageImpl, events),
+		model.NewConsolidationState(storageImpl),
 		model.NewReorgState(storageImpl),
 		model.NewStorageCompatibilityState(storageImpl),
-		storageImpl,
+		model.NewPreRollupSyncState(storageImpl),
+		model.NewBlockState(storageImpl, events),
+		events,
 	}
 	// Connect cache invalidation on Reorg
 	res.ReorgState.AddOnReorgCallback(res.L1InfoTreeState.OnReorg)
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
 	"github.com/ethereum/go-ethereum/common"
@@ -14,6 +15,18 @@ type VirtualBatch = entities.VirtualBatch
 type SequencedBatches = entities.SequencedBatches
 type storageTxType = entities.Tx
 type kVMetadataEntry = entities.KVMetadataEntry
+type ReorgImpact = entities.ReorgImpact
+type ReorgLogEntry = entities.ReorgLogEntry
+type VerifiedBatch = entities.VerifiedBatch
+type ForcedBatch = entities.ForcedBatch
+type SequencedForceBatch = entities.SequencedForceBatch
+type GlobalExitRoot = entities.GlobalExitRoot
+type GovernanceEvent = entities.GovernanceEvent
+type RollupType = entities.RollupType
+type Rollup = entities.Rollup
+type RollupUpdate = entities.RollupUpdate
+type AuditEvent = entities.AuditEvent
+type PendingState = entities.PendingState
 
 type BlockStorer interface {
 	AddBlock(ctx context.Context, block *L1Block, dbTx storageTxType) error
@@ -23,11 +36,12 @@ type BlockStorer interface {
 	GetPreviousBlock(ctx context.Context, offset uint64, dbTx storageTxType) (*L1Block, error)
 	GetFirstUncheckedBlock(ctx context.Context, fromBlockNumber uint64, dbTx storageTxType) (*L1Block, error)
 	GetUncheckedBlocks(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx storageTxType) (*[]L1Block, error)
+	GetUncheckedBlocksCount(ctx context.Context, dbTx storageTxType) (uint64, error)
 }
 
 type forkidStorer interface {
 	AddForkID(ctx context.Context, forkID ForkIDInterval, dbTx storageTxType) error
-	GetForkIDs(ctx context.Context, dbTx storageTxType) ([]ForkIDInterval, error)
+	GetForkIDs(ctx context.Context, rollupID uint64, dbTx storageTxType) ([]ForkIDInterval, error)
 	UpdateForkID(ctx context.Context, forkID ForkIDInterval, dbTx storageTxType) error
 	GetForkIDByBatchNumber(ctx context.Context, batchNumber uint64, dbTx storageTxType) uint64
 }
@@ -42,16 +56,71 @@ type l1infoTreeStorer interface {
 
 type sequencedBatchStorer interface {
 	AddSequencedBatches(ctx context.Context, sequence *SequencedBatches, dbTx storageTxType) error
-	GetSequenceByBatchNumber(ctx context.Context, batchNumber uint64, dbTx storageTxType) (*SequencedBatches, error)
+	GetSequenceByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx storageTxType) (*SequencedBatches, error)
 }
 
 type virtualBatchStorer interface {
 	AddVirtualBatch(ctx context.Context, virtualBatch *VirtualBatch, dbTx storageTxType) error
-	GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber uint64, dbTx storageTxType) (*VirtualBatch, error)
+	GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx storageTxType) (*VirtualBatch, error)
+}
+
+type verifiedBatchStorer interface {
+	AddVerifiedBatch(ctx context.Context, verifiedBatch *VerifiedBatch, dbTx storageTxType) error
+	GetLastVerifiedBatch(ctx context.Context, rollupID uint64, dbTx storageTxType) (*VerifiedBatch, error)
+	GetVerifiedBatchByNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx storageTxType) (*VerifiedBatch, error)
+}
+
+type forcedBatchStorer interface {
+	AddForcedBatch(ctx context.Context, forcedBatch *ForcedBatch, dbTx storageTxType) error
+	GetForcedBatch(ctx context.Context, rollupID uint64, forcedBatchNumber uint64, dbTx storageTxType) (*ForcedBatch, error)
+	GetPendingForcedBatches(ctx context.Context, rollupID uint64, dbTx storageTxType) ([]ForcedBatch, error)
+	AddSequencedForceBatch(ctx context.Context, sequencedForceBatch *SequencedForceBatch, dbTx storageTxType) error
+	GetLastSequencedForcedBatchNumber(ctx context.Context, rollupID uint64, dbTx storageTxType) (uint64, error)
+}
+
+type globalExitRootStorer interface {
+	AddGlobalExitRoot(ctx context.Context, globalExitRoot *GlobalExitRoot, dbTx storageTxType) error
+	GetLatestGlobalExitRoot(ctx context.Context, dbTx storageTxType) (*GlobalExitRoot, error)
+	GetGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash, dbTx storageTxType) (*GlobalExitRoot, error)
+}
+
+type governanceEventStorer interface {
+	AddGovernanceEvent(ctx context.Context, governanceEvent *GovernanceEvent, dbTx storageTxType) error
+	GetGovernanceEventAtBlock(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx storageTxType) (*GovernanceEvent, error)
+	GetGovernanceEvents(ctx context.Context, rollupID uint64, parameter string, dbTx storageTxType) ([]GovernanceEvent, error)
+}
+
+type rollupRegistryStorer interface {
+	AddRollupType(ctx context.Context, rollupType *RollupType, dbTx storageTxType) error
+	SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx storageTxType) error
+	GetRollupType(ctx context.Context, rollupTypeID uint64, dbTx storageTxType) (*RollupType, error)
+	GetRollupTypes(ctx context.Context, dbTx storageTxType) ([]RollupType, error)
+	AddRollup(ctx context.Context, rollup *Rollup, dbTx storageTxType) error
+	AddRollupUpdate(ctx context.Context, rollupUpdate *RollupUpdate, dbTx storageTxType) error
+	GetRollup(ctx context.Context, rollupID uint64, dbTx storageTxType) (*Rollup, error)
+	GetRollups(ctx context.Context, dbTx storageTxType) ([]Rollup, error)
+}
+
+type auditEventStorer interface {
+	AddAuditEvent(ctx context.Context, auditEvent *AuditEvent, dbTx storageTxType) error
+	GetAuditEvents(ctx context.Context, contract *common.Address, fromBlockNumber uint64, dbTx storageTxType) ([]AuditEvent, error)
+}
+
+type pendingStateStorer interface {
+	AddPendingState(ctx context.Context, pendingState *PendingState, dbTx storageTxType) error
+	ConsolidatePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx storageTxType) error
+	OverridePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx storageTxType) error
+	SetPendingStateNonDeterministic(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx storageTxType) error
+	GetPendingState(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx storageTxType) (*PendingState, error)
+	GetPendingStates(ctx context.Context, rollupID uint64, fromBatchNumber uint64, dbTx storageTxType) ([]PendingState, error)
+	GetConsolidatedBatchNumber(ctx context.Context, rollupID uint64, dbTx storageTxType) (uint64, error)
 }
 
 type reorgStorer interface {
 	ResetToL1BlockNumber(ctx context.Context, firstBlockNumberToKeep uint64, dbTx storageTxType) error
+	GetReorgImpact(ctx context.Context, firstBlockNumberToKeep uint64, dbTx storageTxType) (*ReorgImpact, error)
+	AddReorgLog(ctx context.Context, entry *ReorgLogEntry, dbTx storageTxType) error
+	GetReorgLogs(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx storageTxType) ([]ReorgLogEntry, error)
 }
 
 type txStorer interface {
@@ -70,6 +139,13 @@ type Storer interface {
 	l1infoTreeStorer
 	virtualBatchStorer
 	sequencedBatchStorer
+	verifiedBatchStorer
+	forcedBatchStorer
+	globalExitRootStorer
+	governanceEventStorer
+	rollupRegistryStorer
+	auditEventStorer
+	pendingStateStorer
 	reorgStorer
 	KvStorer
 }
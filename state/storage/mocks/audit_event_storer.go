@@ -0,0 +1,149 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_storage
+
+import (
+	context "context"
+
+	common "github.com/ethereum/go-ethereum/common"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// auditEventStorer is an autogenerated mock type for the auditEventStorer type
+type auditEventStorer struct {
+	mock.Mock
+}
+
+type auditEventStorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *auditEventStorer) EXPECT() *auditEventStorer_Expecter {
+	return &auditEventStorer_Expecter{mock: &_m.Mock}
+}
+
+// AddAuditEvent provides a mock function with given fields: ctx, auditEvent, dbTx
+func (_m *auditEventStorer) AddAuditEvent(ctx context.Context, auditEvent *entities.AuditEvent, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, auditEvent, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAuditEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.AuditEvent, entities.Tx) error); ok {
+		r0 = rf(ctx, auditEvent, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// auditEventStorer_AddAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAuditEvent'
+type auditEventStorer_AddAuditEvent_Call struct {
+	*mock.Call
+}
+
+// AddAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - auditEvent *entities.AuditEvent
+//   - dbTx entities.Tx
+func (_e *auditEventStorer_Expecter) AddAuditEvent(ctx interface{}, auditEvent interface{}, dbTx interface{}) *auditEventStorer_AddAuditEvent_Call {
+	return &auditEventStorer_AddAuditEvent_Call{Call: _e.mock.On("AddAuditEvent", ctx, auditEvent, dbTx)}
+}
+
+func (_c *auditEventStorer_AddAuditEvent_Call) Run(run func(ctx context.Context, auditEvent *entities.AuditEvent, dbTx entities.Tx)) *auditEventStorer_AddAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.AuditEvent), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *auditEventStorer_AddAuditEvent_Call) Return(_a0 error) *auditEventStorer_AddAuditEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *auditEventStorer_AddAuditEvent_Call) RunAndReturn(run func(context.Context, *entities.AuditEvent, entities.Tx) error) *auditEventStorer_AddAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuditEvents provides a mock function with given fields: ctx, contract, fromBlockNumber, dbTx
+func (_m *auditEventStorer) GetAuditEvents(ctx context.Context, contract *common.Address, fromBlockNumber uint64, dbTx entities.Tx) ([]entities.AuditEvent, error) {
+	ret := _m.Called(ctx, contract, fromBlockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditEvents")
+	}
+
+	var r0 []entities.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *common.Address, uint64, entities.Tx) ([]entities.AuditEvent, error)); ok {
+		return rf(ctx, contract, fromBlockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *common.Address, uint64, entities.Tx) []entities.AuditEvent); ok {
+		r0 = rf(ctx, contract, fromBlockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *common.Address, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, contract, fromBlockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// auditEventStorer_GetAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditEvents'
+type auditEventStorer_GetAuditEvents_Call struct {
+	*mock.Call
+}
+
+// GetAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contract *common.Address
+//   - fromBlockNumber uint64
+//   - dbTx entities.Tx
+func (_e *auditEventStorer_Expecter) GetAuditEvents(ctx interface{}, contract interface{}, fromBlockNumber interface{}, dbTx interface{}) *auditEventStorer_GetAuditEvents_Call {
+	return &auditEventStorer_GetAuditEvents_Call{Call: _e.mock.On("GetAuditEvents", ctx, contract, fromBlockNumber, dbTx)}
+}
+
+func (_c *auditEventStorer_GetAuditEvents_Call) Run(run func(ctx context.Context, contract *common.Address, fromBlockNumber uint64, dbTx entities.Tx)) *auditEventStorer_GetAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*common.Address), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *auditEventStorer_GetAuditEvents_Call) Return(_a0 []entities.AuditEvent, _a1 error) *auditEventStorer_GetAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *auditEventStorer_GetAuditEvents_Call) RunAndReturn(run func(context.Context, *common.Address, uint64, entities.Tx) ([]entities.AuditEvent, error)) *auditEventStorer_GetAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newAuditEventStorer creates a new instance of auditEventStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newAuditEventStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *auditEventStorer {
+	mock := &auditEventStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
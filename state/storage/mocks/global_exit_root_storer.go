@@ -0,0 +1,207 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_storage
+
+import (
+	context "context"
+
+	common "github.com/ethereum/go-ethereum/common"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// globalExitRootStorer is an autogenerated mock type for the globalExitRootStorer type
+type globalExitRootStorer struct {
+	mock.Mock
+}
+
+type globalExitRootStorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *globalExitRootStorer) EXPECT() *globalExitRootStorer_Expecter {
+	return &globalExitRootStorer_Expecter{mock: &_m.Mock}
+}
+
+// AddGlobalExitRoot provides a mock function with given fields: ctx, globalExitRoot, dbTx
+func (_m *globalExitRootStorer) AddGlobalExitRoot(ctx context.Context, globalExitRoot *entities.GlobalExitRoot, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, globalExitRoot, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGlobalExitRoot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.GlobalExitRoot, entities.Tx) error); ok {
+		r0 = rf(ctx, globalExitRoot, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// globalExitRootStorer_AddGlobalExitRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGlobalExitRoot'
+type globalExitRootStorer_AddGlobalExitRoot_Call struct {
+	*mock.Call
+}
+
+// AddGlobalExitRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - globalExitRoot *entities.GlobalExitRoot
+//   - dbTx entities.Tx
+func (_e *globalExitRootStorer_Expecter) AddGlobalExitRoot(ctx interface{}, globalExitRoot interface{}, dbTx interface{}) *globalExitRootStorer_AddGlobalExitRoot_Call {
+	return &globalExitRootStorer_AddGlobalExitRoot_Call{Call: _e.mock.On("AddGlobalExitRoot", ctx, globalExitRoot, dbTx)}
+}
+
+func (_c *globalExitRootStorer_AddGlobalExitRoot_Call) Run(run func(ctx context.Context, globalExitRoot *entities.GlobalExitRoot, dbTx entities.Tx)) *globalExitRootStorer_AddGlobalExitRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.GlobalExitRoot), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *globalExitRootStorer_AddGlobalExitRoot_Call) Return(_a0 error) *globalExitRootStorer_AddGlobalExitRoot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *globalExitRootStorer_AddGlobalExitRoot_Call) RunAndReturn(run func(context.Context, *entities.GlobalExitRoot, entities.Tx) error) *globalExitRootStorer_AddGlobalExitRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGlobalExitRoot provides a mock function with given fields: ctx, globalExitRoot, dbTx
+func (_m *globalExitRootStorer) GetGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash, dbTx entities.Tx) (*entities.GlobalExitRoot, error) {
+	ret := _m.Called(ctx, globalExitRoot, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGlobalExitRoot")
+	}
+
+	var r0 *entities.GlobalExitRoot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) (*entities.GlobalExitRoot, error)); ok {
+		return rf(ctx, globalExitRoot, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) *entities.GlobalExitRoot); ok {
+		r0 = rf(ctx, globalExitRoot, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GlobalExitRoot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, entities.Tx) error); ok {
+		r1 = rf(ctx, globalExitRoot, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// globalExitRootStorer_GetGlobalExitRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGlobalExitRoot'
+type globalExitRootStorer_GetGlobalExitRoot_Call struct {
+	*mock.Call
+}
+
+// GetGlobalExitRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - globalExitRoot common.Hash
+//   - dbTx entities.Tx
+func (_e *globalExitRootStorer_Expecter) GetGlobalExitRoot(ctx interface{}, globalExitRoot interface{}, dbTx interface{}) *globalExitRootStorer_GetGlobalExitRoot_Call {
+	return &globalExitRootStorer_GetGlobalExitRoot_Call{Call: _e.mock.On("GetGlobalExitRoot", ctx, globalExitRoot, dbTx)}
+}
+
+func (_c *globalExitRootStorer_GetGlobalExitRoot_Call) Run(run func(ctx context.Context, globalExitRoot common.Hash, dbTx entities.Tx)) *globalExitRootStorer_GetGlobalExitRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Hash), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *globalExitRootStorer_GetGlobalExitRoot_Call) Return(_a0 *entities.GlobalExitRoot, _a1 error) *globalExitRootStorer_GetGlobalExitRoot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *globalExitRootStorer_GetGlobalExitRoot_Call) RunAndReturn(run func(context.Context, common.Hash, entities.Tx) (*entities.GlobalExitRoot, error)) *globalExitRootStorer_GetGlobalExitRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestGlobalExitRoot provides a mock function with given fields: ctx, dbTx
+func (_m *globalExitRootStorer) GetLatestGlobalExitRoot(ctx context.Context, dbTx entities.Tx) (*entities.GlobalExitRoot, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestGlobalExitRoot")
+	}
+
+	var r0 *entities.GlobalExitRoot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (*entities.GlobalExitRoot, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) *entities.GlobalExitRoot); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GlobalExitRoot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// globalExitRootStorer_GetLatestGlobalExitRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestGlobalExitRoot'
+type globalExitRootStorer_GetLatestGlobalExitRoot_Call struct {
+	*mock.Call
+}
+
+// GetLatestGlobalExitRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *globalExitRootStorer_Expecter) GetLatestGlobalExitRoot(ctx interface{}, dbTx interface{}) *globalExitRootStorer_GetLatestGlobalExitRoot_Call {
+	return &globalExitRootStorer_GetLatestGlobalExitRoot_Call{Call: _e.mock.On("GetLatestGlobalExitRoot", ctx, dbTx)}
+}
+
+func (_c *globalExitRootStorer_GetLatestGlobalExitRoot_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *globalExitRootStorer_GetLatestGlobalExitRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *globalExitRootStorer_GetLatestGlobalExitRoot_Call) Return(_a0 *entities.GlobalExitRoot, _a1 error) *globalExitRootStorer_GetLatestGlobalExitRoot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *globalExitRootStorer_GetLatestGlobalExitRoot_Call) RunAndReturn(run func(context.Context, entities.Tx) (*entities.GlobalExitRoot, error)) *globalExitRootStorer_GetLatestGlobalExitRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newGlobalExitRootStorer creates a new instance of globalExitRootStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newGlobalExitRootStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *globalExitRootStorer {
+	mock := &globalExitRootStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
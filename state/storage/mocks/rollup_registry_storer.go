@@ -0,0 +1,468 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_storage
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// rollupRegistryStorer is an autogenerated mock type for the rollupRegistryStorer type
+type rollupRegistryStorer struct {
+	mock.Mock
+}
+
+type rollupRegistryStorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *rollupRegistryStorer) EXPECT() *rollupRegistryStorer_Expecter {
+	return &rollupRegistryStorer_Expecter{mock: &_m.Mock}
+}
+
+// AddRollup provides a mock function with given fields: ctx, rollup, dbTx
+func (_m *rollupRegistryStorer) AddRollup(ctx context.Context, rollup *entities.Rollup, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollup, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Rollup, entities.Tx) error); ok {
+		r0 = rf(ctx, rollup, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// rollupRegistryStorer_AddRollup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollup'
+type rollupRegistryStorer_AddRollup_Call struct {
+	*mock.Call
+}
+
+// AddRollup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollup *entities.Rollup
+//   - dbTx entities.Tx
+func (_e *rollupRegistryStorer_Expecter) AddRollup(ctx interface{}, rollup interface{}, dbTx interface{}) *rollupRegistryStorer_AddRollup_Call {
+	return &rollupRegistryStorer_AddRollup_Call{Call: _e.mock.On("AddRollup", ctx, rollup, dbTx)}
+}
+
+func (_c *rollupRegistryStorer_AddRollup_Call) Run(run func(ctx context.Context, rollup *entities.Rollup, dbTx entities.Tx)) *rollupRegistryStorer_AddRollup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.Rollup), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *rollupRegistryStorer_AddRollup_Call) Return(_a0 error) *rollupRegistryStorer_AddRollup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *rollupRegistryStorer_AddRollup_Call) RunAndReturn(run func(context.Context, *entities.Rollup, entities.Tx) error) *rollupRegistryStorer_AddRollup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRollupType provides a mock function with given fields: ctx, rollupType, dbTx
+func (_m *rollupRegistryStorer) AddRollupType(ctx context.Context, rollupType *entities.RollupType, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupType, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollupType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RollupType, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupType, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// rollupRegistryStorer_AddRollupType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollupType'
+type rollupRegistryStorer_AddRollupType_Call struct {
+	*mock.Call
+}
+
+// AddRollupType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupType *entities.RollupType
+//   - dbTx entities.Tx
+func (_e *rollupRegistryStorer_Expecter) AddRollupType(ctx interface{}, rollupType interface{}, dbTx interface{}) *rollupRegistryStorer_AddRollupType_Call {
+	return &rollupRegistryStorer_AddRollupType_Call{Call: _e.mock.On("AddRollupType", ctx, rollupType, dbTx)}
+}
+
+func (_c *rollupRegistryStorer_AddRollupType_Call) Run(run func(ctx context.Context, rollupType *entities.RollupType, dbTx entities.Tx)) *rollupRegistryStorer_AddRollupType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.RollupType), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *rollupRegistryStorer_AddRollupType_Call) Return(_a0 error) *rollupRegistryStorer_AddRollupType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *rollupRegistryStorer_AddRollupType_Call) RunAndReturn(run func(context.Context, *entities.RollupType, entities.Tx) error) *rollupRegistryStorer_AddRollupType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRollupUpdate provides a mock function with given fields: ctx, rollupUpdate, dbTx
+func (_m *rollupRegistryStorer) AddRollupUpdate(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupUpdate, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollupUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RollupUpdate, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupUpdate, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// rollupRegistryStorer_AddRollupUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollupUpdate'
+type rollupRegistryStorer_AddRollupUpdate_Call struct {
+	*mock.Call
+}
+
+// AddRollupUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupUpdate *entities.RollupUpdate
+//   - dbTx entities.Tx
+func (_e *rollupRegistryStorer_Expecter) AddRollupUpdate(ctx interface{}, rollupUpdate interface{}, dbTx interface{}) *rollupRegistryStorer_AddRollupUpdate_Call {
+	return &rollupRegistryStorer_AddRollupUpdate_Call{Call: _e.mock.On("AddRollupUpdate", ctx, rollupUpdate, dbTx)}
+}
+
+func (_c *rollupRegistryStorer_AddRollupUpdate_Call) Run(run func(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx entities.Tx)) *rollupRegistryStorer_AddRollupUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.RollupUpdate), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *rollupRegistryStorer_AddRollupUpdate_Call) Return(_a0 error) *rollupRegistryStorer_AddRollupUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *rollupRegistryStorer_AddRollupUpdate_Call) RunAndReturn(run func(context.Context, *entities.RollupUpdate, entities.Tx) error) *rollupRegistryStorer_AddRollupUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRollup provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *rollupRegistryStorer) GetRollup(ctx context.Context, rollupID uint64, dbTx entities.Tx) (*entities.Rollup, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollup")
+	}
+
+	var r0 *entities.Rollup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.Rollup, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.Rollup); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.Rollup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// rollupRegistryStorer_GetRollup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollup'
+type rollupRegistryStorer_GetRollup_Call struct {
+	*mock.Call
+}
+
+// GetRollup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *rollupRegistryStorer_Expecter) GetRollup(ctx interface{}, rollupID interface{}, dbTx interface{}) *rollupRegistryStorer_GetRollup_Call {
+	return &rollupRegistryStorer_GetRollup_Call{Call: _e.mock.On("GetRollup", ctx, rollupID, dbTx)}
+}
+
+func (_c *rollupRegistryStorer_GetRollup_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *rollupRegistryStorer_GetRollup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *rollupRegistryStorer_GetRollup_Call) Return(_a0 *entities.Rollup, _a1 error) *rollupRegistryStorer_GetRollup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *rollupRegistryStorer_GetRollup_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.Rollup, error)) *rollupRegistryStorer_GetRollup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRollupType provides a mock function with given fields: ctx, rollupTypeID, dbTx
+func (_m *rollupRegistryStorer) GetRollupType(ctx context.Context, rollupTypeID uint64, dbTx entities.Tx) (*entities.RollupType, error) {
+	ret := _m.Called(ctx, rollupTypeID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollupType")
+	}
+
+	var r0 *entities.RollupType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.RollupType, error)); ok {
+		return rf(ctx, rollupTypeID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.RollupType); ok {
+		r0 = rf(ctx, rollupTypeID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.RollupType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupTypeID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// rollupRegistryStorer_GetRollupType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollupType'
+type rollupRegistryStorer_GetRollupType_Call struct {
+	*mock.Call
+}
+
+// GetRollupType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupTypeID uint64
+//   - dbTx entities.Tx
+func (_e *rollupRegistryStorer_Expecter) GetRollupType(ctx interface{}, rollupTypeID interface{}, dbTx interface{}) *rollupRegistryStorer_GetRollupType_Call {
+	return &rollupRegistryStorer_GetRollupType_Call{Call: _e.mock.On("GetRollupType", ctx, rollupTypeID, dbTx)}
+}
+
+func (_c *rollupRegistryStorer_GetRollupType_Call) Run(run func(ctx context.Context, rollupTypeID uint64, dbTx entities.Tx)) *rollupRegistryStorer_GetRollupType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *rollupRegistryStorer_GetRollupType_Call) Return(_a0 *entities.RollupType, _a1 error) *rollupRegistryStorer_GetRollupType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *rollupRegistryStorer_GetRollupType_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.RollupType, error)) *rollupRegistryStorer_GetRollupType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRollupTypes provides a mock function with given fields: ctx, dbTx
+func (_m *rollupRegistryStorer) GetRollupTypes(ctx context.Context, dbTx entities.Tx) ([]entities.RollupType, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollupTypes")
+	}
+
+	var r0 []entities.RollupType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.RollupType, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.RollupType); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.RollupType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// rollupRegistryStorer_GetRollupTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollupTypes'
+type rollupRegistryStorer_GetRollupTypes_Call struct {
+	*mock.Call
+}
+
+// GetRollupTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *rollupRegistryStorer_Expecter) GetRollupTypes(ctx interface{}, dbTx interface{}) *rollupRegistryStorer_GetRollupTypes_Call {
+	return &rollupRegistryStorer_GetRollupTypes_Call{Call: _e.mock.On("GetRollupTypes", ctx, dbTx)}
+}
+
+func (_c *rollupRegistryStorer_GetRollupTypes_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *rollupRegistryStorer_GetRollupTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *rollupRegistryStorer_GetRollupTypes_Call) Return(_a0 []entities.RollupType, _a1 error) *rollupRegistryStorer_GetRollupTypes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *rollupRegistryStorer_GetRollupTypes_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.RollupType, error)) *rollupRegistryStorer_GetRollupTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRollups provides a mock function with given fields: ctx, dbTx
+func (_m *rollupRegistryStorer) GetRollups(ctx context.Context, dbTx entities.Tx) ([]entities.Rollup, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollups")
+	}
+
+	var r0 []entities.Rollup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.Rollup, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.Rollup); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.Rollup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// rollupRegistryStorer_GetRollups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollups'
+type rollupRegistryStorer_GetRollups_Call struct {
+	*mock.Call
+}
+
+// GetRollups is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *rollupRegistryStorer_Expecter) GetRollups(ctx interface{}, dbTx interface{}) *rollupRegistryStorer_GetRollups_Call {
+	return &rollupRegistryStorer_GetRollups_Call{Call: _e.mock.On("GetRollups", ctx, dbTx)}
+}
+
+func (_c *rollupRegistryStorer_GetRollups_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *rollupRegistryStorer_GetRollups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *rollupRegistryStorer_GetRollups_Call) Return(_a0 []entities.Rollup, _a1 error) *rollupRegistryStorer_GetRollups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *rollupRegistryStorer_GetRollups_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.Rollup, error)) *rollupRegistryStorer_GetRollups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRollupTypeObsolete provides a mock function with given fields: ctx, rollupTypeID, blockNumber, dbTx
+func (_m *rollupRegistryStorer) SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupTypeID, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRollupTypeObsolete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupTypeID, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// rollupRegistryStorer_SetRollupTypeObsolete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRollupTypeObsolete'
+type rollupRegistryStorer_SetRollupTypeObsolete_Call struct {
+	*mock.Call
+}
+
+// SetRollupTypeObsolete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupTypeID uint64
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *rollupRegistryStorer_Expecter) SetRollupTypeObsolete(ctx interface{}, rollupTypeID interface{}, blockNumber interface{}, dbTx interface{}) *rollupRegistryStorer_SetRollupTypeObsolete_Call {
+	return &rollupRegistryStorer_SetRollupTypeObsolete_Call{Call: _e.mock.On("SetRollupTypeObsolete", ctx, rollupTypeID, blockNumber, dbTx)}
+}
+
+func (_c *rollupRegistryStorer_SetRollupTypeObsolete_Call) Run(run func(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx entities.Tx)) *rollupRegistryStorer_SetRollupTypeObsolete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *rollupRegistryStorer_SetRollupTypeObsolete_Call) Return(_a0 error) *rollupRegistryStorer_SetRollupTypeObsolete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *rollupRegistryStorer_SetRollupTypeObsolete_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) error) *rollupRegistryStorer_SetRollupTypeObsolete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newRollupRegistryStorer creates a new instance of rollupRegistryStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newRollupRegistryStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *rollupRegistryStorer {
+	mock := &rollupRegistryStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
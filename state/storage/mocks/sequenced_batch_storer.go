@@ -70,9 +70,9 @@ func (_c *sequencedBatchStorer_AddSequencedBatches_Call) RunAndReturn(run func(c
 	return _c
 }
 
-// GetSequenceByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *sequencedBatchStorer) GetSequenceByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) (*entities.SequencedBatches, error) {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetSequenceByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *sequencedBatchStorer) GetSequenceByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.SequencedBatches, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetSequenceByBatchNumber")
@@ -80,19 +80,19 @@ func (_m *sequencedBatchStorer) GetSequenceByBatchNumber(ctx context.Context, ba
 
 	var r0 *entities.SequencedBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.SequencedBatches, error)); ok {
-		return rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.SequencedBatches, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.SequencedBatches); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.SequencedBatches); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.SequencedBatches)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -107,15 +107,16 @@ type sequencedBatchStorer_GetSequenceByBatchNumber_Call struct {
 
 // GetSequenceByBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *sequencedBatchStorer_Expecter) GetSequenceByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *sequencedBatchStorer_GetSequenceByBatchNumber_Call {
-	return &sequencedBatchStorer_GetSequenceByBatchNumber_Call{Call: _e.mock.On("GetSequenceByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *sequencedBatchStorer_Expecter) GetSequenceByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *sequencedBatchStorer_GetSequenceByBatchNumber_Call {
+	return &sequencedBatchStorer_GetSequenceByBatchNumber_Call{Call: _e.mock.On("GetSequenceByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *sequencedBatchStorer_GetSequenceByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *sequencedBatchStorer_GetSequenceByBatchNumber_Call {
+func (_c *sequencedBatchStorer_GetSequenceByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *sequencedBatchStorer_GetSequenceByBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
@@ -125,7 +126,7 @@ func (_c *sequencedBatchStorer_GetSequenceByBatchNumber_Call) Return(_a0 *entiti
 	return _c
 }
 
-func (_c *sequencedBatchStorer_GetSequenceByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.SequencedBatches, error)) *sequencedBatchStorer_GetSequenceByBatchNumber_Call {
+func (_c *sequencedBatchStorer_GetSequenceByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.SequencedBatches, error)) *sequencedBatchStorer_GetSequenceByBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
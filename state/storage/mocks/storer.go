@@ -10,6 +10,8 @@ import (
 	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Storer is an autogenerated mock type for the Storer type
@@ -25,6 +27,54 @@ func (_m *Storer) EXPECT() *Storer_Expecter {
 	return &Storer_Expecter{mock: &_m.Mock}
 }
 
+// AddAuditEvent provides a mock function with given fields: ctx, auditEvent, dbTx
+func (_m *Storer) AddAuditEvent(ctx context.Context, auditEvent *entities.AuditEvent, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, auditEvent, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAuditEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.AuditEvent, entities.Tx) error); ok {
+		r0 = rf(ctx, auditEvent, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAuditEvent'
+type Storer_AddAuditEvent_Call struct {
+	*mock.Call
+}
+
+// AddAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - auditEvent *entities.AuditEvent
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddAuditEvent(ctx interface{}, auditEvent interface{}, dbTx interface{}) *Storer_AddAuditEvent_Call {
+	return &Storer_AddAuditEvent_Call{Call: _e.mock.On("AddAuditEvent", ctx, auditEvent, dbTx)}
+}
+
+func (_c *Storer_AddAuditEvent_Call) Run(run func(ctx context.Context, auditEvent *entities.AuditEvent, dbTx entities.Tx)) *Storer_AddAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.AuditEvent), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddAuditEvent_Call) Return(_a0 error) *Storer_AddAuditEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddAuditEvent_Call) RunAndReturn(run func(context.Context, *entities.AuditEvent, entities.Tx) error) *Storer_AddAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddBlock provides a mock function with given fields: ctx, block, dbTx
 func (_m *Storer) AddBlock(ctx context.Context, block *entities.L1Block, dbTx entities.Tx) error {
 	ret := _m.Called(ctx, block, dbTx)
@@ -73,6 +123,54 @@ func (_c *Storer_AddBlock_Call) RunAndReturn(run func(context.Context, *entities
 	return _c
 }
 
+// AddForcedBatch provides a mock function with given fields: ctx, forcedBatch, dbTx
+func (_m *Storer) AddForcedBatch(ctx context.Context, forcedBatch *entities.ForcedBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, forcedBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddForcedBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ForcedBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, forcedBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddForcedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddForcedBatch'
+type Storer_AddForcedBatch_Call struct {
+	*mock.Call
+}
+
+// AddForcedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - forcedBatch *entities.ForcedBatch
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddForcedBatch(ctx interface{}, forcedBatch interface{}, dbTx interface{}) *Storer_AddForcedBatch_Call {
+	return &Storer_AddForcedBatch_Call{Call: _e.mock.On("AddForcedBatch", ctx, forcedBatch, dbTx)}
+}
+
+func (_c *Storer_AddForcedBatch_Call) Run(run func(ctx context.Context, forcedBatch *entities.ForcedBatch, dbTx entities.Tx)) *Storer_AddForcedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ForcedBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddForcedBatch_Call) Return(_a0 error) *Storer_AddForcedBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddForcedBatch_Call) RunAndReturn(run func(context.Context, *entities.ForcedBatch, entities.Tx) error) *Storer_AddForcedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddForkID provides a mock function with given fields: ctx, forkID, dbTx
 func (_m *Storer) AddForkID(ctx context.Context, forkID entities.ForkIDInterval, dbTx entities.Tx) error {
 	ret := _m.Called(ctx, forkID, dbTx)
@@ -121,6 +219,102 @@ func (_c *Storer_AddForkID_Call) RunAndReturn(run func(context.Context, entities
 	return _c
 }
 
+// AddGlobalExitRoot provides a mock function with given fields: ctx, globalExitRoot, dbTx
+func (_m *Storer) AddGlobalExitRoot(ctx context.Context, globalExitRoot *entities.GlobalExitRoot, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, globalExitRoot, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGlobalExitRoot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.GlobalExitRoot, entities.Tx) error); ok {
+		r0 = rf(ctx, globalExitRoot, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddGlobalExitRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGlobalExitRoot'
+type Storer_AddGlobalExitRoot_Call struct {
+	*mock.Call
+}
+
+// AddGlobalExitRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - globalExitRoot *entities.GlobalExitRoot
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddGlobalExitRoot(ctx interface{}, globalExitRoot interface{}, dbTx interface{}) *Storer_AddGlobalExitRoot_Call {
+	return &Storer_AddGlobalExitRoot_Call{Call: _e.mock.On("AddGlobalExitRoot", ctx, globalExitRoot, dbTx)}
+}
+
+func (_c *Storer_AddGlobalExitRoot_Call) Run(run func(ctx context.Context, globalExitRoot *entities.GlobalExitRoot, dbTx entities.Tx)) *Storer_AddGlobalExitRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.GlobalExitRoot), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddGlobalExitRoot_Call) Return(_a0 error) *Storer_AddGlobalExitRoot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddGlobalExitRoot_Call) RunAndReturn(run func(context.Context, *entities.GlobalExitRoot, entities.Tx) error) *Storer_AddGlobalExitRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddGovernanceEvent provides a mock function with given fields: ctx, governanceEvent, dbTx
+func (_m *Storer) AddGovernanceEvent(ctx context.Context, governanceEvent *entities.GovernanceEvent, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, governanceEvent, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGovernanceEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.GovernanceEvent, entities.Tx) error); ok {
+		r0 = rf(ctx, governanceEvent, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddGovernanceEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGovernanceEvent'
+type Storer_AddGovernanceEvent_Call struct {
+	*mock.Call
+}
+
+// AddGovernanceEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - governanceEvent *entities.GovernanceEvent
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddGovernanceEvent(ctx interface{}, governanceEvent interface{}, dbTx interface{}) *Storer_AddGovernanceEvent_Call {
+	return &Storer_AddGovernanceEvent_Call{Call: _e.mock.On("AddGovernanceEvent", ctx, governanceEvent, dbTx)}
+}
+
+func (_c *Storer_AddGovernanceEvent_Call) Run(run func(ctx context.Context, governanceEvent *entities.GovernanceEvent, dbTx entities.Tx)) *Storer_AddGovernanceEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.GovernanceEvent), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddGovernanceEvent_Call) Return(_a0 error) *Storer_AddGovernanceEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddGovernanceEvent_Call) RunAndReturn(run func(context.Context, *entities.GovernanceEvent, entities.Tx) error) *Storer_AddGovernanceEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddL1InfoTreeLeaf provides a mock function with given fields: ctx, exitRoot, dbTx
 func (_m *Storer) AddL1InfoTreeLeaf(ctx context.Context, exitRoot *entities.L1InfoTreeLeaf, dbTx entities.Tx) error {
 	ret := _m.Called(ctx, exitRoot, dbTx)
@@ -169,125 +363,1694 @@ func (_c *Storer_AddL1InfoTreeLeaf_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
-// AddSequencedBatches provides a mock function with given fields: ctx, sequence, dbTx
-func (_m *Storer) AddSequencedBatches(ctx context.Context, sequence *entities.SequencedBatches, dbTx entities.Tx) error {
-	ret := _m.Called(ctx, sequence, dbTx)
+// AddPendingState provides a mock function with given fields: ctx, pendingState, dbTx
+func (_m *Storer) AddPendingState(ctx context.Context, pendingState *entities.PendingState, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, pendingState, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPendingState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.PendingState, entities.Tx) error); ok {
+		r0 = rf(ctx, pendingState, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddPendingState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPendingState'
+type Storer_AddPendingState_Call struct {
+	*mock.Call
+}
+
+// AddPendingState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pendingState *entities.PendingState
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddPendingState(ctx interface{}, pendingState interface{}, dbTx interface{}) *Storer_AddPendingState_Call {
+	return &Storer_AddPendingState_Call{Call: _e.mock.On("AddPendingState", ctx, pendingState, dbTx)}
+}
+
+func (_c *Storer_AddPendingState_Call) Run(run func(ctx context.Context, pendingState *entities.PendingState, dbTx entities.Tx)) *Storer_AddPendingState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.PendingState), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddPendingState_Call) Return(_a0 error) *Storer_AddPendingState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddPendingState_Call) RunAndReturn(run func(context.Context, *entities.PendingState, entities.Tx) error) *Storer_AddPendingState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddReorgLog provides a mock function with given fields: ctx, entry, dbTx
+func (_m *Storer) AddReorgLog(ctx context.Context, entry *entities.ReorgLogEntry, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, entry, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddReorgLog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ReorgLogEntry, entities.Tx) error); ok {
+		r0 = rf(ctx, entry, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddReorgLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddReorgLog'
+type Storer_AddReorgLog_Call struct {
+	*mock.Call
+}
+
+// AddReorgLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - entry *entities.ReorgLogEntry
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddReorgLog(ctx interface{}, entry interface{}, dbTx interface{}) *Storer_AddReorgLog_Call {
+	return &Storer_AddReorgLog_Call{Call: _e.mock.On("AddReorgLog", ctx, entry, dbTx)}
+}
+
+func (_c *Storer_AddReorgLog_Call) Run(run func(ctx context.Context, entry *entities.ReorgLogEntry, dbTx entities.Tx)) *Storer_AddReorgLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ReorgLogEntry), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddReorgLog_Call) Return(_a0 error) *Storer_AddReorgLog_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddReorgLog_Call) RunAndReturn(run func(context.Context, *entities.ReorgLogEntry, entities.Tx) error) *Storer_AddReorgLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRollup provides a mock function with given fields: ctx, rollup, dbTx
+func (_m *Storer) AddRollup(ctx context.Context, rollup *entities.Rollup, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollup, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Rollup, entities.Tx) error); ok {
+		r0 = rf(ctx, rollup, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddRollup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollup'
+type Storer_AddRollup_Call struct {
+	*mock.Call
+}
+
+// AddRollup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollup *entities.Rollup
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddRollup(ctx interface{}, rollup interface{}, dbTx interface{}) *Storer_AddRollup_Call {
+	return &Storer_AddRollup_Call{Call: _e.mock.On("AddRollup", ctx, rollup, dbTx)}
+}
+
+func (_c *Storer_AddRollup_Call) Run(run func(ctx context.Context, rollup *entities.Rollup, dbTx entities.Tx)) *Storer_AddRollup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.Rollup), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddRollup_Call) Return(_a0 error) *Storer_AddRollup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddRollup_Call) RunAndReturn(run func(context.Context, *entities.Rollup, entities.Tx) error) *Storer_AddRollup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRollupType provides a mock function with given fields: ctx, rollupType, dbTx
+func (_m *Storer) AddRollupType(ctx context.Context, rollupType *entities.RollupType, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupType, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollupType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RollupType, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupType, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddRollupType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollupType'
+type Storer_AddRollupType_Call struct {
+	*mock.Call
+}
+
+// AddRollupType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupType *entities.RollupType
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddRollupType(ctx interface{}, rollupType interface{}, dbTx interface{}) *Storer_AddRollupType_Call {
+	return &Storer_AddRollupType_Call{Call: _e.mock.On("AddRollupType", ctx, rollupType, dbTx)}
+}
+
+func (_c *Storer_AddRollupType_Call) Run(run func(ctx context.Context, rollupType *entities.RollupType, dbTx entities.Tx)) *Storer_AddRollupType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.RollupType), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddRollupType_Call) Return(_a0 error) *Storer_AddRollupType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddRollupType_Call) RunAndReturn(run func(context.Context, *entities.RollupType, entities.Tx) error) *Storer_AddRollupType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRollupUpdate provides a mock function with given fields: ctx, rollupUpdate, dbTx
+func (_m *Storer) AddRollupUpdate(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupUpdate, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollupUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RollupUpdate, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupUpdate, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddRollupUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollupUpdate'
+type Storer_AddRollupUpdate_Call struct {
+	*mock.Call
+}
+
+// AddRollupUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupUpdate *entities.RollupUpdate
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddRollupUpdate(ctx interface{}, rollupUpdate interface{}, dbTx interface{}) *Storer_AddRollupUpdate_Call {
+	return &Storer_AddRollupUpdate_Call{Call: _e.mock.On("AddRollupUpdate", ctx, rollupUpdate, dbTx)}
+}
+
+func (_c *Storer_AddRollupUpdate_Call) Run(run func(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx entities.Tx)) *Storer_AddRollupUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.RollupUpdate), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddRollupUpdate_Call) Return(_a0 error) *Storer_AddRollupUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddRollupUpdate_Call) RunAndReturn(run func(context.Context, *entities.RollupUpdate, entities.Tx) error) *Storer_AddRollupUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddSequencedBatches provides a mock function with given fields: ctx, sequence, dbTx
+func (_m *Storer) AddSequencedBatches(ctx context.Context, sequence *entities.SequencedBatches, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, sequence, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSequencedBatches")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.SequencedBatches, entities.Tx) error); ok {
+		r0 = rf(ctx, sequence, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddSequencedBatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSequencedBatches'
+type Storer_AddSequencedBatches_Call struct {
+	*mock.Call
+}
+
+// AddSequencedBatches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sequence *entities.SequencedBatches
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddSequencedBatches(ctx interface{}, sequence interface{}, dbTx interface{}) *Storer_AddSequencedBatches_Call {
+	return &Storer_AddSequencedBatches_Call{Call: _e.mock.On("AddSequencedBatches", ctx, sequence, dbTx)}
+}
+
+func (_c *Storer_AddSequencedBatches_Call) Run(run func(ctx context.Context, sequence *entities.SequencedBatches, dbTx entities.Tx)) *Storer_AddSequencedBatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.SequencedBatches), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddSequencedBatches_Call) Return(_a0 error) *Storer_AddSequencedBatches_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddSequencedBatches_Call) RunAndReturn(run func(context.Context, *entities.SequencedBatches, entities.Tx) error) *Storer_AddSequencedBatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddSequencedForceBatch provides a mock function with given fields: ctx, sequencedForceBatch, dbTx
+func (_m *Storer) AddSequencedForceBatch(ctx context.Context, sequencedForceBatch *entities.SequencedForceBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, sequencedForceBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSequencedForceBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.SequencedForceBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, sequencedForceBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddSequencedForceBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSequencedForceBatch'
+type Storer_AddSequencedForceBatch_Call struct {
+	*mock.Call
+}
+
+// AddSequencedForceBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sequencedForceBatch *entities.SequencedForceBatch
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddSequencedForceBatch(ctx interface{}, sequencedForceBatch interface{}, dbTx interface{}) *Storer_AddSequencedForceBatch_Call {
+	return &Storer_AddSequencedForceBatch_Call{Call: _e.mock.On("AddSequencedForceBatch", ctx, sequencedForceBatch, dbTx)}
+}
+
+func (_c *Storer_AddSequencedForceBatch_Call) Run(run func(ctx context.Context, sequencedForceBatch *entities.SequencedForceBatch, dbTx entities.Tx)) *Storer_AddSequencedForceBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.SequencedForceBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddSequencedForceBatch_Call) Return(_a0 error) *Storer_AddSequencedForceBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddSequencedForceBatch_Call) RunAndReturn(run func(context.Context, *entities.SequencedForceBatch, entities.Tx) error) *Storer_AddSequencedForceBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddVerifiedBatch provides a mock function with given fields: ctx, verifiedBatch, dbTx
+func (_m *Storer) AddVerifiedBatch(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, verifiedBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddVerifiedBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.VerifiedBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, verifiedBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddVerifiedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVerifiedBatch'
+type Storer_AddVerifiedBatch_Call struct {
+	*mock.Call
+}
+
+// AddVerifiedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - verifiedBatch *entities.VerifiedBatch
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddVerifiedBatch(ctx interface{}, verifiedBatch interface{}, dbTx interface{}) *Storer_AddVerifiedBatch_Call {
+	return &Storer_AddVerifiedBatch_Call{Call: _e.mock.On("AddVerifiedBatch", ctx, verifiedBatch, dbTx)}
+}
+
+func (_c *Storer_AddVerifiedBatch_Call) Run(run func(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx)) *Storer_AddVerifiedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.VerifiedBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddVerifiedBatch_Call) Return(_a0 error) *Storer_AddVerifiedBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddVerifiedBatch_Call) RunAndReturn(run func(context.Context, *entities.VerifiedBatch, entities.Tx) error) *Storer_AddVerifiedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddVirtualBatch provides a mock function with given fields: ctx, virtualBatch, dbTx
+func (_m *Storer) AddVirtualBatch(ctx context.Context, virtualBatch *entities.VirtualBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, virtualBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddVirtualBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.VirtualBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, virtualBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_AddVirtualBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVirtualBatch'
+type Storer_AddVirtualBatch_Call struct {
+	*mock.Call
+}
+
+// AddVirtualBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - virtualBatch *entities.VirtualBatch
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) AddVirtualBatch(ctx interface{}, virtualBatch interface{}, dbTx interface{}) *Storer_AddVirtualBatch_Call {
+	return &Storer_AddVirtualBatch_Call{Call: _e.mock.On("AddVirtualBatch", ctx, virtualBatch, dbTx)}
+}
+
+func (_c *Storer_AddVirtualBatch_Call) Run(run func(ctx context.Context, virtualBatch *entities.VirtualBatch, dbTx entities.Tx)) *Storer_AddVirtualBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.VirtualBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_AddVirtualBatch_Call) Return(_a0 error) *Storer_AddVirtualBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_AddVirtualBatch_Call) RunAndReturn(run func(context.Context, *entities.VirtualBatch, entities.Tx) error) *Storer_AddVirtualBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginTransaction provides a mock function with given fields: ctx
+func (_m *Storer) BeginTransaction(ctx context.Context) (entities.Tx, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginTransaction")
+	}
+
+	var r0 entities.Tx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entities.Tx, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entities.Tx); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entities.Tx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_BeginTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTransaction'
+type Storer_BeginTransaction_Call struct {
+	*mock.Call
+}
+
+// BeginTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Storer_Expecter) BeginTransaction(ctx interface{}) *Storer_BeginTransaction_Call {
+	return &Storer_BeginTransaction_Call{Call: _e.mock.On("BeginTransaction", ctx)}
+}
+
+func (_c *Storer_BeginTransaction_Call) Run(run func(ctx context.Context)) *Storer_BeginTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Storer_BeginTransaction_Call) Return(_a0 entities.Tx, _a1 error) *Storer_BeginTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_BeginTransaction_Call) RunAndReturn(run func(context.Context) (entities.Tx, error)) *Storer_BeginTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsolidatePendingStates provides a mock function with given fields: ctx, rollupID, batchNumber, blockNumber, dbTx
+func (_m *Storer) ConsolidatePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupID, batchNumber, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsolidatePendingStates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupID, batchNumber, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_ConsolidatePendingStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsolidatePendingStates'
+type Storer_ConsolidatePendingStates_Call struct {
+	*mock.Call
+}
+
+// ConsolidatePendingStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) ConsolidatePendingStates(ctx interface{}, rollupID interface{}, batchNumber interface{}, blockNumber interface{}, dbTx interface{}) *Storer_ConsolidatePendingStates_Call {
+	return &Storer_ConsolidatePendingStates_Call{Call: _e.mock.On("ConsolidatePendingStates", ctx, rollupID, batchNumber, blockNumber, dbTx)}
+}
+
+func (_c *Storer_ConsolidatePendingStates_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx entities.Tx)) *Storer_ConsolidatePendingStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(uint64), args[4].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_ConsolidatePendingStates_Call) Return(_a0 error) *Storer_ConsolidatePendingStates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_ConsolidatePendingStates_Call) RunAndReturn(run func(context.Context, uint64, uint64, uint64, entities.Tx) error) *Storer_ConsolidatePendingStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllL1InfoTreeLeaves provides a mock function with given fields: ctx, dbTx
+func (_m *Storer) GetAllL1InfoTreeLeaves(ctx context.Context, dbTx entities.Tx) ([]entities.L1InfoTreeLeaf, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllL1InfoTreeLeaves")
+	}
+
+	var r0 []entities.L1InfoTreeLeaf
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.L1InfoTreeLeaf, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.L1InfoTreeLeaf); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.L1InfoTreeLeaf)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetAllL1InfoTreeLeaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllL1InfoTreeLeaves'
+type Storer_GetAllL1InfoTreeLeaves_Call struct {
+	*mock.Call
+}
+
+// GetAllL1InfoTreeLeaves is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetAllL1InfoTreeLeaves(ctx interface{}, dbTx interface{}) *Storer_GetAllL1InfoTreeLeaves_Call {
+	return &Storer_GetAllL1InfoTreeLeaves_Call{Call: _e.mock.On("GetAllL1InfoTreeLeaves", ctx, dbTx)}
+}
+
+func (_c *Storer_GetAllL1InfoTreeLeaves_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetAllL1InfoTreeLeaves_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetAllL1InfoTreeLeaves_Call) Return(_a0 []entities.L1InfoTreeLeaf, _a1 error) *Storer_GetAllL1InfoTreeLeaves_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetAllL1InfoTreeLeaves_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.L1InfoTreeLeaf, error)) *Storer_GetAllL1InfoTreeLeaves_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAuditEvents provides a mock function with given fields: ctx, contract, fromBlockNumber, dbTx
+func (_m *Storer) GetAuditEvents(ctx context.Context, contract *common.Address, fromBlockNumber uint64, dbTx entities.Tx) ([]entities.AuditEvent, error) {
+	ret := _m.Called(ctx, contract, fromBlockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAuditEvents")
+	}
+
+	var r0 []entities.AuditEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *common.Address, uint64, entities.Tx) ([]entities.AuditEvent, error)); ok {
+		return rf(ctx, contract, fromBlockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *common.Address, uint64, entities.Tx) []entities.AuditEvent); ok {
+		r0 = rf(ctx, contract, fromBlockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *common.Address, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, contract, fromBlockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAuditEvents'
+type Storer_GetAuditEvents_Call struct {
+	*mock.Call
+}
+
+// GetAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - contract *common.Address
+//   - fromBlockNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetAuditEvents(ctx interface{}, contract interface{}, fromBlockNumber interface{}, dbTx interface{}) *Storer_GetAuditEvents_Call {
+	return &Storer_GetAuditEvents_Call{Call: _e.mock.On("GetAuditEvents", ctx, contract, fromBlockNumber, dbTx)}
+}
+
+func (_c *Storer_GetAuditEvents_Call) Run(run func(ctx context.Context, contract *common.Address, fromBlockNumber uint64, dbTx entities.Tx)) *Storer_GetAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*common.Address), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetAuditEvents_Call) Return(_a0 []entities.AuditEvent, _a1 error) *Storer_GetAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetAuditEvents_Call) RunAndReturn(run func(context.Context, *common.Address, uint64, entities.Tx) ([]entities.AuditEvent, error)) *Storer_GetAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockByNumber provides a mock function with given fields: ctx, blockNumber, dbTx
+func (_m *Storer) GetBlockByNumber(ctx context.Context, blockNumber uint64, dbTx entities.Tx) (*entities.L1Block, error) {
+	ret := _m.Called(ctx, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockByNumber")
+	}
+
+	var r0 *entities.L1Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
+		return rf(ctx, blockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
+		r0 = rf(ctx, blockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.L1Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, blockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetBlockByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockByNumber'
+type Storer_GetBlockByNumber_Call struct {
+	*mock.Call
+}
+
+// GetBlockByNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetBlockByNumber(ctx interface{}, blockNumber interface{}, dbTx interface{}) *Storer_GetBlockByNumber_Call {
+	return &Storer_GetBlockByNumber_Call{Call: _e.mock.On("GetBlockByNumber", ctx, blockNumber, dbTx)}
+}
+
+func (_c *Storer_GetBlockByNumber_Call) Run(run func(ctx context.Context, blockNumber uint64, dbTx entities.Tx)) *Storer_GetBlockByNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetBlockByNumber_Call) Return(_a0 *entities.L1Block, _a1 error) *Storer_GetBlockByNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetBlockByNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *Storer_GetBlockByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConsolidatedBatchNumber provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *Storer) GetConsolidatedBatchNumber(ctx context.Context, rollupID uint64, dbTx entities.Tx) (uint64, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsolidatedBatchNumber")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (uint64, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) uint64); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetConsolidatedBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConsolidatedBatchNumber'
+type Storer_GetConsolidatedBatchNumber_Call struct {
+	*mock.Call
+}
+
+// GetConsolidatedBatchNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetConsolidatedBatchNumber(ctx interface{}, rollupID interface{}, dbTx interface{}) *Storer_GetConsolidatedBatchNumber_Call {
+	return &Storer_GetConsolidatedBatchNumber_Call{Call: _e.mock.On("GetConsolidatedBatchNumber", ctx, rollupID, dbTx)}
+}
+
+func (_c *Storer_GetConsolidatedBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *Storer_GetConsolidatedBatchNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetConsolidatedBatchNumber_Call) Return(_a0 uint64, _a1 error) *Storer_GetConsolidatedBatchNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetConsolidatedBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (uint64, error)) *Storer_GetConsolidatedBatchNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFirstUncheckedBlock provides a mock function with given fields: ctx, fromBlockNumber, dbTx
+func (_m *Storer) GetFirstUncheckedBlock(ctx context.Context, fromBlockNumber uint64, dbTx entities.Tx) (*entities.L1Block, error) {
+	ret := _m.Called(ctx, fromBlockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFirstUncheckedBlock")
+	}
+
+	var r0 *entities.L1Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
+		return rf(ctx, fromBlockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
+		r0 = rf(ctx, fromBlockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.L1Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, fromBlockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetFirstUncheckedBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFirstUncheckedBlock'
+type Storer_GetFirstUncheckedBlock_Call struct {
+	*mock.Call
+}
+
+// GetFirstUncheckedBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromBlockNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetFirstUncheckedBlock(ctx interface{}, fromBlockNumber interface{}, dbTx interface{}) *Storer_GetFirstUncheckedBlock_Call {
+	return &Storer_GetFirstUncheckedBlock_Call{Call: _e.mock.On("GetFirstUncheckedBlock", ctx, fromBlockNumber, dbTx)}
+}
+
+func (_c *Storer_GetFirstUncheckedBlock_Call) Run(run func(ctx context.Context, fromBlockNumber uint64, dbTx entities.Tx)) *Storer_GetFirstUncheckedBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetFirstUncheckedBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *Storer_GetFirstUncheckedBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetFirstUncheckedBlock_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *Storer_GetFirstUncheckedBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForcedBatch provides a mock function with given fields: ctx, rollupID, forcedBatchNumber, dbTx
+func (_m *Storer) GetForcedBatch(ctx context.Context, rollupID uint64, forcedBatchNumber uint64, dbTx entities.Tx) (*entities.ForcedBatch, error) {
+	ret := _m.Called(ctx, rollupID, forcedBatchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForcedBatch")
+	}
+
+	var r0 *entities.ForcedBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.ForcedBatch, error)); ok {
+		return rf(ctx, rollupID, forcedBatchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.ForcedBatch); ok {
+		r0 = rf(ctx, rollupID, forcedBatchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ForcedBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, forcedBatchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetForcedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForcedBatch'
+type Storer_GetForcedBatch_Call struct {
+	*mock.Call
+}
+
+// GetForcedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - forcedBatchNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetForcedBatch(ctx interface{}, rollupID interface{}, forcedBatchNumber interface{}, dbTx interface{}) *Storer_GetForcedBatch_Call {
+	return &Storer_GetForcedBatch_Call{Call: _e.mock.On("GetForcedBatch", ctx, rollupID, forcedBatchNumber, dbTx)}
+}
+
+func (_c *Storer_GetForcedBatch_Call) Run(run func(ctx context.Context, rollupID uint64, forcedBatchNumber uint64, dbTx entities.Tx)) *Storer_GetForcedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetForcedBatch_Call) Return(_a0 *entities.ForcedBatch, _a1 error) *Storer_GetForcedBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetForcedBatch_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.ForcedBatch, error)) *Storer_GetForcedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForkIDByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
+func (_m *Storer) GetForkIDByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) uint64 {
+	ret := _m.Called(ctx, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForkIDByBatchNumber")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) uint64); ok {
+		r0 = rf(ctx, batchNumber, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// Storer_GetForkIDByBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForkIDByBatchNumber'
+type Storer_GetForkIDByBatchNumber_Call struct {
+	*mock.Call
+}
+
+// GetForkIDByBatchNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - batchNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetForkIDByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *Storer_GetForkIDByBatchNumber_Call {
+	return &Storer_GetForkIDByBatchNumber_Call{Call: _e.mock.On("GetForkIDByBatchNumber", ctx, batchNumber, dbTx)}
+}
+
+func (_c *Storer_GetForkIDByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *Storer_GetForkIDByBatchNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetForkIDByBatchNumber_Call) Return(_a0 uint64) *Storer_GetForkIDByBatchNumber_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_GetForkIDByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) uint64) *Storer_GetForkIDByBatchNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForkIDs provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *Storer) GetForkIDs(ctx context.Context, rollupID uint64, dbTx entities.Tx) ([]entities.ForkIDInterval, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForkIDs")
+	}
+
+	var r0 []entities.ForkIDInterval
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) ([]entities.ForkIDInterval, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) []entities.ForkIDInterval); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ForkIDInterval)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetForkIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForkIDs'
+type Storer_GetForkIDs_Call struct {
+	*mock.Call
+}
+
+// GetForkIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetForkIDs(ctx interface{}, rollupID interface{}, dbTx interface{}) *Storer_GetForkIDs_Call {
+	return &Storer_GetForkIDs_Call{Call: _e.mock.On("GetForkIDs", ctx, rollupID, dbTx)}
+}
+
+func (_c *Storer_GetForkIDs_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *Storer_GetForkIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetForkIDs_Call) Return(_a0 []entities.ForkIDInterval, _a1 error) *Storer_GetForkIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetForkIDs_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) ([]entities.ForkIDInterval, error)) *Storer_GetForkIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGlobalExitRoot provides a mock function with given fields: ctx, globalExitRoot, dbTx
+func (_m *Storer) GetGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash, dbTx entities.Tx) (*entities.GlobalExitRoot, error) {
+	ret := _m.Called(ctx, globalExitRoot, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGlobalExitRoot")
+	}
+
+	var r0 *entities.GlobalExitRoot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) (*entities.GlobalExitRoot, error)); ok {
+		return rf(ctx, globalExitRoot, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) *entities.GlobalExitRoot); ok {
+		r0 = rf(ctx, globalExitRoot, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GlobalExitRoot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, entities.Tx) error); ok {
+		r1 = rf(ctx, globalExitRoot, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetGlobalExitRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGlobalExitRoot'
+type Storer_GetGlobalExitRoot_Call struct {
+	*mock.Call
+}
+
+// GetGlobalExitRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - globalExitRoot common.Hash
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetGlobalExitRoot(ctx interface{}, globalExitRoot interface{}, dbTx interface{}) *Storer_GetGlobalExitRoot_Call {
+	return &Storer_GetGlobalExitRoot_Call{Call: _e.mock.On("GetGlobalExitRoot", ctx, globalExitRoot, dbTx)}
+}
+
+func (_c *Storer_GetGlobalExitRoot_Call) Run(run func(ctx context.Context, globalExitRoot common.Hash, dbTx entities.Tx)) *Storer_GetGlobalExitRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Hash), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetGlobalExitRoot_Call) Return(_a0 *entities.GlobalExitRoot, _a1 error) *Storer_GetGlobalExitRoot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetGlobalExitRoot_Call) RunAndReturn(run func(context.Context, common.Hash, entities.Tx) (*entities.GlobalExitRoot, error)) *Storer_GetGlobalExitRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGovernanceEventAtBlock provides a mock function with given fields: ctx, rollupID, parameter, blockNumber, dbTx
+func (_m *Storer) GetGovernanceEventAtBlock(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx entities.Tx) (*entities.GovernanceEvent, error) {
+	ret := _m.Called(ctx, rollupID, parameter, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGovernanceEventAtBlock")
+	}
+
+	var r0 *entities.GovernanceEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, entities.Tx) (*entities.GovernanceEvent, error)); ok {
+		return rf(ctx, rollupID, parameter, blockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, uint64, entities.Tx) *entities.GovernanceEvent); ok {
+		r0 = rf(ctx, rollupID, parameter, blockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GovernanceEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, parameter, blockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetGovernanceEventAtBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGovernanceEventAtBlock'
+type Storer_GetGovernanceEventAtBlock_Call struct {
+	*mock.Call
+}
+
+// GetGovernanceEventAtBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - parameter string
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetGovernanceEventAtBlock(ctx interface{}, rollupID interface{}, parameter interface{}, blockNumber interface{}, dbTx interface{}) *Storer_GetGovernanceEventAtBlock_Call {
+	return &Storer_GetGovernanceEventAtBlock_Call{Call: _e.mock.On("GetGovernanceEventAtBlock", ctx, rollupID, parameter, blockNumber, dbTx)}
+}
+
+func (_c *Storer_GetGovernanceEventAtBlock_Call) Run(run func(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx entities.Tx)) *Storer_GetGovernanceEventAtBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(uint64), args[4].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetGovernanceEventAtBlock_Call) Return(_a0 *entities.GovernanceEvent, _a1 error) *Storer_GetGovernanceEventAtBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetGovernanceEventAtBlock_Call) RunAndReturn(run func(context.Context, uint64, string, uint64, entities.Tx) (*entities.GovernanceEvent, error)) *Storer_GetGovernanceEventAtBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGovernanceEvents provides a mock function with given fields: ctx, rollupID, parameter, dbTx
+func (_m *Storer) GetGovernanceEvents(ctx context.Context, rollupID uint64, parameter string, dbTx entities.Tx) ([]entities.GovernanceEvent, error) {
+	ret := _m.Called(ctx, rollupID, parameter, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGovernanceEvents")
+	}
+
+	var r0 []entities.GovernanceEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, entities.Tx) ([]entities.GovernanceEvent, error)); ok {
+		return rf(ctx, rollupID, parameter, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, string, entities.Tx) []entities.GovernanceEvent); ok {
+		r0 = rf(ctx, rollupID, parameter, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.GovernanceEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, string, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, parameter, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetGovernanceEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGovernanceEvents'
+type Storer_GetGovernanceEvents_Call struct {
+	*mock.Call
+}
+
+// GetGovernanceEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - parameter string
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetGovernanceEvents(ctx interface{}, rollupID interface{}, parameter interface{}, dbTx interface{}) *Storer_GetGovernanceEvents_Call {
+	return &Storer_GetGovernanceEvents_Call{Call: _e.mock.On("GetGovernanceEvents", ctx, rollupID, parameter, dbTx)}
+}
+
+func (_c *Storer_GetGovernanceEvents_Call) Run(run func(ctx context.Context, rollupID uint64, parameter string, dbTx entities.Tx)) *Storer_GetGovernanceEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(string), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetGovernanceEvents_Call) Return(_a0 []entities.GovernanceEvent, _a1 error) *Storer_GetGovernanceEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetGovernanceEvents_Call) RunAndReturn(run func(context.Context, uint64, string, entities.Tx) ([]entities.GovernanceEvent, error)) *Storer_GetGovernanceEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetL1InfoLeafPerIndex provides a mock function with given fields: ctx, L1InfoTreeIndex, dbTx
+func (_m *Storer) GetL1InfoLeafPerIndex(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx) (*entities.L1InfoTreeLeaf, error) {
+	ret := _m.Called(ctx, L1InfoTreeIndex, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL1InfoLeafPerIndex")
+	}
+
+	var r0 *entities.L1InfoTreeLeaf
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) (*entities.L1InfoTreeLeaf, error)); ok {
+		return rf(ctx, L1InfoTreeIndex, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) *entities.L1InfoTreeLeaf); ok {
+		r0 = rf(ctx, L1InfoTreeIndex, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.L1InfoTreeLeaf)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, entities.Tx) error); ok {
+		r1 = rf(ctx, L1InfoTreeIndex, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetL1InfoLeafPerIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetL1InfoLeafPerIndex'
+type Storer_GetL1InfoLeafPerIndex_Call struct {
+	*mock.Call
+}
+
+// GetL1InfoLeafPerIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - L1InfoTreeIndex uint32
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetL1InfoLeafPerIndex(ctx interface{}, L1InfoTreeIndex interface{}, dbTx interface{}) *Storer_GetL1InfoLeafPerIndex_Call {
+	return &Storer_GetL1InfoLeafPerIndex_Call{Call: _e.mock.On("GetL1InfoLeafPerIndex", ctx, L1InfoTreeIndex, dbTx)}
+}
+
+func (_c *Storer_GetL1InfoLeafPerIndex_Call) Run(run func(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx)) *Storer_GetL1InfoLeafPerIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetL1InfoLeafPerIndex_Call) Return(_a0 *entities.L1InfoTreeLeaf, _a1 error) *Storer_GetL1InfoLeafPerIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetL1InfoLeafPerIndex_Call) RunAndReturn(run func(context.Context, uint32, entities.Tx) (*entities.L1InfoTreeLeaf, error)) *Storer_GetL1InfoLeafPerIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastBlock provides a mock function with given fields: ctx, dbTx
+func (_m *Storer) GetLastBlock(ctx context.Context, dbTx entities.Tx) (*entities.L1Block, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastBlock")
+	}
+
+	var r0 *entities.L1Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (*entities.L1Block, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) *entities.L1Block); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.L1Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetLastBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastBlock'
+type Storer_GetLastBlock_Call struct {
+	*mock.Call
+}
+
+// GetLastBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetLastBlock(ctx interface{}, dbTx interface{}) *Storer_GetLastBlock_Call {
+	return &Storer_GetLastBlock_Call{Call: _e.mock.On("GetLastBlock", ctx, dbTx)}
+}
+
+func (_c *Storer_GetLastBlock_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetLastBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetLastBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *Storer_GetLastBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetLastBlock_Call) RunAndReturn(run func(context.Context, entities.Tx) (*entities.L1Block, error)) *Storer_GetLastBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastSequencedForcedBatchNumber provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *Storer) GetLastSequencedForcedBatchNumber(ctx context.Context, rollupID uint64, dbTx entities.Tx) (uint64, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastSequencedForcedBatchNumber")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (uint64, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) uint64); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetLastSequencedForcedBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastSequencedForcedBatchNumber'
+type Storer_GetLastSequencedForcedBatchNumber_Call struct {
+	*mock.Call
+}
+
+// GetLastSequencedForcedBatchNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetLastSequencedForcedBatchNumber(ctx interface{}, rollupID interface{}, dbTx interface{}) *Storer_GetLastSequencedForcedBatchNumber_Call {
+	return &Storer_GetLastSequencedForcedBatchNumber_Call{Call: _e.mock.On("GetLastSequencedForcedBatchNumber", ctx, rollupID, dbTx)}
+}
+
+func (_c *Storer_GetLastSequencedForcedBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *Storer_GetLastSequencedForcedBatchNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetLastSequencedForcedBatchNumber_Call) Return(_a0 uint64, _a1 error) *Storer_GetLastSequencedForcedBatchNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetLastSequencedForcedBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (uint64, error)) *Storer_GetLastSequencedForcedBatchNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastVerifiedBatch provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *Storer) GetLastVerifiedBatch(ctx context.Context, rollupID uint64, dbTx entities.Tx) (*entities.VerifiedBatch, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastVerifiedBatch")
+	}
+
+	var r0 *entities.VerifiedBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.VerifiedBatch, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.VerifiedBatch); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VerifiedBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetLastVerifiedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastVerifiedBatch'
+type Storer_GetLastVerifiedBatch_Call struct {
+	*mock.Call
+}
+
+// GetLastVerifiedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetLastVerifiedBatch(ctx interface{}, rollupID interface{}, dbTx interface{}) *Storer_GetLastVerifiedBatch_Call {
+	return &Storer_GetLastVerifiedBatch_Call{Call: _e.mock.On("GetLastVerifiedBatch", ctx, rollupID, dbTx)}
+}
+
+func (_c *Storer_GetLastVerifiedBatch_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *Storer_GetLastVerifiedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetLastVerifiedBatch_Call) Return(_a0 *entities.VerifiedBatch, _a1 error) *Storer_GetLastVerifiedBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetLastVerifiedBatch_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.VerifiedBatch, error)) *Storer_GetLastVerifiedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestGlobalExitRoot provides a mock function with given fields: ctx, dbTx
+func (_m *Storer) GetLatestGlobalExitRoot(ctx context.Context, dbTx entities.Tx) (*entities.GlobalExitRoot, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestGlobalExitRoot")
+	}
+
+	var r0 *entities.GlobalExitRoot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (*entities.GlobalExitRoot, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) *entities.GlobalExitRoot); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.GlobalExitRoot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetLatestGlobalExitRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestGlobalExitRoot'
+type Storer_GetLatestGlobalExitRoot_Call struct {
+	*mock.Call
+}
+
+// GetLatestGlobalExitRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetLatestGlobalExitRoot(ctx interface{}, dbTx interface{}) *Storer_GetLatestGlobalExitRoot_Call {
+	return &Storer_GetLatestGlobalExitRoot_Call{Call: _e.mock.On("GetLatestGlobalExitRoot", ctx, dbTx)}
+}
+
+func (_c *Storer_GetLatestGlobalExitRoot_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetLatestGlobalExitRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetLatestGlobalExitRoot_Call) Return(_a0 *entities.GlobalExitRoot, _a1 error) *Storer_GetLatestGlobalExitRoot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetLatestGlobalExitRoot_Call) RunAndReturn(run func(context.Context, entities.Tx) (*entities.GlobalExitRoot, error)) *Storer_GetLatestGlobalExitRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestL1InfoTreeLeaf provides a mock function with given fields: ctx, dbTx
+func (_m *Storer) GetLatestL1InfoTreeLeaf(ctx context.Context, dbTx entities.Tx) (*entities.L1InfoTreeLeaf, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestL1InfoTreeLeaf")
+	}
+
+	var r0 *entities.L1InfoTreeLeaf
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (*entities.L1InfoTreeLeaf, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) *entities.L1InfoTreeLeaf); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.L1InfoTreeLeaf)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Storer_GetLatestL1InfoTreeLeaf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestL1InfoTreeLeaf'
+type Storer_GetLatestL1InfoTreeLeaf_Call struct {
+	*mock.Call
+}
+
+// GetLatestL1InfoTreeLeaf is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetLatestL1InfoTreeLeaf(ctx interface{}, dbTx interface{}) *Storer_GetLatestL1InfoTreeLeaf_Call {
+	return &Storer_GetLatestL1InfoTreeLeaf_Call{Call: _e.mock.On("GetLatestL1InfoTreeLeaf", ctx, dbTx)}
+}
+
+func (_c *Storer_GetLatestL1InfoTreeLeaf_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetLatestL1InfoTreeLeaf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_GetLatestL1InfoTreeLeaf_Call) Return(_a0 *entities.L1InfoTreeLeaf, _a1 error) *Storer_GetLatestL1InfoTreeLeaf_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Storer_GetLatestL1InfoTreeLeaf_Call) RunAndReturn(run func(context.Context, entities.Tx) (*entities.L1InfoTreeLeaf, error)) *Storer_GetLatestL1InfoTreeLeaf_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeafsByL1InfoRoot provides a mock function with given fields: ctx, l1InfoRoot, dbTx
+func (_m *Storer) GetLeafsByL1InfoRoot(ctx context.Context, l1InfoRoot common.Hash, dbTx entities.Tx) ([]entities.L1InfoTreeLeaf, error) {
+	ret := _m.Called(ctx, l1InfoRoot, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddSequencedBatches")
+		panic("no return value specified for GetLeafsByL1InfoRoot")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entities.SequencedBatches, entities.Tx) error); ok {
-		r0 = rf(ctx, sequence, dbTx)
+	var r0 []entities.L1InfoTreeLeaf
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) ([]entities.L1InfoTreeLeaf, error)); ok {
+		return rf(ctx, l1InfoRoot, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) []entities.L1InfoTreeLeaf); ok {
+		r0 = rf(ctx, l1InfoRoot, dbTx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.L1InfoTreeLeaf)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, entities.Tx) error); ok {
+		r1 = rf(ctx, l1InfoRoot, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Storer_AddSequencedBatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSequencedBatches'
-type Storer_AddSequencedBatches_Call struct {
+// Storer_GetLeafsByL1InfoRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeafsByL1InfoRoot'
+type Storer_GetLeafsByL1InfoRoot_Call struct {
 	*mock.Call
 }
 
-// AddSequencedBatches is a helper method to define mock.On call
+// GetLeafsByL1InfoRoot is a helper method to define mock.On call
 //   - ctx context.Context
-//   - sequence *entities.SequencedBatches
+//   - l1InfoRoot common.Hash
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) AddSequencedBatches(ctx interface{}, sequence interface{}, dbTx interface{}) *Storer_AddSequencedBatches_Call {
-	return &Storer_AddSequencedBatches_Call{Call: _e.mock.On("AddSequencedBatches", ctx, sequence, dbTx)}
+func (_e *Storer_Expecter) GetLeafsByL1InfoRoot(ctx interface{}, l1InfoRoot interface{}, dbTx interface{}) *Storer_GetLeafsByL1InfoRoot_Call {
+	return &Storer_GetLeafsByL1InfoRoot_Call{Call: _e.mock.On("GetLeafsByL1InfoRoot", ctx, l1InfoRoot, dbTx)}
 }
 
-func (_c *Storer_AddSequencedBatches_Call) Run(run func(ctx context.Context, sequence *entities.SequencedBatches, dbTx entities.Tx)) *Storer_AddSequencedBatches_Call {
+func (_c *Storer_GetLeafsByL1InfoRoot_Call) Run(run func(ctx context.Context, l1InfoRoot common.Hash, dbTx entities.Tx)) *Storer_GetLeafsByL1InfoRoot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*entities.SequencedBatches), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(common.Hash), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_AddSequencedBatches_Call) Return(_a0 error) *Storer_AddSequencedBatches_Call {
-	_c.Call.Return(_a0)
+func (_c *Storer_GetLeafsByL1InfoRoot_Call) Return(_a0 []entities.L1InfoTreeLeaf, _a1 error) *Storer_GetLeafsByL1InfoRoot_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_AddSequencedBatches_Call) RunAndReturn(run func(context.Context, *entities.SequencedBatches, entities.Tx) error) *Storer_AddSequencedBatches_Call {
+func (_c *Storer_GetLeafsByL1InfoRoot_Call) RunAndReturn(run func(context.Context, common.Hash, entities.Tx) ([]entities.L1InfoTreeLeaf, error)) *Storer_GetLeafsByL1InfoRoot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// AddVirtualBatch provides a mock function with given fields: ctx, virtualBatch, dbTx
-func (_m *Storer) AddVirtualBatch(ctx context.Context, virtualBatch *entities.VirtualBatch, dbTx entities.Tx) error {
-	ret := _m.Called(ctx, virtualBatch, dbTx)
+// GetPendingForcedBatches provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *Storer) GetPendingForcedBatches(ctx context.Context, rollupID uint64, dbTx entities.Tx) ([]entities.ForcedBatch, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddVirtualBatch")
+		panic("no return value specified for GetPendingForcedBatches")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entities.VirtualBatch, entities.Tx) error); ok {
-		r0 = rf(ctx, virtualBatch, dbTx)
+	var r0 []entities.ForcedBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) ([]entities.ForcedBatch, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) []entities.ForcedBatch); ok {
+		r0 = rf(ctx, rollupID, dbTx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ForcedBatch)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Storer_AddVirtualBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVirtualBatch'
-type Storer_AddVirtualBatch_Call struct {
+// Storer_GetPendingForcedBatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingForcedBatches'
+type Storer_GetPendingForcedBatches_Call struct {
 	*mock.Call
 }
 
-// AddVirtualBatch is a helper method to define mock.On call
+// GetPendingForcedBatches is a helper method to define mock.On call
 //   - ctx context.Context
-//   - virtualBatch *entities.VirtualBatch
+//   - rollupID uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) AddVirtualBatch(ctx interface{}, virtualBatch interface{}, dbTx interface{}) *Storer_AddVirtualBatch_Call {
-	return &Storer_AddVirtualBatch_Call{Call: _e.mock.On("AddVirtualBatch", ctx, virtualBatch, dbTx)}
+func (_e *Storer_Expecter) GetPendingForcedBatches(ctx interface{}, rollupID interface{}, dbTx interface{}) *Storer_GetPendingForcedBatches_Call {
+	return &Storer_GetPendingForcedBatches_Call{Call: _e.mock.On("GetPendingForcedBatches", ctx, rollupID, dbTx)}
 }
 
-func (_c *Storer_AddVirtualBatch_Call) Run(run func(ctx context.Context, virtualBatch *entities.VirtualBatch, dbTx entities.Tx)) *Storer_AddVirtualBatch_Call {
+func (_c *Storer_GetPendingForcedBatches_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *Storer_GetPendingForcedBatches_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*entities.VirtualBatch), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_AddVirtualBatch_Call) Return(_a0 error) *Storer_AddVirtualBatch_Call {
-	_c.Call.Return(_a0)
+func (_c *Storer_GetPendingForcedBatches_Call) Return(_a0 []entities.ForcedBatch, _a1 error) *Storer_GetPendingForcedBatches_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_AddVirtualBatch_Call) RunAndReturn(run func(context.Context, *entities.VirtualBatch, entities.Tx) error) *Storer_AddVirtualBatch_Call {
+func (_c *Storer_GetPendingForcedBatches_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) ([]entities.ForcedBatch, error)) *Storer_GetPendingForcedBatches_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// BeginTransaction provides a mock function with given fields: ctx
-func (_m *Storer) BeginTransaction(ctx context.Context) (entities.Tx, error) {
-	ret := _m.Called(ctx)
+// GetPendingState provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *Storer) GetPendingState(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.PendingState, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BeginTransaction")
+		panic("no return value specified for GetPendingState")
 	}
 
-	var r0 entities.Tx
+	var r0 *entities.PendingState
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (entities.Tx, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.PendingState, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) entities.Tx); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.PendingState); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(entities.Tx)
+			r0 = ret.Get(0).(*entities.PendingState)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -295,57 +2058,60 @@ func (_m *Storer) BeginTransaction(ctx context.Context) (entities.Tx, error) {
 	return r0, r1
 }
 
-// Storer_BeginTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTransaction'
-type Storer_BeginTransaction_Call struct {
+// Storer_GetPendingState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingState'
+type Storer_GetPendingState_Call struct {
 	*mock.Call
 }
 
-// BeginTransaction is a helper method to define mock.On call
+// GetPendingState is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *Storer_Expecter) BeginTransaction(ctx interface{}) *Storer_BeginTransaction_Call {
-	return &Storer_BeginTransaction_Call{Call: _e.mock.On("BeginTransaction", ctx)}
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) GetPendingState(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *Storer_GetPendingState_Call {
+	return &Storer_GetPendingState_Call{Call: _e.mock.On("GetPendingState", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *Storer_BeginTransaction_Call) Run(run func(ctx context.Context)) *Storer_BeginTransaction_Call {
+func (_c *Storer_GetPendingState_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *Storer_GetPendingState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_BeginTransaction_Call) Return(_a0 entities.Tx, _a1 error) *Storer_BeginTransaction_Call {
+func (_c *Storer_GetPendingState_Call) Return(_a0 *entities.PendingState, _a1 error) *Storer_GetPendingState_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_BeginTransaction_Call) RunAndReturn(run func(context.Context) (entities.Tx, error)) *Storer_BeginTransaction_Call {
+func (_c *Storer_GetPendingState_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.PendingState, error)) *Storer_GetPendingState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllL1InfoTreeLeaves provides a mock function with given fields: ctx, dbTx
-func (_m *Storer) GetAllL1InfoTreeLeaves(ctx context.Context, dbTx entities.Tx) ([]entities.L1InfoTreeLeaf, error) {
-	ret := _m.Called(ctx, dbTx)
+// GetPendingStates provides a mock function with given fields: ctx, rollupID, fromBatchNumber, dbTx
+func (_m *Storer) GetPendingStates(ctx context.Context, rollupID uint64, fromBatchNumber uint64, dbTx entities.Tx) ([]entities.PendingState, error) {
+	ret := _m.Called(ctx, rollupID, fromBatchNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllL1InfoTreeLeaves")
+		panic("no return value specified for GetPendingStates")
 	}
 
-	var r0 []entities.L1InfoTreeLeaf
+	var r0 []entities.PendingState
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.L1InfoTreeLeaf, error)); ok {
-		return rf(ctx, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) ([]entities.PendingState, error)); ok {
+		return rf(ctx, rollupID, fromBatchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.L1InfoTreeLeaf); ok {
-		r0 = rf(ctx, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) []entities.PendingState); ok {
+		r0 = rf(ctx, rollupID, fromBatchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entities.L1InfoTreeLeaf)
+			r0 = ret.Get(0).([]entities.PendingState)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
-		r1 = rf(ctx, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, fromBatchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -353,50 +2119,52 @@ func (_m *Storer) GetAllL1InfoTreeLeaves(ctx context.Context, dbTx entities.Tx)
 	return r0, r1
 }
 
-// Storer_GetAllL1InfoTreeLeaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllL1InfoTreeLeaves'
-type Storer_GetAllL1InfoTreeLeaves_Call struct {
+// Storer_GetPendingStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingStates'
+type Storer_GetPendingStates_Call struct {
 	*mock.Call
 }
 
-// GetAllL1InfoTreeLeaves is a helper method to define mock.On call
+// GetPendingStates is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
+//   - fromBatchNumber uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetAllL1InfoTreeLeaves(ctx interface{}, dbTx interface{}) *Storer_GetAllL1InfoTreeLeaves_Call {
-	return &Storer_GetAllL1InfoTreeLeaves_Call{Call: _e.mock.On("GetAllL1InfoTreeLeaves", ctx, dbTx)}
+func (_e *Storer_Expecter) GetPendingStates(ctx interface{}, rollupID interface{}, fromBatchNumber interface{}, dbTx interface{}) *Storer_GetPendingStates_Call {
+	return &Storer_GetPendingStates_Call{Call: _e.mock.On("GetPendingStates", ctx, rollupID, fromBatchNumber, dbTx)}
 }
 
-func (_c *Storer_GetAllL1InfoTreeLeaves_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetAllL1InfoTreeLeaves_Call {
+func (_c *Storer_GetPendingStates_Call) Run(run func(ctx context.Context, rollupID uint64, fromBatchNumber uint64, dbTx entities.Tx)) *Storer_GetPendingStates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetAllL1InfoTreeLeaves_Call) Return(_a0 []entities.L1InfoTreeLeaf, _a1 error) *Storer_GetAllL1InfoTreeLeaves_Call {
+func (_c *Storer_GetPendingStates_Call) Return(_a0 []entities.PendingState, _a1 error) *Storer_GetPendingStates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetAllL1InfoTreeLeaves_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.L1InfoTreeLeaf, error)) *Storer_GetAllL1InfoTreeLeaves_Call {
+func (_c *Storer_GetPendingStates_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) ([]entities.PendingState, error)) *Storer_GetPendingStates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetBlockByNumber provides a mock function with given fields: ctx, blockNumber, dbTx
-func (_m *Storer) GetBlockByNumber(ctx context.Context, blockNumber uint64, dbTx entities.Tx) (*entities.L1Block, error) {
-	ret := _m.Called(ctx, blockNumber, dbTx)
+// GetPreviousBlock provides a mock function with given fields: ctx, offset, dbTx
+func (_m *Storer) GetPreviousBlock(ctx context.Context, offset uint64, dbTx entities.Tx) (*entities.L1Block, error) {
+	ret := _m.Called(ctx, offset, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBlockByNumber")
+		panic("no return value specified for GetPreviousBlock")
 	}
 
 	var r0 *entities.L1Block
 	var r1 error
 	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
-		return rf(ctx, blockNumber, dbTx)
+		return rf(ctx, offset, dbTx)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
-		r0 = rf(ctx, blockNumber, dbTx)
+		r0 = rf(ctx, offset, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.L1Block)
@@ -404,7 +2172,7 @@ func (_m *Storer) GetBlockByNumber(ctx context.Context, blockNumber uint64, dbTx
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, blockNumber, dbTx)
+		r1 = rf(ctx, offset, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -412,59 +2180,59 @@ func (_m *Storer) GetBlockByNumber(ctx context.Context, blockNumber uint64, dbTx
 	return r0, r1
 }
 
-// Storer_GetBlockByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockByNumber'
-type Storer_GetBlockByNumber_Call struct {
+// Storer_GetPreviousBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousBlock'
+type Storer_GetPreviousBlock_Call struct {
 	*mock.Call
 }
 
-// GetBlockByNumber is a helper method to define mock.On call
+// GetPreviousBlock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - blockNumber uint64
+//   - offset uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetBlockByNumber(ctx interface{}, blockNumber interface{}, dbTx interface{}) *Storer_GetBlockByNumber_Call {
-	return &Storer_GetBlockByNumber_Call{Call: _e.mock.On("GetBlockByNumber", ctx, blockNumber, dbTx)}
+func (_e *Storer_Expecter) GetPreviousBlock(ctx interface{}, offset interface{}, dbTx interface{}) *Storer_GetPreviousBlock_Call {
+	return &Storer_GetPreviousBlock_Call{Call: _e.mock.On("GetPreviousBlock", ctx, offset, dbTx)}
 }
 
-func (_c *Storer_GetBlockByNumber_Call) Run(run func(ctx context.Context, blockNumber uint64, dbTx entities.Tx)) *Storer_GetBlockByNumber_Call {
+func (_c *Storer_GetPreviousBlock_Call) Run(run func(ctx context.Context, offset uint64, dbTx entities.Tx)) *Storer_GetPreviousBlock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetBlockByNumber_Call) Return(_a0 *entities.L1Block, _a1 error) *Storer_GetBlockByNumber_Call {
+func (_c *Storer_GetPreviousBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *Storer_GetPreviousBlock_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetBlockByNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *Storer_GetBlockByNumber_Call {
+func (_c *Storer_GetPreviousBlock_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *Storer_GetPreviousBlock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFirstUncheckedBlock provides a mock function with given fields: ctx, fromBlockNumber, dbTx
-func (_m *Storer) GetFirstUncheckedBlock(ctx context.Context, fromBlockNumber uint64, dbTx entities.Tx) (*entities.L1Block, error) {
-	ret := _m.Called(ctx, fromBlockNumber, dbTx)
+// GetReorgImpact provides a mock function with given fields: ctx, firstBlockNumberToKeep, dbTx
+func (_m *Storer) GetReorgImpact(ctx context.Context, firstBlockNumberToKeep uint64, dbTx entities.Tx) (*entities.ReorgImpact, error) {
+	ret := _m.Called(ctx, firstBlockNumberToKeep, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFirstUncheckedBlock")
+		panic("no return value specified for GetReorgImpact")
 	}
 
-	var r0 *entities.L1Block
+	var r0 *entities.ReorgImpact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
-		return rf(ctx, fromBlockNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.ReorgImpact, error)); ok {
+		return rf(ctx, firstBlockNumberToKeep, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
-		r0 = rf(ctx, fromBlockNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.ReorgImpact); ok {
+		r0 = rf(ctx, firstBlockNumberToKeep, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.L1Block)
+			r0 = ret.Get(0).(*entities.ReorgImpact)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, fromBlockNumber, dbTx)
+		r1 = rf(ctx, firstBlockNumberToKeep, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -472,107 +2240,120 @@ func (_m *Storer) GetFirstUncheckedBlock(ctx context.Context, fromBlockNumber ui
 	return r0, r1
 }
 
-// Storer_GetFirstUncheckedBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFirstUncheckedBlock'
-type Storer_GetFirstUncheckedBlock_Call struct {
+// Storer_GetReorgImpact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReorgImpact'
+type Storer_GetReorgImpact_Call struct {
 	*mock.Call
 }
 
-// GetFirstUncheckedBlock is a helper method to define mock.On call
+// GetReorgImpact is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fromBlockNumber uint64
+//   - firstBlockNumberToKeep uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetFirstUncheckedBlock(ctx interface{}, fromBlockNumber interface{}, dbTx interface{}) *Storer_GetFirstUncheckedBlock_Call {
-	return &Storer_GetFirstUncheckedBlock_Call{Call: _e.mock.On("GetFirstUncheckedBlock", ctx, fromBlockNumber, dbTx)}
+func (_e *Storer_Expecter) GetReorgImpact(ctx interface{}, firstBlockNumberToKeep interface{}, dbTx interface{}) *Storer_GetReorgImpact_Call {
+	return &Storer_GetReorgImpact_Call{Call: _e.mock.On("GetReorgImpact", ctx, firstBlockNumberToKeep, dbTx)}
 }
 
-func (_c *Storer_GetFirstUncheckedBlock_Call) Run(run func(ctx context.Context, fromBlockNumber uint64, dbTx entities.Tx)) *Storer_GetFirstUncheckedBlock_Call {
+func (_c *Storer_GetReorgImpact_Call) Run(run func(ctx context.Context, firstBlockNumberToKeep uint64, dbTx entities.Tx)) *Storer_GetReorgImpact_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetFirstUncheckedBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *Storer_GetFirstUncheckedBlock_Call {
+func (_c *Storer_GetReorgImpact_Call) Return(_a0 *entities.ReorgImpact, _a1 error) *Storer_GetReorgImpact_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetFirstUncheckedBlock_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *Storer_GetFirstUncheckedBlock_Call {
+func (_c *Storer_GetReorgImpact_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.ReorgImpact, error)) *Storer_GetReorgImpact_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetForkIDByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *Storer) GetForkIDByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) uint64 {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetReorgLogs provides a mock function with given fields: ctx, fromTimestamp, limit, dbTx
+func (_m *Storer) GetReorgLogs(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx entities.Tx) ([]entities.ReorgLogEntry, error) {
+	ret := _m.Called(ctx, fromTimestamp, limit, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetForkIDByBatchNumber")
+		panic("no return value specified for GetReorgLogs")
 	}
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) uint64); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	var r0 []entities.ReorgLogEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64, entities.Tx) ([]entities.ReorgLogEntry, error)); ok {
+		return rf(ctx, fromTimestamp, limit, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64, entities.Tx) []entities.ReorgLogEntry); ok {
+		r0 = rf(ctx, fromTimestamp, limit, dbTx)
 	} else {
-		r0 = ret.Get(0).(uint64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ReorgLogEntry)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, fromTimestamp, limit, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// Storer_GetForkIDByBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForkIDByBatchNumber'
-type Storer_GetForkIDByBatchNumber_Call struct {
+// Storer_GetReorgLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReorgLogs'
+type Storer_GetReorgLogs_Call struct {
 	*mock.Call
 }
 
-// GetForkIDByBatchNumber is a helper method to define mock.On call
+// GetReorgLogs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - batchNumber uint64
+//   - fromTimestamp time.Time
+//   - limit uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetForkIDByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *Storer_GetForkIDByBatchNumber_Call {
-	return &Storer_GetForkIDByBatchNumber_Call{Call: _e.mock.On("GetForkIDByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *Storer_Expecter) GetReorgLogs(ctx interface{}, fromTimestamp interface{}, limit interface{}, dbTx interface{}) *Storer_GetReorgLogs_Call {
+	return &Storer_GetReorgLogs_Call{Call: _e.mock.On("GetReorgLogs", ctx, fromTimestamp, limit, dbTx)}
 }
 
-func (_c *Storer_GetForkIDByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *Storer_GetForkIDByBatchNumber_Call {
+func (_c *Storer_GetReorgLogs_Call) Run(run func(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx entities.Tx)) *Storer_GetReorgLogs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetForkIDByBatchNumber_Call) Return(_a0 uint64) *Storer_GetForkIDByBatchNumber_Call {
-	_c.Call.Return(_a0)
+func (_c *Storer_GetReorgLogs_Call) Return(_a0 []entities.ReorgLogEntry, _a1 error) *Storer_GetReorgLogs_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetForkIDByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) uint64) *Storer_GetForkIDByBatchNumber_Call {
+func (_c *Storer_GetReorgLogs_Call) RunAndReturn(run func(context.Context, time.Time, uint64, entities.Tx) ([]entities.ReorgLogEntry, error)) *Storer_GetReorgLogs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetForkIDs provides a mock function with given fields: ctx, dbTx
-func (_m *Storer) GetForkIDs(ctx context.Context, dbTx entities.Tx) ([]entities.ForkIDInterval, error) {
-	ret := _m.Called(ctx, dbTx)
+// GetRollup provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *Storer) GetRollup(ctx context.Context, rollupID uint64, dbTx entities.Tx) (*entities.Rollup, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetForkIDs")
+		panic("no return value specified for GetRollup")
 	}
 
-	var r0 []entities.ForkIDInterval
+	var r0 *entities.Rollup
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.ForkIDInterval, error)); ok {
-		return rf(ctx, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.Rollup, error)); ok {
+		return rf(ctx, rollupID, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.ForkIDInterval); ok {
-		r0 = rf(ctx, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.Rollup); ok {
+		r0 = rf(ctx, rollupID, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entities.ForkIDInterval)
+			r0 = ret.Get(0).(*entities.Rollup)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
-		r1 = rf(ctx, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -580,58 +2361,59 @@ func (_m *Storer) GetForkIDs(ctx context.Context, dbTx entities.Tx) ([]entities.
 	return r0, r1
 }
 
-// Storer_GetForkIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForkIDs'
-type Storer_GetForkIDs_Call struct {
+// Storer_GetRollup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollup'
+type Storer_GetRollup_Call struct {
 	*mock.Call
 }
 
-// GetForkIDs is a helper method to define mock.On call
+// GetRollup is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetForkIDs(ctx interface{}, dbTx interface{}) *Storer_GetForkIDs_Call {
-	return &Storer_GetForkIDs_Call{Call: _e.mock.On("GetForkIDs", ctx, dbTx)}
+func (_e *Storer_Expecter) GetRollup(ctx interface{}, rollupID interface{}, dbTx interface{}) *Storer_GetRollup_Call {
+	return &Storer_GetRollup_Call{Call: _e.mock.On("GetRollup", ctx, rollupID, dbTx)}
 }
 
-func (_c *Storer_GetForkIDs_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetForkIDs_Call {
+func (_c *Storer_GetRollup_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *Storer_GetRollup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetForkIDs_Call) Return(_a0 []entities.ForkIDInterval, _a1 error) *Storer_GetForkIDs_Call {
+func (_c *Storer_GetRollup_Call) Return(_a0 *entities.Rollup, _a1 error) *Storer_GetRollup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetForkIDs_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.ForkIDInterval, error)) *Storer_GetForkIDs_Call {
+func (_c *Storer_GetRollup_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.Rollup, error)) *Storer_GetRollup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetL1InfoLeafPerIndex provides a mock function with given fields: ctx, L1InfoTreeIndex, dbTx
-func (_m *Storer) GetL1InfoLeafPerIndex(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx) (*entities.L1InfoTreeLeaf, error) {
-	ret := _m.Called(ctx, L1InfoTreeIndex, dbTx)
+// GetRollupType provides a mock function with given fields: ctx, rollupTypeID, dbTx
+func (_m *Storer) GetRollupType(ctx context.Context, rollupTypeID uint64, dbTx entities.Tx) (*entities.RollupType, error) {
+	ret := _m.Called(ctx, rollupTypeID, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetL1InfoLeafPerIndex")
+		panic("no return value specified for GetRollupType")
 	}
 
-	var r0 *entities.L1InfoTreeLeaf
+	var r0 *entities.RollupType
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) (*entities.L1InfoTreeLeaf, error)); ok {
-		return rf(ctx, L1InfoTreeIndex, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.RollupType, error)); ok {
+		return rf(ctx, rollupTypeID, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) *entities.L1InfoTreeLeaf); ok {
-		r0 = rf(ctx, L1InfoTreeIndex, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.RollupType); ok {
+		r0 = rf(ctx, rollupTypeID, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.L1InfoTreeLeaf)
+			r0 = ret.Get(0).(*entities.RollupType)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint32, entities.Tx) error); ok {
-		r1 = rf(ctx, L1InfoTreeIndex, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupTypeID, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -639,54 +2421,54 @@ func (_m *Storer) GetL1InfoLeafPerIndex(ctx context.Context, L1InfoTreeIndex uin
 	return r0, r1
 }
 
-// Storer_GetL1InfoLeafPerIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetL1InfoLeafPerIndex'
-type Storer_GetL1InfoLeafPerIndex_Call struct {
+// Storer_GetRollupType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollupType'
+type Storer_GetRollupType_Call struct {
 	*mock.Call
 }
 
-// GetL1InfoLeafPerIndex is a helper method to define mock.On call
+// GetRollupType is a helper method to define mock.On call
 //   - ctx context.Context
-//   - L1InfoTreeIndex uint32
+//   - rollupTypeID uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetL1InfoLeafPerIndex(ctx interface{}, L1InfoTreeIndex interface{}, dbTx interface{}) *Storer_GetL1InfoLeafPerIndex_Call {
-	return &Storer_GetL1InfoLeafPerIndex_Call{Call: _e.mock.On("GetL1InfoLeafPerIndex", ctx, L1InfoTreeIndex, dbTx)}
+func (_e *Storer_Expecter) GetRollupType(ctx interface{}, rollupTypeID interface{}, dbTx interface{}) *Storer_GetRollupType_Call {
+	return &Storer_GetRollupType_Call{Call: _e.mock.On("GetRollupType", ctx, rollupTypeID, dbTx)}
 }
 
-func (_c *Storer_GetL1InfoLeafPerIndex_Call) Run(run func(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx)) *Storer_GetL1InfoLeafPerIndex_Call {
+func (_c *Storer_GetRollupType_Call) Run(run func(ctx context.Context, rollupTypeID uint64, dbTx entities.Tx)) *Storer_GetRollupType_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint32), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetL1InfoLeafPerIndex_Call) Return(_a0 *entities.L1InfoTreeLeaf, _a1 error) *Storer_GetL1InfoLeafPerIndex_Call {
+func (_c *Storer_GetRollupType_Call) Return(_a0 *entities.RollupType, _a1 error) *Storer_GetRollupType_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetL1InfoLeafPerIndex_Call) RunAndReturn(run func(context.Context, uint32, entities.Tx) (*entities.L1InfoTreeLeaf, error)) *Storer_GetL1InfoLeafPerIndex_Call {
+func (_c *Storer_GetRollupType_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.RollupType, error)) *Storer_GetRollupType_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLastBlock provides a mock function with given fields: ctx, dbTx
-func (_m *Storer) GetLastBlock(ctx context.Context, dbTx entities.Tx) (*entities.L1Block, error) {
+// GetRollupTypes provides a mock function with given fields: ctx, dbTx
+func (_m *Storer) GetRollupTypes(ctx context.Context, dbTx entities.Tx) ([]entities.RollupType, error) {
 	ret := _m.Called(ctx, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLastBlock")
+		panic("no return value specified for GetRollupTypes")
 	}
 
-	var r0 *entities.L1Block
+	var r0 []entities.RollupType
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (*entities.L1Block, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.RollupType, error)); ok {
 		return rf(ctx, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) *entities.L1Block); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.RollupType); ok {
 		r0 = rf(ctx, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.L1Block)
+			r0 = ret.Get(0).([]entities.RollupType)
 		}
 	}
 
@@ -699,53 +2481,53 @@ func (_m *Storer) GetLastBlock(ctx context.Context, dbTx entities.Tx) (*entities
 	return r0, r1
 }
 
-// Storer_GetLastBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastBlock'
-type Storer_GetLastBlock_Call struct {
+// Storer_GetRollupTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollupTypes'
+type Storer_GetRollupTypes_Call struct {
 	*mock.Call
 }
 
-// GetLastBlock is a helper method to define mock.On call
+// GetRollupTypes is a helper method to define mock.On call
 //   - ctx context.Context
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetLastBlock(ctx interface{}, dbTx interface{}) *Storer_GetLastBlock_Call {
-	return &Storer_GetLastBlock_Call{Call: _e.mock.On("GetLastBlock", ctx, dbTx)}
+func (_e *Storer_Expecter) GetRollupTypes(ctx interface{}, dbTx interface{}) *Storer_GetRollupTypes_Call {
+	return &Storer_GetRollupTypes_Call{Call: _e.mock.On("GetRollupTypes", ctx, dbTx)}
 }
 
-func (_c *Storer_GetLastBlock_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetLastBlock_Call {
+func (_c *Storer_GetRollupTypes_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetRollupTypes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetLastBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *Storer_GetLastBlock_Call {
+func (_c *Storer_GetRollupTypes_Call) Return(_a0 []entities.RollupType, _a1 error) *Storer_GetRollupTypes_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetLastBlock_Call) RunAndReturn(run func(context.Context, entities.Tx) (*entities.L1Block, error)) *Storer_GetLastBlock_Call {
+func (_c *Storer_GetRollupTypes_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.RollupType, error)) *Storer_GetRollupTypes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLatestL1InfoTreeLeaf provides a mock function with given fields: ctx, dbTx
-func (_m *Storer) GetLatestL1InfoTreeLeaf(ctx context.Context, dbTx entities.Tx) (*entities.L1InfoTreeLeaf, error) {
+// GetRollups provides a mock function with given fields: ctx, dbTx
+func (_m *Storer) GetRollups(ctx context.Context, dbTx entities.Tx) ([]entities.Rollup, error) {
 	ret := _m.Called(ctx, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLatestL1InfoTreeLeaf")
+		panic("no return value specified for GetRollups")
 	}
 
-	var r0 *entities.L1InfoTreeLeaf
+	var r0 []entities.Rollup
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (*entities.L1InfoTreeLeaf, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.Rollup, error)); ok {
 		return rf(ctx, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) *entities.L1InfoTreeLeaf); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.Rollup); ok {
 		r0 = rf(ctx, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.L1InfoTreeLeaf)
+			r0 = ret.Get(0).([]entities.Rollup)
 		}
 	}
 
@@ -758,58 +2540,58 @@ func (_m *Storer) GetLatestL1InfoTreeLeaf(ctx context.Context, dbTx entities.Tx)
 	return r0, r1
 }
 
-// Storer_GetLatestL1InfoTreeLeaf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestL1InfoTreeLeaf'
-type Storer_GetLatestL1InfoTreeLeaf_Call struct {
+// Storer_GetRollups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollups'
+type Storer_GetRollups_Call struct {
 	*mock.Call
 }
 
-// GetLatestL1InfoTreeLeaf is a helper method to define mock.On call
+// GetRollups is a helper method to define mock.On call
 //   - ctx context.Context
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetLatestL1InfoTreeLeaf(ctx interface{}, dbTx interface{}) *Storer_GetLatestL1InfoTreeLeaf_Call {
-	return &Storer_GetLatestL1InfoTreeLeaf_Call{Call: _e.mock.On("GetLatestL1InfoTreeLeaf", ctx, dbTx)}
+func (_e *Storer_Expecter) GetRollups(ctx interface{}, dbTx interface{}) *Storer_GetRollups_Call {
+	return &Storer_GetRollups_Call{Call: _e.mock.On("GetRollups", ctx, dbTx)}
 }
 
-func (_c *Storer_GetLatestL1InfoTreeLeaf_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetLatestL1InfoTreeLeaf_Call {
+func (_c *Storer_GetRollups_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetRollups_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetLatestL1InfoTreeLeaf_Call) Return(_a0 *entities.L1InfoTreeLeaf, _a1 error) *Storer_GetLatestL1InfoTreeLeaf_Call {
+func (_c *Storer_GetRollups_Call) Return(_a0 []entities.Rollup, _a1 error) *Storer_GetRollups_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetLatestL1InfoTreeLeaf_Call) RunAndReturn(run func(context.Context, entities.Tx) (*entities.L1InfoTreeLeaf, error)) *Storer_GetLatestL1InfoTreeLeaf_Call {
+func (_c *Storer_GetRollups_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.Rollup, error)) *Storer_GetRollups_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLeafsByL1InfoRoot provides a mock function with given fields: ctx, l1InfoRoot, dbTx
-func (_m *Storer) GetLeafsByL1InfoRoot(ctx context.Context, l1InfoRoot common.Hash, dbTx entities.Tx) ([]entities.L1InfoTreeLeaf, error) {
-	ret := _m.Called(ctx, l1InfoRoot, dbTx)
+// GetSequenceByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *Storer) GetSequenceByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.SequencedBatches, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLeafsByL1InfoRoot")
+		panic("no return value specified for GetSequenceByBatchNumber")
 	}
 
-	var r0 []entities.L1InfoTreeLeaf
+	var r0 *entities.SequencedBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) ([]entities.L1InfoTreeLeaf, error)); ok {
-		return rf(ctx, l1InfoRoot, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.SequencedBatches, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) []entities.L1InfoTreeLeaf); ok {
-		r0 = rf(ctx, l1InfoRoot, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.SequencedBatches); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entities.L1InfoTreeLeaf)
+			r0 = ret.Get(0).(*entities.SequencedBatches)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, entities.Tx) error); ok {
-		r1 = rf(ctx, l1InfoRoot, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -817,59 +2599,60 @@ func (_m *Storer) GetLeafsByL1InfoRoot(ctx context.Context, l1InfoRoot common.Ha
 	return r0, r1
 }
 
-// Storer_GetLeafsByL1InfoRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeafsByL1InfoRoot'
-type Storer_GetLeafsByL1InfoRoot_Call struct {
+// Storer_GetSequenceByBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSequenceByBatchNumber'
+type Storer_GetSequenceByBatchNumber_Call struct {
 	*mock.Call
 }
 
-// GetLeafsByL1InfoRoot is a helper method to define mock.On call
+// GetSequenceByBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
-//   - l1InfoRoot common.Hash
+//   - rollupID uint64
+//   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetLeafsByL1InfoRoot(ctx interface{}, l1InfoRoot interface{}, dbTx interface{}) *Storer_GetLeafsByL1InfoRoot_Call {
-	return &Storer_GetLeafsByL1InfoRoot_Call{Call: _e.mock.On("GetLeafsByL1InfoRoot", ctx, l1InfoRoot, dbTx)}
+func (_e *Storer_Expecter) GetSequenceByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *Storer_GetSequenceByBatchNumber_Call {
+	return &Storer_GetSequenceByBatchNumber_Call{Call: _e.mock.On("GetSequenceByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *Storer_GetLeafsByL1InfoRoot_Call) Run(run func(ctx context.Context, l1InfoRoot common.Hash, dbTx entities.Tx)) *Storer_GetLeafsByL1InfoRoot_Call {
+func (_c *Storer_GetSequenceByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *Storer_GetSequenceByBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(common.Hash), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetLeafsByL1InfoRoot_Call) Return(_a0 []entities.L1InfoTreeLeaf, _a1 error) *Storer_GetLeafsByL1InfoRoot_Call {
+func (_c *Storer_GetSequenceByBatchNumber_Call) Return(_a0 *entities.SequencedBatches, _a1 error) *Storer_GetSequenceByBatchNumber_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetLeafsByL1InfoRoot_Call) RunAndReturn(run func(context.Context, common.Hash, entities.Tx) ([]entities.L1InfoTreeLeaf, error)) *Storer_GetLeafsByL1InfoRoot_Call {
+func (_c *Storer_GetSequenceByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.SequencedBatches, error)) *Storer_GetSequenceByBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPreviousBlock provides a mock function with given fields: ctx, offset, dbTx
-func (_m *Storer) GetPreviousBlock(ctx context.Context, offset uint64, dbTx entities.Tx) (*entities.L1Block, error) {
-	ret := _m.Called(ctx, offset, dbTx)
+// GetUncheckedBlocks provides a mock function with given fields: ctx, fromBlockNumber, toBlockNumber, dbTx
+func (_m *Storer) GetUncheckedBlocks(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx entities.Tx) (*[]entities.L1Block, error) {
+	ret := _m.Called(ctx, fromBlockNumber, toBlockNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPreviousBlock")
+		panic("no return value specified for GetUncheckedBlocks")
 	}
 
-	var r0 *entities.L1Block
+	var r0 *[]entities.L1Block
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
-		return rf(ctx, offset, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*[]entities.L1Block, error)); ok {
+		return rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
-		r0 = rf(ctx, offset, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *[]entities.L1Block); ok {
+		r0 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.L1Block)
+			r0 = ret.Get(0).(*[]entities.L1Block)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, offset, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -877,59 +2660,58 @@ func (_m *Storer) GetPreviousBlock(ctx context.Context, offset uint64, dbTx enti
 	return r0, r1
 }
 
-// Storer_GetPreviousBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousBlock'
-type Storer_GetPreviousBlock_Call struct {
+// Storer_GetUncheckedBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUncheckedBlocks'
+type Storer_GetUncheckedBlocks_Call struct {
 	*mock.Call
 }
 
-// GetPreviousBlock is a helper method to define mock.On call
+// GetUncheckedBlocks is a helper method to define mock.On call
 //   - ctx context.Context
-//   - offset uint64
+//   - fromBlockNumber uint64
+//   - toBlockNumber uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetPreviousBlock(ctx interface{}, offset interface{}, dbTx interface{}) *Storer_GetPreviousBlock_Call {
-	return &Storer_GetPreviousBlock_Call{Call: _e.mock.On("GetPreviousBlock", ctx, offset, dbTx)}
+func (_e *Storer_Expecter) GetUncheckedBlocks(ctx interface{}, fromBlockNumber interface{}, toBlockNumber interface{}, dbTx interface{}) *Storer_GetUncheckedBlocks_Call {
+	return &Storer_GetUncheckedBlocks_Call{Call: _e.mock.On("GetUncheckedBlocks", ctx, fromBlockNumber, toBlockNumber, dbTx)}
 }
 
-func (_c *Storer_GetPreviousBlock_Call) Run(run func(ctx context.Context, offset uint64, dbTx entities.Tx)) *Storer_GetPreviousBlock_Call {
+func (_c *Storer_GetUncheckedBlocks_Call) Run(run func(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx entities.Tx)) *Storer_GetUncheckedBlocks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetPreviousBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *Storer_GetPreviousBlock_Call {
+func (_c *Storer_GetUncheckedBlocks_Call) Return(_a0 *[]entities.L1Block, _a1 error) *Storer_GetUncheckedBlocks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetPreviousBlock_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *Storer_GetPreviousBlock_Call {
+func (_c *Storer_GetUncheckedBlocks_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*[]entities.L1Block, error)) *Storer_GetUncheckedBlocks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSequenceByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *Storer) GetSequenceByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) (*entities.SequencedBatches, error) {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetUncheckedBlocksCount provides a mock function with given fields: ctx, dbTx
+func (_m *Storer) GetUncheckedBlocksCount(ctx context.Context, dbTx entities.Tx) (uint64, error) {
+	ret := _m.Called(ctx, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSequenceByBatchNumber")
+		panic("no return value specified for GetUncheckedBlocksCount")
 	}
 
-	var r0 *entities.SequencedBatches
+	var r0 uint64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.SequencedBatches, error)); ok {
-		return rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (uint64, error)); ok {
+		return rf(ctx, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.SequencedBatches); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) uint64); ok {
+		r0 = rf(ctx, dbTx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.SequencedBatches)
-		}
+		r0 = ret.Get(0).(uint64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -937,59 +2719,58 @@ func (_m *Storer) GetSequenceByBatchNumber(ctx context.Context, batchNumber uint
 	return r0, r1
 }
 
-// Storer_GetSequenceByBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSequenceByBatchNumber'
-type Storer_GetSequenceByBatchNumber_Call struct {
+// Storer_GetUncheckedBlocksCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUncheckedBlocksCount'
+type Storer_GetUncheckedBlocksCount_Call struct {
 	*mock.Call
 }
 
-// GetSequenceByBatchNumber is a helper method to define mock.On call
+// GetUncheckedBlocksCount is a helper method to define mock.On call
 //   - ctx context.Context
-//   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetSequenceByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *Storer_GetSequenceByBatchNumber_Call {
-	return &Storer_GetSequenceByBatchNumber_Call{Call: _e.mock.On("GetSequenceByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *Storer_Expecter) GetUncheckedBlocksCount(ctx interface{}, dbTx interface{}) *Storer_GetUncheckedBlocksCount_Call {
+	return &Storer_GetUncheckedBlocksCount_Call{Call: _e.mock.On("GetUncheckedBlocksCount", ctx, dbTx)}
 }
 
-func (_c *Storer_GetSequenceByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *Storer_GetSequenceByBatchNumber_Call {
+func (_c *Storer_GetUncheckedBlocksCount_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *Storer_GetUncheckedBlocksCount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetSequenceByBatchNumber_Call) Return(_a0 *entities.SequencedBatches, _a1 error) *Storer_GetSequenceByBatchNumber_Call {
+func (_c *Storer_GetUncheckedBlocksCount_Call) Return(_a0 uint64, _a1 error) *Storer_GetUncheckedBlocksCount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetSequenceByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.SequencedBatches, error)) *Storer_GetSequenceByBatchNumber_Call {
+func (_c *Storer_GetUncheckedBlocksCount_Call) RunAndReturn(run func(context.Context, entities.Tx) (uint64, error)) *Storer_GetUncheckedBlocksCount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetUncheckedBlocks provides a mock function with given fields: ctx, fromBlockNumber, toBlockNumber, dbTx
-func (_m *Storer) GetUncheckedBlocks(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx entities.Tx) (*[]entities.L1Block, error) {
-	ret := _m.Called(ctx, fromBlockNumber, toBlockNumber, dbTx)
+// GetVerifiedBatchByNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *Storer) GetVerifiedBatchByNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.VerifiedBatch, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetUncheckedBlocks")
+		panic("no return value specified for GetVerifiedBatchByNumber")
 	}
 
-	var r0 *[]entities.L1Block
+	var r0 *entities.VerifiedBatch
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*[]entities.L1Block, error)); ok {
-		return rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.VerifiedBatch, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *[]entities.L1Block); ok {
-		r0 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.VerifiedBatch); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*[]entities.L1Block)
+			r0 = ret.Get(0).(*entities.VerifiedBatch)
 		}
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -997,40 +2778,40 @@ func (_m *Storer) GetUncheckedBlocks(ctx context.Context, fromBlockNumber uint64
 	return r0, r1
 }
 
-// Storer_GetUncheckedBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUncheckedBlocks'
-type Storer_GetUncheckedBlocks_Call struct {
+// Storer_GetVerifiedBatchByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerifiedBatchByNumber'
+type Storer_GetVerifiedBatchByNumber_Call struct {
 	*mock.Call
 }
 
-// GetUncheckedBlocks is a helper method to define mock.On call
+// GetVerifiedBatchByNumber is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fromBlockNumber uint64
-//   - toBlockNumber uint64
+//   - rollupID uint64
+//   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetUncheckedBlocks(ctx interface{}, fromBlockNumber interface{}, toBlockNumber interface{}, dbTx interface{}) *Storer_GetUncheckedBlocks_Call {
-	return &Storer_GetUncheckedBlocks_Call{Call: _e.mock.On("GetUncheckedBlocks", ctx, fromBlockNumber, toBlockNumber, dbTx)}
+func (_e *Storer_Expecter) GetVerifiedBatchByNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *Storer_GetVerifiedBatchByNumber_Call {
+	return &Storer_GetVerifiedBatchByNumber_Call{Call: _e.mock.On("GetVerifiedBatchByNumber", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *Storer_GetUncheckedBlocks_Call) Run(run func(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx entities.Tx)) *Storer_GetUncheckedBlocks_Call {
+func (_c *Storer_GetVerifiedBatchByNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *Storer_GetVerifiedBatchByNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *Storer_GetUncheckedBlocks_Call) Return(_a0 *[]entities.L1Block, _a1 error) *Storer_GetUncheckedBlocks_Call {
+func (_c *Storer_GetVerifiedBatchByNumber_Call) Return(_a0 *entities.VerifiedBatch, _a1 error) *Storer_GetVerifiedBatchByNumber_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *Storer_GetUncheckedBlocks_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*[]entities.L1Block, error)) *Storer_GetUncheckedBlocks_Call {
+func (_c *Storer_GetVerifiedBatchByNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.VerifiedBatch, error)) *Storer_GetVerifiedBatchByNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetVirtualBatchByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *Storer) GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) (*entities.VirtualBatch, error) {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetVirtualBatchByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *Storer) GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.VirtualBatch, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetVirtualBatchByBatchNumber")
@@ -1038,19 +2819,19 @@ func (_m *Storer) GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber
 
 	var r0 *entities.VirtualBatch
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.VirtualBatch, error)); ok {
-		return rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.VirtualBatch, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.VirtualBatch); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.VirtualBatch); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.VirtualBatch)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1065,15 +2846,16 @@ type Storer_GetVirtualBatchByBatchNumber_Call struct {
 
 // GetVirtualBatchByBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *Storer_Expecter) GetVirtualBatchByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *Storer_GetVirtualBatchByBatchNumber_Call {
-	return &Storer_GetVirtualBatchByBatchNumber_Call{Call: _e.mock.On("GetVirtualBatchByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *Storer_Expecter) GetVirtualBatchByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *Storer_GetVirtualBatchByBatchNumber_Call {
+	return &Storer_GetVirtualBatchByBatchNumber_Call{Call: _e.mock.On("GetVirtualBatchByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *Storer_GetVirtualBatchByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *Storer_GetVirtualBatchByBatchNumber_Call {
+func (_c *Storer_GetVirtualBatchByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *Storer_GetVirtualBatchByBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
@@ -1083,7 +2865,7 @@ func (_c *Storer_GetVirtualBatchByBatchNumber_Call) Return(_a0 *entities.Virtual
 	return _c
 }
 
-func (_c *Storer_GetVirtualBatchByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.VirtualBatch, error)) *Storer_GetVirtualBatchByBatchNumber_Call {
+func (_c *Storer_GetVirtualBatchByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.VirtualBatch, error)) *Storer_GetVirtualBatchByBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1188,6 +2970,57 @@ func (_c *Storer_KVSetJson_Call) RunAndReturn(run func(context.Context, string,
 	return _c
 }
 
+// OverridePendingStates provides a mock function with given fields: ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx
+func (_m *Storer) OverridePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OverridePendingStates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, common.Hash, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_OverridePendingStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OverridePendingStates'
+type Storer_OverridePendingStates_Call struct {
+	*mock.Call
+}
+
+// OverridePendingStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - stateRoot common.Hash
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) OverridePendingStates(ctx interface{}, rollupID interface{}, batchNumber interface{}, stateRoot interface{}, blockNumber interface{}, dbTx interface{}) *Storer_OverridePendingStates_Call {
+	return &Storer_OverridePendingStates_Call{Call: _e.mock.On("OverridePendingStates", ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)}
+}
+
+func (_c *Storer_OverridePendingStates_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx entities.Tx)) *Storer_OverridePendingStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(common.Hash), args[4].(uint64), args[5].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_OverridePendingStates_Call) Return(_a0 error) *Storer_OverridePendingStates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_OverridePendingStates_Call) RunAndReturn(run func(context.Context, uint64, uint64, common.Hash, uint64, entities.Tx) error) *Storer_OverridePendingStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ResetToL1BlockNumber provides a mock function with given fields: ctx, firstBlockNumberToKeep, dbTx
 func (_m *Storer) ResetToL1BlockNumber(ctx context.Context, firstBlockNumberToKeep uint64, dbTx entities.Tx) error {
 	ret := _m.Called(ctx, firstBlockNumberToKeep, dbTx)
@@ -1236,6 +3069,105 @@ func (_c *Storer_ResetToL1BlockNumber_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// SetPendingStateNonDeterministic provides a mock function with given fields: ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx
+func (_m *Storer) SetPendingStateNonDeterministic(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPendingStateNonDeterministic")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, common.Hash, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_SetPendingStateNonDeterministic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPendingStateNonDeterministic'
+type Storer_SetPendingStateNonDeterministic_Call struct {
+	*mock.Call
+}
+
+// SetPendingStateNonDeterministic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - storedStateRoot common.Hash
+//   - provedStateRoot common.Hash
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) SetPendingStateNonDeterministic(ctx interface{}, storedStateRoot interface{}, provedStateRoot interface{}, blockNumber interface{}, dbTx interface{}) *Storer_SetPendingStateNonDeterministic_Call {
+	return &Storer_SetPendingStateNonDeterministic_Call{Call: _e.mock.On("SetPendingStateNonDeterministic", ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)}
+}
+
+func (_c *Storer_SetPendingStateNonDeterministic_Call) Run(run func(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx entities.Tx)) *Storer_SetPendingStateNonDeterministic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Hash), args[2].(common.Hash), args[3].(uint64), args[4].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_SetPendingStateNonDeterministic_Call) Return(_a0 error) *Storer_SetPendingStateNonDeterministic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_SetPendingStateNonDeterministic_Call) RunAndReturn(run func(context.Context, common.Hash, common.Hash, uint64, entities.Tx) error) *Storer_SetPendingStateNonDeterministic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRollupTypeObsolete provides a mock function with given fields: ctx, rollupTypeID, blockNumber, dbTx
+func (_m *Storer) SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupTypeID, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRollupTypeObsolete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupTypeID, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Storer_SetRollupTypeObsolete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRollupTypeObsolete'
+type Storer_SetRollupTypeObsolete_Call struct {
+	*mock.Call
+}
+
+// SetRollupTypeObsolete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupTypeID uint64
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *Storer_Expecter) SetRollupTypeObsolete(ctx interface{}, rollupTypeID interface{}, blockNumber interface{}, dbTx interface{}) *Storer_SetRollupTypeObsolete_Call {
+	return &Storer_SetRollupTypeObsolete_Call{Call: _e.mock.On("SetRollupTypeObsolete", ctx, rollupTypeID, blockNumber, dbTx)}
+}
+
+func (_c *Storer_SetRollupTypeObsolete_Call) Run(run func(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx entities.Tx)) *Storer_SetRollupTypeObsolete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *Storer_SetRollupTypeObsolete_Call) Return(_a0 error) *Storer_SetRollupTypeObsolete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Storer_SetRollupTypeObsolete_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) error) *Storer_SetRollupTypeObsolete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCheckedBlockByNumber provides a mock function with given fields: ctx, blockNumber, newCheckedStatus, dbTx
 func (_m *Storer) UpdateCheckedBlockByNumber(ctx context.Context, blockNumber uint64, newCheckedStatus bool, dbTx entities.Tx) error {
 	ret := _m.Called(ctx, blockNumber, newCheckedStatus, dbTx)
@@ -0,0 +1,206 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_storage
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// verifiedBatchStorer is an autogenerated mock type for the verifiedBatchStorer type
+type verifiedBatchStorer struct {
+	mock.Mock
+}
+
+type verifiedBatchStorer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *verifiedBatchStorer) EXPECT() *verifiedBatchStorer_Expecter {
+	return &verifiedBatchStorer_Expecter{mock: &_m.Mock}
+}
+
+// AddVerifiedBatch provides a mock function with given fields: ctx, verifiedBatch, dbTx
+func (_m *verifiedBatchStorer) AddVerifiedBatch(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, verifiedBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddVerifiedBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.VerifiedBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, verifiedBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// verifiedBatchStorer_AddVerifiedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVerifiedBatch'
+type verifiedBatchStorer_AddVerifiedBatch_Call struct {
+	*mock.Call
+}
+
+// AddVerifiedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - verifiedBatch *entities.VerifiedBatch
+//   - dbTx entities.Tx
+func (_e *verifiedBatchStorer_Expecter) AddVerifiedBatch(ctx interface{}, verifiedBatch interface{}, dbTx interface{}) *verifiedBatchStorer_AddVerifiedBatch_Call {
+	return &verifiedBatchStorer_AddVerifiedBatch_Call{Call: _e.mock.On("AddVerifiedBatch", ctx, verifiedBatch, dbTx)}
+}
+
+func (_c *verifiedBatchStorer_AddVerifiedBatch_Call) Run(run func(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx)) *verifiedBatchStorer_AddVerifiedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.VerifiedBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *verifiedBatchStorer_AddVerifiedBatch_Call) Return(_a0 error) *verifiedBatchStorer_AddVerifiedBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *verifiedBatchStorer_AddVerifiedBatch_Call) RunAndReturn(run func(context.Context, *entities.VerifiedBatch, entities.Tx) error) *verifiedBatchStorer_AddVerifiedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastVerifiedBatch provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *verifiedBatchStorer) GetLastVerifiedBatch(ctx context.Context, rollupID uint64, dbTx entities.Tx) (*entities.VerifiedBatch, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastVerifiedBatch")
+	}
+
+	var r0 *entities.VerifiedBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.VerifiedBatch, error)); ok {
+		return rf(ctx, rollupID, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.VerifiedBatch); ok {
+		r0 = rf(ctx, rollupID, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VerifiedBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// verifiedBatchStorer_GetLastVerifiedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastVerifiedBatch'
+type verifiedBatchStorer_GetLastVerifiedBatch_Call struct {
+	*mock.Call
+}
+
+// GetLastVerifiedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - dbTx entities.Tx
+func (_e *verifiedBatchStorer_Expecter) GetLastVerifiedBatch(ctx interface{}, rollupID interface{}, dbTx interface{}) *verifiedBatchStorer_GetLastVerifiedBatch_Call {
+	return &verifiedBatchStorer_GetLastVerifiedBatch_Call{Call: _e.mock.On("GetLastVerifiedBatch", ctx, rollupID, dbTx)}
+}
+
+func (_c *verifiedBatchStorer_GetLastVerifiedBatch_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *verifiedBatchStorer_GetLastVerifiedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *verifiedBatchStorer_GetLastVerifiedBatch_Call) Return(_a0 *entities.VerifiedBatch, _a1 error) *verifiedBatchStorer_GetLastVerifiedBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *verifiedBatchStorer_GetLastVerifiedBatch_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.VerifiedBatch, error)) *verifiedBatchStorer_GetLastVerifiedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVerifiedBatchByNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *verifiedBatchStorer) GetVerifiedBatchByNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.VerifiedBatch, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerifiedBatchByNumber")
+	}
+
+	var r0 *entities.VerifiedBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.VerifiedBatch, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.VerifiedBatch); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VerifiedBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// verifiedBatchStorer_GetVerifiedBatchByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerifiedBatchByNumber'
+type verifiedBatchStorer_GetVerifiedBatchByNumber_Call struct {
+	*mock.Call
+}
+
+// GetVerifiedBatchByNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - dbTx entities.Tx
+func (_e *verifiedBatchStorer_Expecter) GetVerifiedBatchByNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *verifiedBatchStorer_GetVerifiedBatchByNumber_Call {
+	return &verifiedBatchStorer_GetVerifiedBatchByNumber_Call{Call: _e.mock.On("GetVerifiedBatchByNumber", ctx, rollupID, batchNumber, dbTx)}
+}
+
+func (_c *verifiedBatchStorer_GetVerifiedBatchByNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *verifiedBatchStorer_GetVerifiedBatchByNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *verifiedBatchStorer_GetVerifiedBatchByNumber_Call) Return(_a0 *entities.VerifiedBatch, _a1 error) *verifiedBatchStorer_GetVerifiedBatchByNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *verifiedBatchStorer_GetVerifiedBatchByNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.VerifiedBatch, error)) *verifiedBatchStorer_GetVerifiedBatchByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newVerifiedBatchStorer creates a new instance of verifiedBatchStorer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newVerifiedBatchStorer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *verifiedBatchStorer {
+	mock := &verifiedBatchStorer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -70,9 +70,9 @@ func (_c *virtualBatchStorer_AddVirtualBatch_Call) RunAndReturn(run func(context
 	return _c
 }
 
-// GetVirtualBatchByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *virtualBatchStorer) GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) (*entities.VirtualBatch, error) {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetVirtualBatchByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *virtualBatchStorer) GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.VirtualBatch, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetVirtualBatchByBatchNumber")
@@ -80,19 +80,19 @@ func (_m *virtualBatchStorer) GetVirtualBatchByBatchNumber(ctx context.Context,
 
 	var r0 *entities.VirtualBatch
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.VirtualBatch, error)); ok {
-		return rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.VirtualBatch, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.VirtualBatch); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.VirtualBatch); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.VirtualBatch)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -107,15 +107,16 @@ type virtualBatchStorer_GetVirtualBatchByBatchNumber_Call struct {
 
 // GetVirtualBatchByBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *virtualBatchStorer_Expecter) GetVirtualBatchByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call {
-	return &virtualBatchStorer_GetVirtualBatchByBatchNumber_Call{Call: _e.mock.On("GetVirtualBatchByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *virtualBatchStorer_Expecter) GetVirtualBatchByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call {
+	return &virtualBatchStorer_GetVirtualBatchByBatchNumber_Call{Call: _e.mock.On("GetVirtualBatchByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call {
+func (_c *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
@@ -125,7 +126,7 @@ func (_c *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call) Return(_a0 *enti
 	return _c
 }
 
-func (_c *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.VirtualBatch, error)) *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call {
+func (_c *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.VirtualBatch, error)) *virtualBatchStorer_GetVirtualBatchByBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,76 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	zkevm_synchronizer_l1 "github.com/0xPolygonHermez/zkevm-synchronizer-l1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	tableAuditEvent  = "sync.audit_event"
+	fieldsAuditEvent = []string{"block_num", "tx_hash", "contract", "event_type", "implementation", "role", "previous_admin_role",
+		"admin_role", "previous_account", "account", "sender", "received_at", "sync_version"}
+)
+
+// AddAuditEvent adds a new entry of the audit trail to the storage
+func (p *PostgresStorage) AddAuditEvent(ctx context.Context, auditEvent *AuditEvent, dbTx dbTxType) error {
+	arguments := []interface{}{auditEvent.BlockNumber, auditEvent.TxHash.String(), auditEvent.Contract.String(), auditEvent.EventType,
+		auditEvent.Implementation.String(), auditEvent.Role.String(), auditEvent.PreviousAdminRole.String(), auditEvent.AdminRole.String(),
+		auditEvent.PreviousAccount.String(), auditEvent.Account.String(), auditEvent.Sender.String(), auditEvent.ReceivedAt, zkevm_synchronizer_l1.Version}
+	sql := composeInsertSql(fieldsAuditEvent, tableAuditEvent)
+	e := p.getExecQuerier(getPgTx(dbTx))
+	_, err := e.Exec(ctx, sql, arguments...)
+	return translatePgxError(err, fmt.Sprintf("AddAuditEvent %s %s", auditEvent.Contract.String(), auditEvent.EventType))
+}
+
+// GetAuditEvents returns the entries of the audit trail from fromBlockNumber, oldest first. If contract is nil
+// it returns the entries of all the contracts
+func (p *PostgresStorage) GetAuditEvents(ctx context.Context, contract *common.Address, fromBlockNumber uint64, dbTx dbTxType) ([]AuditEvent, error) {
+	where := "block_num >= $1"
+	arguments := []interface{}{fromBlockNumber}
+	if contract != nil {
+		where += " AND contract = $2"
+		arguments = append(arguments, contract.String())
+	}
+	sql := composeSelectSql(fieldsAuditEvent, tableAuditEvent, where) + " ORDER BY block_num ASC, id ASC"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	rows, err := e.Query(ctx, sql, arguments...)
+	if err != nil {
+		return nil, translatePgxError(err, "GetAuditEvents")
+	}
+	defer rows.Close()
+	res := []AuditEvent{}
+	for rows.Next() {
+		auditEvent, err := scanAuditEvent(rows, "GetAuditEvents")
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *auditEvent)
+	}
+	return res, translatePgxError(rows.Err(), "GetAuditEvents")
+}
+
+func scanAuditEvent(row pgx.Row, contextDescription string) (*AuditEvent, error) {
+	auditEvent := &AuditEvent{}
+	var txHash, contract, implementation, role, previousAdminRole, adminRole, previousAccount, account, sender string
+	var syncVersion *string
+	err := row.Scan(&auditEvent.BlockNumber, &txHash, &contract, &auditEvent.EventType, &implementation, &role, &previousAdminRole,
+		&adminRole, &previousAccount, &account, &sender, &auditEvent.ReceivedAt, &syncVersion)
+	err = translatePgxError(err, contextDescription)
+	if err != nil {
+		return nil, err
+	}
+	auditEvent.TxHash = common.HexToHash(txHash)
+	auditEvent.Contract = common.HexToAddress(contract)
+	auditEvent.Implementation = common.HexToAddress(implementation)
+	auditEvent.Role = common.HexToHash(role)
+	auditEvent.PreviousAdminRole = common.HexToHash(previousAdminRole)
+	auditEvent.AdminRole = common.HexToHash(adminRole)
+	auditEvent.PreviousAccount = common.HexToAddress(previousAccount)
+	auditEvent.Account = common.HexToAddress(account)
+	auditEvent.Sender = common.HexToAddress(sender)
+	return auditEvent, nil
+}
@@ -0,0 +1,73 @@
+package pgstorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditEvents(t *testing.T) {
+	skipDatabaseTestIfNeeded(t)
+	storage := initDbForTest(t)
+	ctx := context.TODO()
+	dbTx, err := storage.BeginTransaction(ctx)
+	require.NoError(t, err)
+	defer func() { _ = dbTx.Rollback(ctx) }()
+	for _, blockNumber := range []uint64{123, 124, 125} {
+		err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: blockNumber}, dbTx)
+		require.NoError(t, err)
+	}
+
+	rollupManager := common.HexToAddress("0x5678")
+	upgraded := pgstorage.AuditEvent{BlockNumber: 123,
+		TxHash:         common.HexToHash("0x1234"),
+		Contract:       rollupManager,
+		EventType:      etherman.AuditUpgraded,
+		Implementation: common.HexToAddress("0x9abc"),
+		ReceivedAt:     time.Date(2023, 3, 24, 0, 0, 0, 0, time.UTC),
+	}
+	roleGranted := pgstorage.AuditEvent{BlockNumber: 125,
+		TxHash:     common.HexToHash("0x1235"),
+		Contract:   rollupManager,
+		EventType:  etherman.AuditRoleGranted,
+		Role:       common.HexToHash("0xab"),
+		Account:    common.HexToAddress("0xcd"),
+		Sender:     common.HexToAddress("0xef"),
+		ReceivedAt: time.Date(2023, 3, 24, 0, 0, 0, 0, time.UTC),
+	}
+	otherContract := pgstorage.AuditEvent{BlockNumber: 124,
+		TxHash:          common.HexToHash("0x1236"),
+		Contract:        common.HexToAddress("0x1111"),
+		EventType:       etherman.AuditOwnershipTransferred,
+		PreviousAccount: common.HexToAddress("0x01"),
+		Account:         common.HexToAddress("0x02"),
+		ReceivedAt:      time.Date(2023, 3, 24, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, storage.AddAuditEvent(ctx, &upgraded, dbTx))
+	require.NoError(t, storage.AddAuditEvent(ctx, &roleGranted, dbTx))
+	require.NoError(t, storage.AddAuditEvent(ctx, &otherContract, dbTx))
+
+	trail, err := storage.GetAuditEvents(ctx, nil, 0, dbTx)
+	require.NoError(t, err)
+	require.Len(t, trail, 3)
+	require.True(t, upgraded.IsEqual(&trail[0]), trail[0].String())
+	require.True(t, otherContract.IsEqual(&trail[1]), trail[1].String())
+	require.True(t, roleGranted.IsEqual(&trail[2]), trail[2].String())
+
+	trail, err = storage.GetAuditEvents(ctx, &rollupManager, 124, dbTx)
+	require.NoError(t, err)
+	require.Len(t, trail, 1)
+	require.True(t, roleGranted.IsEqual(&trail[0]), trail[0].String())
+
+	// The entries are deleted with its block
+	err = storage.ResetToL1BlockNumber(ctx, 124, dbTx)
+	require.NoError(t, err)
+	trail, err = storage.GetAuditEvents(ctx, nil, 0, dbTx)
+	require.NoError(t, err)
+	require.Len(t, trail, 2)
+}
@@ -63,6 +63,18 @@ func (p *PostgresStorage) GetUncheckedBlocks(ctx context.Context, fromBlockNumbe
 	return p.queryBlocks(ctx, "GetUncheckedBlocks", getUncheckedBlocksSQL, getPgTx(dbTx), fromBlockNumber, toBlockNumber)
 }
 
+// GetUncheckedBlocksCount returns the number of blocks that have not been checked yet
+func (p *PostgresStorage) GetUncheckedBlocksCount(ctx context.Context, dbTx dbTxType) (uint64, error) {
+	const getUncheckedBlocksCountSQL = "SELECT COUNT(*) FROM sync.block WHERE checked=false"
+	q := p.getExecQuerier(getPgTx(dbTx))
+	var count uint64
+	err := q.QueryRow(ctx, getUncheckedBlocksCountSQL).Scan(&count)
+	if err != nil {
+		return 0, translatePgxError(err, "GetUncheckedBlocksCount")
+	}
+	return count, nil
+}
+
 func (p *PostgresStorage) queryBlocks(ctx context.Context, desc string, sql string, dbTx pgx.Tx, args ...interface{}) (*[]L1Block, error) {
 	q := p.getExecQuerier(dbTx)
 	rows, err := q.Query(ctx, sql, args...)
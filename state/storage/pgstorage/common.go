@@ -12,6 +12,18 @@ type L1Block = entities.L1Block
 type VirtualBatch = entities.VirtualBatch
 type ForkIDInterval = entities.ForkIDInterval
 type dbTxType = entities.Tx
+type ReorgImpact = entities.ReorgImpact
+type ReorgLogEntry = entities.ReorgLogEntry
+type VerifiedBatch = entities.VerifiedBatch
+type ForcedBatch = entities.ForcedBatch
+type SequencedForceBatch = entities.SequencedForceBatch
+type GlobalExitRoot = entities.GlobalExitRoot
+type GovernanceEvent = entities.GovernanceEvent
+type RollupType = entities.RollupType
+type Rollup = entities.Rollup
+type RollupUpdate = entities.RollupUpdate
+type AuditEvent = entities.AuditEvent
+type PendingState = entities.PendingState
 
 func getPgTx(tx dbTxType) pgx.Tx {
 	res, ok := tx.(pgx.Tx)
@@ -0,0 +1,100 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	zkevm_synchronizer_l1 "github.com/0xPolygonHermez/zkevm-synchronizer-l1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	tableForcedBatch          = "sync.forced_batch"
+	fieldsForcedBatch         = []string{"rollup_id", "forced_batch_num", "block_num", "global_exit_root", "raw_txs_data", "forcer_addr", "forced_at", "sync_version"}
+	tableSequencedForceBatch  = "sync.sequenced_force_batch"
+	fieldsSequencedForceBatch = []string{"rollup_id", "batch_num", "forced_batch_num", "block_num", "tx_hash", "coinbase", "forced_global_exit_root",
+		"forced_timestamp", "forced_block_hash_l1", "source", "sync_version"}
+)
+
+// selectForcedBatchSQL returns the forced batches with the batch that have sequenced them (NULL if pending)
+const selectForcedBatchSQL = `SELECT f.rollup_id, f.forced_batch_num, f.block_num, f.global_exit_root, f.raw_txs_data, f.forcer_addr, f.forced_at, s.batch_num
+	FROM sync.forced_batch f LEFT JOIN sync.sequenced_force_batch s ON s.rollup_id = f.rollup_id AND s.forced_batch_num = f.forced_batch_num`
+
+// AddForcedBatch adds a new forced batch to the storage
+func (p *PostgresStorage) AddForcedBatch(ctx context.Context, forcedBatch *ForcedBatch, dbTx dbTxType) error {
+	arguments := []interface{}{forcedBatch.RollupID, forcedBatch.ForcedBatchNumber, forcedBatch.BlockNumber, forcedBatch.GlobalExitRoot.String(),
+		forcedBatch.RawTxsData, forcedBatch.ForcerAddr.String(), forcedBatch.ForcedAt, zkevm_synchronizer_l1.Version}
+	sql := composeInsertSql(fieldsForcedBatch, tableForcedBatch)
+	e := p.getExecQuerier(getPgTx(dbTx))
+	_, err := e.Exec(ctx, sql, arguments...)
+	return translatePgxError(err, fmt.Sprintf("AddForcedBatch rollupID %d %d", forcedBatch.RollupID, forcedBatch.ForcedBatchNumber))
+}
+
+// GetForcedBatch returns the forced batch of the rollup
+func (p *PostgresStorage) GetForcedBatch(ctx context.Context, rollupID uint64, forcedBatchNumber uint64, dbTx dbTxType) (*ForcedBatch, error) {
+	sql := selectForcedBatchSQL + " WHERE f.rollup_id = $1 AND f.forced_batch_num = $2"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	row := e.QueryRow(ctx, sql, rollupID, forcedBatchNumber)
+	return scanForcedBatch(row, fmt.Sprintf("GetForcedBatch rollupID %d %d", rollupID, forcedBatchNumber))
+}
+
+// GetPendingForcedBatches returns the forced batches of the rollup that have not been sequenced, oldest first
+func (p *PostgresStorage) GetPendingForcedBatches(ctx context.Context, rollupID uint64, dbTx dbTxType) ([]ForcedBatch, error) {
+	sql := selectForcedBatchSQL + " WHERE f.rollup_id = $1 AND s.batch_num IS NULL ORDER BY f.forced_batch_num ASC"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	rows, err := e.Query(ctx, sql, rollupID)
+	if err != nil {
+		return nil, translatePgxError(err, "GetPendingForcedBatches")
+	}
+	defer rows.Close()
+	res := []ForcedBatch{}
+	for rows.Next() {
+		forcedBatch, err := scanForcedBatch(rows, "GetPendingForcedBatches")
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *forcedBatch)
+	}
+	return res, translatePgxError(rows.Err(), "GetPendingForcedBatches")
+}
+
+// AddSequencedForceBatch adds a batch that includes a forced batch
+func (p *PostgresStorage) AddSequencedForceBatch(ctx context.Context, sequencedForceBatch *SequencedForceBatch, dbTx dbTxType) error {
+	arguments := []interface{}{sequencedForceBatch.RollupID, sequencedForceBatch.BatchNumber, sequencedForceBatch.ForcedBatchNumber, sequencedForceBatch.BlockNumber,
+		sequencedForceBatch.TxHash.String(), sequencedForceBatch.Coinbase.String(), sequencedForceBatch.ForcedGlobalExitRoot.String(),
+		sequencedForceBatch.ForcedTimestamp, sequencedForceBatch.ForcedBlockHashL1.String(), sequencedForceBatch.Source, zkevm_synchronizer_l1.Version}
+	sql := composeInsertSql(fieldsSequencedForceBatch, tableSequencedForceBatch)
+	e := p.getExecQuerier(getPgTx(dbTx))
+	_, err := e.Exec(ctx, sql, arguments...)
+	return translatePgxError(err, fmt.Sprintf("AddSequencedForceBatch rollupID %d %d", sequencedForceBatch.RollupID, sequencedForceBatch.BatchNumber))
+}
+
+// GetLastSequencedForcedBatchNumber returns the last forced batch number sequenced of the rollup
+func (p *PostgresStorage) GetLastSequencedForcedBatchNumber(ctx context.Context, rollupID uint64, dbTx dbTxType) (uint64, error) {
+	const sql = "SELECT MAX(forced_batch_num) FROM sync.sequenced_force_batch WHERE rollup_id = $1"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	var forcedBatchNumber *uint64
+	err := translatePgxError(e.QueryRow(ctx, sql, rollupID).Scan(&forcedBatchNumber), "GetLastSequencedForcedBatchNumber")
+	if err != nil {
+		return 0, err
+	}
+	if forcedBatchNumber == nil {
+		return 0, translatePgxError(pgx.ErrNoRows, "GetLastSequencedForcedBatchNumber")
+	}
+	return *forcedBatchNumber, nil
+}
+
+func scanForcedBatch(row pgx.Row, contextDescription string) (*ForcedBatch, error) {
+	forcedBatch := &ForcedBatch{}
+	var globalExitRoot, forcerAddr string
+	err := row.Scan(&forcedBatch.RollupID, &forcedBatch.ForcedBatchNumber, &forcedBatch.BlockNumber, &globalExitRoot,
+		&forcedBatch.RawTxsData, &forcerAddr, &forcedBatch.ForcedAt, &forcedBatch.SequencedBatchNumber)
+	err = translatePgxError(err, contextDescription)
+	if err != nil {
+		return nil, err
+	}
+	forcedBatch.GlobalExitRoot = common.HexToHash(globalExitRoot)
+	forcedBatch.ForcerAddr = common.HexToAddress(forcerAddr)
+	return forcedBatch, nil
+}
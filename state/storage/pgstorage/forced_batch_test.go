@@ -0,0 +1,66 @@
+package pgstorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForcedBatches(t *testing.T) {
+	skipDatabaseTestIfNeeded(t)
+	storage := initDbForTest(t)
+	ctx := context.TODO()
+	dbTx, err := storage.BeginTransaction(ctx)
+	require.NoError(t, err)
+	defer func() { _ = dbTx.Rollback(ctx) }()
+	err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: 123}, dbTx)
+	require.NoError(t, err)
+	err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: 124}, dbTx)
+	require.NoError(t, err)
+
+	_, err = storage.GetLastSequencedForcedBatchNumber(ctx, 1, dbTx)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+	for _, forcedBatchNumber := range []uint64{1, 2} {
+		forcedBatch := pgstorage.ForcedBatch{RollupID: 1, ForcedBatchNumber: forcedBatchNumber, BlockNumber: 123,
+			GlobalExitRoot: common.HexToHash("0x1234"),
+			RawTxsData:     []byte{0x01, 0x02},
+			ForcerAddr:     common.HexToAddress("0x5678"),
+			ForcedAt:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+		require.NoError(t, storage.AddForcedBatch(ctx, &forcedBatch, dbTx))
+	}
+	pending, err := storage.GetPendingForcedBatches(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.Equal(t, uint64(1), pending[0].ForcedBatchNumber)
+
+	forcedBatchNumber := uint64(1)
+	sequenced := pgstorage.SequencedForceBatch{RollupID: 1, BatchNumber: 50, ForcedBatchNumber: &forcedBatchNumber, BlockNumber: 124,
+		ForcedGlobalExitRoot: common.HexToHash("0x1234"), ForcedTimestamp: 1704067200}
+	require.NoError(t, storage.AddSequencedForceBatch(ctx, &sequenced, dbTx))
+	lastSequenced, err := storage.GetLastSequencedForcedBatchNumber(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), lastSequenced)
+
+	forcedBatch, err := storage.GetForcedBatch(ctx, 1, 1, dbTx)
+	require.NoError(t, err)
+	require.False(t, forcedBatch.IsPending())
+	require.Equal(t, uint64(50), *forcedBatch.SequencedBatchNumber)
+	require.Equal(t, []byte{0x01, 0x02}, forcedBatch.RawTxsData)
+	pending, err = storage.GetPendingForcedBatches(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, uint64(2), pending[0].ForcedBatchNumber)
+
+	// Reorg of the block that have sequenced it, so it's pending again
+	err = storage.ResetToL1BlockNumber(ctx, 123, dbTx)
+	require.NoError(t, err)
+	pending, err = storage.GetPendingForcedBatches(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+}
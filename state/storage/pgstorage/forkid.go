@@ -6,19 +6,19 @@ import (
 
 // AddForkID adds a new forkID to the storage
 func (p *PostgresStorage) AddForkID(ctx context.Context, forkID ForkIDInterval, dbTx dbTxType) error {
-	const addForkIDSQL = "INSERT INTO sync.fork_id (from_batch_num, to_batch_num, fork_id, version, block_num) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (fork_id) DO UPDATE SET block_num = $5 WHERE sync.fork_id.fork_id = $3;"
+	const addForkIDSQL = "INSERT INTO sync.fork_id (from_batch_num, to_batch_num, fork_id, version, block_num, rollup_id) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (rollup_id, fork_id) DO UPDATE SET block_num = $5 WHERE sync.fork_id.fork_id = $3 AND sync.fork_id.rollup_id = $6;"
 	e := p.getExecQuerier(getPgTx(dbTx))
-	_, err := e.Exec(ctx, addForkIDSQL, forkID.FromBatchNumber, forkID.ToBatchNumber, forkID.ForkId, forkID.Version, forkID.BlockNumber)
+	_, err := e.Exec(ctx, addForkIDSQL, forkID.FromBatchNumber, forkID.ToBatchNumber, forkID.ForkId, forkID.Version, forkID.BlockNumber, forkID.RollupID)
 	err = translatePgxError(err, "AddForkID")
 	return err
 }
 
-// GetForkIDs get all the forkIDs stored
-func (p *PostgresStorage) GetForkIDs(ctx context.Context, dbTx dbTxType) ([]ForkIDInterval, error) {
-	const getForkIDsSQL = "SELECT from_batch_num, to_batch_num, fork_id, version, block_num FROM sync.fork_id ORDER BY from_batch_num ASC"
+// GetForkIDs get all the forkIDs stored for a rollup
+func (p *PostgresStorage) GetForkIDs(ctx context.Context, rollupID uint64, dbTx dbTxType) ([]ForkIDInterval, error) {
+	const getForkIDsSQL = "SELECT from_batch_num, to_batch_num, fork_id, version, block_num, rollup_id FROM sync.fork_id WHERE rollup_id = $1 ORDER BY from_batch_num ASC"
 	q := p.getExecQuerier(getPgTx(dbTx))
 
-	rows, err := q.Query(ctx, getForkIDsSQL)
+	rows, err := q.Query(ctx, getForkIDsSQL, rollupID)
 	if err != nil {
 		return nil, translatePgxError(err, "GetForkIDs")
 	}
@@ -34,6 +34,7 @@ func (p *PostgresStorage) GetForkIDs(ctx context.Context, dbTx dbTxType) ([]Fork
 			&forkID.ForkId,
 			&forkID.Version,
 			&forkID.BlockNumber,
+			&forkID.RollupID,
 		); err != nil {
 			return forkIDs, err
 		}
@@ -44,9 +45,9 @@ func (p *PostgresStorage) GetForkIDs(ctx context.Context, dbTx dbTxType) ([]Fork
 
 // UpdateForkID updates the forkID stored in db
 func (p *PostgresStorage) UpdateForkID(ctx context.Context, forkID ForkIDInterval, dbTx dbTxType) error {
-	const updateForkIDSQL = "UPDATE sync.fork_id SET to_batch_num = $1 WHERE fork_id = $2"
+	const updateForkIDSQL = "UPDATE sync.fork_id SET to_batch_num = $1 WHERE fork_id = $2 AND rollup_id = $3"
 	e := p.getExecQuerier(getPgTx(dbTx))
-	if _, err := e.Exec(ctx, updateForkIDSQL, forkID.ToBatchNumber, forkID.ForkId); err != nil {
+	if _, err := e.Exec(ctx, updateForkIDSQL, forkID.ToBatchNumber, forkID.ForkId, forkID.RollupID); err != nil {
 		return translatePgxError(err, "UpdateForkID")
 	}
 	return nil
@@ -0,0 +1,56 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	zkevm_synchronizer_l1 "github.com/0xPolygonHermez/zkevm-synchronizer-l1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	tableGlobalExitRoot  = "sync.global_exit_root"
+	fieldsGlobalExitRoot = []string{"block_num", "global_exit_root", "mainnet_exit_root", "rollup_exit_root", "timestamp", "sync_version"}
+)
+
+// AddGlobalExitRoot adds a new update of the GlobalExitRoot previous to LxLy to the storage
+func (p *PostgresStorage) AddGlobalExitRoot(ctx context.Context, globalExitRoot *GlobalExitRoot, dbTx dbTxType) error {
+	arguments := []interface{}{globalExitRoot.BlockNumber, globalExitRoot.GlobalExitRoot.String(), globalExitRoot.MainnetExitRoot.String(),
+		globalExitRoot.RollupExitRoot.String(), globalExitRoot.Timestamp, zkevm_synchronizer_l1.Version}
+	sql := composeInsertSql(fieldsGlobalExitRoot, tableGlobalExitRoot)
+	e := p.getExecQuerier(getPgTx(dbTx))
+	_, err := e.Exec(ctx, sql, arguments...)
+	return translatePgxError(err, fmt.Sprintf("AddGlobalExitRoot %s", globalExitRoot.GlobalExitRoot.String()))
+}
+
+// GetLatestGlobalExitRoot returns the last update of the GlobalExitRoot
+func (p *PostgresStorage) GetLatestGlobalExitRoot(ctx context.Context, dbTx dbTxType) (*GlobalExitRoot, error) {
+	sql := composeSelectSql(fieldsGlobalExitRoot, tableGlobalExitRoot, "") + " ORDER BY id DESC LIMIT 1"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	row := e.QueryRow(ctx, sql)
+	return scanGlobalExitRoot(row, "GetLatestGlobalExitRoot")
+}
+
+// GetGlobalExitRoot returns the first update to globalExitRoot
+func (p *PostgresStorage) GetGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash, dbTx dbTxType) (*GlobalExitRoot, error) {
+	sql := composeSelectSql(fieldsGlobalExitRoot, tableGlobalExitRoot, "global_exit_root = $1") + " ORDER BY id ASC LIMIT 1"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	row := e.QueryRow(ctx, sql, globalExitRoot.String())
+	return scanGlobalExitRoot(row, fmt.Sprintf("GetGlobalExitRoot %s", globalExitRoot.String()))
+}
+
+func scanGlobalExitRoot(row pgx.Row, contextDescription string) (*GlobalExitRoot, error) {
+	globalExitRoot := &GlobalExitRoot{}
+	var ger, mainnetExitRoot, rollupExitRoot string
+	var syncVersion *string
+	err := row.Scan(&globalExitRoot.BlockNumber, &ger, &mainnetExitRoot, &rollupExitRoot, &globalExitRoot.Timestamp, &syncVersion)
+	err = translatePgxError(err, contextDescription)
+	if err != nil {
+		return nil, err
+	}
+	globalExitRoot.GlobalExitRoot = common.HexToHash(ger)
+	globalExitRoot.MainnetExitRoot = common.HexToHash(mainnetExitRoot)
+	globalExitRoot.RollupExitRoot = common.HexToHash(rollupExitRoot)
+	return globalExitRoot, nil
+}
@@ -0,0 +1,56 @@
+package pgstorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalExitRoots(t *testing.T) {
+	skipDatabaseTestIfNeeded(t)
+	storage := initDbForTest(t)
+	ctx := context.TODO()
+	dbTx, err := storage.BeginTransaction(ctx)
+	require.NoError(t, err)
+	defer func() { _ = dbTx.Rollback(ctx) }()
+	err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: 123}, dbTx)
+	require.NoError(t, err)
+	err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: 124}, dbTx)
+	require.NoError(t, err)
+
+	_, err = storage.GetLatestGlobalExitRoot(ctx, dbTx)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	ger1 := pgstorage.GlobalExitRoot{BlockNumber: 123,
+		GlobalExitRoot:  common.HexToHash("0x1234"),
+		MainnetExitRoot: common.HexToHash("0x5678"),
+		RollupExitRoot:  common.HexToHash("0x9abc"),
+		Timestamp:       time.Date(2023, 3, 24, 0, 0, 0, 0, time.UTC),
+	}
+	ger2 := ger1
+	ger2.BlockNumber = 124
+	ger2.GlobalExitRoot = common.HexToHash("0xdef0")
+	require.NoError(t, storage.AddGlobalExitRoot(ctx, &ger1, dbTx))
+	require.NoError(t, storage.AddGlobalExitRoot(ctx, &ger2, dbTx))
+
+	latest, err := storage.GetLatestGlobalExitRoot(ctx, dbTx)
+	require.NoError(t, err)
+	require.True(t, ger2.IsEqual(latest), latest.String())
+	byHash, err := storage.GetGlobalExitRoot(ctx, ger1.GlobalExitRoot, dbTx)
+	require.NoError(t, err)
+	require.True(t, ger1.IsEqual(byHash), byHash.String())
+	_, err = storage.GetGlobalExitRoot(ctx, common.HexToHash("0x01"), dbTx)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	// The updates are deleted with its block
+	err = storage.ResetToL1BlockNumber(ctx, 123, dbTx)
+	require.NoError(t, err)
+	latest, err = storage.GetLatestGlobalExitRoot(ctx, dbTx)
+	require.NoError(t, err)
+	require.True(t, ger1.IsEqual(latest), latest.String())
+}
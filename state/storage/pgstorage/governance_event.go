@@ -0,0 +1,69 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	zkevm_synchronizer_l1 "github.com/0xPolygonHermez/zkevm-synchronizer-l1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	tableGovernanceEvent  = "sync.governance_event"
+	fieldsGovernanceEvent = []string{"rollup_id", "block_num", "tx_hash", "contract", "parameter", "value", "received_at", "sync_version"}
+)
+
+// AddGovernanceEvent adds a new change of a parameter to the storage
+func (p *PostgresStorage) AddGovernanceEvent(ctx context.Context, governanceEvent *GovernanceEvent, dbTx dbTxType) error {
+	arguments := []interface{}{governanceEvent.RollupID, governanceEvent.BlockNumber, governanceEvent.TxHash.String(), governanceEvent.Contract.String(),
+		governanceEvent.Parameter, governanceEvent.Value, governanceEvent.ReceivedAt, zkevm_synchronizer_l1.Version}
+	sql := composeInsertSql(fieldsGovernanceEvent, tableGovernanceEvent)
+	e := p.getExecQuerier(getPgTx(dbTx))
+	_, err := e.Exec(ctx, sql, arguments...)
+	return translatePgxError(err, fmt.Sprintf("AddGovernanceEvent rollupID %d %s", governanceEvent.RollupID, governanceEvent.Parameter))
+}
+
+// GetGovernanceEventAtBlock returns the last change of the parameter on a block previous or equal to blockNumber
+func (p *PostgresStorage) GetGovernanceEventAtBlock(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx dbTxType) (*GovernanceEvent, error) {
+	sql := composeSelectSql(fieldsGovernanceEvent, tableGovernanceEvent, "rollup_id = $1 AND parameter = $2 AND block_num <= $3") +
+		" ORDER BY block_num DESC, id DESC LIMIT 1"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	row := e.QueryRow(ctx, sql, rollupID, parameter, blockNumber)
+	return scanGovernanceEvent(row, fmt.Sprintf("GetGovernanceEventAtBlock rollupID %d %s %d", rollupID, parameter, blockNumber))
+}
+
+// GetGovernanceEvents returns all the changes of the parameter, oldest first
+func (p *PostgresStorage) GetGovernanceEvents(ctx context.Context, rollupID uint64, parameter string, dbTx dbTxType) ([]GovernanceEvent, error) {
+	sql := composeSelectSql(fieldsGovernanceEvent, tableGovernanceEvent, "rollup_id = $1 AND parameter = $2") + " ORDER BY block_num ASC, id ASC"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	rows, err := e.Query(ctx, sql, rollupID, parameter)
+	if err != nil {
+		return nil, translatePgxError(err, "GetGovernanceEvents")
+	}
+	defer rows.Close()
+	res := []GovernanceEvent{}
+	for rows.Next() {
+		governanceEvent, err := scanGovernanceEvent(rows, "GetGovernanceEvents")
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *governanceEvent)
+	}
+	return res, translatePgxError(rows.Err(), "GetGovernanceEvents")
+}
+
+func scanGovernanceEvent(row pgx.Row, contextDescription string) (*GovernanceEvent, error) {
+	governanceEvent := &GovernanceEvent{}
+	var txHash, contract string
+	var syncVersion *string
+	err := row.Scan(&governanceEvent.RollupID, &governanceEvent.BlockNumber, &txHash, &contract, &governanceEvent.Parameter,
+		&governanceEvent.Value, &governanceEvent.ReceivedAt, &syncVersion)
+	err = translatePgxError(err, contextDescription)
+	if err != nil {
+		return nil, err
+	}
+	governanceEvent.TxHash = common.HexToHash(txHash)
+	governanceEvent.Contract = common.HexToAddress(contract)
+	return governanceEvent, nil
+}
@@ -0,0 +1,65 @@
+package pgstorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernanceEvents(t *testing.T) {
+	skipDatabaseTestIfNeeded(t)
+	storage := initDbForTest(t)
+	ctx := context.TODO()
+	dbTx, err := storage.BeginTransaction(ctx)
+	require.NoError(t, err)
+	defer func() { _ = dbTx.Rollback(ctx) }()
+	for _, blockNumber := range []uint64{123, 124, 125} {
+		err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: blockNumber}, dbTx)
+		require.NoError(t, err)
+	}
+
+	_, err = storage.GetGovernanceEventAtBlock(ctx, 1, etherman.GovernanceTrustedSequencerURL, 125, dbTx)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	url1 := pgstorage.GovernanceEvent{RollupID: 1, BlockNumber: 123,
+		TxHash:     common.HexToHash("0x1234"),
+		Contract:   common.HexToAddress("0x5678"),
+		Parameter:  etherman.GovernanceTrustedSequencerURL,
+		Value:      "http://sequencer1",
+		ReceivedAt: time.Date(2023, 3, 24, 0, 0, 0, 0, time.UTC),
+	}
+	url2 := url1
+	url2.BlockNumber = 125
+	url2.Value = "http://sequencer2"
+	otherRollup := url1
+	otherRollup.RollupID = 2
+	otherRollup.BlockNumber = 124
+	require.NoError(t, storage.AddGovernanceEvent(ctx, &url1, dbTx))
+	require.NoError(t, storage.AddGovernanceEvent(ctx, &otherRollup, dbTx))
+	require.NoError(t, storage.AddGovernanceEvent(ctx, &url2, dbTx))
+
+	atBlock, err := storage.GetGovernanceEventAtBlock(ctx, 1, etherman.GovernanceTrustedSequencerURL, 124, dbTx)
+	require.NoError(t, err)
+	require.True(t, url1.IsEqual(atBlock), atBlock.String())
+	atBlock, err = storage.GetGovernanceEventAtBlock(ctx, 1, etherman.GovernanceTrustedSequencerURL, 125, dbTx)
+	require.NoError(t, err)
+	require.True(t, url2.IsEqual(atBlock), atBlock.String())
+	history, err := storage.GetGovernanceEvents(ctx, 1, etherman.GovernanceTrustedSequencerURL, dbTx)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.True(t, url1.IsEqual(&history[0]), history[0].String())
+	require.True(t, url2.IsEqual(&history[1]), history[1].String())
+
+	// The changes are deleted with its block
+	err = storage.ResetToL1BlockNumber(ctx, 124, dbTx)
+	require.NoError(t, err)
+	history, err = storage.GetGovernanceEvents(ctx, 1, etherman.GovernanceTrustedSequencerURL, dbTx)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+}
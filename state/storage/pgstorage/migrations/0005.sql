@@ -0,0 +1,42 @@
+-- +migrate Up
+-- Batches and forkIDs are stored per rollup. The data already stored belongs to the rollup bound to this storage (kv.ContentBound)
+ALTER TABLE sync.sequenced_batches ADD COLUMN IF NOT EXISTS rollup_id BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sync.virtual_batch ADD COLUMN IF NOT EXISTS rollup_id BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sync.fork_id ADD COLUMN IF NOT EXISTS rollup_id BIGINT NOT NULL DEFAULT 0;
+
+UPDATE sync.sequenced_batches SET rollup_id = COALESCE((SELECT (value::json->>'RollupID')::BIGINT FROM sync.kv WHERE key = 'ContentBound'), 0);
+UPDATE sync.virtual_batch SET rollup_id = COALESCE((SELECT (value::json->>'RollupID')::BIGINT FROM sync.kv WHERE key = 'ContentBound'), 0);
+UPDATE sync.fork_id SET rollup_id = COALESCE((SELECT (value::json->>'RollupID')::BIGINT FROM sync.kv WHERE key = 'ContentBound'), 0);
+
+ALTER TABLE sync.sequenced_batches ALTER COLUMN rollup_id DROP DEFAULT;
+ALTER TABLE sync.virtual_batch ALTER COLUMN rollup_id DROP DEFAULT;
+ALTER TABLE sync.fork_id ALTER COLUMN rollup_id DROP DEFAULT;
+
+ALTER TABLE sync.virtual_batch DROP CONSTRAINT IF EXISTS virtual_batch_sequence_from_batch_num_fkey;
+ALTER TABLE sync.virtual_batch DROP CONSTRAINT IF EXISTS virtual_batch_pkey;
+ALTER TABLE sync.sequenced_batches DROP CONSTRAINT IF EXISTS sequenced_batches_pkey;
+ALTER TABLE sync.fork_id DROP CONSTRAINT IF EXISTS fork_id_pkey;
+
+ALTER TABLE sync.sequenced_batches ADD CONSTRAINT sequenced_batches_pkey PRIMARY KEY (rollup_id, from_batch_num);
+ALTER TABLE sync.virtual_batch ADD CONSTRAINT virtual_batch_pkey PRIMARY KEY (rollup_id, batch_num);
+ALTER TABLE sync.virtual_batch ADD CONSTRAINT virtual_batch_sequence_from_batch_num_fkey FOREIGN KEY (rollup_id, sequence_from_batch_num) REFERENCES sync.sequenced_batches (rollup_id, from_batch_num) ON DELETE CASCADE;
+ALTER TABLE sync.fork_id ADD CONSTRAINT fork_id_pkey PRIMARY KEY (rollup_id, fork_id);
+
+comment on column sync.sequenced_batches.rollup_id is 'rollupID on RollupManager of this sequence';
+comment on column sync.virtual_batch.rollup_id is 'rollupID on RollupManager of this batch';
+comment on column sync.fork_id.rollup_id is 'rollupID on RollupManager of this forkID';
+
+-- +migrate Down
+ALTER TABLE sync.virtual_batch DROP CONSTRAINT IF EXISTS virtual_batch_sequence_from_batch_num_fkey;
+ALTER TABLE sync.virtual_batch DROP CONSTRAINT IF EXISTS virtual_batch_pkey;
+ALTER TABLE sync.sequenced_batches DROP CONSTRAINT IF EXISTS sequenced_batches_pkey;
+ALTER TABLE sync.fork_id DROP CONSTRAINT IF EXISTS fork_id_pkey;
+
+ALTER TABLE sync.sequenced_batches ADD CONSTRAINT sequenced_batches_pkey PRIMARY KEY (from_batch_num);
+ALTER TABLE sync.virtual_batch ADD CONSTRAINT virtual_batch_pkey PRIMARY KEY (batch_num);
+ALTER TABLE sync.virtual_batch ADD CONSTRAINT virtual_batch_sequence_from_batch_num_fkey FOREIGN KEY (sequence_from_batch_num) REFERENCES sync.sequenced_batches (from_batch_num) ON DELETE CASCADE;
+ALTER TABLE sync.fork_id ADD CONSTRAINT fork_id_pkey PRIMARY KEY (fork_id);
+
+ALTER TABLE sync.sequenced_batches DROP COLUMN IF EXISTS rollup_id;
+ALTER TABLE sync.virtual_batch DROP COLUMN IF EXISTS rollup_id;
+ALTER TABLE sync.fork_id DROP COLUMN IF EXISTS rollup_id;
@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Each reorg executed on DB is logged, two reorgs can have the same timestamp so it's identified by reorg_id
+ALTER TABLE sync.reorg_log DROP CONSTRAINT IF EXISTS trusted_reorg_pkey;
+ALTER TABLE sync.reorg_log ADD COLUMN IF NOT EXISTS reorg_id BIGSERIAL PRIMARY KEY;
+ALTER TABLE sync.reorg_log ADD COLUMN IF NOT EXISTS deleted_blocks BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sync.reorg_log ADD COLUMN IF NOT EXISTS deleted_virtual_batches BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sync.reorg_log ADD COLUMN IF NOT EXISTS deleted_l1_info_tree_leaves BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sync.reorg_log ADD COLUMN IF NOT EXISTS duration_ms BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sync.reorg_log ADD COLUMN IF NOT EXISTS sync_version VARCHAR(128);
+CREATE INDEX IF NOT EXISTS reorg_log_timestamp_idx ON sync.reorg_log ("timestamp");
+
+comment on column sync.reorg_log.block_num is 'last L1 block kept, the following ones were deleted';
+comment on column sync.reorg_log.duration_ms is 'time spent executing the reorg in milliseconds';
+
+-- +migrate Down
+DROP INDEX IF EXISTS sync.reorg_log_timestamp_idx;
+ALTER TABLE sync.reorg_log DROP COLUMN IF EXISTS sync_version;
+ALTER TABLE sync.reorg_log DROP COLUMN IF EXISTS duration_ms;
+ALTER TABLE sync.reorg_log DROP COLUMN IF EXISTS deleted_l1_info_tree_leaves;
+ALTER TABLE sync.reorg_log DROP COLUMN IF EXISTS deleted_virtual_batches;
+ALTER TABLE sync.reorg_log DROP COLUMN IF EXISTS deleted_blocks;
+ALTER TABLE sync.reorg_log DROP COLUMN IF EXISTS reorg_id;
+ALTER TABLE sync.reorg_log ADD CONSTRAINT trusted_reorg_pkey PRIMARY KEY ("timestamp");
@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Verifications of batches on L1, each one verifies all the batches of the rollup up to batch_num
+CREATE TABLE IF NOT EXISTS sync.verified_batch
+(
+    rollup_id    BIGINT NOT NULL,
+    batch_num    BIGINT NOT NULL,
+    block_num    BIGINT NOT NULL REFERENCES sync.block (block_num) ON DELETE CASCADE,
+    state_root   VARCHAR(66) NOT NULL,
+    aggregator   VARCHAR(42) NOT NULL,
+    tx_hash      VARCHAR(66) NOT NULL,
+    is_trusted   BOOLEAN NOT NULL DEFAULT TRUE,
+    received_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    sync_version VARCHAR(128),
+    CONSTRAINT verified_batch_pkey PRIMARY KEY (rollup_id, batch_num)
+);
+
+CREATE INDEX IF NOT EXISTS verified_batch_block_num_idx ON sync.verified_batch (block_num);
+
+comment on column sync.verified_batch.batch_num is 'last batch verified, all the previous ones are verified too';
+comment on column sync.verified_batch.is_trusted is 'verified by the trusted aggregator (VerifyBatchesTrustedAggregator event)';
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.verified_batch;
@@ -0,0 +1,42 @@
+-- +migrate Up
+-- Batches forced by the users on L1 (ForceBatch event)
+CREATE TABLE IF NOT EXISTS sync.forced_batch
+(
+    rollup_id        BIGINT NOT NULL,
+    forced_batch_num BIGINT NOT NULL,
+    block_num        BIGINT NOT NULL REFERENCES sync.block (block_num) ON DELETE CASCADE,
+    global_exit_root VARCHAR(66) NOT NULL,
+    raw_txs_data     BYTEA,
+    forcer_addr      VARCHAR(42) NOT NULL,
+    forced_at        TIMESTAMP WITH TIME ZONE NOT NULL,
+    sync_version     VARCHAR(128),
+    CONSTRAINT forced_batch_pkey PRIMARY KEY (rollup_id, forced_batch_num)
+);
+
+-- Batches sequenced on L1 that include a forced batch (SequenceBatches with forced data or SequenceForceBatches)
+CREATE TABLE IF NOT EXISTS sync.sequenced_force_batch
+(
+    rollup_id               BIGINT NOT NULL,
+    batch_num               BIGINT NOT NULL,
+    forced_batch_num        BIGINT,
+    block_num               BIGINT NOT NULL REFERENCES sync.block (block_num) ON DELETE CASCADE,
+    tx_hash                 VARCHAR(66) NOT NULL,
+    coinbase                VARCHAR(42) NOT NULL,
+    forced_global_exit_root VARCHAR(66) NOT NULL,
+    forced_timestamp        BIGINT NOT NULL,
+    forced_block_hash_l1    VARCHAR(66) NOT NULL,
+    source                  VARCHAR(256),
+    sync_version            VARCHAR(128),
+    CONSTRAINT sequenced_force_batch_pkey PRIMARY KEY (rollup_id, batch_num),
+    CONSTRAINT sequenced_force_batch_forced_batch_num_key UNIQUE (rollup_id, forced_batch_num)
+);
+
+CREATE INDEX IF NOT EXISTS forced_batch_block_num_idx ON sync.forced_batch (block_num);
+CREATE INDEX IF NOT EXISTS sequenced_force_batch_block_num_idx ON sync.sequenced_force_batch (block_num);
+
+comment on column sync.forced_batch.forcer_addr is 'address that have forced the batch';
+comment on column sync.sequenced_force_batch.forced_batch_num is 'forced batch included, NULL if it was forced before the first block synced';
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.sequenced_force_batch;
+DROP TABLE IF EXISTS sync.forced_batch;
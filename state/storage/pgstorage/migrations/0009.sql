@@ -0,0 +1,24 @@
+-- +migrate Up
+-- Updates of the GlobalExitRoot previous to LxLy (UpdateGlobalExitRoot event)
+CREATE TABLE IF NOT EXISTS sync.global_exit_root
+(
+    id                BIGSERIAL PRIMARY KEY,
+    block_num         BIGINT NOT NULL REFERENCES sync.block (block_num) ON DELETE CASCADE,
+    global_exit_root  VARCHAR(66) NOT NULL,
+    mainnet_exit_root VARCHAR(66) NOT NULL,
+    rollup_exit_root  VARCHAR(66) NOT NULL,
+    timestamp         TIMESTAMP WITH TIME ZONE NOT NULL,
+    sync_version      VARCHAR(128)
+);
+
+CREATE INDEX IF NOT EXISTS global_exit_root_block_num_idx ON sync.global_exit_root (block_num);
+CREATE INDEX IF NOT EXISTS global_exit_root_global_exit_root_idx ON sync.global_exit_root (global_exit_root);
+
+ALTER TABLE sync.virtual_batch ADD COLUMN IF NOT EXISTS global_exit_root VARCHAR(66);
+
+comment on column sync.global_exit_root.id is 'order of the updates, the last one is the current GlobalExitRoot';
+comment on column sync.virtual_batch.global_exit_root is 'GlobalExitRoot of the batch, only for forkIDs previous to Etrog';
+
+-- +migrate Down
+ALTER TABLE sync.virtual_batch DROP COLUMN IF EXISTS global_exit_root;
+DROP TABLE IF EXISTS sync.global_exit_root;
@@ -0,0 +1,23 @@
+-- +migrate Up
+-- Changes of the parameters of the rollups and of the RollupManager (SetTrustedSequencerURL, EmergencyStateActivated...)
+CREATE TABLE IF NOT EXISTS sync.governance_event
+(
+    id           BIGSERIAL PRIMARY KEY,
+    rollup_id    BIGINT NOT NULL,
+    block_num    BIGINT NOT NULL REFERENCES sync.block (block_num) ON DELETE CASCADE,
+    tx_hash      VARCHAR(66) NOT NULL,
+    contract     VARCHAR(42) NOT NULL,
+    parameter    VARCHAR(64) NOT NULL,
+    value        VARCHAR NOT NULL,
+    received_at  TIMESTAMP WITH TIME ZONE NOT NULL,
+    sync_version VARCHAR(128)
+);
+
+CREATE INDEX IF NOT EXISTS governance_event_block_num_idx ON sync.governance_event (block_num);
+CREATE INDEX IF NOT EXISTS governance_event_parameter_idx ON sync.governance_event (rollup_id, parameter, block_num);
+
+comment on column sync.governance_event.rollup_id is '0 for the parameters of the RollupManager, they are shared by all the rollups';
+comment on column sync.governance_event.value is 'new value: addresses in hex, numbers in decimal and true/false for the emergency state';
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.governance_event;
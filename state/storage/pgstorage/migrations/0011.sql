@@ -0,0 +1,58 @@
+-- +migrate Up
+-- Registry of the rollup types and rollups of the RollupManager
+CREATE TABLE IF NOT EXISTS sync.rollup_type
+(
+    rollup_type_id           BIGINT PRIMARY KEY,
+    block_num                BIGINT NOT NULL REFERENCES sync.block (block_num) ON DELETE CASCADE,
+    tx_hash                  VARCHAR(66) NOT NULL,
+    consensus_implementation VARCHAR(42) NOT NULL,
+    verifier                 VARCHAR(42) NOT NULL,
+    fork_id                  BIGINT NOT NULL,
+    rollup_compatibility_id  BIGINT NOT NULL,
+    genesis                  VARCHAR(66) NOT NULL,
+    description              VARCHAR NOT NULL,
+    obsolete_block_num       BIGINT REFERENCES sync.block (block_num) ON DELETE SET NULL,
+    received_at              TIMESTAMP WITH TIME ZONE NOT NULL,
+    sync_version             VARCHAR(128)
+);
+
+CREATE TABLE IF NOT EXISTS sync.rollup
+(
+    rollup_id         BIGINT PRIMARY KEY,
+    block_num         BIGINT NOT NULL REFERENCES sync.block (block_num) ON DELETE CASCADE,
+    tx_hash           VARCHAR(66) NOT NULL,
+    rollup_address    VARCHAR(42) NOT NULL,
+    chain_id          BIGINT NOT NULL,
+    gas_token_address VARCHAR(42) NOT NULL,
+    rollup_type_id    BIGINT NOT NULL,
+    fork_id           BIGINT NOT NULL,
+    received_at       TIMESTAMP WITH TIME ZONE NOT NULL,
+    sync_version      VARCHAR(128)
+);
+
+CREATE TABLE IF NOT EXISTS sync.rollup_update
+(
+    id                                 BIGSERIAL PRIMARY KEY,
+    rollup_id                          BIGINT NOT NULL,
+    block_num                          BIGINT NOT NULL REFERENCES sync.block (block_num) ON DELETE CASCADE,
+    tx_hash                            VARCHAR(66) NOT NULL,
+    rollup_type_id                     BIGINT NOT NULL,
+    fork_id                            BIGINT NOT NULL,
+    last_verified_batch_before_upgrade BIGINT NOT NULL,
+    received_at                        TIMESTAMP WITH TIME ZONE NOT NULL,
+    sync_version                       VARCHAR(128)
+);
+
+CREATE INDEX IF NOT EXISTS rollup_type_block_num_idx ON sync.rollup_type (block_num);
+CREATE INDEX IF NOT EXISTS rollup_type_obsolete_block_num_idx ON sync.rollup_type (obsolete_block_num);
+CREATE INDEX IF NOT EXISTS rollup_block_num_idx ON sync.rollup (block_num);
+CREATE INDEX IF NOT EXISTS rollup_update_block_num_idx ON sync.rollup_update (block_num);
+CREATE INDEX IF NOT EXISTS rollup_update_rollup_id_idx ON sync.rollup_update (rollup_id, block_num);
+
+comment on column sync.rollup_type.obsolete_block_num is 'block of the ObsoleteRollupType event, NULL if the type is not obsolete';
+comment on column sync.rollup.rollup_type_id is 'initial type of the rollup, 0 if it was added with AddExistingRollup. The current one is the last sync.rollup_update';
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.rollup_update;
+DROP TABLE IF EXISTS sync.rollup;
+DROP TABLE IF EXISTS sync.rollup_type;
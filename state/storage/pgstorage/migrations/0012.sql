@@ -0,0 +1,28 @@
+-- +migrate Up
+-- Audit trail of the proxies, roles and owners of the contracts (Upgraded, RoleGranted, OwnershipTransferred...)
+CREATE TABLE IF NOT EXISTS sync.audit_event
+(
+    id                  BIGSERIAL PRIMARY KEY,
+    block_num           BIGINT NOT NULL REFERENCES sync.block (block_num) ON DELETE CASCADE,
+    tx_hash             VARCHAR(66) NOT NULL,
+    contract            VARCHAR(42) NOT NULL,
+    event_type          VARCHAR(64) NOT NULL,
+    implementation      VARCHAR(42) NOT NULL,
+    role                VARCHAR(66) NOT NULL,
+    previous_admin_role VARCHAR(66) NOT NULL,
+    admin_role          VARCHAR(66) NOT NULL,
+    previous_account    VARCHAR(42) NOT NULL,
+    account             VARCHAR(42) NOT NULL,
+    sender              VARCHAR(42) NOT NULL,
+    received_at         TIMESTAMP WITH TIME ZONE NOT NULL,
+    sync_version        VARCHAR(128)
+);
+
+CREATE INDEX IF NOT EXISTS audit_event_block_num_idx ON sync.audit_event (block_num);
+CREATE INDEX IF NOT EXISTS audit_event_contract_idx ON sync.audit_event (contract, block_num);
+
+comment on column sync.audit_event.previous_account is 'previous admin (AdminChanged) or owner (OwnershipTransferred)';
+comment on column sync.audit_event.account is 'account of the role, new admin or new owner';
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.audit_event;
@@ -0,0 +1,31 @@
+-- +migrate Up
+-- Pending states of the verifications of the non trusted aggregators, until they are consolidated or overridden
+CREATE TABLE IF NOT EXISTS sync.pending_state
+(
+    rollup_id                   BIGINT NOT NULL,
+    batch_num                   BIGINT NOT NULL,
+    block_num                   BIGINT NOT NULL REFERENCES sync.block (block_num) ON DELETE CASCADE,
+    exit_root                   VARCHAR(66) NOT NULL,
+    consolidated_block_num      BIGINT REFERENCES sync.block (block_num) ON DELETE SET NULL,
+    overridden_block_num        BIGINT REFERENCES sync.block (block_num) ON DELETE SET NULL,
+    override_state_root         VARCHAR(66),
+    non_deterministic_block_num BIGINT REFERENCES sync.block (block_num) ON DELETE SET NULL,
+    proved_state_root           VARCHAR(66),
+    received_at                 TIMESTAMP WITH TIME ZONE NOT NULL,
+    sync_version                VARCHAR(128),
+    CONSTRAINT pending_state_pkey PRIMARY KEY (rollup_id, batch_num),
+    CONSTRAINT pending_state_verified_batch_fkey FOREIGN KEY (rollup_id, batch_num)
+        REFERENCES sync.verified_batch (rollup_id, batch_num) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS pending_state_block_num_idx ON sync.pending_state (block_num);
+CREATE INDEX IF NOT EXISTS pending_state_consolidated_block_num_idx ON sync.pending_state (consolidated_block_num);
+CREATE INDEX IF NOT EXISTS pending_state_overridden_block_num_idx ON sync.pending_state (overridden_block_num);
+CREATE INDEX IF NOT EXISTS pending_state_non_deterministic_block_num_idx ON sync.pending_state (non_deterministic_block_num);
+
+comment on column sync.pending_state.batch_num is 'the state root and the aggregator are the ones of sync.verified_batch';
+comment on column sync.pending_state.override_state_root is 'state root proved by the trusted aggregator, only for the batch of the OverridePendingState and if overridden_block_num is set';
+comment on column sync.pending_state.proved_state_root is 'state root of ProveNonDeterministicPendingState, only if non_deterministic_block_num is set';
+
+-- +migrate Down
+DROP TABLE IF EXISTS sync.pending_state;
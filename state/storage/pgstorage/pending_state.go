@@ -0,0 +1,163 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	zkevm_synchronizer_l1 "github.com/0xPolygonHermez/zkevm-synchronizer-l1"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	tablePendingState  = "sync.pending_state"
+	fieldsPendingState = []string{"rollup_id", "batch_num", "block_num", "exit_root", "consolidated_block_num", "overridden_block_num",
+		"override_state_root", "non_deterministic_block_num", "proved_state_root", "received_at", "sync_version"}
+)
+
+// selectPendingStateSql returns the pending states with the data of their verification
+const selectPendingStateSql = `SELECT p.rollup_id, p.batch_num, p.block_num, v.tx_hash, v.state_root, p.exit_root, v.aggregator,
+		p.consolidated_block_num, p.overridden_block_num, p.override_state_root, p.non_deterministic_block_num, p.proved_state_root, p.received_at
+	FROM sync.pending_state p INNER JOIN sync.verified_batch v ON v.rollup_id = p.rollup_id AND v.batch_num = p.batch_num`
+
+// AddPendingState adds a new pending state to the storage, its verification must be stored
+func (p *PostgresStorage) AddPendingState(ctx context.Context, pendingState *PendingState, dbTx dbTxType) error {
+	var overrideStateRoot *string
+	if pendingState.OverrideStateRoot != nil {
+		tmp := pendingState.OverrideStateRoot.String()
+		overrideStateRoot = &tmp
+	}
+	var provedStateRoot *string
+	if pendingState.ProvedStateRoot != nil {
+		tmp := pendingState.ProvedStateRoot.String()
+		provedStateRoot = &tmp
+	}
+	arguments := []interface{}{pendingState.RollupID, pendingState.BatchNumber, pendingState.BlockNumber, pendingState.ExitRoot.String(),
+		pendingState.ConsolidatedBlockNumber, pendingState.OverriddenBlockNumber, overrideStateRoot,
+		pendingState.NonDeterministicBlockNumber, provedStateRoot, pendingState.ReceivedAt, zkevm_synchronizer_l1.Version}
+	sql := composeInsertSql(fieldsPendingState, tablePendingState)
+	e := p.getExecQuerier(getPgTx(dbTx))
+	_, err := e.Exec(ctx, sql, arguments...)
+	return translatePgxError(err, fmt.Sprintf("AddPendingState rollupID %d %d", pendingState.RollupID, pendingState.BatchNumber))
+}
+
+// ConsolidatePendingStates marks the pending states of the rollup up to batchNumber as consolidated on blockNumber,
+// it returns ErrNotFound if there are no pending states to consolidate
+func (p *PostgresStorage) ConsolidatePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx dbTxType) error {
+	const sql = `UPDATE sync.pending_state SET consolidated_block_num = $3
+		WHERE rollup_id = $1 AND batch_num <= $2 AND consolidated_block_num IS NULL AND overridden_block_num IS NULL`
+	e := p.getExecQuerier(getPgTx(dbTx))
+	res, err := e.Exec(ctx, sql, rollupID, batchNumber, blockNumber)
+	if err != nil {
+		return translatePgxError(err, fmt.Sprintf("ConsolidatePendingStates rollupID %d %d", rollupID, batchNumber))
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("ConsolidatePendingStates rollupID %d %d. Err: %w", rollupID, batchNumber, entities.ErrNotFound)
+	}
+	return nil
+}
+
+// OverridePendingStates marks all the pending states of the rollup as overridden on blockNumber, the one of batchNumber keeps
+// the stateRoot proved by the trusted aggregator. It returns ErrNotFound if there are no pending states to override
+func (p *PostgresStorage) OverridePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx dbTxType) error {
+	const sql = `UPDATE sync.pending_state SET overridden_block_num = $4,
+		override_state_root = CASE WHEN batch_num = $2 THEN $3 ELSE NULL END
+		WHERE rollup_id = $1 AND consolidated_block_num IS NULL AND overridden_block_num IS NULL`
+	e := p.getExecQuerier(getPgTx(dbTx))
+	res, err := e.Exec(ctx, sql, rollupID, batchNumber, stateRoot.String(), blockNumber)
+	if err != nil {
+		return translatePgxError(err, fmt.Sprintf("OverridePendingStates rollupID %d %d", rollupID, batchNumber))
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("OverridePendingStates rollupID %d %d. Err: %w", rollupID, batchNumber, entities.ErrNotFound)
+	}
+	return nil
+}
+
+// SetPendingStateNonDeterministic marks the pending states with storedStateRoot as non deterministic on blockNumber,
+// it returns ErrNotFound if there are no pending states with storedStateRoot
+func (p *PostgresStorage) SetPendingStateNonDeterministic(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx dbTxType) error {
+	const sql = `UPDATE sync.pending_state p SET non_deterministic_block_num = $3, proved_state_root = $2
+		FROM sync.verified_batch v
+		WHERE v.rollup_id = p.rollup_id AND v.batch_num = p.batch_num AND v.state_root = $1 AND p.non_deterministic_block_num IS NULL`
+	e := p.getExecQuerier(getPgTx(dbTx))
+	res, err := e.Exec(ctx, sql, storedStateRoot.String(), provedStateRoot.String(), blockNumber)
+	if err != nil {
+		return translatePgxError(err, fmt.Sprintf("SetPendingStateNonDeterministic %s", storedStateRoot.String()))
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("SetPendingStateNonDeterministic %s. Err: %w", storedStateRoot.String(), entities.ErrNotFound)
+	}
+	return nil
+}
+
+// GetPendingState returns the pending state of the batch, ErrNotFound if it's not stored
+func (p *PostgresStorage) GetPendingState(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx dbTxType) (*PendingState, error) {
+	sql := selectPendingStateSql + " WHERE p.rollup_id = $1 AND p.batch_num = $2"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	row := e.QueryRow(ctx, sql, rollupID, batchNumber)
+	return scanPendingState(row, fmt.Sprintf("GetPendingState rollupID %d %d", rollupID, batchNumber))
+}
+
+// GetPendingStates returns the pending states of the rollup after fromBatchNumber that are not consolidated
+// or overridden, ordered by batchNumber
+func (p *PostgresStorage) GetPendingStates(ctx context.Context, rollupID uint64, fromBatchNumber uint64, dbTx dbTxType) ([]PendingState, error) {
+	sql := selectPendingStateSql + ` WHERE p.rollup_id = $1 AND p.batch_num > $2
+		AND p.consolidated_block_num IS NULL AND p.overridden_block_num IS NULL ORDER BY p.batch_num ASC`
+	e := p.getExecQuerier(getPgTx(dbTx))
+	rows, err := e.Query(ctx, sql, rollupID, fromBatchNumber)
+	if err != nil {
+		return nil, translatePgxError(err, "GetPendingStates")
+	}
+	defer rows.Close()
+	res := []PendingState{}
+	for rows.Next() {
+		pendingState, err := scanPendingState(rows, "GetPendingStates")
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *pendingState)
+	}
+	return res, translatePgxError(rows.Err(), "GetPendingStates")
+}
+
+// GetConsolidatedBatchNumber returns the last batch of the rollup verified by the trusted aggregator, consolidated
+// or overridden. 0 if there are none
+func (p *PostgresStorage) GetConsolidatedBatchNumber(ctx context.Context, rollupID uint64, dbTx dbTxType) (uint64, error) {
+	const sql = `SELECT GREATEST(
+		(SELECT COALESCE(MAX(batch_num), 0) FROM sync.verified_batch WHERE rollup_id = $1 AND is_trusted),
+		(SELECT COALESCE(MAX(batch_num), 0) FROM sync.pending_state WHERE rollup_id = $1 AND
+			(consolidated_block_num IS NOT NULL OR (overridden_block_num IS NOT NULL AND override_state_root IS NOT NULL))))`
+	e := p.getExecQuerier(getPgTx(dbTx))
+	var res uint64
+	err := e.QueryRow(ctx, sql, rollupID).Scan(&res)
+	return res, translatePgxError(err, fmt.Sprintf("GetConsolidatedBatchNumber rollupID %d", rollupID))
+}
+
+func scanPendingState(row pgx.Row, contextDescription string) (*PendingState, error) {
+	pendingState := &PendingState{}
+	var txHash, stateRoot, exitRoot, aggregator string
+	var overrideStateRoot, provedStateRoot *string
+	err := row.Scan(&pendingState.RollupID, &pendingState.BatchNumber, &pendingState.BlockNumber, &txHash, &stateRoot, &exitRoot, &aggregator,
+		&pendingState.ConsolidatedBlockNumber, &pendingState.OverriddenBlockNumber, &overrideStateRoot,
+		&pendingState.NonDeterministicBlockNumber, &provedStateRoot, &pendingState.ReceivedAt)
+	err = translatePgxError(err, contextDescription)
+	if err != nil {
+		return nil, err
+	}
+	pendingState.TxHash = common.HexToHash(txHash)
+	pendingState.StateRoot = common.HexToHash(stateRoot)
+	pendingState.ExitRoot = common.HexToHash(exitRoot)
+	pendingState.Aggregator = common.HexToAddress(aggregator)
+	// The roots are kept after a reorg of their block, they are only valid if the block is set
+	if pendingState.OverriddenBlockNumber != nil && overrideStateRoot != nil {
+		root := common.HexToHash(*overrideStateRoot)
+		pendingState.OverrideStateRoot = &root
+	}
+	if pendingState.NonDeterministicBlockNumber != nil && provedStateRoot != nil {
+		root := common.HexToHash(*provedStateRoot)
+		pendingState.ProvedStateRoot = &root
+	}
+	return pendingState, nil
+}
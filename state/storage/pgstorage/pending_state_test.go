@@ -0,0 +1,86 @@
+package pgstorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func addPendingStateForTest(t *testing.T, storage *pgstorage.PostgresStorage, batchNumber, blockNumber uint64, stateRoot common.Hash, dbTx entities.Tx) {
+	ctx := context.TODO()
+	verifiedBatch := pgstorage.VerifiedBatch{RollupID: 1, BatchNumber: batchNumber, BlockNumber: blockNumber,
+		StateRoot:  stateRoot,
+		Aggregator: common.HexToAddress("0x5678"),
+		TxHash:     common.HexToHash("0x9abc"),
+		ReceivedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, storage.AddVerifiedBatch(ctx, &verifiedBatch, dbTx))
+	pendingState := pgstorage.PendingState{RollupID: 1, BatchNumber: batchNumber, BlockNumber: blockNumber,
+		ExitRoot:   common.HexToHash("0xdef0"),
+		ReceivedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, storage.AddPendingState(ctx, &pendingState, dbTx))
+}
+
+func TestPendingStates(t *testing.T) {
+	skipDatabaseTestIfNeeded(t)
+	storage := initDbForTest(t)
+	ctx := context.TODO()
+	dbTx, err := storage.BeginTransaction(ctx)
+	require.NoError(t, err)
+	defer func() { _ = dbTx.Rollback(ctx) }()
+	for _, blockNumber := range []uint64{123, 124, 125, 126} {
+		err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: blockNumber}, dbTx)
+		require.NoError(t, err)
+	}
+	addPendingStateForTest(t, storage, 10, 123, common.HexToHash("0x10"), dbTx)
+	addPendingStateForTest(t, storage, 20, 124, common.HexToHash("0x20"), dbTx)
+
+	pendingState, err := storage.GetPendingState(ctx, 1, 10, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash("0x10"), pendingState.StateRoot)
+	require.Equal(t, common.HexToHash("0xdef0"), pendingState.ExitRoot)
+	require.Equal(t, common.HexToAddress("0x5678"), pendingState.Aggregator)
+	consolidated, err := storage.GetConsolidatedBatchNumber(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), consolidated)
+
+	require.NoError(t, storage.ConsolidatePendingStates(ctx, 1, 10, 125, dbTx))
+	require.ErrorIs(t, storage.ConsolidatePendingStates(ctx, 1, 10, 125, dbTx), entities.ErrNotFound)
+	consolidated, err = storage.GetConsolidatedBatchNumber(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), consolidated)
+	pending, err := storage.GetPendingStates(ctx, 1, consolidated, dbTx)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, uint64(20), pending[0].BatchNumber)
+
+	require.NoError(t, storage.SetPendingStateNonDeterministic(ctx, common.HexToHash("0x20"), common.HexToHash("0x21"), 126, dbTx))
+	require.NoError(t, storage.OverridePendingStates(ctx, 1, 20, common.HexToHash("0x21"), 126, dbTx))
+	consolidated, err = storage.GetConsolidatedBatchNumber(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), consolidated)
+	pendingState, err = storage.GetPendingState(ctx, 1, 20, dbTx)
+	require.NoError(t, err)
+	require.True(t, pendingState.IsOverridden())
+	require.Equal(t, common.HexToHash("0x21"), *pendingState.OverrideStateRoot)
+	require.True(t, pendingState.IsNonDeterministic())
+	require.Equal(t, common.HexToHash("0x21"), *pendingState.ProvedStateRoot)
+
+	// The consolidation and the override are undone with their block
+	err = storage.ResetToL1BlockNumber(ctx, 124, dbTx)
+	require.NoError(t, err)
+	consolidated, err = storage.GetConsolidatedBatchNumber(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), consolidated)
+	pending, err = storage.GetPendingStates(ctx, 1, consolidated, dbTx)
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	require.False(t, pending[1].IsNonDeterministic())
+	require.Nil(t, pending[1].OverrideStateRoot)
+}
@@ -40,7 +40,7 @@ func TestQueries(t *testing.T) {
 	}
 	err = storage.AddSequencedBatches(context.Background(), &sb, dbTx)
 	require.NoError(t, err)
-	data, err := storage.GetSequenceByBatchNumber(context.Background(), uint64(102), dbTx)
+	data, err := storage.GetSequenceByBatchNumber(context.Background(), sb.RollupID, uint64(102), dbTx)
 	require.NoError(t, err)
 	require.Equal(t, sb.FromBatchNumber, data.FromBatchNumber)
 }
@@ -0,0 +1,62 @@
+package pgstorage
+
+import (
+	"context"
+	"time"
+
+	zkevm_synchronizer_l1 "github.com/0xPolygonHermez/zkevm-synchronizer-l1"
+	"github.com/jackc/pgx/v4"
+)
+
+// AddReorgLog stores a reorg executed, ReorgID and SyncVersion are assigned by storage
+func (p *PostgresStorage) AddReorgLog(ctx context.Context, entry *ReorgLogEntry, dbTx dbTxType) error {
+	const addReorgLogSQL = `INSERT INTO sync.reorg_log ("timestamp", block_num, reason, deleted_blocks, deleted_virtual_batches,
+		deleted_l1_info_tree_leaves, duration_ms, sync_version) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING reorg_id`
+	e := p.getExecQuerier(getPgTx(dbTx))
+	row := e.QueryRow(ctx, addReorgLogSQL, entry.Timestamp, entry.FirstL1BlockNumberKept, entry.Reason, entry.DeletedBlocks,
+		entry.DeletedVirtualBatches, entry.DeletedL1InfoTreeLeaves, entry.Duration.Milliseconds(), zkevm_synchronizer_l1.Version)
+	err := translatePgxError(row.Scan(&entry.ReorgID), "AddReorgLog")
+	if err != nil {
+		return err
+	}
+	entry.SyncVersion = zkevm_synchronizer_l1.Version
+	return nil
+}
+
+// GetReorgLogs returns the reorgs executed since fromTimestamp (included), ordered by execution. limit 0 means no limit
+func (p *PostgresStorage) GetReorgLogs(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx dbTxType) ([]ReorgLogEntry, error) {
+	sql := `SELECT reorg_id, "timestamp", COALESCE(block_num, 0), reason, deleted_blocks, deleted_virtual_batches,
+		deleted_l1_info_tree_leaves, duration_ms, COALESCE(sync_version, '') FROM sync.reorg_log WHERE "timestamp" >= $1 ORDER BY reorg_id`
+	args := []interface{}{fromTimestamp}
+	if limit > 0 {
+		sql += " LIMIT $2"
+		args = append(args, limit)
+	}
+	e := p.getExecQuerier(getPgTx(dbTx))
+	rows, err := e.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, translatePgxError(err, "GetReorgLogs")
+	}
+	defer rows.Close()
+	res := []ReorgLogEntry{}
+	for rows.Next() {
+		entry, err := scanReorgLog(rows)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *entry)
+	}
+	return res, translatePgxError(rows.Err(), "GetReorgLogs")
+}
+
+func scanReorgLog(row pgx.Row) (*ReorgLogEntry, error) {
+	entry := &ReorgLogEntry{}
+	var durationMs int64
+	err := row.Scan(&entry.ReorgID, &entry.Timestamp, &entry.FirstL1BlockNumberKept, &entry.Reason, &entry.DeletedBlocks,
+		&entry.DeletedVirtualBatches, &entry.DeletedL1InfoTreeLeaves, &durationMs, &entry.SyncVersion)
+	if err != nil {
+		return nil, translatePgxError(err, "scanReorgLog")
+	}
+	entry.Duration = time.Duration(durationMs) * time.Millisecond
+	return entry, nil
+}
@@ -0,0 +1,55 @@
+package pgstorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	zkevm_synchronizer_l1 "github.com/0xPolygonHermez/zkevm-synchronizer-l1"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReorgLog(t *testing.T) {
+	skipDatabaseTestIfNeeded(t)
+	storage := initDbForTest(t)
+	ctx := context.TODO()
+	dbTx, err := storage.BeginTransaction(ctx)
+	require.NoError(t, err)
+	defer func() { _ = dbTx.Rollback(ctx) }()
+	for _, blockNumber := range []uint64{100, 101, 102} {
+		err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: blockNumber}, dbTx)
+		require.NoError(t, err)
+	}
+	impact, err := storage.GetReorgImpact(ctx, 100, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), impact.Blocks)
+	require.Equal(t, uint64(102), impact.LastL1BlockNumber)
+	require.Equal(t, uint64(0), impact.CheckedBlocks)
+	require.Equal(t, uint64(101), impact.FirstDeletedBlockNumber)
+	require.Nil(t, impact.L1InfoTreeIndexRange)
+
+	now := time.Now().Truncate(time.Second)
+	entry := &pgstorage.ReorgLogEntry{
+		Timestamp:              now,
+		FirstL1BlockNumberKept: 100,
+		Reason:                 "test",
+		DeletedBlocks:          impact.Blocks,
+		Duration:               1500 * time.Millisecond,
+	}
+	err = storage.AddReorgLog(ctx, entry, dbTx)
+	require.NoError(t, err)
+	require.NotEqual(t, uint64(0), entry.ReorgID)
+
+	entries, err := storage.GetReorgLogs(ctx, now, 0, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	require.Equal(t, entry.ReorgID, entries[0].ReorgID)
+	require.Equal(t, uint64(2), entries[0].DeletedBlocks)
+	require.Equal(t, 1500*time.Millisecond, entries[0].Duration)
+	require.Equal(t, zkevm_synchronizer_l1.Version, entries[0].SyncVersion)
+
+	entries, err = storage.GetReorgLogs(ctx, now.Add(time.Second), 0, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+}
@@ -2,6 +2,10 @@ package pgstorage
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // ResetToL1BlockNumber resets the state to a block for the given DB tx
@@ -13,3 +17,55 @@ func (p *PostgresStorage) ResetToL1BlockNumber(ctx context.Context, firstBlockNu
 	}
 	return nil
 }
+
+// GetReorgImpact returns the data that ResetToL1BlockNumber would delete, without deleting it
+func (p *PostgresStorage) GetReorgImpact(ctx context.Context, firstBlockNumberToKeep uint64, dbTx dbTxType) (*ReorgImpact, error) {
+	const reorgImpactSQL = `SELECT
+		(SELECT COALESCE(MAX(block_num), 0) FROM sync.block WHERE block_num > $1),
+		(SELECT COUNT(*) FROM sync.block WHERE block_num > $1),
+		(SELECT COUNT(*) FROM sync.block WHERE block_num > $1 AND checked),
+		(SELECT COUNT(*) FROM sync.sequenced_batches WHERE block_num > $1),
+		(SELECT COUNT(*) FROM sync.virtual_batch WHERE block_num > $1),
+		(SELECT COUNT(*) FROM sync.exit_root WHERE block_num > $1),
+		(SELECT COALESCE(MIN(block_num), 0) FROM sync.block WHERE block_num > $1),
+		(SELECT block_hash FROM sync.block WHERE block_num > $1 ORDER BY block_num LIMIT 1),
+		(SELECT MIN(l1_info_tree_index) FROM sync.exit_root WHERE block_num > $1),
+		(SELECT MAX(l1_info_tree_index) FROM sync.exit_root WHERE block_num > $1)`
+	const virtualBatchRangesSQL = `SELECT rollup_id, MIN(batch_num), MAX(batch_num) FROM sync.virtual_batch
+		WHERE block_num > $1 GROUP BY rollup_id ORDER BY rollup_id`
+	e := p.getExecQuerier(getPgTx(dbTx))
+	res := &ReorgImpact{FirstL1BlockNumberToKeep: firstBlockNumberToKeep}
+	var firstDeletedBlockHash *string
+	var fromL1InfoTreeIndex, toL1InfoTreeIndex *uint32
+	err := e.QueryRow(ctx, reorgImpactSQL, firstBlockNumberToKeep).Scan(&res.LastL1BlockNumber, &res.Blocks, &res.CheckedBlocks,
+		&res.SequencedBatches, &res.VirtualBatches, &res.L1InfoTreeLeaves, &res.FirstDeletedBlockNumber, &firstDeletedBlockHash,
+		&fromL1InfoTreeIndex, &toL1InfoTreeIndex)
+	err = translatePgxError(err, fmt.Sprintf("GetReorgImpact %d", firstBlockNumberToKeep))
+	if err != nil {
+		return nil, err
+	}
+	if firstDeletedBlockHash != nil {
+		res.FirstDeletedBlockHash = common.HexToHash(*firstDeletedBlockHash)
+	}
+	if fromL1InfoTreeIndex != nil && toL1InfoTreeIndex != nil {
+		res.L1InfoTreeIndexRange = &entities.L1InfoTreeIndexRange{FromIndex: *fromL1InfoTreeIndex, ToIndex: *toL1InfoTreeIndex}
+	}
+	rows, err := e.Query(ctx, virtualBatchRangesSQL, firstBlockNumberToKeep)
+	if err != nil {
+		return nil, translatePgxError(err, "GetReorgImpact virtual batch ranges")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var batchRange entities.BatchNumberRange
+		err = rows.Scan(&batchRange.RollupID, &batchRange.FromBatchNumber, &batchRange.ToBatchNumber)
+		if err != nil {
+			return nil, translatePgxError(err, "GetReorgImpact virtual batch ranges")
+		}
+		res.VirtualBatchRanges = append(res.VirtualBatchRanges, batchRange)
+	}
+	err = translatePgxError(rows.Err(), "GetReorgImpact virtual batch ranges")
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
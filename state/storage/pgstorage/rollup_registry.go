@@ -0,0 +1,161 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	zkevm_synchronizer_l1 "github.com/0xPolygonHermez/zkevm-synchronizer-l1"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	tableRollupType  = "sync.rollup_type"
+	fieldsRollupType = []string{"rollup_type_id", "block_num", "tx_hash", "consensus_implementation", "verifier", "fork_id",
+		"rollup_compatibility_id", "genesis", "description", "obsolete_block_num", "received_at", "sync_version"}
+	tableRollup  = "sync.rollup"
+	fieldsRollup = []string{"rollup_id", "block_num", "tx_hash", "rollup_address", "chain_id", "gas_token_address",
+		"rollup_type_id", "fork_id", "received_at", "sync_version"}
+	tableRollupUpdate  = "sync.rollup_update"
+	fieldsRollupUpdate = []string{"rollup_id", "block_num", "tx_hash", "rollup_type_id", "fork_id",
+		"last_verified_batch_before_upgrade", "received_at", "sync_version"}
+)
+
+// selectRollupSql returns the rollups with the type of their last update, or the initial one if they have not been updated
+const selectRollupSql = `SELECT r.rollup_id, r.block_num, r.tx_hash, r.rollup_address, r.chain_id, r.gas_token_address,
+		COALESCE(u.rollup_type_id, r.rollup_type_id), COALESCE(u.fork_id, r.fork_id), r.received_at
+	FROM sync.rollup r LEFT JOIN LATERAL (
+		SELECT rollup_type_id, fork_id FROM sync.rollup_update
+		WHERE rollup_id = r.rollup_id ORDER BY block_num DESC, id DESC LIMIT 1) u ON TRUE`
+
+// AddRollupType adds a new rollup type to the storage
+func (p *PostgresStorage) AddRollupType(ctx context.Context, rollupType *RollupType, dbTx dbTxType) error {
+	arguments := []interface{}{rollupType.RollupTypeID, rollupType.BlockNumber, rollupType.TxHash.String(),
+		rollupType.ConsensusImplementation.String(), rollupType.Verifier.String(), rollupType.ForkID, rollupType.RollupCompatibilityID,
+		rollupType.Genesis.String(), rollupType.Description, rollupType.ObsoleteBlockNumber, rollupType.ReceivedAt, zkevm_synchronizer_l1.Version}
+	sql := composeInsertSql(fieldsRollupType, tableRollupType)
+	e := p.getExecQuerier(getPgTx(dbTx))
+	_, err := e.Exec(ctx, sql, arguments...)
+	return translatePgxError(err, fmt.Sprintf("AddRollupType rollupTypeID %d", rollupType.RollupTypeID))
+}
+
+// SetRollupTypeObsolete marks the rollup type as obsolete on blockNumber, it returns ErrNotFound if the type is not stored
+func (p *PostgresStorage) SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx dbTxType) error {
+	const sql = "UPDATE sync.rollup_type SET obsolete_block_num = $2 WHERE rollup_type_id = $1"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	res, err := e.Exec(ctx, sql, rollupTypeID, blockNumber)
+	if err != nil {
+		return translatePgxError(err, fmt.Sprintf("SetRollupTypeObsolete rollupTypeID %d", rollupTypeID))
+	}
+	if res.RowsAffected() == 0 {
+		return fmt.Errorf("SetRollupTypeObsolete rollupTypeID %d. Err: %w", rollupTypeID, entities.ErrNotFound)
+	}
+	return nil
+}
+
+// GetRollupType returns the rollup type, ErrNotFound if it's not stored
+func (p *PostgresStorage) GetRollupType(ctx context.Context, rollupTypeID uint64, dbTx dbTxType) (*RollupType, error) {
+	sql := composeSelectSql(fieldsRollupType, tableRollupType, "rollup_type_id = $1")
+	e := p.getExecQuerier(getPgTx(dbTx))
+	row := e.QueryRow(ctx, sql, rollupTypeID)
+	return scanRollupType(row, fmt.Sprintf("GetRollupType rollupTypeID %d", rollupTypeID))
+}
+
+// GetRollupTypes returns all the rollup types ordered by rollupTypeID
+func (p *PostgresStorage) GetRollupTypes(ctx context.Context, dbTx dbTxType) ([]RollupType, error) {
+	sql := composeSelectSql(fieldsRollupType, tableRollupType, "") + " ORDER BY rollup_type_id ASC"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	rows, err := e.Query(ctx, sql)
+	if err != nil {
+		return nil, translatePgxError(err, "GetRollupTypes")
+	}
+	defer rows.Close()
+	res := []RollupType{}
+	for rows.Next() {
+		rollupType, err := scanRollupType(rows, "GetRollupTypes")
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *rollupType)
+	}
+	return res, translatePgxError(rows.Err(), "GetRollupTypes")
+}
+
+// AddRollup adds a new rollup to the storage
+func (p *PostgresStorage) AddRollup(ctx context.Context, rollup *Rollup, dbTx dbTxType) error {
+	arguments := []interface{}{rollup.RollupID, rollup.BlockNumber, rollup.TxHash.String(), rollup.RollupAddress.String(), rollup.ChainID,
+		rollup.GasTokenAddress.String(), rollup.RollupTypeID, rollup.ForkID, rollup.ReceivedAt, zkevm_synchronizer_l1.Version}
+	sql := composeInsertSql(fieldsRollup, tableRollup)
+	e := p.getExecQuerier(getPgTx(dbTx))
+	_, err := e.Exec(ctx, sql, arguments...)
+	return translatePgxError(err, fmt.Sprintf("AddRollup rollupID %d", rollup.RollupID))
+}
+
+// AddRollupUpdate adds an upgrade of a rollup to a new rollup type
+func (p *PostgresStorage) AddRollupUpdate(ctx context.Context, rollupUpdate *RollupUpdate, dbTx dbTxType) error {
+	arguments := []interface{}{rollupUpdate.RollupID, rollupUpdate.BlockNumber, rollupUpdate.TxHash.String(), rollupUpdate.RollupTypeID,
+		rollupUpdate.ForkID, rollupUpdate.LastVerifiedBatchBeforeUpgrade, rollupUpdate.ReceivedAt, zkevm_synchronizer_l1.Version}
+	sql := composeInsertSql(fieldsRollupUpdate, tableRollupUpdate)
+	e := p.getExecQuerier(getPgTx(dbTx))
+	_, err := e.Exec(ctx, sql, arguments...)
+	return translatePgxError(err, fmt.Sprintf("AddRollupUpdate rollupID %d", rollupUpdate.RollupID))
+}
+
+// GetRollup returns the rollup with its current type, ErrNotFound if it's not stored
+func (p *PostgresStorage) GetRollup(ctx context.Context, rollupID uint64, dbTx dbTxType) (*Rollup, error) {
+	e := p.getExecQuerier(getPgTx(dbTx))
+	row := e.QueryRow(ctx, selectRollupSql+" WHERE r.rollup_id = $1", rollupID)
+	return scanRollup(row, fmt.Sprintf("GetRollup rollupID %d", rollupID))
+}
+
+// GetRollups returns all the rollups with its current type ordered by rollupID
+func (p *PostgresStorage) GetRollups(ctx context.Context, dbTx dbTxType) ([]Rollup, error) {
+	e := p.getExecQuerier(getPgTx(dbTx))
+	rows, err := e.Query(ctx, selectRollupSql+" ORDER BY r.rollup_id ASC")
+	if err != nil {
+		return nil, translatePgxError(err, "GetRollups")
+	}
+	defer rows.Close()
+	res := []Rollup{}
+	for rows.Next() {
+		rollup, err := scanRollup(rows, "GetRollups")
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, *rollup)
+	}
+	return res, translatePgxError(rows.Err(), "GetRollups")
+}
+
+func scanRollupType(row pgx.Row, contextDescription string) (*RollupType, error) {
+	rollupType := &RollupType{}
+	var txHash, consensusImplementation, verifier, genesis string
+	var syncVersion *string
+	err := row.Scan(&rollupType.RollupTypeID, &rollupType.BlockNumber, &txHash, &consensusImplementation, &verifier, &rollupType.ForkID,
+		&rollupType.RollupCompatibilityID, &genesis, &rollupType.Description, &rollupType.ObsoleteBlockNumber, &rollupType.ReceivedAt, &syncVersion)
+	err = translatePgxError(err, contextDescription)
+	if err != nil {
+		return nil, err
+	}
+	rollupType.TxHash = common.HexToHash(txHash)
+	rollupType.ConsensusImplementation = common.HexToAddress(consensusImplementation)
+	rollupType.Verifier = common.HexToAddress(verifier)
+	rollupType.Genesis = common.HexToHash(genesis)
+	return rollupType, nil
+}
+
+func scanRollup(row pgx.Row, contextDescription string) (*Rollup, error) {
+	rollup := &Rollup{}
+	var txHash, rollupAddress, gasTokenAddress string
+	err := row.Scan(&rollup.RollupID, &rollup.BlockNumber, &txHash, &rollupAddress, &rollup.ChainID, &gasTokenAddress,
+		&rollup.RollupTypeID, &rollup.ForkID, &rollup.ReceivedAt)
+	err = translatePgxError(err, contextDescription)
+	if err != nil {
+		return nil, err
+	}
+	rollup.TxHash = common.HexToHash(txHash)
+	rollup.RollupAddress = common.HexToAddress(rollupAddress)
+	rollup.GasTokenAddress = common.HexToAddress(gasTokenAddress)
+	return rollup, nil
+}
@@ -0,0 +1,103 @@
+package pgstorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupTypes(t *testing.T) {
+	skipDatabaseTestIfNeeded(t)
+	storage := initDbForTest(t)
+	ctx := context.TODO()
+	dbTx, err := storage.BeginTransaction(ctx)
+	require.NoError(t, err)
+	defer func() { _ = dbTx.Rollback(ctx) }()
+	for _, blockNumber := range []uint64{123, 124} {
+		err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: blockNumber}, dbTx)
+		require.NoError(t, err)
+	}
+
+	_, err = storage.GetRollupType(ctx, 1, dbTx)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+	require.ErrorIs(t, storage.SetRollupTypeObsolete(ctx, 1, 124, dbTx), entities.ErrNotFound)
+
+	rollupType := pgstorage.RollupType{
+		RollupTypeID:            1,
+		BlockNumber:             123,
+		TxHash:                  common.HexToHash("0x1234"),
+		ConsensusImplementation: common.HexToAddress("0x10"),
+		Verifier:                common.HexToAddress("0x11"),
+		ForkID:                  9,
+		Genesis:                 common.HexToHash("0x12"),
+		Description:             "fork9",
+		ReceivedAt:              time.Date(2023, 3, 24, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, storage.AddRollupType(ctx, &rollupType, dbTx))
+	require.NoError(t, storage.SetRollupTypeObsolete(ctx, 1, 124, dbTx))
+
+	stored, err := storage.GetRollupType(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.True(t, stored.IsObsolete())
+	require.Equal(t, uint64(124), *stored.ObsoleteBlockNumber)
+	require.Equal(t, rollupType.Genesis, stored.Genesis)
+
+	// Deleting the block of ObsoleteRollupType makes the type usable again
+	require.NoError(t, storage.ResetToL1BlockNumber(ctx, 123, dbTx))
+	rollupTypes, err := storage.GetRollupTypes(ctx, dbTx)
+	require.NoError(t, err)
+	require.Len(t, rollupTypes, 1)
+	require.False(t, rollupTypes[0].IsObsolete())
+}
+
+func TestRollupsWithUpdates(t *testing.T) {
+	skipDatabaseTestIfNeeded(t)
+	storage := initDbForTest(t)
+	ctx := context.TODO()
+	dbTx, err := storage.BeginTransaction(ctx)
+	require.NoError(t, err)
+	defer func() { _ = dbTx.Rollback(ctx) }()
+	for _, blockNumber := range []uint64{123, 124, 125} {
+		err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: blockNumber}, dbTx)
+		require.NoError(t, err)
+	}
+
+	rollup := pgstorage.Rollup{
+		RollupID:      1,
+		BlockNumber:   123,
+		TxHash:        common.HexToHash("0x1234"),
+		RollupAddress: common.HexToAddress("0x20"),
+		ChainID:       1101,
+		RollupTypeID:  0,
+		ForkID:        6,
+		ReceivedAt:    time.Date(2023, 3, 24, 0, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, storage.AddRollup(ctx, &rollup, dbTx))
+	for _, update := range []pgstorage.RollupUpdate{{RollupID: 1, BlockNumber: 124, RollupTypeID: 2, ForkID: 7},
+		{RollupID: 1, BlockNumber: 125, RollupTypeID: 3, ForkID: 9}} {
+		update := update
+		require.NoError(t, storage.AddRollupUpdate(ctx, &update, dbTx))
+	}
+
+	stored, err := storage.GetRollup(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), stored.RollupTypeID)
+	require.Equal(t, uint64(9), stored.ForkID)
+	require.Equal(t, rollup.RollupAddress, stored.RollupAddress)
+
+	require.NoError(t, storage.ResetToL1BlockNumber(ctx, 124, dbTx))
+	rollups, err := storage.GetRollups(ctx, dbTx)
+	require.NoError(t, err)
+	require.Len(t, rollups, 1)
+	require.Equal(t, uint64(2), rollups[0].RollupTypeID)
+	require.Equal(t, uint64(7), rollups[0].ForkID)
+
+	require.NoError(t, storage.ResetToL1BlockNumber(ctx, 122, dbTx))
+	_, err = storage.GetRollup(ctx, 1, dbTx)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+}
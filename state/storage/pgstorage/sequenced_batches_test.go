@@ -21,6 +21,7 @@ func TestAddSequence(t *testing.T) {
 	require.NoError(t, err)
 
 	seq := &pgstorage.SequencedBatches{
+		RollupID:        1,
 		FromBatchNumber: 100,
 		ToBatchNumber:   300,
 		L1BlockNumber:   123,
@@ -31,7 +32,7 @@ func TestAddSequence(t *testing.T) {
 	err = storage.AddSequencedBatches(ctx, seq, dbTx)
 	require.NoError(t, err)
 
-	seqDb, err := storage.GetSequenceByBatchNumber(ctx, seq.FromBatchNumber, dbTx)
+	seqDb, err := storage.GetSequenceByBatchNumber(ctx, seq.RollupID, seq.FromBatchNumber, dbTx)
 	require.NoError(t, err)
 	require.Equal(t, seq.FromBatchNumber, seqDb.FromBatchNumber)
 	require.Equal(t, seq, seqDb)
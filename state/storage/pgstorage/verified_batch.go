@@ -0,0 +1,58 @@
+package pgstorage
+
+import (
+	"context"
+	"fmt"
+
+	zkevm_synchronizer_l1 "github.com/0xPolygonHermez/zkevm-synchronizer-l1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v4"
+)
+
+var (
+	tableVerifiedBatch  = "sync.verified_batch"
+	fieldsVerifiedBatch = []string{"rollup_id", "batch_num", "block_num", "state_root", "aggregator", "tx_hash", "is_trusted", "received_at", "sync_version"}
+)
+
+// AddVerifiedBatch adds a new verification of batches to the storage
+func (p *PostgresStorage) AddVerifiedBatch(ctx context.Context, verifiedBatch *VerifiedBatch, dbTx dbTxType) error {
+	arguments := []interface{}{verifiedBatch.RollupID, verifiedBatch.BatchNumber, verifiedBatch.BlockNumber, verifiedBatch.StateRoot.String(),
+		verifiedBatch.Aggregator.String(), verifiedBatch.TxHash.String(), verifiedBatch.IsTrusted, verifiedBatch.ReceivedAt, zkevm_synchronizer_l1.Version}
+	sql := composeInsertSql(fieldsVerifiedBatch, tableVerifiedBatch)
+	e := p.getExecQuerier(getPgTx(dbTx))
+	_, err := e.Exec(ctx, sql, arguments...)
+	return translatePgxError(err, fmt.Sprintf("AddVerifiedBatch rollupID %d %d", verifiedBatch.RollupID, verifiedBatch.BatchNumber))
+}
+
+// GetLastVerifiedBatch returns the verification of the highest batch of the rollup
+func (p *PostgresStorage) GetLastVerifiedBatch(ctx context.Context, rollupID uint64, dbTx dbTxType) (*VerifiedBatch, error) {
+	sql := composeSelectSql(fieldsVerifiedBatch, tableVerifiedBatch, "rollup_id = $1") + " ORDER BY batch_num DESC LIMIT 1"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	row := e.QueryRow(ctx, sql, rollupID)
+	return scanVerifiedBatch(row, fmt.Sprintf("GetLastVerifiedBatch rollupID %d", rollupID))
+}
+
+// GetVerifiedBatchByNumber returns the first verification that includes batchNumber, the
+// BatchNumber of the result can be greater than batchNumber because the batches are verified in ranges
+func (p *PostgresStorage) GetVerifiedBatchByNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx dbTxType) (*VerifiedBatch, error) {
+	sql := composeSelectSql(fieldsVerifiedBatch, tableVerifiedBatch, "rollup_id = $1 AND batch_num >= $2") + " ORDER BY batch_num ASC LIMIT 1"
+	e := p.getExecQuerier(getPgTx(dbTx))
+	row := e.QueryRow(ctx, sql, rollupID, batchNumber)
+	return scanVerifiedBatch(row, fmt.Sprintf("GetVerifiedBatchByNumber rollupID %d %d", rollupID, batchNumber))
+}
+
+func scanVerifiedBatch(row pgx.Row, contextDescription string) (*VerifiedBatch, error) {
+	verifiedBatch := &VerifiedBatch{}
+	var stateRoot, aggregator, txHash string
+	var syncVersion *string
+	err := row.Scan(&verifiedBatch.RollupID, &verifiedBatch.BatchNumber, &verifiedBatch.BlockNumber, &stateRoot, &aggregator, &txHash,
+		&verifiedBatch.IsTrusted, &verifiedBatch.ReceivedAt, &syncVersion)
+	err = translatePgxError(err, contextDescription)
+	if err != nil {
+		return nil, err
+	}
+	verifiedBatch.StateRoot = common.HexToHash(stateRoot)
+	verifiedBatch.Aggregator = common.HexToAddress(aggregator)
+	verifiedBatch.TxHash = common.HexToHash(txHash)
+	return verifiedBatch, nil
+}
@@ -0,0 +1,65 @@
+package pgstorage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifiedBatches(t *testing.T) {
+	skipDatabaseTestIfNeeded(t)
+	storage := initDbForTest(t)
+	ctx := context.TODO()
+	dbTx, err := storage.BeginTransaction(ctx)
+	require.NoError(t, err)
+	defer func() { _ = dbTx.Rollback(ctx) }()
+	err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: 123}, dbTx)
+	require.NoError(t, err)
+	err = storage.AddBlock(ctx, &pgstorage.L1Block{BlockNumber: 124}, dbTx)
+	require.NoError(t, err)
+
+	_, err = storage.GetLastVerifiedBatch(ctx, 1, dbTx)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	verified1 := pgstorage.VerifiedBatch{RollupID: 1, BatchNumber: 10, BlockNumber: 123,
+		StateRoot:  common.HexToHash("0x1234"),
+		Aggregator: common.HexToAddress("0x5678"),
+		TxHash:     common.HexToHash("0x9abc"),
+		IsTrusted:  true,
+		ReceivedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	verified2 := verified1
+	verified2.BatchNumber = 20
+	verified2.BlockNumber = 124
+	require.NoError(t, storage.AddVerifiedBatch(ctx, &verified1, dbTx))
+	require.NoError(t, storage.AddVerifiedBatch(ctx, &verified2, dbTx))
+	require.ErrorIs(t, storage.AddVerifiedBatch(ctx, &verified2, dbTx), entities.ErrAlreadyExists)
+
+	last, err := storage.GetLastVerifiedBatch(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.True(t, verified2.IsEqual(last), last.String())
+
+	// Batch 15 is verified by the verification of batch 20
+	byNumber, err := storage.GetVerifiedBatchByNumber(ctx, 1, 15, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), byNumber.BatchNumber)
+	byNumber, err = storage.GetVerifiedBatchByNumber(ctx, 1, 10, dbTx)
+	require.NoError(t, err)
+	require.True(t, verified1.IsEqual(byNumber), byNumber.String())
+	_, err = storage.GetVerifiedBatchByNumber(ctx, 1, 21, dbTx)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+	_, err = storage.GetVerifiedBatchByNumber(ctx, 2, 10, dbTx)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	// The verifications are deleted with its block
+	err = storage.ResetToL1BlockNumber(ctx, 123, dbTx)
+	require.NoError(t, err)
+	last, err = storage.GetLastVerifiedBatch(ctx, 1, dbTx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), last.BatchNumber)
+}
@@ -12,14 +12,14 @@ import (
 
 var (
 	tableVirtualBatch           = "sync.virtual_batch"
-	mandatoryFieldsVirtualBatch = []string{"batch_num", "fork_id", "raw_txs_data", "vlog_tx_hash", "coinbase", "sequence_from_batch_num", "block_num",
+	mandatoryFieldsVirtualBatch = []string{"rollup_id", "batch_num", "fork_id", "raw_txs_data", "vlog_tx_hash", "coinbase", "sequence_from_batch_num", "block_num",
 		"sequencer_addr", "received_at", "sync_version"}
-	optionalFieldsVirtualBatch = []string{"l1_info_root", "extra_info", "batch_timestamp"}
+	optionalFieldsVirtualBatch = []string{"l1_info_root", "extra_info", "batch_timestamp", "global_exit_root"}
 )
 
 // AddVirtualBatch adds a new virtual batch to the storage.
 func (p *PostgresStorage) AddVirtualBatch(ctx context.Context, virtualBatch *VirtualBatch, dbTx dbTxType) error {
-	mandatoryArguments := []interface{}{virtualBatch.BatchNumber, virtualBatch.ForkID, virtualBatch.BatchL2Data, virtualBatch.VlogTxHash.String(),
+	mandatoryArguments := []interface{}{virtualBatch.RollupID, virtualBatch.BatchNumber, virtualBatch.ForkID, virtualBatch.BatchL2Data, virtualBatch.VlogTxHash.String(),
 		virtualBatch.Coinbase.String(), virtualBatch.SequenceFromBatchNumber, virtualBatch.BlockNumber, virtualBatch.SequencerAddr.String(), virtualBatch.ReceivedAt, zkevm_synchronizer_l1.Version}
 
 	var l1inforoot *string
@@ -27,36 +27,40 @@ func (p *PostgresStorage) AddVirtualBatch(ctx context.Context, virtualBatch *Vir
 		tmp := virtualBatch.L1InfoRoot.String()
 		l1inforoot = &tmp
 	}
-	optionalArguments := []interface{}{l1inforoot, virtualBatch.ExtraInfo, virtualBatch.BatchTimestamp}
+	var globalExitRoot *string
+	if virtualBatch.GlobalExitRoot != nil {
+		tmp := virtualBatch.GlobalExitRoot.String()
+		globalExitRoot = &tmp
+	}
+	optionalArguments := []interface{}{l1inforoot, virtualBatch.ExtraInfo, virtualBatch.BatchTimestamp, globalExitRoot}
 	fields := append(mandatoryFieldsVirtualBatch, optionalFieldsVirtualBatch...)
 	arguments := append(mandatoryArguments, optionalArguments...)
 	sql := composeInsertSql(fields, tableVirtualBatch)
 	e := p.getExecQuerier(getPgTx(dbTx))
 	_, err := e.Exec(ctx, sql, arguments...)
-	err = translatePgxError(err, fmt.Sprintf("AddVirtualBatch %d", virtualBatch.BatchNumber))
+	err = translatePgxError(err, fmt.Sprintf("AddVirtualBatch rollupID %d %d", virtualBatch.RollupID, virtualBatch.BatchNumber))
 	return err
 
 }
 
-func (p *PostgresStorage) GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber uint64, dbTx dbTxType) (*VirtualBatch, error) {
+func (p *PostgresStorage) GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx dbTxType) (*VirtualBatch, error) {
 	fields := append(mandatoryFieldsVirtualBatch, optionalFieldsVirtualBatch...)
-	sql := composeSelectSql(fields, tableVirtualBatch, "batch_num = $1")
+	sql := composeSelectSql(fields, tableVirtualBatch, "rollup_id = $1 AND batch_num = $2")
 	e := p.getExecQuerier(getPgTx(dbTx))
-	row := e.QueryRow(ctx, sql, batchNumber)
-	return scanVirtualBatch(row, fmt.Sprintf("GetVirtualBatchByBatchNumber %d", batchNumber))
+	row := e.QueryRow(ctx, sql, rollupID, batchNumber)
+	return scanVirtualBatch(row, fmt.Sprintf("GetVirtualBatchByBatchNumber rollupID %d %d", rollupID, batchNumber))
 }
 
-func (p *PostgresStorage) GetLastestVirtualBatchNumber(ctx context.Context, constrains *VirtualBatchConstraints, dbTx dbTxType) (uint64, error) {
-	whereClause := ""
+func (p *PostgresStorage) GetLastestVirtualBatchNumber(ctx context.Context, rollupID uint64, constrains *VirtualBatchConstraints, dbTx dbTxType) (uint64, error) {
+	whereClause := "WHERE rollup_id = $1"
 	if constrains != nil {
-		whereClause = constrains.WhereClause()
-		if whereClause != "" {
-			whereClause = "WHERE " + whereClause
+		if constrainsClause := constrains.WhereClause(); constrainsClause != "" {
+			whereClause += " AND " + constrainsClause
 		}
 	}
-	sql := "SELECT batch_num FROM sync.virtual_batch ORDER BY batch_num " + whereClause + " DESC LIMIT 1"
+	sql := "SELECT batch_num FROM sync.virtual_batch " + whereClause + " ORDER BY batch_num DESC LIMIT 1"
 	e := p.getExecQuerier(getPgTx(dbTx))
-	row := e.QueryRow(ctx, sql)
+	row := e.QueryRow(ctx, sql, rollupID)
 	var batchNumber uint64
 	err := row.Scan(&batchNumber)
 	err = translatePgxError(err, "GetLastestVirtualBatchNumber")
@@ -103,14 +107,15 @@ func (c *VirtualBatchConstraints) WhereClause() string {
 func scanVirtualBatch(row pgx.Row, contextDescription string) (*VirtualBatch, error) {
 	virtualBatch := &VirtualBatch{}
 	var l1InfoRootStr *string
+	var globalExitRootStr *string
 	var batchTimestamp *time.Time
 	var syncVersion string
 	var vlogTxHash string
 	var coinbase string
 	var sequencerAddr string
-	err := row.Scan(&virtualBatch.BatchNumber, &virtualBatch.ForkID, &virtualBatch.BatchL2Data, &vlogTxHash, &coinbase,
+	err := row.Scan(&virtualBatch.RollupID, &virtualBatch.BatchNumber, &virtualBatch.ForkID, &virtualBatch.BatchL2Data, &vlogTxHash, &coinbase,
 		&virtualBatch.SequenceFromBatchNumber, &virtualBatch.BlockNumber, &sequencerAddr, &virtualBatch.ReceivedAt, &syncVersion,
-		&l1InfoRootStr, &virtualBatch.ExtraInfo, &batchTimestamp)
+		&l1InfoRootStr, &virtualBatch.ExtraInfo, &batchTimestamp, &globalExitRootStr)
 	err = translatePgxError(err, contextDescription)
 	if err != nil {
 		return nil, err
@@ -125,6 +130,10 @@ func scanVirtualBatch(row pgx.Row, contextDescription string) (*VirtualBatch, er
 	if batchTimestamp != nil {
 		virtualBatch.BatchTimestamp = batchTimestamp
 	}
+	if globalExitRootStr != nil {
+		globalExitRoot := common.HexToHash(*globalExitRootStr)
+		virtualBatch.GlobalExitRoot = &globalExitRoot
+	}
 	return virtualBatch, nil
 }
 
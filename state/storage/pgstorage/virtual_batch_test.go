@@ -91,7 +91,7 @@ func TestGetVirtualBatch(t *testing.T) {
 	err = storage.AddVirtualBatch(ctx, &virtualBatch, dbTx)
 	require.NoError(t, err)
 
-	dbVirtualBatch, err := storage.GetVirtualBatchByBatchNumber(ctx, 300, dbTx)
+	dbVirtualBatch, err := storage.GetVirtualBatchByBatchNumber(ctx, 0, 300, dbTx)
 	require.NoError(t, err)
 	require.Equal(t, virtualBatch, *dbVirtualBatch)
 }
@@ -105,6 +105,6 @@ func TestGetVirtualBatchNotFound(t *testing.T) {
 
 	defer func() { _ = dbTx.Commit(ctx) }()
 
-	_, err = storage.GetVirtualBatchByBatchNumber(ctx, 300, dbTx)
+	_, err = storage.GetVirtualBatchByBatchNumber(ctx, 0, 300, dbTx)
 	require.ErrorIs(t, err, entities.ErrNotFound)
 }
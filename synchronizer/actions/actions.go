@@ -25,3 +25,10 @@ type L1EventProcessor interface {
 	// Process a incomming event
 	Process(ctx context.Context, forkId ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx entities.Tx) error
 }
+
+// L1EventProcessorForkFamilies is implemented by the processors that support families of forkIds,
+// the forkId of the event is resolved to a family using the ForkIdRegistry
+type L1EventProcessorForkFamilies interface {
+	// SupportedForkFamilies list of families of forkIds that support
+	SupportedForkFamilies() []ForkFamily
+}
@@ -33,8 +33,8 @@ type ProcessorL1SequenceBatchesElderberry struct {
 func NewProcessorL1SequenceBatchesElderberry(previousProcessor PreviousProcessor) *ProcessorL1SequenceBatchesElderberry {
 	return &ProcessorL1SequenceBatchesElderberry{
 		ProcessorBase: actions.ProcessorBase[ProcessorL1SequenceBatchesElderberry]{
-			SupportedEvent:    []etherman.EventOrder{etherman.SequenceBatchesOrder},
-			SupportedForkdIds: &actions.ForksIdOnlyElderberry},
+			SupportedEvent:      []etherman.EventOrder{etherman.SequenceBatchesOrder},
+			SupportedForkFamily: &actions.ForkFamiliesOnlyElderberry},
 		previousProcessor: previousProcessor,
 	}
 }
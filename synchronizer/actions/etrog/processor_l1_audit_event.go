@@ -0,0 +1,46 @@
+package etrog
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+)
+
+type stateAuditEventInterface interface {
+	AddAuditEvent(ctx context.Context, auditEvent *entities.AuditEvent, dbTx stateTxType) error
+}
+
+// ProcessorL1AuditEvent implements L1EventProcessor for AuditEventsOrder
+type ProcessorL1AuditEvent struct {
+	actions.ProcessorBase[ProcessorL1AuditEvent]
+	state stateAuditEventInterface
+}
+
+// NewProcessorL1AuditEvent returns instance of a processor for AuditEventsOrder
+func NewProcessorL1AuditEvent(state stateAuditEventInterface) *ProcessorL1AuditEvent {
+	return &ProcessorL1AuditEvent{
+		ProcessorBase: actions.ProcessorBase[ProcessorL1AuditEvent]{
+			SupportedEvent:    []etherman.EventOrder{etherman.AuditEventsOrder},
+			SupportedForkdIds: &actions.ForksIdAll},
+		state: state,
+	}
+}
+
+// Process process event
+func (p *ProcessorL1AuditEvent) Process(ctx context.Context, forkId actions.ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx stateTxType) error {
+	if l1Block == nil || len(l1Block.AuditEvents) <= order.Pos {
+		return actions.ErrInvalidParams
+	}
+	auditEvent := entities.NewAuditEventFromL1(l1Block.AuditEvents[order.Pos])
+	err := p.state.AddAuditEvent(ctx, auditEvent, dbTx)
+	if err != nil {
+		log.Errorf("error storing the audit event. BlockNumber: %d, Contract: %s, EventType: %s, error: %v",
+			l1Block.BlockNumber, auditEvent.Contract.String(), auditEvent.EventType, err)
+		return err
+	}
+	log.Warnf("Audit event on L1: %s", auditEvent.String())
+	return nil
+}
@@ -0,0 +1,47 @@
+package etrog
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+)
+
+// stateProcessorL1ForcedBatchInterface interface required from state
+type stateProcessorL1ForcedBatchInterface interface {
+	AddForcedBatch(ctx context.Context, forcedBatch *entities.ForcedBatch, dbTx stateTxType) error
+}
+
+// ProcessorL1ForcedBatch implements L1EventProcessor for ForcedBatchesOrder
+type ProcessorL1ForcedBatch struct {
+	actions.ProcessorBase[ProcessorL1ForcedBatch]
+	state stateProcessorL1ForcedBatchInterface
+}
+
+// NewProcessorL1ForcedBatch new processor for ForcedBatchesOrder
+func NewProcessorL1ForcedBatch(state stateProcessorL1ForcedBatchInterface) *ProcessorL1ForcedBatch {
+	return &ProcessorL1ForcedBatch{
+		ProcessorBase: actions.ProcessorBase[ProcessorL1ForcedBatch]{
+			SupportedEvent:    []etherman.EventOrder{etherman.ForcedBatchesOrder},
+			SupportedForkdIds: &actions.ForksIdAll},
+		state: state}
+}
+
+// Process process event
+func (p *ProcessorL1ForcedBatch) Process(ctx context.Context, forkId ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx stateTxType) error {
+	if l1Block == nil || len(l1Block.ForcedBatches) <= order.Pos {
+		return actions.ErrInvalidParams
+	}
+	forcedBatch := entities.NewForcedBatchFromL1(l1Block.ForcedBatches[order.Pos])
+	err := p.state.AddForcedBatch(ctx, forcedBatch, dbTx)
+	if err != nil {
+		log.Errorf("error storing the forced batch. BlockNumber: %d, RollupID: %d, ForcedBatchNumber: %d, error: %v",
+			l1Block.BlockNumber, forcedBatch.RollupID, forcedBatch.ForcedBatchNumber, err)
+		return err
+	}
+	log.Infof("Forced batch stored. BlockNumber: %d, RollupID: %d, ForcedBatchNumber: %d, Forcer: %s",
+		l1Block.BlockNumber, forcedBatch.RollupID, forcedBatch.ForcedBatchNumber, forcedBatch.ForcerAddr.String())
+	return nil
+}
@@ -0,0 +1,47 @@
+package etrog
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+)
+
+type stateGovernanceEventInterface interface {
+	AddGovernanceEvent(ctx context.Context, governanceEvent *entities.GovernanceEvent, dbTx stateTxType) error
+}
+
+// ProcessorL1GovernanceEvent implements L1EventProcessor for GovernanceEventsOrder
+type ProcessorL1GovernanceEvent struct {
+	actions.ProcessorBase[ProcessorL1GovernanceEvent]
+	state stateGovernanceEventInterface
+}
+
+// NewProcessorL1GovernanceEvent returns instance of a processor for GovernanceEventsOrder
+func NewProcessorL1GovernanceEvent(state stateGovernanceEventInterface) *ProcessorL1GovernanceEvent {
+	return &ProcessorL1GovernanceEvent{
+		ProcessorBase: actions.ProcessorBase[ProcessorL1GovernanceEvent]{
+			SupportedEvent:    []etherman.EventOrder{etherman.GovernanceEventsOrder},
+			SupportedForkdIds: &actions.ForksIdAll},
+		state: state,
+	}
+}
+
+// Process process event
+func (p *ProcessorL1GovernanceEvent) Process(ctx context.Context, forkId actions.ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx stateTxType) error {
+	if l1Block == nil || len(l1Block.GovernanceEvents) <= order.Pos {
+		return actions.ErrInvalidParams
+	}
+	governanceEvent := entities.NewGovernanceEventFromL1(l1Block.GovernanceEvents[order.Pos])
+	err := p.state.AddGovernanceEvent(ctx, governanceEvent, dbTx)
+	if err != nil {
+		log.Errorf("error storing the governance event. BlockNumber: %d, RollupID: %d, Parameter: %s, error: %v",
+			l1Block.BlockNumber, governanceEvent.RollupID, governanceEvent.Parameter, err)
+		return err
+	}
+	log.Warnf("Governance parameter changed on L1. BlockNumber: %d, RollupID: %d, Parameter: %s, Value: %s, Contract: %s",
+		l1Block.BlockNumber, governanceEvent.RollupID, governanceEvent.Parameter, governanceEvent.Value, governanceEvent.Contract.String())
+	return nil
+}
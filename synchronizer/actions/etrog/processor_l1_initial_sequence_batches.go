@@ -21,8 +21,8 @@ type ProcessorL1InitialSequenceBatches struct {
 func NewProcessorL1InitialSequenceBatches(state stateOnSequencedBatchesInterface) *ProcessorL1InitialSequenceBatches {
 	return &ProcessorL1InitialSequenceBatches{
 		ProcessorBase: actions.ProcessorBase[ProcessorL1InitialSequenceBatches]{
-			SupportedEvent:    []etherman.EventOrder{etherman.InitialSequenceBatchesOrder},
-			SupportedForkdIds: &actions.ForksIdOnlyEtrogAndElderberry},
+			SupportedEvent:      []etherman.EventOrder{etherman.InitialSequenceBatchesOrder},
+			SupportedForkFamily: &actions.ForkFamiliesEtrogAndElderberry},
 		state: state,
 	}
 }
@@ -50,7 +50,7 @@ func (p *ProcessorL1InitialSequenceBatches) ProcessSequenceBatches(ctx context.C
 	l1BlockTimestamp := time.Unix(int64(sequencedBatches[0].PolygonRollupBaseEtrogBatchData.ForcedTimestamp), 0)
 	seq := SequenceOfBatches{}
 
-	seq.Sequence = *entities.NewSequencedBatches(
+	seq.Sequence = *entities.NewSequencedBatches(uint64(sequencedBatches[0].RollupID),
 		sequencedBatches[0].BatchNumber, sequencedBatches[len(sequencedBatches)-1].BatchNumber,
 		blockNumber, uint64(forkId),
 		l1BlockTimestamp, time.Now(),
@@ -0,0 +1,70 @@
+package etrog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type statePendingStateInterface interface {
+	ConsolidatePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx stateTxType) error
+	OverridePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx stateTxType) error
+	SetPendingStateNonDeterministic(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx stateTxType) error
+}
+
+// ProcessorL1PendingState implements L1EventProcessor for PendingStateEventsOrder
+type ProcessorL1PendingState struct {
+	actions.ProcessorBase[ProcessorL1PendingState]
+	state statePendingStateInterface
+}
+
+// NewProcessorL1PendingState returns instance of a processor for PendingStateEventsOrder
+func NewProcessorL1PendingState(state statePendingStateInterface) *ProcessorL1PendingState {
+	return &ProcessorL1PendingState{
+		ProcessorBase: actions.ProcessorBase[ProcessorL1PendingState]{
+			SupportedEvent:    []etherman.EventOrder{etherman.PendingStateEventsOrder},
+			SupportedForkdIds: &actions.ForksIdAll},
+		state: state,
+	}
+}
+
+// Process process event
+func (p *ProcessorL1PendingState) Process(ctx context.Context, forkId actions.ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx stateTxType) error {
+	if l1Block == nil || len(l1Block.PendingStateEvents) <= order.Pos {
+		return actions.ErrInvalidParams
+	}
+	event := l1Block.PendingStateEvents[order.Pos]
+	var err error
+	switch event.Action {
+	case etherman.PendingStateConsolidated:
+		err = p.state.ConsolidatePendingStates(ctx, uint64(event.RollupID), event.BatchNumber, event.BlockNumber, dbTx)
+	case etherman.PendingStateOverridden:
+		log.Warnf("Pending states of RollupID %d overridden on L1 by the trusted aggregator %s. BlockNumber: %d, BatchNumber: %d, StateRoot: %s",
+			event.RollupID, event.Aggregator.String(), event.BlockNumber, event.BatchNumber, event.StateRoot.String())
+		err = p.state.OverridePendingStates(ctx, uint64(event.RollupID), event.BatchNumber, event.StateRoot, event.BlockNumber, dbTx)
+	case etherman.PendingStateProvedNonDeterministic:
+		log.Warnf("Pending state proved non deterministic on L1. BlockNumber: %d, StoredStateRoot: %s, ProvedStateRoot: %s",
+			event.BlockNumber, event.StoredStateRoot.String(), event.ProvedStateRoot.String())
+		err = p.state.SetPendingStateNonDeterministic(ctx, event.StoredStateRoot, event.ProvedStateRoot, event.BlockNumber, dbTx)
+	default:
+		return fmt.Errorf("unexpected pending state action %s: %w", event.Action, actions.ErrInvalidParams)
+	}
+	if errors.Is(err, entities.ErrNotFound) {
+		log.Warnf("Pending state %s on block %d (RollupID: %d, BatchNumber: %d) doesn't match any pending state, it have been verified before the genesis block. Ignoring it",
+			event.Action, event.BlockNumber, event.RollupID, event.BatchNumber)
+		return nil
+	}
+	if err != nil {
+		log.Errorf("error storing the pending state %s. BlockNumber: %d, RollupID: %d, BatchNumber: %d, error: %v",
+			event.Action, event.BlockNumber, event.RollupID, event.BatchNumber, err)
+		return err
+	}
+	log.Infof("Pending state %s on L1. BlockNumber: %d, RollupID: %d, BatchNumber: %d", event.Action, event.BlockNumber, event.RollupID, event.BatchNumber)
+	return nil
+}
@@ -0,0 +1,107 @@
+package etrog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+)
+
+type stateRollupRegistryInterface interface {
+	AddRollupType(ctx context.Context, rollupType *entities.RollupType, dbTx stateTxType) error
+	SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx stateTxType) error
+	AddRollup(ctx context.Context, rollup *entities.Rollup, dbTx stateTxType) error
+	UpdateRollup(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx stateTxType) error
+}
+
+// ProcessorL1RollupRegistry implements L1EventProcessor for RollupTypesOrder, ObsoleteRollupTypesOrder and RollupsOrder
+type ProcessorL1RollupRegistry struct {
+	actions.ProcessorBase[ProcessorL1RollupRegistry]
+	state stateRollupRegistryInterface
+}
+
+// NewProcessorL1RollupRegistry returns instance of a processor for RollupTypesOrder, ObsoleteRollupTypesOrder and RollupsOrder
+func NewProcessorL1RollupRegistry(state stateRollupRegistryInterface) *ProcessorL1RollupRegistry {
+	return &ProcessorL1RollupRegistry{
+		ProcessorBase: actions.ProcessorBase[ProcessorL1RollupRegistry]{
+			SupportedEvent:    []etherman.EventOrder{etherman.RollupTypesOrder, etherman.ObsoleteRollupTypesOrder, etherman.RollupsOrder},
+			SupportedForkdIds: &actions.ForksIdAll},
+		state: state,
+	}
+}
+
+// Process process event
+func (p *ProcessorL1RollupRegistry) Process(ctx context.Context, forkId actions.ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx stateTxType) error {
+	if l1Block == nil {
+		return actions.ErrInvalidParams
+	}
+	switch order.Name {
+	case etherman.RollupTypesOrder:
+		if len(l1Block.RollupTypes) <= order.Pos {
+			return actions.ErrInvalidParams
+		}
+		return p.processRollupType(ctx, l1Block.RollupTypes[order.Pos], dbTx)
+	case etherman.ObsoleteRollupTypesOrder:
+		if len(l1Block.ObsoleteRollupTypes) <= order.Pos {
+			return actions.ErrInvalidParams
+		}
+		return p.processObsoleteRollupType(ctx, l1Block.ObsoleteRollupTypes[order.Pos], dbTx)
+	case etherman.RollupsOrder:
+		if len(l1Block.Rollups) <= order.Pos {
+			return actions.ErrInvalidParams
+		}
+		return p.processRollup(ctx, l1Block.Rollups[order.Pos], dbTx)
+	}
+	return fmt.Errorf("unexpected event %s: %w", order.Name, actions.ErrInvalidParams)
+}
+
+func (p *ProcessorL1RollupRegistry) processRollupType(ctx context.Context, ethRollupType etherman.RollupType, dbTx stateTxType) error {
+	rollupType := entities.NewRollupTypeFromL1(ethRollupType)
+	err := p.state.AddRollupType(ctx, rollupType, dbTx)
+	if err != nil {
+		log.Errorf("error storing the rollup type. BlockNumber: %d, RollupTypeID: %d, error: %v", rollupType.BlockNumber, rollupType.RollupTypeID, err)
+		return err
+	}
+	log.Infof("New rollup type on L1: %s", rollupType.String())
+	return nil
+}
+
+func (p *ProcessorL1RollupRegistry) processObsoleteRollupType(ctx context.Context, obsolete etherman.ObsoleteRollupType, dbTx stateTxType) error {
+	err := p.state.SetRollupTypeObsolete(ctx, uint64(obsolete.RollupTypeID), obsolete.BlockNumber, dbTx)
+	if errors.Is(err, entities.ErrNotFound) {
+		log.Warnf("RollupTypeID %d marked as obsolete on block %d is unknown, it have been registered before the genesis block. Ignoring it",
+			obsolete.RollupTypeID, obsolete.BlockNumber)
+		return nil
+	}
+	if err != nil {
+		log.Errorf("error marking the rollup type as obsolete. BlockNumber: %d, RollupTypeID: %d, error: %v", obsolete.BlockNumber, obsolete.RollupTypeID, err)
+		return err
+	}
+	log.Infof("RollupTypeID %d marked as obsolete on L1. BlockNumber: %d", obsolete.RollupTypeID, obsolete.BlockNumber)
+	return nil
+}
+
+func (p *ProcessorL1RollupRegistry) processRollup(ctx context.Context, ethRollup etherman.Rollup, dbTx stateTxType) error {
+	rollup, rollupUpdate := entities.NewRollupFromL1(ethRollup)
+	if rollupUpdate != nil {
+		err := p.state.UpdateRollup(ctx, rollupUpdate, dbTx)
+		if err != nil {
+			log.Errorf("error storing the update of the rollup. BlockNumber: %d, RollupID: %d, error: %v", rollupUpdate.BlockNumber, rollupUpdate.RollupID, err)
+			return err
+		}
+		log.Infof("RollupID %d updated on L1 to RollupTypeID %d (forkID %d). BlockNumber: %d",
+			rollupUpdate.RollupID, rollupUpdate.RollupTypeID, rollupUpdate.ForkID, rollupUpdate.BlockNumber)
+		return nil
+	}
+	err := p.state.AddRollup(ctx, rollup, dbTx)
+	if err != nil {
+		log.Errorf("error storing the rollup. BlockNumber: %d, RollupID: %d, error: %v", rollup.BlockNumber, rollup.RollupID, err)
+		return err
+	}
+	log.Infof("New rollup on L1 (%s): %s", ethRollup.Action, rollup.String())
+	return nil
+}
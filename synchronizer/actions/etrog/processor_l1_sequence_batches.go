@@ -21,8 +21,8 @@ type ProcessorL1SequenceBatchesEtrog struct {
 func NewProcessorL1SequenceBatches(state stateOnSequencedBatchesInterface) *ProcessorL1SequenceBatchesEtrog {
 	return &ProcessorL1SequenceBatchesEtrog{
 		ProcessorBase: actions.ProcessorBase[ProcessorL1SequenceBatchesEtrog]{
-			SupportedEvent:    []etherman.EventOrder{etherman.SequenceBatchesOrder},
-			SupportedForkdIds: &actions.ForksIdOnlyEtrog},
+			SupportedEvent:      []etherman.EventOrder{etherman.SequenceBatchesOrder},
+			SupportedForkFamily: &actions.ForkFamiliesOnlyEtrog},
 		state: state,
 	}
 }
@@ -51,16 +51,25 @@ func (p *ProcessorL1SequenceBatchesEtrog) ProcessSequenceBatches(ctx context.Con
 	if sequencedBatches[0].Metadata != nil {
 		seqSource = seqSource + "/" + sequencedBatches[0].Metadata.RollupFlavor + "/" + sequencedBatches[0].Metadata.ForkName
 	}
-	seq.Sequence = *entities.NewSequencedBatches(
+	seq.Sequence = *entities.NewSequencedBatches(uint64(sequencedBatches[0].RollupID),
 		sequencedBatches[0].BatchNumber, sequencedBatches[len(sequencedBatches)-1].BatchNumber,
 		blockNumber, uint64(forkId),
 		l1BlockTimestamp, time.Now(),
 		l1inforoot, seqSource)
 
+	var sequencedForceBatches []*entities.SequencedForceBatch
 	for _, sequencedBatch := range sequencedBatches {
 		virtualBatch := entities.NewVirtualBatchFromL1(blockNumber, seq.Sequence.FromBatchNumber,
 			seq.Sequence.ForkID, sequencedBatch)
 		seq.Batches = append(seq.Batches, virtualBatch)
+		if sequencedForceBatch := entities.NewSequencedForceBatchFromSequencedBatch(blockNumber, sequencedBatch, seqSource); sequencedForceBatch != nil {
+			sequencedForceBatches = append(sequencedForceBatches, sequencedForceBatch)
+		}
 	}
-	return p.state.OnSequencedBatchesOnL1(ctx, seq, dbTx)
+	err := p.state.OnSequencedBatchesOnL1(ctx, seq, dbTx)
+	if err != nil || len(sequencedForceBatches) == 0 {
+		return err
+	}
+	// The forced batches included by the trusted sequencer
+	return p.state.OnSequencedForceBatchesOnL1(ctx, sequencedForceBatches, dbTx)
 }
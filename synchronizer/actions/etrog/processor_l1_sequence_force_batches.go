@@ -0,0 +1,81 @@
+package etrog
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ProcessorL1SequenceForceBatches implements L1EventProcessor for SequenceForceBatchesOrder.
+// The batches are stored as a sequence of virtual batches and as sequenced force batches
+type ProcessorL1SequenceForceBatches struct {
+	actions.ProcessorBase[ProcessorL1SequenceForceBatches]
+	state stateOnSequencedBatchesInterface
+}
+
+// NewProcessorL1SequenceForceBatches new processor for SequenceForceBatchesOrder
+func NewProcessorL1SequenceForceBatches(state stateOnSequencedBatchesInterface) *ProcessorL1SequenceForceBatches {
+	return &ProcessorL1SequenceForceBatches{
+		ProcessorBase: actions.ProcessorBase[ProcessorL1SequenceForceBatches]{
+			SupportedEvent:    []etherman.EventOrder{etherman.SequenceForceBatchesOrder},
+			SupportedForkdIds: &actions.ForksIdAll},
+		state: state}
+}
+
+// Process process event
+func (p *ProcessorL1SequenceForceBatches) Process(ctx context.Context, forkId ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx stateTxType) error {
+	if l1Block == nil || len(l1Block.SequencedForceBatches) <= order.Pos {
+		return actions.ErrInvalidParams
+	}
+	ethSeqForceBatches := l1Block.SequencedForceBatches[order.Pos]
+	if len(ethSeqForceBatches) == 0 {
+		log.Warn("Empty sequencedForceBatches array detected, ignoring...")
+		return nil
+	}
+	seqSource := string(etherman.SequenceForceBatchesOrder)
+	seq := SequenceOfBatches{}
+	seq.Sequence = *entities.NewSequencedBatches(uint64(ethSeqForceBatches[0].RollupID),
+		ethSeqForceBatches[0].BatchNumber, ethSeqForceBatches[len(ethSeqForceBatches)-1].BatchNumber,
+		l1Block.BlockNumber, uint64(forkId),
+		l1Block.ReceivedAt, time.Now(),
+		common.Hash{}, seqSource)
+	sequencedForceBatches := make([]*entities.SequencedForceBatch, 0, len(ethSeqForceBatches))
+	for _, ethSeqForceBatch := range ethSeqForceBatches {
+		seq.Batches = append(seq.Batches, entities.NewVirtualBatchFromL1(l1Block.BlockNumber, seq.Sequence.FromBatchNumber,
+			seq.Sequence.ForkID, sequencedBatchFromForceBatch(ethSeqForceBatch)))
+		sequencedForceBatches = append(sequencedForceBatches,
+			entities.NewSequencedForceBatchFromL1(l1Block.BlockNumber, ethSeqForceBatch, seqSource))
+	}
+	err := p.state.OnSequencedBatchesOnL1(ctx, seq, dbTx)
+	if err != nil {
+		log.Errorf("error storing the virtual batches of the sequenced force batches. BlockNumber: %d, error: %v", l1Block.BlockNumber, err)
+		return err
+	}
+	err = p.state.OnSequencedForceBatchesOnL1(ctx, sequencedForceBatches, dbTx)
+	if err != nil {
+		log.Errorf("error storing the sequenced force batches. BlockNumber: %d, error: %v", l1Block.BlockNumber, err)
+		return err
+	}
+	log.Infof("Sequenced force batches stored. BlockNumber: %d, RollupID: %d, batches: %d..%d", l1Block.BlockNumber,
+		sequencedForceBatches[0].RollupID, sequencedForceBatches[0].BatchNumber, sequencedForceBatches[len(sequencedForceBatches)-1].BatchNumber)
+	return nil
+}
+
+// sequencedBatchFromForceBatch converts a batch sequenced by sequenceForceBatches to a SequencedBatch,
+// so it's stored as the virtual batch of the rollup
+func sequencedBatchFromForceBatch(ethSeqForceBatch etherman.SequencedForceBatch) etherman.SequencedBatch {
+	batchData := ethSeqForceBatch.PolygonRollupBaseEtrogBatchData
+	return etherman.SequencedBatch{
+		RollupID:                        ethSeqForceBatch.RollupID,
+		BatchNumber:                     ethSeqForceBatch.BatchNumber,
+		TxHash:                          ethSeqForceBatch.TxHash,
+		Nonce:                           ethSeqForceBatch.Nonce,
+		Coinbase:                        ethSeqForceBatch.Coinbase,
+		PolygonRollupBaseEtrogBatchData: &batchData,
+	}
+}
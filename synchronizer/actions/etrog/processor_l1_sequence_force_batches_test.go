@@ -0,0 +1,59 @@
+package etrog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/polygonzkevm"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type stateFake struct {
+	sequences             []SequenceOfBatches
+	sequencedForceBatches []*entities.SequencedForceBatch
+}
+
+func (s *stateFake) OnSequencedBatchesOnL1(ctx context.Context, seq SequenceOfBatches, dbTx stateTxType) error {
+	s.sequences = append(s.sequences, seq)
+	return nil
+}
+
+func (s *stateFake) OnSequencedForceBatchesOnL1(ctx context.Context, sequencedForceBatches []*entities.SequencedForceBatch, dbTx stateTxType) error {
+	s.sequencedForceBatches = append(s.sequencedForceBatches, sequencedForceBatches...)
+	return nil
+}
+
+func TestProcessorL1SequenceForceBatchesStoresVirtualBatches(t *testing.T) {
+	state := &stateFake{}
+	sut := NewProcessorL1SequenceForceBatches(state)
+	txHash := common.HexToHash("0xabcd")
+	l1Block := &etherman.Block{BlockNumber: 123, ReceivedAt: time.Unix(2000, 0), SequencedForceBatches: [][]etherman.SequencedForceBatch{{
+		{RollupID: 1, BatchNumber: 10, TxHash: txHash, Coinbase: common.HexToAddress("0x1"), PolygonRollupBaseEtrogBatchData: polygonzkevm.PolygonRollupBaseEtrogBatchData{
+			Transactions: []byte{0x01}, ForcedGlobalExitRoot: common.HexToHash("0x1234"), ForcedTimestamp: 1000}},
+		{RollupID: 1, BatchNumber: 11, TxHash: txHash, Coinbase: common.HexToAddress("0x1"), PolygonRollupBaseEtrogBatchData: polygonzkevm.PolygonRollupBaseEtrogBatchData{
+			Transactions: []byte{0x02}, ForcedGlobalExitRoot: common.HexToHash("0x5678"), ForcedTimestamp: 1001}},
+	}}}
+
+	err := sut.Process(context.TODO(), actions.ForkIDEtrog, etherman.Order{Name: etherman.SequenceForceBatchesOrder, Pos: 0}, l1Block, nil)
+	require.NoError(t, err)
+	require.Len(t, state.sequences, 1)
+	seq := state.sequences[0]
+	require.Equal(t, uint64(1), seq.Sequence.RollupID)
+	require.Equal(t, uint64(10), seq.Sequence.FromBatchNumber)
+	require.Equal(t, uint64(11), seq.Sequence.ToBatchNumber)
+	require.Equal(t, uint64(actions.ForkIDEtrog), seq.Sequence.ForkID)
+	require.Equal(t, string(etherman.SequenceForceBatchesOrder), seq.Sequence.Source)
+	require.Len(t, seq.Batches, 2)
+	require.Equal(t, uint64(11), seq.Batches[1].BatchNumber)
+	require.Equal(t, []byte{0x02}, seq.Batches[1].BatchL2Data)
+	require.Equal(t, txHash, seq.Batches[1].VlogTxHash)
+	require.Equal(t, uint64(10), seq.Batches[1].SequenceFromBatchNumber)
+	require.Len(t, state.sequencedForceBatches, 2)
+	require.Equal(t, uint64(11), state.sequencedForceBatches[1].BatchNumber)
+	require.Equal(t, common.HexToHash("0x5678"), state.sequencedForceBatches[1].ForcedGlobalExitRoot)
+}
@@ -21,8 +21,8 @@ func NewProcessorL1UpdateEtrogSequence(state stateOnSequencedBatchesInterface,
 ) *ProcessorL1UpdateEtrogSequence {
 	return &ProcessorL1UpdateEtrogSequence{
 		ProcessorBase: actions.ProcessorBase[ProcessorL1UpdateEtrogSequence]{
-			SupportedEvent:    []etherman.EventOrder{etherman.UpdateEtrogSequenceOrder},
-			SupportedForkdIds: &actions.ForksIdOnlyEtrog},
+			SupportedEvent:      []etherman.EventOrder{etherman.UpdateEtrogSequenceOrder},
+			SupportedForkFamily: &actions.ForkFamiliesOnlyEtrog},
 		state: state,
 	}
 }
@@ -39,12 +39,13 @@ func (p *ProcessorL1UpdateEtrogSequence) Process(ctx context.Context, forkId For
 func (p *ProcessorL1UpdateEtrogSequence) processUpdateEtrogSequence(ctx context.Context, forkId ForkIdType, order etherman.Order, updateEtrogSequence etherman.UpdateEtrogSequence, blockNumber uint64, l1BlockTimestamp time.Time, dbTx stateTxType) error {
 	l1inforoot := common.Hash(updateEtrogSequence.PolygonRollupBaseEtrogBatchData.ForcedGlobalExitRoot)
 	seq := SequenceOfBatches{}
-	seq.Sequence = *entities.NewSequencedBatches(
+	seq.Sequence = *entities.NewSequencedBatches(uint64(updateEtrogSequence.RollupID),
 		updateEtrogSequence.BatchNumber, updateEtrogSequence.BatchNumber,
 		blockNumber, uint64(forkId),
 		l1BlockTimestamp, time.Now(),
 		l1inforoot, string(order.Name))
 	ethSeqBatch := etherman.SequencedBatch{
+		RollupID:                        updateEtrogSequence.RollupID,
 		BatchNumber:                     updateEtrogSequence.BatchNumber,
 		L1InfoRoot:                      &l1inforoot,
 		SequencerAddr:                   updateEtrogSequence.SequencerAddr,
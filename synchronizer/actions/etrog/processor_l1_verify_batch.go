@@ -0,0 +1,55 @@
+package etrog
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+)
+
+// stateProcessorL1VerifyBatchInterface interface required from state
+type stateProcessorL1VerifyBatchInterface interface {
+	AddVerifiedBatch(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx stateTxType) error
+	AddPendingState(ctx context.Context, pendingState *entities.PendingState, dbTx stateTxType) error
+}
+
+// ProcessorL1VerifyBatch implements L1EventProcessor for TrustedVerifyBatchOrder and VerifyBatchOrder
+type ProcessorL1VerifyBatch struct {
+	actions.ProcessorBase[ProcessorL1VerifyBatch]
+	state stateProcessorL1VerifyBatchInterface
+}
+
+// NewProcessorL1VerifyBatch new processor for TrustedVerifyBatchOrder and VerifyBatchOrder
+func NewProcessorL1VerifyBatch(state stateProcessorL1VerifyBatchInterface) *ProcessorL1VerifyBatch {
+	return &ProcessorL1VerifyBatch{
+		ProcessorBase: actions.ProcessorBase[ProcessorL1VerifyBatch]{
+			SupportedEvent:    []etherman.EventOrder{etherman.TrustedVerifyBatchOrder, etherman.VerifyBatchOrder},
+			SupportedForkdIds: &actions.ForksIdAll},
+		state: state}
+}
+
+// Process process event
+func (p *ProcessorL1VerifyBatch) Process(ctx context.Context, forkId ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx stateTxType) error {
+	ethVerifiedBatch := l1Block.VerifiedBatches[order.Pos]
+	verifiedBatch := entities.NewVerifiedBatchFromL1(ethVerifiedBatch, order.Name == etherman.TrustedVerifyBatchOrder)
+	err := p.state.AddVerifiedBatch(ctx, verifiedBatch, dbTx)
+	if err != nil {
+		log.Errorf("error storing the verified batch. BlockNumber: %d, RollupID: %d, BatchNumber: %d, error: %v",
+			l1Block.BlockNumber, verifiedBatch.RollupID, verifiedBatch.BatchNumber, err)
+		return err
+	}
+	if !verifiedBatch.IsTrusted {
+		// The verifications of non trusted aggregators are pending states until they are consolidated
+		err = p.state.AddPendingState(ctx, entities.NewPendingStateFromL1(ethVerifiedBatch), dbTx)
+		if err != nil {
+			log.Errorf("error storing the pending state. BlockNumber: %d, RollupID: %d, BatchNumber: %d, error: %v",
+				l1Block.BlockNumber, verifiedBatch.RollupID, verifiedBatch.BatchNumber, err)
+			return err
+		}
+	}
+	log.Infof("Verified batch stored. BlockNumber: %d, RollupID: %d, BatchNumber: %d, StateRoot: %s, Trusted: %t",
+		l1Block.BlockNumber, verifiedBatch.RollupID, verifiedBatch.BatchNumber, verifiedBatch.StateRoot.String(), verifiedBatch.IsTrusted)
+	return nil
+}
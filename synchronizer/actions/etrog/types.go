@@ -15,6 +15,11 @@ type VirtualBatch = entities.VirtualBatch
 type SequencedBatches = entities.SequencedBatches
 type SequenceOfBatches = model.SequenceOfBatches
 
+type stateOnSequencedForceBatchesInterface interface {
+	OnSequencedForceBatchesOnL1(ctx context.Context, sequencedForceBatches []*entities.SequencedForceBatch, dbTx stateTxType) error
+}
+
 type stateOnSequencedBatchesInterface interface {
 	OnSequencedBatchesOnL1(ctx context.Context, seq SequenceOfBatches, dbTx stateTxType) error
+	stateOnSequencedForceBatchesInterface
 }
@@ -0,0 +1,129 @@
+package actions
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ForkFamily is a group of forkIds that share the same L1 interface, so their events are
+// processed by the same processors
+type ForkFamily string
+
+const (
+	// ForkFamilyIncaberry are the forkIds till incaberry (pre-etrog)
+	ForkFamilyIncaberry ForkFamily = "incaberry"
+	// ForkFamilyEtrog is the etrog forkId
+	ForkFamilyEtrog ForkFamily = "etrog"
+	// ForkFamilyElderberry are elderberry and the following forkIds without changes on interfaces
+	ForkFamilyElderberry ForkFamily = "elderberry"
+)
+
+// UnknownForkIdPolicy is what to do with a forkId greater than the last one of the registry
+type UnknownForkIdPolicy string
+
+const (
+	// UnknownForkIdHalt returns an error, so the synchronization stops until the registry is updated
+	UnknownForkIdHalt UnknownForkIdPolicy = "halt"
+	// UnknownForkIdLatest processes the unknown forkId as the family of the last known forkId
+	UnknownForkIdLatest UnknownForkIdPolicy = "latest"
+)
+
+var (
+	// ErrUnknownForkId is returned when the forkId is not in the registry
+	ErrUnknownForkId = errors.New("unknown forkId")
+
+	// KnownForkFamilies are the families with processors
+	KnownForkFamilies = []ForkFamily{ForkFamilyIncaberry, ForkFamilyEtrog, ForkFamilyElderberry}
+
+	// DefaultForkIdRanges are the ranges of forkIds known by this version
+	DefaultForkIdRanges = []ForkIdRange{
+		{FromForkId: 1, ToForkId: ForkIDIncaberry, Family: ForkFamilyIncaberry},
+		{FromForkId: ForkIDEtrog, ToForkId: ForkIDEtrog, Family: ForkFamilyEtrog},
+		{FromForkId: ForkIDElderberry, ToForkId: ForkID11, Family: ForkFamilyElderberry},
+	}
+)
+
+// ForkIdRange is a range of forkIds (both included) that belongs to a family
+type ForkIdRange struct {
+	FromForkId ForkIdType
+	ToForkId   ForkIdType
+	Family     ForkFamily
+}
+
+func (r ForkIdRange) String() string {
+	return fmt.Sprintf("[%d-%d]:%s", r.FromForkId, r.ToForkId, r.Family)
+}
+
+func (r ForkIdRange) contains(forkId ForkIdType) bool {
+	return forkId >= r.FromForkId && forkId <= r.ToForkId
+}
+
+// ForkIdRegistry maps the forkIds to the family of processors that process their events
+type ForkIdRegistry struct {
+	ranges []ForkIdRange
+	policy UnknownForkIdPolicy
+}
+
+// NewForkIdRegistry returns a registry for the ranges, they can't overlap and must be of a known family
+func NewForkIdRegistry(ranges []ForkIdRange, policy UnknownForkIdPolicy) (*ForkIdRegistry, error) {
+	if policy != UnknownForkIdHalt && policy != UnknownForkIdLatest {
+		return nil, fmt.Errorf("invalid unknown forkId policy %q, must be %q or %q", policy, UnknownForkIdHalt, UnknownForkIdLatest)
+	}
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("the forkId registry needs at least one range. Err: %w", ErrInvalidParams)
+	}
+	sorted := make([]ForkIdRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].FromForkId < sorted[j].FromForkId })
+	for i, r := range sorted {
+		if r.FromForkId == WildcardForkId || r.FromForkId > r.ToForkId {
+			return nil, fmt.Errorf("invalid forkId range %s. Err: %w", r.String(), ErrInvalidParams)
+		}
+		if !isKnownForkFamily(r.Family) {
+			return nil, fmt.Errorf("forkId range %s has an unknown family, must be one of %v. Err: %w", r.String(), KnownForkFamilies, ErrInvalidParams)
+		}
+		if i > 0 && sorted[i-1].ToForkId >= r.FromForkId {
+			return nil, fmt.Errorf("forkId range %s overlaps with %s. Err: %w", r.String(), sorted[i-1].String(), ErrInvalidParams)
+		}
+	}
+	return &ForkIdRegistry{ranges: sorted, policy: policy}, nil
+}
+
+// NewDefaultForkIdRegistry returns a registry with the DefaultForkIdRanges that halts on unknown forkIds
+func NewDefaultForkIdRegistry() *ForkIdRegistry {
+	res, err := NewForkIdRegistry(DefaultForkIdRanges, UnknownForkIdHalt)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// Family returns the family of the forkId. A forkId greater than the last range is resolved
+// following the policy, the rest of forkIds out of the ranges return ErrUnknownForkId
+func (r *ForkIdRegistry) Family(forkId ForkIdType) (ForkFamily, error) {
+	for _, rng := range r.ranges {
+		if rng.contains(forkId) {
+			return rng.Family, nil
+		}
+	}
+	last := r.ranges[len(r.ranges)-1]
+	if forkId > last.ToForkId && r.policy == UnknownForkIdLatest {
+		return last.Family, nil
+	}
+	return "", fmt.Errorf("forkId %d is not in the registry %v (policy: %s). Err: %w", forkId, r.ranges, r.policy, ErrUnknownForkId)
+}
+
+// Policy returns the policy for unknown forkIds
+func (r *ForkIdRegistry) Policy() UnknownForkIdPolicy {
+	return r.policy
+}
+
+func isKnownForkFamily(family ForkFamily) bool {
+	for _, known := range KnownForkFamilies {
+		if family == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package actions_test
+
+import (
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewForkIdRegistryInvalidRanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []actions.ForkIdRange
+		policy actions.UnknownForkIdPolicy
+	}{
+		{name: "no ranges", policy: actions.UnknownForkIdHalt},
+		{name: "invalid policy", ranges: actions.DefaultForkIdRanges, policy: "ignore"},
+		{name: "reversed range", ranges: []actions.ForkIdRange{{FromForkId: 9, ToForkId: 8, Family: actions.ForkFamilyElderberry}}, policy: actions.UnknownForkIdHalt},
+		{name: "wildcard forkId", ranges: []actions.ForkIdRange{{FromForkId: 0, ToForkId: 8, Family: actions.ForkFamilyElderberry}}, policy: actions.UnknownForkIdHalt},
+		{name: "unknown family", ranges: []actions.ForkIdRange{{FromForkId: 12, ToForkId: 12, Family: "durian"}}, policy: actions.UnknownForkIdHalt},
+		{name: "overlap", ranges: []actions.ForkIdRange{
+			{FromForkId: 8, ToForkId: 11, Family: actions.ForkFamilyElderberry},
+			{FromForkId: 7, ToForkId: 8, Family: actions.ForkFamilyEtrog},
+		}, policy: actions.UnknownForkIdHalt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := actions.NewForkIdRegistry(tt.ranges, tt.policy)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestForkIdRegistryFamily(t *testing.T) {
+	sut := actions.NewDefaultForkIdRegistry()
+	for forkId := actions.ForkIdType(1); forkId <= actions.ForkIDIncaberry; forkId++ {
+		family, err := sut.Family(forkId)
+		require.NoError(t, err)
+		require.Equal(t, actions.ForkFamilyIncaberry, family)
+	}
+	family, err := sut.Family(actions.ForkIDEtrog)
+	require.NoError(t, err)
+	require.Equal(t, actions.ForkFamilyEtrog, family)
+	family, err = sut.Family(actions.ForkID11)
+	require.NoError(t, err)
+	require.Equal(t, actions.ForkFamilyElderberry, family)
+	_, err = sut.Family(actions.ForkID11 + 1)
+	require.ErrorIs(t, err, actions.ErrUnknownForkId)
+	_, err = sut.Family(actions.WildcardForkId)
+	require.ErrorIs(t, err, actions.ErrUnknownForkId)
+}
+
+func TestForkIdRegistryFamilyUnknownPolicyLatest(t *testing.T) {
+	sut, err := actions.NewForkIdRegistry([]actions.ForkIdRange{
+		{FromForkId: 8, ToForkId: 11, Family: actions.ForkFamilyElderberry},
+		{FromForkId: 7, ToForkId: 7, Family: actions.ForkFamilyEtrog},
+	}, actions.UnknownForkIdLatest)
+	require.NoError(t, err)
+	family, err := sut.Family(13)
+	require.NoError(t, err)
+	require.Equal(t, actions.ForkFamilyElderberry, family, "ranges are sorted, the latest is elderberry")
+	_, err = sut.Family(6)
+	require.ErrorIs(t, err, actions.ErrUnknownForkId, "the policy only applies to forkIds after the last range")
+}
@@ -25,15 +25,15 @@ var (
 	// ForksIdAll support all forkIds
 	ForksIdAll = []ForkIdType{WildcardForkId}
 
-	// ForksIdOnlyEtrogAndElderberry support ETROG and ELDERBERRY
-	ForksIdOnlyEtrogAndElderberry = []ForkIdType{ForkIDEtrog, ForkIDElderberry, ForkID9, ForkID10, ForkID11}
+	// ForkFamiliesEtrogAndElderberry support ETROG and ELDERBERRY families
+	ForkFamiliesEtrogAndElderberry = []ForkFamily{ForkFamilyEtrog, ForkFamilyElderberry}
 
-	// ForksIdOnlyElderberry support only elderberry forkId
-	ForksIdOnlyElderberry = []ForkIdType{ForkIDElderberry, ForkID9, ForkID10, ForkID11}
+	// ForkFamiliesOnlyElderberry support only elderberry family
+	ForkFamiliesOnlyElderberry = []ForkFamily{ForkFamilyElderberry}
 
-	// ForksIdOnlyEtrog support only etrog forkId
-	ForksIdOnlyEtrog = []ForkIdType{ForkIDEtrog}
+	// ForkFamiliesOnlyEtrog support only etrog family
+	ForkFamiliesOnlyEtrog = []ForkFamily{ForkFamilyEtrog}
 
-	// ForksIdToIncaberry support all forkIds till incaberry
-	ForksIdToIncaberry = []ForkIdType{1, 2, 3, 4, 5, ForkIDIncaberry}
+	// ForkFamiliesOnlyIncaberry support all forkIds till incaberry
+	ForkFamiliesOnlyIncaberry = []ForkFamily{ForkFamilyIncaberry}
 )
@@ -38,6 +38,7 @@ func (p *ProcessorForkId) Process(ctx context.Context, forkId actions.ForkIdType
 
 func (s *ProcessorForkId) processForkID(ctx context.Context, forkID etherman.ForkID, blockNumber uint64, dbTx entities.Tx) error {
 	fID := entities.ForkIDInterval{
+		RollupID:        uint64(forkID.RollupID),
 		FromBatchNumber: forkID.BatchNumber + 1,
 		ToBatchNumber:   math.MaxUint64,
 		ForkId:          forkID.ForkID,
@@ -46,7 +47,7 @@ func (s *ProcessorForkId) processForkID(ctx context.Context, forkID etherman.For
 	}
 
 	// If forkID affects to a batch from the past. State must be reseted.
-	log.Infof("ForkID: %d, synchronization must use the new forkID since batch: %d", forkID.ForkID, forkID.BatchNumber+1)
+	log.Infof("RollupID: %d ForkID: %d, synchronization must use the new forkID since batch: %d", forkID.RollupID, forkID.ForkID, forkID.BatchNumber+1)
 	err := s.stateForkId.AddForkID(ctx, fID, dbTx)
 	if err != nil {
 		log.Errorf("Fails to add ForkID: %d from BatchNumer:%d", forkID.ForkID, forkID.BatchNumber+1)
@@ -0,0 +1,47 @@
+package incaberry
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+)
+
+type stateGlobalExitRootInterface interface {
+	AddGlobalExitRoot(ctx context.Context, globalExitRoot *entities.GlobalExitRoot, dbTx entities.Tx) error
+}
+
+// ProcessorL1GlobalExitRoot implements L1EventProcessor for GlobalExitRootsOrder
+type ProcessorL1GlobalExitRoot struct {
+	actions.ProcessorBase[ProcessorL1GlobalExitRoot]
+	state stateGlobalExitRootInterface
+}
+
+// NewProcessorL1GlobalExitRoot returns instance of a processor for GlobalExitRootsOrder. The event UpdateGlobalExitRoot
+// is only emitted before LxLy, so it's not restricted to a forkID
+func NewProcessorL1GlobalExitRoot(state stateGlobalExitRootInterface) *ProcessorL1GlobalExitRoot {
+	return &ProcessorL1GlobalExitRoot{
+		ProcessorBase: actions.ProcessorBase[ProcessorL1GlobalExitRoot]{
+			SupportedEvent:    []etherman.EventOrder{etherman.GlobalExitRootsOrder},
+			SupportedForkdIds: &actions.ForksIdAll},
+		state: state,
+	}
+}
+
+// Process process event
+func (p *ProcessorL1GlobalExitRoot) Process(ctx context.Context, forkId actions.ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx entities.Tx) error {
+	if l1Block == nil || len(l1Block.GlobalExitRoots) <= order.Pos {
+		return actions.ErrInvalidParams
+	}
+	globalExitRoot := entities.NewGlobalExitRootFromL1(l1Block.GlobalExitRoots[order.Pos])
+	err := p.state.AddGlobalExitRoot(ctx, globalExitRoot, dbTx)
+	if err != nil {
+		log.Errorf("error storing the GlobalExitRoot. BlockNumber: %d, GlobalExitRoot: %s, error: %v",
+			l1Block.BlockNumber, globalExitRoot.GlobalExitRoot.String(), err)
+		return err
+	}
+	log.Infof("GlobalExitRoot stored. BlockNumber: %d, GlobalExitRoot: %s", l1Block.BlockNumber, globalExitRoot.GlobalExitRoot.String())
+	return nil
+}
@@ -0,0 +1,93 @@
+package incaberry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman/smartcontracts/oldpolygonzkevm"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type stateFake struct {
+	sequences             []model.SequenceOfBatches
+	sequencedForceBatches []*entities.SequencedForceBatch
+	verifiedBatches       []*entities.VerifiedBatch
+	pendingStates         []*entities.PendingState
+}
+
+func (s *stateFake) OnSequencedBatchesOnL1(ctx context.Context, seq model.SequenceOfBatches, dbTx entities.Tx) error {
+	s.sequences = append(s.sequences, seq)
+	return nil
+}
+
+func (s *stateFake) OnSequencedForceBatchesOnL1(ctx context.Context, sequencedForceBatches []*entities.SequencedForceBatch, dbTx entities.Tx) error {
+	s.sequencedForceBatches = append(s.sequencedForceBatches, sequencedForceBatches...)
+	return nil
+}
+
+func (s *stateFake) AddVerifiedBatch(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx) error {
+	s.verifiedBatches = append(s.verifiedBatches, verifiedBatch)
+	return nil
+}
+
+func (s *stateFake) AddPendingState(ctx context.Context, pendingState *entities.PendingState, dbTx entities.Tx) error {
+	s.pendingStates = append(s.pendingStates, pendingState)
+	return nil
+}
+
+func TestProcessorL1SequenceBatchesPreEtrog(t *testing.T) {
+	state := &stateFake{}
+	sut := NewProcessorL1SequenceBatchesPreEtrog(state)
+	l1Block := &etherman.Block{BlockNumber: 123, SequencedBatches: [][]etherman.SequencedBatch{{
+		{RollupID: 1, BatchNumber: 10, PolygonZkEVMBatchData: &oldpolygonzkevm.PolygonZkEVMBatchData{
+			Transactions: []byte{0x01}, GlobalExitRoot: common.HexToHash("0x1234"), Timestamp: 1000}},
+		{RollupID: 1, BatchNumber: 11, PolygonZkEVMBatchData: &oldpolygonzkevm.PolygonZkEVMBatchData{
+			Transactions: []byte{0x02}, GlobalExitRoot: common.HexToHash("0x5678"), Timestamp: 1001, MinForcedTimestamp: 900}},
+	}}}
+
+	err := sut.Process(context.TODO(), actions.ForkIDIncaberry, etherman.Order{Name: etherman.SequenceBatchesOrder, Pos: 0}, l1Block, nil)
+	require.NoError(t, err)
+	require.Len(t, state.sequences, 1)
+	seq := state.sequences[0]
+	require.Equal(t, uint64(10), seq.Sequence.FromBatchNumber)
+	require.Equal(t, uint64(11), seq.Sequence.ToBatchNumber)
+	require.Equal(t, uint64(actions.ForkIDIncaberry), seq.Sequence.ForkID)
+	require.Len(t, seq.Batches, 2)
+	require.Nil(t, seq.Batches[0].L1InfoRoot)
+	require.Equal(t, common.HexToHash("0x1234"), *seq.Batches[0].GlobalExitRoot)
+	require.Equal(t, int64(1000), seq.Batches[0].BatchTimestamp.Unix())
+	// Batch 11 includes a forced batch
+	require.Len(t, state.sequencedForceBatches, 1)
+	require.Equal(t, uint64(11), state.sequencedForceBatches[0].BatchNumber)
+	require.Equal(t, uint64(900), state.sequencedForceBatches[0].ForcedTimestamp)
+	require.Equal(t, common.HexToHash("0x5678"), state.sequencedForceBatches[0].ForcedGlobalExitRoot)
+}
+
+func TestProcessorL1VerifyBatchPreEtrogIgnoresRollupManagerVerifications(t *testing.T) {
+	state := &stateFake{}
+	sut := NewProcessorL1VerifyBatchPreEtrog(state)
+	txHash := common.HexToHash("0xabcd")
+	l1Block := &etherman.Block{BlockNumber: 123, VerifiedBatches: []etherman.VerifiedBatch{
+		// Emitted by the rollup contract and the RollupManager in the same tx
+		{RollupID: 1, BatchNumber: 10, TxHash: txHash},
+		{RollupID: 1, BatchNumber: 10, TxHash: txHash},
+		// Previous to LxLy only the rollup contract emits it
+		{RollupID: 1, BatchNumber: 20, TxHash: common.HexToHash("0xef01")},
+	}}
+
+	err := sut.Process(context.TODO(), actions.ForkIDEtrog, etherman.Order{Name: etherman.VerifyBatchPreEtrogOrder, Pos: 0}, l1Block, nil)
+	require.NoError(t, err)
+	require.Len(t, state.verifiedBatches, 0)
+	err = sut.Process(context.TODO(), actions.ForkIDIncaberry, etherman.Order{Name: etherman.VerifyBatchPreEtrogOrder, Pos: 2}, l1Block, nil)
+	require.NoError(t, err)
+	require.Len(t, state.verifiedBatches, 1)
+	require.Equal(t, uint64(20), state.verifiedBatches[0].BatchNumber)
+	require.False(t, state.verifiedBatches[0].IsTrusted)
+	require.Len(t, state.pendingStates, 1)
+	require.Equal(t, uint64(20), state.pendingStates[0].BatchNumber)
+}
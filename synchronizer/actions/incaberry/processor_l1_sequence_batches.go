@@ -0,0 +1,78 @@
+package incaberry
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type stateOnSequencedBatchesInterface interface {
+	OnSequencedBatchesOnL1(ctx context.Context, seq model.SequenceOfBatches, dbTx entities.Tx) error
+	OnSequencedForceBatchesOnL1(ctx context.Context, sequencedForceBatches []*entities.SequencedForceBatch, dbTx entities.Tx) error
+}
+
+// ProcessorL1SequenceBatchesPreEtrog implements L1EventProcessor for the sequences previous to Etrog
+type ProcessorL1SequenceBatchesPreEtrog struct {
+	actions.ProcessorBase[ProcessorL1SequenceBatchesPreEtrog]
+	state stateOnSequencedBatchesInterface
+}
+
+// NewProcessorL1SequenceBatchesPreEtrog returns instance of a processor for SequenceBatchesOrder of forkIDs previous to Etrog
+func NewProcessorL1SequenceBatchesPreEtrog(state stateOnSequencedBatchesInterface) *ProcessorL1SequenceBatchesPreEtrog {
+	return &ProcessorL1SequenceBatchesPreEtrog{
+		ProcessorBase: actions.ProcessorBase[ProcessorL1SequenceBatchesPreEtrog]{
+			SupportedEvent:      []etherman.EventOrder{etherman.SequenceBatchesOrder},
+			SupportedForkFamily: &actions.ForkFamiliesOnlyIncaberry},
+		state: state,
+	}
+}
+
+// Process process event
+func (p *ProcessorL1SequenceBatchesPreEtrog) Process(ctx context.Context, forkId actions.ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx entities.Tx) error {
+	if l1Block == nil || len(l1Block.SequencedBatches) <= order.Pos {
+		return actions.ErrInvalidParams
+	}
+	return p.processSequenceBatches(ctx, forkId, l1Block.SequencedBatches[order.Pos], l1Block.BlockNumber, l1Block.ReceivedAt, dbTx)
+}
+
+// processSequenceBatches the batches previous to Etrog have no L1InfoRoot, each one have its own GlobalExitRoot and timestamp
+func (p *ProcessorL1SequenceBatchesPreEtrog) processSequenceBatches(ctx context.Context, forkId actions.ForkIdType, sequencedBatches []etherman.SequencedBatch, blockNumber uint64, l1BlockTimestamp time.Time, dbTx entities.Tx) error {
+	if len(sequencedBatches) == 0 {
+		log.Warn("Empty sequencedBatches array detected, ignoring...")
+		return nil
+	}
+	for _, sequencedBatch := range sequencedBatches {
+		if sequencedBatch.PolygonZkEVMBatchData == nil {
+			log.Errorf("batch %d of block %d is not a batch previous to Etrog, but forkID is %d", sequencedBatch.BatchNumber, blockNumber, forkId)
+			return actions.ErrInvalidParams
+		}
+	}
+	seq := model.SequenceOfBatches{}
+	seqSource := string(etherman.SequenceBatchesOrder)
+	seq.Sequence = *entities.NewSequencedBatches(uint64(sequencedBatches[0].RollupID),
+		sequencedBatches[0].BatchNumber, sequencedBatches[len(sequencedBatches)-1].BatchNumber,
+		blockNumber, uint64(forkId),
+		l1BlockTimestamp, time.Now(),
+		common.Hash{}, seqSource)
+
+	var sequencedForceBatches []*entities.SequencedForceBatch
+	for _, sequencedBatch := range sequencedBatches {
+		virtualBatch := entities.NewVirtualBatchFromL1(blockNumber, seq.Sequence.FromBatchNumber,
+			seq.Sequence.ForkID, sequencedBatch)
+		seq.Batches = append(seq.Batches, virtualBatch)
+		if sequencedForceBatch := entities.NewSequencedForceBatchFromSequencedBatch(blockNumber, sequencedBatch, seqSource); sequencedForceBatch != nil {
+			sequencedForceBatches = append(sequencedForceBatches, sequencedForceBatch)
+		}
+	}
+	err := p.state.OnSequencedBatchesOnL1(ctx, seq, dbTx)
+	if err != nil || len(sequencedForceBatches) == 0 {
+		return err
+	}
+	return p.state.OnSequencedForceBatchesOnL1(ctx, sequencedForceBatches, dbTx)
+}
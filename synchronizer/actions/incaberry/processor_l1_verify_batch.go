@@ -0,0 +1,73 @@
+package incaberry
+
+import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+)
+
+type stateVerifyBatchInterface interface {
+	AddVerifiedBatch(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx) error
+	AddPendingState(ctx context.Context, pendingState *entities.PendingState, dbTx entities.Tx) error
+}
+
+// ProcessorL1VerifyBatchPreEtrog implements L1EventProcessor for VerifyBatchPreEtrogOrder
+type ProcessorL1VerifyBatchPreEtrog struct {
+	actions.ProcessorBase[ProcessorL1VerifyBatchPreEtrog]
+	state stateVerifyBatchInterface
+}
+
+// NewProcessorL1VerifyBatchPreEtrog returns instance of a processor for VerifyBatchPreEtrogOrder
+func NewProcessorL1VerifyBatchPreEtrog(state stateVerifyBatchInterface) *ProcessorL1VerifyBatchPreEtrog {
+	return &ProcessorL1VerifyBatchPreEtrog{
+		ProcessorBase: actions.ProcessorBase[ProcessorL1VerifyBatchPreEtrog]{
+			SupportedEvent:    []etherman.EventOrder{etherman.VerifyBatchPreEtrogOrder},
+			SupportedForkdIds: &actions.ForksIdAll},
+		state: state,
+	}
+}
+
+// Process process event. After LxLy the rollup contract emits this event on each verification
+// of the RollupManager, in that case it's ignored because it's stored from the RollupManager event
+func (p *ProcessorL1VerifyBatchPreEtrog) Process(ctx context.Context, forkId actions.ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx entities.Tx) error {
+	if l1Block == nil || len(l1Block.VerifiedBatches) <= order.Pos {
+		return actions.ErrInvalidParams
+	}
+	ethVerifiedBatch := l1Block.VerifiedBatches[order.Pos]
+	if isVerifiedByOtherEvent(l1Block, order.Pos) {
+		log.Debugf("VerifyBatches of rollup contract ignored, it's verified by the RollupManager. BlockNumber: %d, RollupID: %d, BatchNumber: %d",
+			l1Block.BlockNumber, ethVerifiedBatch.RollupID, ethVerifiedBatch.BatchNumber)
+		return nil
+	}
+	verifiedBatch := entities.NewVerifiedBatchFromL1(ethVerifiedBatch, false)
+	err := p.state.AddVerifiedBatch(ctx, verifiedBatch, dbTx)
+	if err != nil {
+		log.Errorf("error storing the verified batch. BlockNumber: %d, RollupID: %d, BatchNumber: %d, error: %v",
+			l1Block.BlockNumber, verifiedBatch.RollupID, verifiedBatch.BatchNumber, err)
+		return err
+	}
+	err = p.state.AddPendingState(ctx, entities.NewPendingStateFromL1(ethVerifiedBatch), dbTx)
+	if err != nil {
+		log.Errorf("error storing the pending state. BlockNumber: %d, RollupID: %d, BatchNumber: %d, error: %v",
+			l1Block.BlockNumber, verifiedBatch.RollupID, verifiedBatch.BatchNumber, err)
+		return err
+	}
+	log.Infof("Verified batch stored. BlockNumber: %d, RollupID: %d, BatchNumber: %d, StateRoot: %s, Trusted: %t",
+		l1Block.BlockNumber, verifiedBatch.RollupID, verifiedBatch.BatchNumber, verifiedBatch.StateRoot.String(), verifiedBatch.IsTrusted)
+	return nil
+}
+
+// isVerifiedByOtherEvent returns true if the same tx have emitted other verification of the same batch
+func isVerifiedByOtherEvent(l1Block *etherman.Block, pos int) bool {
+	verifiedBatch := l1Block.VerifiedBatches[pos]
+	for i, other := range l1Block.VerifiedBatches {
+		if i != pos && other.TxHash == verifiedBatch.TxHash && other.BatchNumber == verifiedBatch.BatchNumber &&
+			other.RollupID == verifiedBatch.RollupID {
+			return true
+		}
+	}
+	return false
+}
@@ -7,10 +7,11 @@ import (
 )
 
 // ProcessorBase is the base struct for all the processors, if reduces the boilerplate
-// implementing the Name, SupportedEvents and SupportedForkIds functions
+// implementing the Name, SupportedEvents, SupportedForkIds and SupportedForkFamilies functions
 type ProcessorBase[T any] struct {
-	SupportedEvent    []etherman.EventOrder
-	SupportedForkdIds *[]ForkIdType
+	SupportedEvent      []etherman.EventOrder
+	SupportedForkdIds   *[]ForkIdType
+	SupportedForkFamily *[]ForkFamily
 }
 
 // Name returns the name of the struct T
@@ -34,3 +35,11 @@ func (p *ProcessorBase[T]) SupportedForkIds() []ForkIdType {
 	// returns none
 	return []ForkIdType{}
 }
+
+// SupportedForkFamilies returns the supported families of forkIds in the struct
+func (p *ProcessorBase[T]) SupportedForkFamilies() []ForkFamily {
+	if p.SupportedForkFamily != nil {
+		return *p.SupportedForkFamily
+	}
+	return []ForkFamily{}
+}
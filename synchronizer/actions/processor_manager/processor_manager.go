@@ -18,40 +18,74 @@ var (
 // L1EventProcessors is a manager of L1EventProcessor, it have processor for each forkId and event
 //
 //	  and it could:
-//		 	- Returns specific processor for a forkId and event (Get function)
+//		 	- Returns specific processor for a forkId and event (Get function), if there is no
+//		 	  processor for the forkId it tries the family of the forkId and then the wildcard
 //	  	- Execute a event for a forkId and event (Process function)
 //
 // To build the object use L1EventProcessorsBuilder
 type L1EventProcessors struct {
 	// forkId -> event -> processor
 	processors map[actions.ForkIdType]map[etherman.EventOrder]actions.L1EventProcessor
+	// family -> event -> processor
+	familyProcessors map[actions.ForkFamily]map[etherman.EventOrder]actions.L1EventProcessor
+	forkIdRegistry   *actions.ForkIdRegistry
 }
 
 // NewL1EventProcessors returns a empty new L1EventProcessors
 func NewL1EventProcessors() *L1EventProcessors {
 	return &L1EventProcessors{
-		processors: make(map[actions.ForkIdType]map[etherman.EventOrder]actions.L1EventProcessor),
+		processors:       make(map[actions.ForkIdType]map[etherman.EventOrder]actions.L1EventProcessor),
+		familyProcessors: make(map[actions.ForkFamily]map[etherman.EventOrder]actions.L1EventProcessor),
+		forkIdRegistry:   actions.NewDefaultForkIdRegistry(),
 	}
 }
 
-// Get returns the processor, first try specific, if not wildcard and if not found returns nil
+// Get returns the processor, first try specific, then the family of the forkId, if not wildcard and if not found returns nil.
+// So a wildcard processor is only used for the forkIds without a specific or family processor for the event
 func (p *L1EventProcessors) Get(forkId actions.ForkIdType, event etherman.EventOrder) actions.L1EventProcessor {
 	if p == nil || p.processors == nil {
 		return nil
 	}
-	if _, ok := p.processors[forkId]; !ok {
-		if forkId == actions.WildcardForkId {
-			return nil
+	if processor, ok := p.processors[forkId][event]; ok {
+		return processor
+	}
+	if forkId == actions.WildcardForkId {
+		return nil
+	}
+	if processor := p.getByFamily(forkId, event); processor != nil {
+		return processor
+	}
+	return p.Get(actions.WildcardForkId, event)
+}
+
+// ShadowedForEvent returns true if the processor is registered as wildcard for the event but there are
+// specific or family processors for the same event, that are used instead of it for their forkIds
+func (p *L1EventProcessors) ShadowedForEvent(processor actions.L1EventProcessor, event etherman.EventOrder) bool {
+	if p == nil || p.processors[actions.WildcardForkId][event] != processor {
+		return false
+	}
+	for forkId, processors := range p.processors {
+		if other, ok := processors[event]; ok && forkId != actions.WildcardForkId && other != processor {
+			return true
 		}
-		return p.Get(actions.WildcardForkId, event)
 	}
-	if _, ok := p.processors[forkId][event]; !ok {
-		if forkId == actions.WildcardForkId {
-			return nil
+	for _, processors := range p.familyProcessors {
+		if other, ok := processors[event]; ok && other != processor {
+			return true
 		}
-		return p.Get(actions.WildcardForkId, event)
 	}
-	return p.processors[forkId][event]
+	return false
+}
+
+func (p *L1EventProcessors) getByFamily(forkId actions.ForkIdType, event etherman.EventOrder) actions.L1EventProcessor {
+	if p.forkIdRegistry == nil {
+		return nil
+	}
+	family, err := p.forkIdRegistry.Family(forkId)
+	if err != nil {
+		return nil
+	}
+	return p.familyProcessors[family][event]
 }
 
 // Process execute the event for the forkId and event
@@ -64,7 +98,21 @@ func (p *L1EventProcessors) Process(ctx context.Context, forkId actions.ForkIdTy
 		} else {
 			strBlockNumber = "nil"
 		}
+		if p.forkIdRegistry != nil && p.hasFamilyProcessor(order.Name) {
+			if _, err := p.forkIdRegistry.Family(forkId); err != nil {
+				return fmt.Errorf("can't process blocknumber:%s event:%s, forkid:%d because: %w. Err: %w", strBlockNumber, order.Name, forkId, ErrCantProcessThisEvent, err)
+			}
+		}
 		return fmt.Errorf("can't process blocknumber:%s event:%s, forkid:%d because: %w", strBlockNumber, order.Name, forkId, ErrCantProcessThisEvent)
 	}
 	return processor.Process(ctx, forkId, order, block, dbTx)
 }
+
+func (p *L1EventProcessors) hasFamilyProcessor(event etherman.EventOrder) bool {
+	for _, processors := range p.familyProcessors {
+		if _, ok := processors[event]; ok {
+			return true
+		}
+	}
+	return false
+}
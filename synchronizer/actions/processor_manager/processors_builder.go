@@ -27,15 +27,51 @@ func (p *L1EventProcessorsBuilder) Build() *L1EventProcessors {
 	return p.result
 }
 
-// Register register a L1EventProcessor. It ask to the processor the supported forkId and events
+// SetForkIdRegistry set the registry used to resolve the family of a forkId, by default
+// it's used actions.NewDefaultForkIdRegistry
+func (p *L1EventProcessorsBuilder) SetForkIdRegistry(registry *actions.ForkIdRegistry) {
+	p.createResultIfNeeded()
+	p.result.forkIdRegistry = registry
+}
+
+// Register register a L1EventProcessor. It ask to the processor the supported forkId, families and events
 // if there are a previous object register it will panic
 func (p *L1EventProcessorsBuilder) Register(processor actions.L1EventProcessor) {
+	p.register(processor, true)
+}
+
+// Replace register a L1EventProcessor as Register does, but it replaces the previous
+// processors for the same forkId or family and event instead of panic
+func (p *L1EventProcessorsBuilder) Replace(processor actions.L1EventProcessor) {
+	p.register(processor, false)
+}
+
+func (p *L1EventProcessorsBuilder) register(processor actions.L1EventProcessor, panicIfExists bool) {
 	p.createResultIfNeeded()
 	for _, forkID := range processor.SupportedForkIds() {
 		for _, event := range processor.SupportedEvents() {
-			p.Set(forkID, event, processor, true)
+			p.Set(forkID, event, processor, panicIfExists)
 		}
 	}
+	if withFamilies, ok := processor.(actions.L1EventProcessorForkFamilies); ok {
+		for _, family := range withFamilies.SupportedForkFamilies() {
+			for _, event := range processor.SupportedEvents() {
+				p.SetFamily(family, event, processor, panicIfExists)
+			}
+		}
+	}
+}
+
+// SetFamily add a L1EventProcessor for a family of forkIds. If param panicIfExists is true, will panic if already exists the object
+func (p *L1EventProcessorsBuilder) SetFamily(family actions.ForkFamily, event etherman.EventOrder, processor actions.L1EventProcessor, panicIfExists bool) {
+	p.createResultIfNeeded()
+	if _, ok := p.result.familyProcessors[family]; !ok {
+		p.result.familyProcessors[family] = make(map[etherman.EventOrder]actions.L1EventProcessor)
+	}
+	if _, ok := p.result.familyProcessors[family][event]; ok && panicIfExists {
+		panic("processor already set for family")
+	}
+	p.result.familyProcessors[family][event] = processor
 }
 
 // Set add a L1EventProcessor. If param panicIfExists is true, will panic if already exists the object
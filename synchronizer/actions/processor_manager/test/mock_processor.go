@@ -30,3 +30,12 @@ func (p *ProcessorStub) SupportedForkIds() []actions.ForkIdType {
 func (p *ProcessorStub) Process(ctx context.Context, forkId actions.ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx entities.Tx) error {
 	return p.responseProcess
 }
+
+type ProcessorFamilyStub struct {
+	ProcessorStub
+	supportedForkFamilies []actions.ForkFamily
+}
+
+func (p *ProcessorFamilyStub) SupportedForkFamilies() []actions.ForkFamily {
+	return p.supportedForkFamilies
+}
@@ -83,3 +83,86 @@ func TestL1EventProcessors_Process(t *testing.T) {
 	result = sut.Process(context.Background(), actions.ForkIdType(2), etherman.Order{Name: event1, Pos: 0}, nil, nil)
 	require.ErrorIs(t, result, processor_manager.ErrCantProcessThisEvent, "must return not found error")
 }
+
+func newFamilyProcessorsForTest(t *testing.T, policy actions.UnknownForkIdPolicy) (*processor_manager.L1EventProcessors, *ProcessorFamilyStub, *ProcessorFamilyStub, *ProcessorStub) {
+	t.Helper()
+	event1 := etherman.EventOrder("event1")
+	registry, err := actions.NewForkIdRegistry([]actions.ForkIdRange{
+		{FromForkId: 7, ToForkId: 7, Family: actions.ForkFamilyEtrog},
+		{FromForkId: 8, ToForkId: 11, Family: actions.ForkFamilyElderberry},
+	}, policy)
+	require.NoError(t, err)
+	processorEtrog := ProcessorFamilyStub{
+		ProcessorStub:         ProcessorStub{name: "processor_event1_etrog", supportedEvents: []etherman.EventOrder{event1}},
+		supportedForkFamilies: []actions.ForkFamily{actions.ForkFamilyEtrog},
+	}
+	processorElderberry := ProcessorFamilyStub{
+		ProcessorStub:         ProcessorStub{name: "processor_event1_elderberry", supportedEvents: []etherman.EventOrder{event1}},
+		supportedForkFamilies: []actions.ForkFamily{actions.ForkFamilyElderberry},
+	}
+	processorConcrete := ProcessorStub{
+		name:             "processor_event1_forkid9",
+		supportedEvents:  []etherman.EventOrder{event1},
+		supportedForkIds: []actions.ForkIdType{9},
+	}
+	builder := processor_manager.NewL1EventProcessorsBuilder()
+	builder.SetForkIdRegistry(registry)
+	builder.Register(&processorEtrog)
+	builder.Register(&processorElderberry)
+	builder.Register(&processorConcrete)
+	return builder.Build(), &processorEtrog, &processorElderberry, &processorConcrete
+}
+
+func TestL1EventProcessors_GetByFamily(t *testing.T) {
+	event1 := etherman.EventOrder("event1")
+	sut, processorEtrog, processorElderberry, processorConcrete := newFamilyProcessorsForTest(t, actions.UnknownForkIdHalt)
+
+	require.Equal(t, processorEtrog, sut.Get(7, event1), "forkId 7 is etrog family")
+	require.Equal(t, processorElderberry, sut.Get(8, event1), "forkId 8 is elderberry family")
+	require.Equal(t, processorElderberry, sut.Get(11, event1), "forkId 11 is elderberry family")
+	require.Equal(t, processorConcrete, sut.Get(9, event1), "specific forkId has priority over family")
+	require.Nil(t, sut.Get(12, event1), "forkId 12 is unknown")
+	require.Nil(t, sut.Get(6, event1), "forkId 6 is not in the registry")
+	require.Nil(t, sut.Get(7, etherman.EventOrder("event2")), "no processor for event")
+}
+
+func TestL1EventProcessors_ProcessUnknownForkIdHalt(t *testing.T) {
+	sut, _, _, _ := newFamilyProcessorsForTest(t, actions.UnknownForkIdHalt)
+
+	err := sut.Process(context.Background(), 12, etherman.Order{Name: "event1"}, &etherman.Block{BlockNumber: 100}, nil)
+	require.ErrorIs(t, err, processor_manager.ErrCantProcessThisEvent)
+	require.ErrorIs(t, err, actions.ErrUnknownForkId)
+
+	err = sut.Process(context.Background(), 12, etherman.Order{Name: "event2"}, nil, nil)
+	require.ErrorIs(t, err, processor_manager.ErrCantProcessThisEvent)
+	require.NotErrorIs(t, err, actions.ErrUnknownForkId, "event2 is not processed by any family")
+}
+
+func TestL1EventProcessors_ProcessUnknownForkIdLatest(t *testing.T) {
+	event1 := etherman.EventOrder("event1")
+	sut, _, processorElderberry, _ := newFamilyProcessorsForTest(t, actions.UnknownForkIdLatest)
+
+	require.Equal(t, processorElderberry, sut.Get(12, event1), "forkId 12 is processed as the latest family")
+	require.Equal(t, processorElderberry, sut.Get(100, event1), "forkId 100 is processed as the latest family")
+	require.Nil(t, sut.Get(6, event1), "forkId 6 is before the first range")
+	err := sut.Process(context.Background(), 12, etherman.Order{Name: event1}, nil, nil)
+	require.NoError(t, err)
+}
+
+func TestL1EventProcessors_ShadowedForEvent(t *testing.T) {
+	event1 := etherman.EventOrder("event1")
+	builder := processor_manager.NewL1EventProcessorsBuilder()
+	processorWildcard := &ProcessorStub{name: "wildcard", supportedEvents: []etherman.EventOrder{event1, "event2"},
+		supportedForkIds: []actions.ForkIdType{actions.WildcardForkId}}
+	processorEtrog := &ProcessorFamilyStub{ProcessorStub: ProcessorStub{name: "etrog", supportedEvents: []etherman.EventOrder{event1}},
+		supportedForkFamilies: []actions.ForkFamily{actions.ForkFamilyEtrog}}
+	builder.Register(processorWildcard)
+	builder.Register(processorEtrog)
+	sut := builder.Build()
+
+	require.True(t, sut.ShadowedForEvent(processorWildcard, event1), "etrog family has its own processor for event1")
+	require.False(t, sut.ShadowedForEvent(processorWildcard, "event2"), "no other processor for event2")
+	require.False(t, sut.ShadowedForEvent(processorEtrog, event1), "it's not a wildcard processor")
+	require.Equal(t, processorEtrog, sut.Get(7, event1))
+	require.Equal(t, processorWildcard, sut.Get(7, "event2"))
+}
@@ -30,3 +30,20 @@ func TestL1EventProcessorsBuilder_Register(t *testing.T) {
 		}
 	}
 }
+
+func TestL1EventProcessorsBuilder_Replace(t *testing.T) {
+	builder := processor_manager.NewL1EventProcessorsBuilder()
+	builtinProcessor := &ProcessorFamilyStub{
+		ProcessorStub:         ProcessorStub{name: "builtin", supportedEvents: []etherman.EventOrder{"event1"}},
+		supportedForkFamilies: []actions.ForkFamily{actions.ForkFamilyElderberry},
+	}
+	customProcessor := &ProcessorFamilyStub{
+		ProcessorStub:         ProcessorStub{name: "custom", supportedEvents: []etherman.EventOrder{"event1"}},
+		supportedForkFamilies: []actions.ForkFamily{actions.ForkFamilyElderberry},
+	}
+	builder.Register(builtinProcessor)
+	assert.Panics(t, func() { builder.Register(customProcessor) }, "Register must panic if the processor already exists")
+	builder.Replace(customProcessor)
+	result := builder.Build()
+	assert.Equal(t, customProcessor, result.Get(actions.ForkIDElderberry, "event1"), "the processor must be replaced")
+}
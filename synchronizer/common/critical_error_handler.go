@@ -0,0 +1,63 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+)
+
+// CriticalErrorHalt logs the error and blocks until ctx is done (the synchronizer is stopped or shut down),
+// so the process keeps running but the synchronizer is stopped. Then Sync returns the error
+type CriticalErrorHalt struct {
+	timeBetweenLogs time.Duration
+}
+
+// NewCriticalErrorHalt creates a CriticalErrorHalt that logs the error every timeBetweenLogs
+func NewCriticalErrorHalt(timeBetweenLogs time.Duration) *CriticalErrorHalt {
+	return &CriticalErrorHalt{
+		timeBetweenLogs: timeBetweenLogs,
+	}
+}
+
+// CriticalError halts until ctx is done
+func (c *CriticalErrorHalt) CriticalError(ctx context.Context, err error) {
+	log.Errorf("Critical error, halting synchronizer. Err: %v", err)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Halted synchronizer finished. Err: %v", err)
+			return
+		case <-time.After(c.timeBetweenLogs):
+			log.Errorf("Synchronizer halted due to a critical error. Err: %v", err)
+		}
+	}
+}
+
+// CriticalErrorReturn doesn't do anything, so Sync returns the error to the caller
+type CriticalErrorReturn struct {
+}
+
+// NewCriticalErrorReturn creates a CriticalErrorReturn
+func NewCriticalErrorReturn() *CriticalErrorReturn {
+	return &CriticalErrorReturn{}
+}
+
+// CriticalError logs the error
+func (c *CriticalErrorReturn) CriticalError(ctx context.Context, err error) {
+	log.Errorf("Critical error, synchronization finished. Err: %v", err)
+}
+
+// CriticalErrorExit exits the process
+type CriticalErrorExit struct {
+}
+
+// NewCriticalErrorExit creates a CriticalErrorExit
+func NewCriticalErrorExit() *CriticalErrorExit {
+	return &CriticalErrorExit{}
+}
+
+// CriticalError logs the error and exits the process
+func (c *CriticalErrorExit) CriticalError(ctx context.Context, err error) {
+	log.Fatalf("Critical error, exiting. Err: %v", err)
+}
@@ -0,0 +1,15 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCriticalErrorHaltReturnsWhenCtxIsDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	sut := NewCriticalErrorHalt(time.Millisecond)
+	sut.CriticalError(ctx, fmt.Errorf("critical"))
+}
@@ -12,6 +12,14 @@ type Config struct {
 	// SyncChunkSize is the number of blocks to sync on each chunk
 	SyncChunkSize uint64 `mapstructure:"SyncChunkSize"`
 
+	// MinSyncChunkSize is the minimum chunk size, it's reduced if the L1 node rejects the range
+	// (too many results, range limit or timeout). 0 means SyncChunkSize
+	MinSyncChunkSize uint64 `mapstructure:"MinSyncChunkSize"`
+
+	// MaxSyncChunkSize is the maximum chunk size, it grows while the responses are small and fast.
+	// 0 means SyncChunkSize
+	MaxSyncChunkSize uint64 `mapstructure:"MaxSyncChunkSize"`
+
 	// GenesisBlockNumber is the block number of the genesis block (first block to synchronize)
 	// if it's zero it finds the etrog upgrade block
 	GenesisBlockNumber uint64 `mapstructure:"GenesisBlockNumber"`
@@ -27,4 +35,63 @@ type Config struct {
 	// OverrideStorageCheck is a flag to override the storage check
 	// take in account that without that check you can merge data from different rollups or differents L1 networks
 	OverrideStorageCheck bool `mapstructure:"OverrideStorageCheck"`
+	// OverrideRollupTypeCheck skips the check at startup that the forkID of the current rollup type of the
+	// synchronized rollups is supported. Without it the sequences of an unsupported forkID are not decoded
+	OverrideRollupTypeCheck bool `mapstructure:"OverrideRollupTypeCheck"`
+	// MaxReorgDepth is the maximum number of L1 blocks that a reorg can revert, a deeper reorg is not executed
+	// and it's reported as a critical error. 0 means no limit
+	MaxReorgDepth uint64 `mapstructure:"MaxReorgDepth"`
+
+	// ParallelFetch configures the retrieval of finalized block ranges concurrently
+	ParallelFetch ParallelFetchConfig `mapstructure:"ParallelFetch"`
+
+	// L1BlockCheck configures the verification of the hashes of the L1 blocks stored
+	L1BlockCheck L1BlockCheckConfig `mapstructure:"L1BlockCheck"`
+
+	// ForkIDs maps the forkIDs to the family of processors that process their L1 events
+	ForkIDs ForkIDsConfig `mapstructure:"ForkIDs"`
+}
+
+// ParallelFetchConfig configures the parallel retrieval of finalized blocks, the ranges are
+// retrieved concurrently but processed in order
+type ParallelFetchConfig struct {
+	// NumWorkers is the number of concurrent requests to L1. 0 or 1 means sequential
+	NumWorkers uint64 `mapstructure:"NumWorkers"`
+	// MaxPendingRanges is the maximum number of ranges retrieved and not yet processed,
+	// it bounds the memory used. 0 means 2*NumWorkers
+	MaxPendingRanges uint64 `mapstructure:"MaxPendingRanges"`
+}
+
+// L1BlockCheckConfig configures the verification of the L1 blocks stored until they are finalized
+type L1BlockCheckConfig struct {
+	// Async runs the check in background instead of at the start of each range, so the synchronization
+	// is not stalled. The reorgs detected are executed by the synchronization loop
+	Async bool `mapstructure:"Async"`
+	// TimeBetweenChecks is the wait after checking all the pending blocks when Async is enabled
+	TimeBetweenChecks types.Duration `mapstructure:"TimeBetweenChecks"`
+	// PreCheckEnabled verifies the blocks between PreCheckInitialBlock and PreCheckEndBlock without marking them
+	// as checked, so the reorgs are detected before the blocks reach BlockFinality
+	PreCheckEnabled bool `mapstructure:"PreCheckEnabled"`
+	// PreCheckInitialBlock is the first block of the pre-check segment, same syntax as BlockFinality (e.g. safe)
+	PreCheckInitialBlock string `jsonschema:"enum=latest,enum=safe, enum=pending, enum=finalized" mapstructure:"PreCheckInitialBlock"`
+	// PreCheckEndBlock is the last block of the pre-check segment, same syntax as BlockFinality (e.g. latest/-32)
+	PreCheckEndBlock string `jsonschema:"enum=latest,enum=safe, enum=pending, enum=finalized" mapstructure:"PreCheckEndBlock"`
+}
+
+// ForkIDsConfig configures the registry of forkIDs, a new forkID that keeps the L1 interface of a
+// previous one only needs to be added to the range of its family
+type ForkIDsConfig struct {
+	// Ranges of forkIDs and their family. If it's empty the ranges known by this version are used
+	Ranges []ForkIDRangeConfig `mapstructure:"Ranges"`
+	// UnknownForkIDPolicy is what to do with a forkID greater than the last range:
+	// 'halt' stops the synchronization with an error, 'latest' uses the family of the last range
+	UnknownForkIDPolicy string `jsonschema:"enum=halt,enum=latest" mapstructure:"UnknownForkIDPolicy"`
+}
+
+// ForkIDRangeConfig is a range of forkIDs (both included) processed by the same family of processors
+type ForkIDRangeConfig struct {
+	FromForkID uint64 `mapstructure:"FromForkID"`
+	ToForkID   uint64 `mapstructure:"ToForkID"`
+	// Family of processors: incaberry, etrog or elderberry
+	Family string `jsonschema:"enum=incaberry,enum=etrog,enum=elderberry" mapstructure:"Family"`
 }
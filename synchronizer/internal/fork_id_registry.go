@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	syncconfig "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/config"
+)
+
+// newForkIdRegistry creates the registry of forkIDs from the configuration, if there are no ranges
+// configured it uses the ones known by this version (actions.DefaultForkIdRanges)
+func newForkIdRegistry(cfg syncconfig.ForkIDsConfig) (*actions.ForkIdRegistry, error) {
+	policy := actions.UnknownForkIdPolicy(cfg.UnknownForkIDPolicy)
+	if policy == "" {
+		policy = actions.UnknownForkIdHalt
+	}
+	ranges := actions.DefaultForkIdRanges
+	if len(cfg.Ranges) > 0 {
+		ranges = make([]actions.ForkIdRange, len(cfg.Ranges))
+		for i, r := range cfg.Ranges {
+			ranges[i] = actions.ForkIdRange{
+				FromForkId: actions.ForkIdType(r.FromForkID),
+				ToForkId:   actions.ForkIdType(r.ToForkID),
+				Family:     actions.ForkFamily(r.Family),
+			}
+		}
+	}
+	registry, err := actions.NewForkIdRegistry(ranges, policy)
+	if err != nil {
+		return nil, fmt.Errorf("synchronizer.ForkIDs has a wrong value. Err: %w", err)
+	}
+	log.Infof("ForkIDs registry: %v unknown forkIDs policy: %s", ranges, policy)
+	return registry, nil
+}
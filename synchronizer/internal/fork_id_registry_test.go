@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	syncconfig "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/config"
+	mock_syncinterfaces "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewForkIdRegistryDefaultRanges(t *testing.T) {
+	registry, err := newForkIdRegistry(syncconfig.ForkIDsConfig{})
+	require.NoError(t, err)
+	require.Equal(t, actions.UnknownForkIdHalt, registry.Policy())
+	family, err := registry.Family(actions.ForkID11)
+	require.NoError(t, err)
+	require.Equal(t, actions.ForkFamilyElderberry, family)
+
+	_, err = newForkIdRegistry(syncconfig.ForkIDsConfig{UnknownForkIDPolicy: "ignore"})
+	require.Error(t, err)
+}
+
+func TestNewForkIdRegistryNewForkIDWithoutRelease(t *testing.T) {
+	registry, err := newForkIdRegistry(syncconfig.ForkIDsConfig{
+		Ranges: []syncconfig.ForkIDRangeConfig{
+			{FromForkID: 1, ToForkID: 6, Family: "incaberry"},
+			{FromForkID: 7, ToForkID: 7, Family: "etrog"},
+			{FromForkID: 8, ToForkID: 12, Family: "elderberry"},
+		},
+		UnknownForkIDPolicy: "halt",
+	})
+	require.NoError(t, err)
+	sut := newL1EventProcessor(mock_syncinterfaces.NewStateInterface(t), registry)
+	require.Equal(t, "ProcessorL1SequenceBatchesElderberry", sut.Get(12, etherman.SequenceBatchesOrder).Name())
+	require.Equal(t, "ProcessorL1InitialSequenceBatches", sut.Get(12, etherman.InitialSequenceBatchesOrder).Name())
+	require.Nil(t, sut.Get(13, etherman.SequenceBatchesOrder))
+
+	_, err = newForkIdRegistry(syncconfig.ForkIDsConfig{
+		Ranges:              []syncconfig.ForkIDRangeConfig{{FromForkID: 12, ToForkID: 12, Family: "unknown"}},
+		UnknownForkIDPolicy: "halt",
+	})
+	require.Error(t, err)
+}
+
+func TestNewForkIdRegistryUnknownPolicyLatest(t *testing.T) {
+	registry, err := newForkIdRegistry(syncconfig.ForkIDsConfig{UnknownForkIDPolicy: "latest"})
+	require.NoError(t, err)
+	sut := newL1EventProcessor(mock_syncinterfaces.NewStateInterface(t), registry)
+	require.Equal(t, "ProcessorL1SequenceBatchesElderberry", sut.Get(99, etherman.SequenceBatchesOrder).Name())
+}
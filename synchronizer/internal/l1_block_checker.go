@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	syncconfig "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/config"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_check_block"
+	l1sync "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_sync"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces"
+)
+
+// backgroundL1BlockChecker is a checker that runs in its own goroutine (l1_check_block.AsyncCheck). The reorgs
+// that it has detected before executing a reorg are stale, and it must be stopped on Shutdown
+type backgroundL1BlockChecker interface {
+	OnReorgExecuted()
+	Stop()
+}
+
+// newL1BlockChecker creates the checker of the L1 blocks stored: the blocks are marked as checked once they
+// reach finalizedBlockNumberFetcher and, if enabled, the segment of the pre-check is verified without marking them
+func newL1BlockChecker(cfg syncconfig.L1BlockCheckConfig, l1Client l1_check_block.L1Requester,
+	state syncinterfaces.StateInterface, finalizedBlockNumberFetcher l1_check_block.L1BlockNumberFetcher) (l1sync.BlockChecker, error) {
+	var checker l1_check_block.L1BlockChecker = l1_check_block.NewCheckL1BlockHash(l1Client, state, finalizedBlockNumberFetcher)
+	if cfg.PreCheckEnabled {
+		initialBlockPoint, err := l1_check_block.StringToL1BlockPointWithOffset(cfg.PreCheckInitialBlock)
+		if err != nil {
+			return nil, fmt.Errorf("synchronizer.L1BlockCheck.PreCheckInitialBlock has a wrong value. Err: %w", err)
+		}
+		endBlockPoint, err := l1_check_block.StringToL1BlockPointWithOffset(cfg.PreCheckEndBlock)
+		if err != nil {
+			return nil, fmt.Errorf("synchronizer.L1BlockCheck.PreCheckEndBlock has a wrong value. Err: %w", err)
+		}
+		if initialBlockPoint.GreaterThan(&endBlockPoint) {
+			return nil, fmt.Errorf("synchronizer.L1BlockCheck.PreCheckEndBlock (%s) must be greater or equal than synchronizer.L1BlockCheck.PreCheckInitialBlock (%s)",
+				endBlockPoint.String(), initialBlockPoint.String())
+		}
+		log.Infof("L1 blocks pre-check enabled from %s to %s", initialBlockPoint.String(), endBlockPoint.String())
+		preChecker := l1_check_block.NewPreCheckL1BlockHash(l1Client, &preCheckStateAdapter{state: state},
+			l1_check_block.NewL1BlockNumberByNameFetch(initialBlockPoint).SetIfNotFoundReturnsZero(),
+			l1_check_block.NewL1BlockNumberByNameFetch(endBlockPoint))
+		checker = l1_check_block.NewMultipleChecker(checker, preChecker)
+	}
+	if cfg.Async {
+		log.Infof("L1 blocks are checked in background every %s", cfg.TimeBetweenChecks.String())
+		checker = l1_check_block.NewAsyncCheck(checker, cfg.TimeBetweenChecks.Duration)
+	}
+	return checker, nil
+}
+
+// preCheckStateAdapter adapts the storage to l1_check_block.StatePreCheckInterfacer
+type preCheckStateAdapter struct {
+	state syncinterfaces.StorageBlockReaderInterface
+}
+
+func (a *preCheckStateAdapter) GetUncheckedBlocks(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx stateTxType) ([]*entities.L1Block, error) {
+	blocks, err := a.state.GetUncheckedBlocks(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	if err != nil || blocks == nil {
+		return nil, err
+	}
+	res := make([]*entities.L1Block, len(*blocks))
+	for i := range *blocks {
+		res[i] = &(*blocks)[i]
+	}
+	return res, nil
+}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	syncconfig "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/config"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_check_block"
+	mock_syncinterfaces "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewL1BlockCheckerPreCheckWrongBlockPoint(t *testing.T) {
+	cfg := syncconfig.L1BlockCheckConfig{
+		PreCheckEnabled:      true,
+		PreCheckInitialBlock: "safe",
+		PreCheckEndBlock:     "unknown",
+	}
+	_, err := newL1BlockChecker(cfg, nil, nil, &l1_check_block.L1FinalizedFetch)
+	require.Error(t, err)
+}
+
+func TestNewL1BlockCheckerPreCheckSegmentReversed(t *testing.T) {
+	cfg := syncconfig.L1BlockCheckConfig{
+		PreCheckEnabled:      true,
+		PreCheckInitialBlock: "latest",
+		PreCheckEndBlock:     "safe",
+	}
+	_, err := newL1BlockChecker(cfg, nil, nil, &l1_check_block.L1FinalizedFetch)
+	require.Error(t, err)
+}
+
+func TestNewL1BlockCheckerChain(t *testing.T) {
+	cfg := syncconfig.L1BlockCheckConfig{
+		PreCheckEnabled:      true,
+		PreCheckInitialBlock: "safe",
+		PreCheckEndBlock:     "latest/-32",
+	}
+	checker, err := newL1BlockChecker(cfg, nil, nil, &l1_check_block.L1FinalizedFetch)
+	require.NoError(t, err)
+	require.IsType(t, &l1_check_block.MultipleChecker{}, checker)
+
+	cfg.Async = true
+	cfg.TimeBetweenChecks.Duration = time.Second
+	checker, err = newL1BlockChecker(cfg, nil, nil, &l1_check_block.L1FinalizedFetch)
+	require.NoError(t, err)
+	require.IsType(t, &l1_check_block.AsyncCheck{}, checker)
+}
+
+func TestPreCheckStateAdapter(t *testing.T) {
+	ctx := context.TODO()
+	mockState := mock_syncinterfaces.NewStateInterface(t)
+	sut := &preCheckStateAdapter{state: mockState}
+	blocks := []entities.L1Block{{BlockNumber: 10}, {BlockNumber: 11}}
+	mockState.EXPECT().GetUncheckedBlocks(ctx, uint64(10), uint64(20), nil).Return(&blocks, nil)
+
+	res, err := sut.GetUncheckedBlocks(ctx, 10, 20, nil)
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Equal(t, uint64(11), res[1].BlockNumber)
+}
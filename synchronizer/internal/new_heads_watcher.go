@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// newHeadsWatcher subscribes to the new L1 blocks to wake up the synchronizer as soon as a block arrives.
+// If the subscription is not possible or drops, the synchronizer keeps polling every SyncInterval and
+// the subscription is retried after retryInterval
+type newHeadsWatcher struct {
+	subscriber    syncinterfaces.EthermanNewHeadSubscriber
+	retryInterval time.Duration
+	wakeUpCh      chan struct{}
+	startOnce     sync.Once
+}
+
+func newNewHeadsWatcher(subscriber syncinterfaces.EthermanNewHeadSubscriber, retryInterval time.Duration) *newHeadsWatcher {
+	return &newHeadsWatcher{
+		subscriber:    subscriber,
+		retryInterval: retryInterval,
+		wakeUpCh:      make(chan struct{}, 1),
+	}
+}
+
+// start launches the subscription in background until ctx is done, it can be called several times
+func (w *newHeadsWatcher) start(ctx context.Context) {
+	w.startOnce.Do(func() {
+		go w.run(ctx)
+	})
+}
+
+// newHeads returns a channel that receives a value when a new L1 block arrives, a nil watcher never wakes up
+func (w *newHeadsWatcher) newHeads() <-chan struct{} {
+	if w == nil {
+		return nil
+	}
+	return w.wakeUpCh
+}
+
+func (w *newHeadsWatcher) run(ctx context.Context) {
+	for {
+		err := w.subscribeAndWatch(ctx)
+		if errors.Is(err, etherman.ErrSubscriptionNotSupported) {
+			log.Infof("L1 endpoint doesn't support subscriptions, polling new blocks")
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		log.Warnf("newHeads subscription failed, polling new blocks until it's restored. Err: %v", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.retryInterval):
+		}
+	}
+}
+
+func (w *newHeadsWatcher) subscribeAndWatch(ctx context.Context) error {
+	headers := make(chan *ethTypes.Header)
+	subscription, err := w.subscriber.SubscribeNewHead(ctx, headers)
+	if err != nil {
+		return err
+	}
+	defer subscription.Unsubscribe()
+	log.Infof("Subscribed to newHeads, the synchronization is triggered by new L1 blocks")
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-subscription.Err():
+			return err
+		case header := <-headers:
+			log.Debugf("New L1 block %d received, waking up synchronizer", header.Number.Uint64())
+			select {
+			case w.wakeUpCh <- struct{}{}:
+			default:
+				// There is already a pending wake up
+			}
+		}
+	}
+}
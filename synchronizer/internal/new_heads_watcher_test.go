@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/config/types"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock_syncinterfaces "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces/mocks"
+	"github.com/ethereum/go-ethereum"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testSubscription struct {
+	errCh chan error
+}
+
+func newTestSubscription() *testSubscription {
+	return &testSubscription{errCh: make(chan error, 1)}
+}
+
+func (s *testSubscription) Unsubscribe()      {}
+func (s *testSubscription) Err() <-chan error { return s.errCh }
+
+func TestNewHeadsWatcherWakesUpOnNewBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	mockSubscriber := mock_syncinterfaces.NewEthermanNewHeadSubscriber(t)
+	headersCh := make(chan chan<- *ethTypes.Header, 1)
+	mockSubscriber.EXPECT().SubscribeNewHead(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, ch chan<- *ethTypes.Header) (ethereum.Subscription, error) {
+		headersCh <- ch
+		return newTestSubscription(), nil
+	}).Once()
+	sut := newNewHeadsWatcher(mockSubscriber, time.Hour)
+	sut.start(ctx)
+	headers := <-headersCh
+	headers <- &ethTypes.Header{Number: big.NewInt(100)}
+	select {
+	case <-sut.newHeads():
+	case <-time.After(time.Second):
+		require.Fail(t, "no wake up received")
+	}
+}
+
+func TestNewHeadsWatcherNotSupportedStops(t *testing.T) {
+	mockSubscriber := mock_syncinterfaces.NewEthermanNewHeadSubscriber(t)
+	mockSubscriber.EXPECT().SubscribeNewHead(mock.Anything, mock.Anything).Return(nil, etherman.ErrSubscriptionNotSupported).Once()
+	sut := newNewHeadsWatcher(mockSubscriber, time.Millisecond)
+	// It returns instead of retrying
+	sut.run(context.TODO())
+}
+
+func TestNewHeadsWatcherResubscribeWhenDropped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	mockSubscriber := mock_syncinterfaces.NewEthermanNewHeadSubscriber(t)
+	subscription := newTestSubscription()
+	subscription.errCh <- fmt.Errorf("connection lost")
+	mockSubscriber.EXPECT().SubscribeNewHead(ctx, mock.Anything).Return(subscription, nil).Once()
+	mockSubscriber.EXPECT().SubscribeNewHead(ctx, mock.Anything).Return(nil, fmt.Errorf("dial error")).Once()
+	resubscribed := make(chan struct{})
+	mockSubscriber.EXPECT().SubscribeNewHead(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, ch chan<- *ethTypes.Header) (ethereum.Subscription, error) {
+		close(resubscribed)
+		cancel()
+		return newTestSubscription(), nil
+	}).Once()
+	sut := newNewHeadsWatcher(mockSubscriber, time.Millisecond)
+	sut.run(ctx)
+	<-resubscribed
+}
+
+// Once synced the synchronizer waits SyncInterval, but a new L1 block starts a new iteration
+func TestSyncImplNewHeadWakesUpSynchronizer(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.sut.cfg.SyncInterval = types.NewDuration(time.Hour)
+	mockSubscriber := mock_syncinterfaces.NewEthermanNewHeadSubscriber(t)
+	testData.sut.newHeadsWatcher = newNewHeadsWatcher(mockSubscriber, time.Hour)
+	headersCh := make(chan chan<- *ethTypes.Header, 1)
+	mockSubscriber.EXPECT().SubscribeNewHead(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, ch chan<- *ethTypes.Header) (ethereum.Subscription, error) {
+		headersCh <- ch
+		return newTestSubscription(), nil
+	}).Once()
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	block := &entities.L1Block{BlockNumber: 123}
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(block, true, nil).Once()
+	secondIteration := make(chan struct{})
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		close(secondIteration)
+		testData.sut.Stop()
+		return block, true, nil
+	}).Once()
+
+	syncReturned := make(chan error)
+	go func() {
+		syncReturned <- testData.sut.Sync(0)
+	}()
+	headers := <-headersCh
+	headers <- &ethTypes.Header{Number: big.NewInt(124)}
+	select {
+	case <-secondIteration:
+	case <-time.After(time.Second):
+		require.Fail(t, "new L1 block doesn't wake up the synchronizer")
+	}
+	require.NoError(t, <-syncReturned)
+}
@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrReorgAborted is returned when a PreReorgHook aborts a reorg
+var ErrReorgAborted = errors.New("reorg aborted by a pre-reorg hook")
+
+// ReorgCallback is called after a reorg has been committed on DB
+type ReorgCallback func(reorgData ReorgExecutionResult)
+
+// PreReorgHook is called before executing a reorg, if it returns an error the reorg is not executed
+// and it's retried on the next iteration
+type PreReorgHook func(ctx context.Context, reorgData ReorgExecutionResult) error
+
+type reorgCallbackEntry struct {
+	id       uint64
+	callback ReorgCallback
+}
+
+type preReorgHookEntry struct {
+	id   uint64
+	hook PreReorgHook
+}
+
+// reorgNotifier keeps the subscribers to the reorgs, they are called in subscription order
+type reorgNotifier struct {
+	mutex     sync.RWMutex
+	lastID    uint64
+	callbacks []reorgCallbackEntry
+	hooks     []preReorgHookEntry
+}
+
+func (n *reorgNotifier) addCallback(callback ReorgCallback) uint64 {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.lastID++
+	n.callbacks = append(n.callbacks, reorgCallbackEntry{id: n.lastID, callback: callback})
+	return n.lastID
+}
+
+func (n *reorgNotifier) removeCallback(id uint64) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for i, entry := range n.callbacks {
+		if entry.id == id {
+			n.callbacks = append(n.callbacks[:i:i], n.callbacks[i+1:]...)
+			return
+		}
+	}
+}
+
+func (n *reorgNotifier) addPreReorgHook(hook PreReorgHook) uint64 {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.lastID++
+	n.hooks = append(n.hooks, preReorgHookEntry{id: n.lastID, hook: hook})
+	return n.lastID
+}
+
+func (n *reorgNotifier) removePreReorgHook(id uint64) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	for i, entry := range n.hooks {
+		if entry.id == id {
+			n.hooks = append(n.hooks[:i:i], n.hooks[i+1:]...)
+			return
+		}
+	}
+}
+
+// runPreReorgHooks stops on the first hook that aborts the reorg
+func (n *reorgNotifier) runPreReorgHooks(ctx context.Context, reorgData ReorgExecutionResult) error {
+	n.mutex.RLock()
+	hooks := n.hooks
+	n.mutex.RUnlock()
+	for _, entry := range hooks {
+		if err := entry.hook(ctx, reorgData); err != nil {
+			return fmt.Errorf("%w: %w", ErrReorgAborted, err)
+		}
+	}
+	return nil
+}
+
+func (n *reorgNotifier) notifyReorgDone(reorgData ReorgExecutionResult) {
+	n.mutex.RLock()
+	callbacks := n.callbacks
+	n.mutex.RUnlock()
+	for _, entry := range callbacks {
+		entry.callback(reorgData)
+	}
+}
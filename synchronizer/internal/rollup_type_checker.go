@@ -0,0 +1,49 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces"
+)
+
+type l1EventProcessorGetter interface {
+	Get(forkId actions.ForkIdType, event etherman.EventOrder) actions.L1EventProcessor
+}
+
+// checkRollupTypesAreSupported checks that there is a processor for the sequences of the forkID of the
+// current rollup type of each rollup synchronized, otherwise its batches can't be decoded. It's skipped if
+// there is no RollupManager because there are no rollup types
+func checkRollupTypesAreSupported(ctx context.Context, ethMan syncinterfaces.EthermanFullInterface,
+	processors l1EventProcessorGetter, override bool) error {
+	if !ethMan.HasRollupManager() {
+		log.Infof("There is no RollupManager, skipping the check of the rollup types")
+		return nil
+	}
+	for _, rollupID := range ethMan.GetRollupIDs() {
+		rollupData, err := ethMan.GetRollupData(ctx, uint32(rollupID))
+		if err != nil {
+			if override {
+				log.Warnf("can't check the rollup type of rollupID %d, but the check is bypassed by configuration (OverrideRollupTypeCheck). Error: %s",
+					rollupID, err.Error())
+				continue
+			}
+			return err
+		}
+		log.Infof("RollupID %d current RollupTypeID: %d forkID: %d", rollupID, rollupData.RollupTypeID, rollupData.ForkID)
+		if processors.Get(actions.ForkIdType(rollupData.ForkID), etherman.SequenceBatchesOrder) != nil {
+			continue
+		}
+		err = fmt.Errorf("the current type of rollupID %d (RollupTypeID: %d) has forkID %d that is not supported by this synchronizer",
+			rollupID, rollupData.RollupTypeID, rollupData.ForkID)
+		if override {
+			log.Warnf("%s, but the check is bypassed by configuration (OverrideRollupTypeCheck)", err.Error())
+			continue
+		}
+		return err
+	}
+	return nil
+}
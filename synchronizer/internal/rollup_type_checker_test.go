@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	mock_syncinterfaces "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckRollupTypesAreSupported(t *testing.T) {
+	ctx := context.TODO()
+	processors := newL1EventProcessor(mock_syncinterfaces.NewStateInterface(t), actions.NewDefaultForkIdRegistry())
+	tests := []struct {
+		name     string
+		forkID   uint64
+		override bool
+		wantErr  bool
+	}{
+		{name: "supported forkID", forkID: 9},
+		{name: "unsupported forkID", forkID: 99, wantErr: true},
+		{name: "unsupported forkID with override", forkID: 99, override: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockEtherman := mock_syncinterfaces.NewEthermanFullInterface(t)
+			mockEtherman.EXPECT().HasRollupManager().Return(true)
+			mockEtherman.EXPECT().GetRollupIDs().Return([]uint{1})
+			mockEtherman.EXPECT().GetRollupData(ctx, uint32(1)).Return(&etherman.RollupData{RollupID: 1, RollupTypeID: 3, ForkID: tt.forkID}, nil)
+
+			err := checkRollupTypesAreSupported(ctx, mockEtherman, processors, tt.override)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckRollupTypesAreSupportedRollupDataError(t *testing.T) {
+	ctx := context.TODO()
+	processors := newL1EventProcessor(mock_syncinterfaces.NewStateInterface(t), actions.NewDefaultForkIdRegistry())
+	errRPC := errors.New("rpc error")
+	tests := []struct {
+		name     string
+		override bool
+		wantErr  error
+	}{
+		{name: "without override", wantErr: errRPC},
+		{name: "with override", override: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockEtherman := mock_syncinterfaces.NewEthermanFullInterface(t)
+			mockEtherman.EXPECT().HasRollupManager().Return(true)
+			mockEtherman.EXPECT().GetRollupIDs().Return([]uint{1})
+			mockEtherman.EXPECT().GetRollupData(ctx, uint32(1)).Return(nil, errRPC)
+
+			err := checkRollupTypesAreSupported(ctx, mockEtherman, processors, tt.override)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCheckRollupTypesAreSupportedWithoutRollupManager(t *testing.T) {
+	ctx := context.TODO()
+	processors := newL1EventProcessor(mock_syncinterfaces.NewStateInterface(t), actions.NewDefaultForkIdRegistry())
+	mockEtherman := mock_syncinterfaces.NewEthermanFullInterface(t)
+	mockEtherman.EXPECT().HasRollupManager().Return(false)
+
+	require.NoError(t, checkRollupTypesAreSupported(ctx, mockEtherman, processors, false))
+}
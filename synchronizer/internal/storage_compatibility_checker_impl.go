@@ -33,5 +33,12 @@ func (s *StorageCompatibilityCheckerImpl) getDataFromEtherman() entities.Storage
 		RollupID:  uint64(s.etherman.GetRollupID()),
 		L1ChainID: s.etherman.GetL1ChainID(),
 	}
+	// With a single rollup the bound data is kept as before, so old storages are still compatible
+	rollupIDs := s.etherman.GetRollupIDs()
+	if len(rollupIDs) > 1 {
+		for _, rollupID := range rollupIDs {
+			data.RollupIDs = append(data.RollupIDs, uint64(rollupID))
+		}
+	}
 	return data
 }
@@ -31,6 +31,7 @@ func TestStorageCheckerNoError(t *testing.T) {
 	testData := newTestDataForSanityStorageChecker(t, false)
 	testData.mockEtherman.On("GetRollupID").Return(uint(1))
 	testData.mockEtherman.On("GetL1ChainID").Return(uint64(10))
+	testData.mockEtherman.On("GetRollupIDs").Return([]uint{1})
 	currentContetsBoundData := entities.StorageContentsBoundData{
 		RollupID:  1,
 		L1ChainID: 10,
@@ -46,6 +47,7 @@ func TestStorageCheckerError(t *testing.T) {
 	testData := newTestDataForSanityStorageChecker(t, false)
 	testData.mockEtherman.On("GetRollupID").Return(uint(1))
 	testData.mockEtherman.On("GetL1ChainID").Return(uint64(10))
+	testData.mockEtherman.On("GetRollupIDs").Return([]uint{1})
 	currentContetsBoundData := entities.StorageContentsBoundData{
 		RollupID:  1,
 		L1ChainID: 10,
@@ -62,6 +64,7 @@ func TestStorageCheckerOverrider(t *testing.T) {
 	testData := newTestDataForSanityStorageChecker(t, true)
 	testData.mockEtherman.On("GetRollupID").Return(uint(1))
 	testData.mockEtherman.On("GetL1ChainID").Return(uint64(10))
+	testData.mockEtherman.On("GetRollupIDs").Return([]uint{1})
 	currentContetsBoundData := entities.StorageContentsBoundData{
 		RollupID:  1,
 		L1ChainID: 10,
@@ -73,3 +76,20 @@ func TestStorageCheckerOverrider(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestStorageCheckerMultipleRollups(t *testing.T) {
+	testData := newTestDataForSanityStorageChecker(t, false)
+	testData.mockEtherman.On("GetRollupID").Return(uint(1))
+	testData.mockEtherman.On("GetL1ChainID").Return(uint64(10))
+	testData.mockEtherman.On("GetRollupIDs").Return([]uint{1, 3})
+	currentContetsBoundData := entities.StorageContentsBoundData{
+		RollupID:  1,
+		L1ChainID: 10,
+		RollupIDs: []uint64{1, 3},
+	}
+	testData.mockStateCompatibility.On("CheckAndUpdateStorage", testData.ctx, currentContetsBoundData, testData.overrideStorageCheck, nil).Return(nil)
+
+	err := testData.sut.CheckAndUpdateStorage(testData.ctx)
+
+	require.NoError(t, err)
+}
@@ -23,6 +23,8 @@ type BlockRangeProcess struct {
 	stateForkId       syncinterfaces.StateForkIdQuerier
 	stateTxProvider   stateTxProvider
 	l1EventProcessors syncinterfaces.L1EventProcessorManager
+	// mainRollupID is the rollup used to choose the forkID of events that are not related to a rollup
+	mainRollupID uint64
 }
 
 // NewBlockRangeProcessLegacy creates a new BlockRangeProcess
@@ -31,12 +33,14 @@ func NewBlockRangeProcessLegacy(
 	stateForkId syncinterfaces.StateForkIdQuerier,
 	stateTxProvider stateTxProvider,
 	l1EventProcessors syncinterfaces.L1EventProcessorManager,
+	mainRollupID uint64,
 ) *BlockRangeProcess {
 	return &BlockRangeProcess{
 		storage:           state,
 		stateForkId:       stateForkId,
 		stateTxProvider:   stateTxProvider,
 		l1EventProcessors: l1EventProcessors,
+		mainRollupID:      mainRollupID,
 	}
 }
 
@@ -130,14 +134,18 @@ func (s *BlockRangeProcess) processBlock(ctx context.Context, blocks []etherman.
 
 func (s *BlockRangeProcess) processElement(ctx context.Context, element etherman.Order, blocks []etherman.Block, i int, dbTx stateTxType) error {
 	batchSequence := l1event_orders.GetSequenceFromL1EventOrder(element.Name, &blocks[i], element.Pos)
+	rollupID, isRollupEvent := l1event_orders.GetRollupIDFromL1EventOrder(element.Name, &blocks[i], element.Pos)
+	if !isRollupEvent {
+		rollupID = s.mainRollupID
+	}
 	forkId := entities.FORKID_ZERO
 	if s.stateForkId != nil {
 		if batchSequence != nil {
-			forkId = s.stateForkId.GetForkIDByBatchNumber(ctx, batchSequence.FromBatchNumber, dbTx)
-			log.Debug("EventOrder: ", element.Name, ". Batch Sequence: ", batchSequence, "forkId: ", forkId)
+			forkId = s.stateForkId.GetForkIDByBatchNumber(ctx, rollupID, batchSequence.FromBatchNumber, dbTx)
+			log.Debug("EventOrder: ", element.Name, ". RollupID: ", rollupID, ". Batch Sequence: ", batchSequence, "forkId: ", forkId)
 		} else {
-			forkId = s.stateForkId.GetForkIDByBlockNumber(ctx, blocks[i].BlockNumber, dbTx)
-			log.Debug("EventOrder: ", element.Name, ". BlockNumber: ", blocks[i].BlockNumber, "forkId: ", forkId)
+			forkId = s.stateForkId.GetForkIDByBlockNumber(ctx, rollupID, blocks[i].BlockNumber, dbTx)
+			log.Debug("EventOrder: ", element.Name, ". RollupID: ", rollupID, ". BlockNumber: ", blocks[i].BlockNumber, "forkId: ", forkId)
 		}
 	}
 	forkIdTyped := actions.ForkIdType(forkId)
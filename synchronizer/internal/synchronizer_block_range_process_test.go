@@ -3,6 +3,7 @@ package internal_test
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"testing"
 
 	zkevm_synchronizer_l1 "github.com/0xPolygonHermez/zkevm-synchronizer-l1"
@@ -10,7 +11,9 @@ import (
 	mock_entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities/mocks"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
 
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions/processor_manager"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/internal"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces"
 	mock_syncinterfaces "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces/mocks"
@@ -36,7 +39,7 @@ func newTestProcessBlockRangeData(t *testing.T) *testProcessBlockRangeData {
 	mockL1EventProcessor := mock_syncinterfaces.NewL1EventProcessorManager(t)
 	mockTransactions := mock_syncinterfaces.NewStateTxProvider(t)
 	DbTx := mock_entities.NewTx(t)
-	sut := internal.NewBlockRangeProcessLegacy(mockState, mockForkId, mockTransactions, mockL1EventProcessor)
+	sut := internal.NewBlockRangeProcessLegacy(mockState, mockForkId, mockTransactions, mockL1EventProcessor, uint64(1))
 	ctx := context.TODO()
 	blocks := []etherman.Block{
 		{
@@ -117,6 +120,7 @@ func TestProcessBlockMultiplesBLocksWithElements(t *testing.T) {
 			BlockNumber: 1,
 			ForkIDs: []etherman.ForkID{
 				{
+					RollupID:    1,
 					BatchNumber: 123,
 					ForkID:      10,
 				},
@@ -138,7 +142,7 @@ func TestProcessBlockMultiplesBLocksWithElements(t *testing.T) {
 	// First BeginStateTransaction returns data.DbTx
 	data.mockTransactions.EXPECT().BeginTransaction(data.ctx).Return(data.DbTx, nil).Once()
 	data.mockState.EXPECT().AddBlock(data.ctx, &pgstorage.L1Block{BlockNumber: 1, SyncVersion: zkevm_synchronizer_l1.Version, Checked: true, HasEvents: true}, data.DbTx).Return(nil)
-	data.mockForkId.EXPECT().GetForkIDByBlockNumber(data.ctx, uint64(1), data.DbTx).Return(uint64(1))
+	data.mockForkId.EXPECT().GetForkIDByBlockNumber(data.ctx, uint64(1), uint64(1), data.DbTx).Return(uint64(1))
 	data.mockL1EventProcessor.EXPECT().Process(data.ctx, actions.ForkIdType(1), mock.Anything, &blocks[0], data.DbTx).Return(nil)
 	data.DbTx.EXPECT().Commit(data.ctx).Return(nil).Once()
 
@@ -160,6 +164,7 @@ func TestProcessBlockMultiplesBLocksWithElementsSingleTx(t *testing.T) {
 			BlockNumber: 1,
 			ForkIDs: []etherman.ForkID{
 				{
+					RollupID:    1,
 					BatchNumber: 123,
 					ForkID:      10,
 				},
@@ -180,7 +185,7 @@ func TestProcessBlockMultiplesBLocksWithElementsSingleTx(t *testing.T) {
 	finalizedBlockNumber := uint64(1)
 	// First BeginStateTransaction returns data.DbTx
 	data.mockState.EXPECT().AddBlock(data.ctx, &pgstorage.L1Block{BlockNumber: 1, SyncVersion: zkevm_synchronizer_l1.Version, Checked: true, HasEvents: true}, mock.Anything).Return(nil)
-	data.mockForkId.EXPECT().GetForkIDByBlockNumber(data.ctx, uint64(1), mock.Anything).Return(uint64(1))
+	data.mockForkId.EXPECT().GetForkIDByBlockNumber(data.ctx, uint64(1), uint64(1), mock.Anything).Return(uint64(1))
 	data.mockL1EventProcessor.EXPECT().Process(data.ctx, actions.ForkIdType(1), mock.Anything, &blocks[0], mock.Anything).Return(nil)
 
 	// Second iteration reuse same tx
@@ -220,3 +225,101 @@ func TestProcessBlockErrorCommit(t *testing.T) {
 	require.Error(t, err)
 	require.ErrorIs(t, err, returnErr)
 }
+
+func TestProcessBlockSequenceOfOtherRollupUsesItsForkID(t *testing.T) {
+	data := newTestProcessBlockRangeData(t)
+	blocks := []etherman.Block{
+		{
+			BlockNumber: 1,
+			SequencedBatches: [][]etherman.SequencedBatch{
+				{
+					{RollupID: 2, BatchNumber: 10},
+					{RollupID: 2, BatchNumber: 11},
+				},
+			},
+		},
+	}
+	order := map[common.Hash][]etherman.Order{}
+	order[blocks[0].BlockHash] = []etherman.Order{
+		{
+			Name: etherman.SequenceBatchesOrder,
+			Pos:  0,
+		},
+	}
+	data.mockTransactions.EXPECT().BeginTransaction(data.ctx).Return(data.DbTx, nil)
+	data.mockState.EXPECT().AddBlock(data.ctx, mock.Anything, data.DbTx).Return(nil)
+	data.mockForkId.EXPECT().GetForkIDByBatchNumber(data.ctx, uint64(2), uint64(10), data.DbTx).Return(uint64(9))
+	data.mockL1EventProcessor.EXPECT().Process(data.ctx, actions.ForkIdType(9), mock.Anything, &blocks[0], data.DbTx).Return(nil)
+	data.DbTx.EXPECT().Commit(data.ctx).Return(nil)
+	err := data.sut.ProcessBlockRange(data.ctx, blocks, order, uint64(1))
+	require.NoError(t, err)
+}
+
+type forkFamilyProcessorForTest struct {
+	actions.ProcessorBase[forkFamilyProcessorForTest]
+	processed []actions.ForkIdType
+}
+
+func (p *forkFamilyProcessorForTest) Process(ctx context.Context, forkId actions.ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx entities.Tx) error {
+	p.processed = append(p.processed, forkId)
+	return nil
+}
+
+func newForkFamilyProcessorForTest(family actions.ForkFamily) *forkFamilyProcessorForTest {
+	return &forkFamilyProcessorForTest{
+		ProcessorBase: actions.ProcessorBase[forkFamilyProcessorForTest]{
+			SupportedEvent:      []etherman.EventOrder{etherman.SequenceBatchesOrder},
+			SupportedForkFamily: &[]actions.ForkFamily{family}},
+	}
+}
+
+// The range contains the sequences of etrog, elderberry and a forkID newer than the registry
+func newForkTransitionBlockRangeForTest(t *testing.T, policy actions.UnknownForkIdPolicy) (*internal.BlockRangeProcess, *forkFamilyProcessorForTest, *forkFamilyProcessorForTest, []etherman.Block, map[common.Hash][]etherman.Order) {
+	t.Helper()
+	mockState := mock_syncinterfaces.NewStorageInterface(t)
+	mockForkId := mock_syncinterfaces.NewStateForkIdQuerier(t)
+	registry, err := actions.NewForkIdRegistry(actions.DefaultForkIdRanges, policy)
+	require.NoError(t, err)
+	processorEtrog := newForkFamilyProcessorForTest(actions.ForkFamilyEtrog)
+	processorElderberry := newForkFamilyProcessorForTest(actions.ForkFamilyElderberry)
+	builder := processor_manager.NewL1EventProcessorsBuilder()
+	builder.SetForkIdRegistry(registry)
+	builder.Register(processorEtrog)
+	builder.Register(processorElderberry)
+	sut := internal.NewBlockRangeProcessLegacy(mockState, mockForkId, nil, builder.Build(), uint64(1))
+
+	blocks := []etherman.Block{}
+	order := map[common.Hash][]etherman.Order{}
+	for i, batchNumber := range []uint64{10, 20, 30} {
+		block := etherman.Block{
+			BlockNumber:      uint64(i + 1),
+			BlockHash:        common.BigToHash(big.NewInt(int64(i + 1))),
+			SequencedBatches: [][]etherman.SequencedBatch{{{RollupID: 1, BatchNumber: batchNumber}}},
+		}
+		blocks = append(blocks, block)
+		order[block.BlockHash] = []etherman.Order{{Name: etherman.SequenceBatchesOrder, Pos: 0}}
+	}
+	mockState.EXPECT().AddBlock(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockForkId.EXPECT().GetForkIDByBatchNumber(mock.Anything, uint64(1), uint64(10), mock.Anything).Return(uint64(actions.ForkIDEtrog)).Maybe()
+	mockForkId.EXPECT().GetForkIDByBatchNumber(mock.Anything, uint64(1), uint64(20), mock.Anything).Return(uint64(actions.ForkIDElderberry)).Maybe()
+	mockForkId.EXPECT().GetForkIDByBatchNumber(mock.Anything, uint64(1), uint64(30), mock.Anything).Return(uint64(actions.ForkID11 + 1)).Maybe()
+	return sut, processorEtrog, processorElderberry, blocks, order
+}
+
+func TestProcessBlockRangeForkTransitionUnknownForkIdLatest(t *testing.T) {
+	sut, processorEtrog, processorElderberry, blocks, order := newForkTransitionBlockRangeForTest(t, actions.UnknownForkIdLatest)
+	err := sut.ProcessBlockRangeSingleDbTx(context.TODO(), blocks, order, uint64(3), syncinterfaces.StoreL1Blocks, mock_entities.NewTx(t))
+	require.NoError(t, err)
+	require.Equal(t, []actions.ForkIdType{actions.ForkIDEtrog}, processorEtrog.processed)
+	require.Equal(t, []actions.ForkIdType{actions.ForkIDElderberry, actions.ForkID11 + 1}, processorElderberry.processed)
+}
+
+func TestProcessBlockRangeForkTransitionUnknownForkIdHalt(t *testing.T) {
+	sut, processorEtrog, processorElderberry, blocks, order := newForkTransitionBlockRangeForTest(t, actions.UnknownForkIdHalt)
+	dbTx := mock_entities.NewTx(t)
+	dbTx.EXPECT().Rollback(mock.Anything).Return(nil)
+	err := sut.ProcessBlockRangeSingleDbTx(context.TODO(), blocks, order, uint64(3), syncinterfaces.StoreL1Blocks, dbTx)
+	require.ErrorIs(t, err, actions.ErrUnknownForkId)
+	require.Equal(t, []actions.ForkIdType{actions.ForkIDEtrog}, processorEtrog.processed)
+	require.Equal(t, []actions.ForkIdType{actions.ForkIDElderberry}, processorElderberry.processed, "the sequence of the unknown forkId is not processed")
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions/elderberry"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions/etrog"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions/incaberry"
@@ -17,9 +21,18 @@ import (
 	syncconfig "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/config"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_check_block"
 	l1sync "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_sync"
+	syncmetrics "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/metrics"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces"
 )
 
+// shutdownAbortTimeout is the maximum wait for the aborted iteration and the reorg callbacks
+// once the deadline of Shutdown is reached
+const shutdownAbortTimeout = 5 * time.Second
+
+// maxDeSyncRestartsWithoutWait is the number of consecutive iterations restarted by ErrDeSync without waiting,
+// the next ones wait SyncInterval to avoid a hot loop if the L1 blocks keep changing
+const maxDeSyncRestartsWithoutWait = 3
+
 // SynchronizerImpl connects L1 and L2
 type SynchronizerImpl struct {
 	etherMan       syncinterfaces.EthermanFullInterface
@@ -30,13 +43,46 @@ type SynchronizerImpl struct {
 	genBlockNumber uint64
 	cfg            syncconfig.Config
 	networkID      uint
-	synced         bool
+	synced         atomic.Bool
 
 	blockRangeProcessor syncinterfaces.BlockRangeProcessor
 	l1Sync              syncinterfaces.L1Syncer
 	storageChecker      syncinterfaces.StorageCompatibilityChecker
+	// preRollupSyncer synchronizes the L1InfoTree events previous to the rollup genesis, if nil it's skipped
+	preRollupSyncer syncinterfaces.SyncPreRollupSyncer
+	// backgroundBlockChecker is the L1 block checker if it runs in background, otherwise nil
+	backgroundBlockChecker backgroundL1BlockChecker
+
+	reorgCallbackMutex sync.RWMutex
+	reorgCallback      func(nreorgData ReorgExecutionResult)
+	// reorgNotifier keeps the reorg subscribers and the pre-reorg hooks
+	reorgNotifier reorgNotifier
+
+	lastErrorMutex sync.Mutex
+	lastError      error
+	lastErrorTime  time.Time
+
+	// stopCh is closed by Shutdown to finish the Sync loop after the current iteration
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	// runningMutex guarantees that a Sync started before Shutdown is registered on runningSync
+	// and that a Sync started after Shutdown doesn't run
+	runningMutex sync.Mutex
+	// runningSync tracks the Sync loop and runningCallbacks the reorg callbacks in progress
+	runningSync      sync.WaitGroup
+	runningCallbacks sync.WaitGroup
+	// syncResult is the error returned by the last Sync, returned by Shutdown
+	syncResultMutex sync.Mutex
+	syncResult      error
+
+	// resumeCh is not nil while the synchronizer is paused, Resume closes it
+	pauseMutex sync.Mutex
+	resumeCh   chan struct{}
+	// newHeadsWatcher wakes up the synchronizer on new L1 blocks, if nil it only polls
+	newHeadsWatcher *newHeadsWatcher
 
-	reorgCallback func(nreorgData ReorgExecutionResult)
+	criticalErrorHandlerMutex sync.Mutex
+	criticalErrorHandler      syncinterfaces.CriticalErrorHandler
 }
 
 // NewSynchronizer creates and initializes an instance of Synchronizer
@@ -46,17 +92,29 @@ func NewSynchronizerImpl(
 	state syncinterfaces.StateInterface,
 	ethMan syncinterfaces.EthermanFullInterface,
 	storageChecker syncinterfaces.StorageCompatibilityChecker,
-	cfg syncconfig.Config) (*SynchronizerImpl, error) {
+	cfg syncconfig.Config,
+	extraProcessors ...actions.L1EventProcessor) (*SynchronizerImpl, error) {
+	syncmetrics.Register()
 	ctx, cancel := context.WithCancel(ctx)
-	networkID := uint(0)
+	networkID := ethMan.GetRollupID()
 	genesisBlockNumber, err := getGenesisBlockNumber(ctx, cfg.GenesisBlockNumber, ethMan)
 	if err != nil {
 		defer cancel()
 		return nil, err
 	}
 	cfg.GenesisBlockNumber = genesisBlockNumber
-	l1EventProcessors := newL1EventProcessor(state)
-	blockRangeProcessor := NewBlockRangeProcessLegacy(storage, state, state, l1EventProcessors)
+	forkIdRegistry, err := newForkIdRegistry(cfg.ForkIDs)
+	if err != nil {
+		defer cancel()
+		return nil, err
+	}
+	l1EventProcessors := newL1EventProcessor(state, forkIdRegistry, extraProcessors...)
+	err = checkRollupTypesAreSupported(ctx, ethMan, l1EventProcessors, cfg.OverrideRollupTypeCheck)
+	if err != nil {
+		defer cancel()
+		return nil, err
+	}
+	blockRangeProcessor := NewBlockRangeProcessLegacy(state, state, state, l1EventProcessors, uint64(ethMan.GetRollupID()))
 	if cfg.BlockFinality == "" {
 		log.Warnf("BlockFinality is empty, setting to finalized")
 		cfg.BlockFinality = "finalized"
@@ -85,31 +143,47 @@ func NewSynchronizerImpl(
 		finalizedBlockNumberFetcher,
 		ethMan,
 	)
-	checkl1blocks := l1_check_block.NewCheckL1BlockHash(ethMan, storage, finalizedBlockNumberFetcher)
+	checkl1blocks, err := newL1BlockChecker(cfg.L1BlockCheck, ethMan, state, finalizedBlockNumberFetcher)
+	if err != nil {
+		defer cancel()
+		return nil, err
+	}
 
 	l1SequentialSync := l1sync.NewL1SequentialSync(blocksRetriever, ethMan, state,
 		blockRangeProcessor, reorgManager,
 		checkl1blocks,
 		l1sync.L1SequentialSyncConfig{
+			NetworkID:                     networkID,
 			SyncChunkSize:                 cfg.SyncChunkSize,
+			MinSyncChunkSize:              cfg.MinSyncChunkSize,
+			MaxSyncChunkSize:              cfg.MaxSyncChunkSize,
 			GenesisBlockNumber:            genesisBlockNumber,
 			AllowEmptyBlocksAsCheckPoints: true,
+			ParallelFetchWorkers:          cfg.ParallelFetch.NumWorkers,
+			ParallelFetchMaxPendingRanges: cfg.ParallelFetch.MaxPendingRanges,
 		})
 
+	backgroundBlockChecker, _ := checkl1blocks.(backgroundL1BlockChecker)
+	preRollupSyncer := NewSyncPreRollup(ethMan, state, blockRangeProcessor, finalizedBlockNumberFetcher,
+		cfg.SyncChunkSize, genesisBlockNumber)
+
 	sync := SynchronizerImpl{
-		storage:             storage,
-		state:               state,
-		etherMan:            ethMan,
-		ctx:                 ctx,
-		cancelCtx:           cancel,
-		genBlockNumber:      genesisBlockNumber,
-		cfg:                 cfg,
-		networkID:           networkID,
-		storageChecker:      storageChecker,
-		l1Sync:              l1SequentialSync,
-		blockRangeProcessor: blockRangeProcessor,
-	}
-	state.AddOnReorgCallback(sync.OnReorgExecuted)
+		storage:                storage,
+		state:                  state,
+		etherMan:               ethMan,
+		ctx:                    ctx,
+		cancelCtx:              cancel,
+		genBlockNumber:         genesisBlockNumber,
+		cfg:                    cfg,
+		networkID:              networkID,
+		storageChecker:         storageChecker,
+		l1Sync:                 l1SequentialSync,
+		blockRangeProcessor:    blockRangeProcessor,
+		preRollupSyncer:        preRollupSyncer,
+		stopCh:                 make(chan struct{}),
+		backgroundBlockChecker: backgroundBlockChecker,
+		newHeadsWatcher:        newNewHeadsWatcher(ethMan, cfg.SyncInterval.Duration),
+	}
 
 	err = sync.CheckStorage(ctx)
 	if err != nil {
@@ -133,41 +207,187 @@ func getGenesisBlockNumber(ctx context.Context, cfgGenesisBlockNumber uint64, et
 	return genesisBlockNumber, nil
 }
 
-func newL1EventProcessor(state syncinterfaces.StateInterface) *processor_manager.L1EventProcessors {
+// newL1EventProcessor registers the built-in processors and then the extra ones, that replace the
+// built-in processors for the same forkId or family and event. An extra processor for all the forkIds
+// doesn't replace the built-in processors of specific forkIds or families, that take precedence
+func newL1EventProcessor(state syncinterfaces.StateInterface, forkIdRegistry *actions.ForkIdRegistry,
+	extraProcessors ...actions.L1EventProcessor) *processor_manager.L1EventProcessors {
 	builder := processor_manager.NewL1EventProcessorsBuilder()
-	builder.Register(etrog.NewProcessorL1InfoTreeUpdate(state))
+	builder.SetForkIdRegistry(forkIdRegistry)
+	for _, processor := range defaultL1EventProcessors(state) {
+		builder.Register(processor)
+	}
+	for _, processor := range extraProcessors {
+		log.Infof("Registering extra L1 event processor %s for events %v", processor.Name(), processor.SupportedEvents())
+		builder.Replace(processor)
+	}
+	processors := builder.Build()
+	for _, processor := range extraProcessors {
+		for _, event := range processor.SupportedEvents() {
+			if processors.ShadowedForEvent(processor, event) {
+				log.Warnf("The extra L1 event processor %s for all forkIds is not used for event %s on the forkIds or families with their own processor, register it for them to replace it",
+					processor.Name(), event)
+			}
+		}
+	}
+	return processors
+}
+
+func defaultL1EventProcessors(state syncinterfaces.StateInterface) []actions.L1EventProcessor {
 	etrogSequenceBatchesProcessor := etrog.NewProcessorL1SequenceBatches(state)
-	builder.Register(etrogSequenceBatchesProcessor)
-	builder.Register(incaberry.NewProcessorForkId(state))
-	builder.Register(etrog.NewProcessorL1InitialSequenceBatches(state))
-	builder.Register(elderberry.NewProcessorL1SequenceBatchesElderberry(etrogSequenceBatchesProcessor))
-	builder.Register(etrog.NewProcessorL1UpdateEtrogSequence(state))
-	return builder.Build()
+	return []actions.L1EventProcessor{
+		etrog.NewProcessorL1InfoTreeUpdate(state),
+		etrogSequenceBatchesProcessor,
+		incaberry.NewProcessorForkId(state),
+		etrog.NewProcessorL1InitialSequenceBatches(state),
+		elderberry.NewProcessorL1SequenceBatchesElderberry(etrogSequenceBatchesProcessor),
+		etrog.NewProcessorL1UpdateEtrogSequence(state),
+		etrog.NewProcessorL1VerifyBatch(state),
+		etrog.NewProcessorL1ForcedBatch(state),
+		etrog.NewProcessorL1SequenceForceBatches(state),
+		etrog.NewProcessorL1GovernanceEvent(state),
+		etrog.NewProcessorL1RollupRegistry(state),
+		etrog.NewProcessorL1AuditEvent(state),
+		etrog.NewProcessorL1PendingState(state),
+		// Previous to Etrog
+		incaberry.NewProcessorL1SequenceBatchesPreEtrog(state),
+		incaberry.NewProcessorL1GlobalExitRoot(state),
+		incaberry.NewProcessorL1VerifyBatchPreEtrog(state),
+	}
 }
 
-var waitDuration = time.Duration(0)
+// GetRollupIDs returns the rollupIDs that are being synchronized, the first one is the main rollup
+func (s *SynchronizerImpl) GetRollupIDs() []uint64 {
+	rollupIDs := s.etherMan.GetRollupIDs()
+	res := make([]uint64, len(rollupIDs))
+	for i, rollupID := range rollupIDs {
+		res[i] = uint64(rollupID)
+	}
+	return res
+}
 
 // IsSynced returns true if the synchronizer is synced or false if it's not
 func (s *SynchronizerImpl) IsSynced() bool {
-	return s.synced
+	return s.synced.Load()
+}
+
+// GetSyncStatus returns a snapshot of the status of the synchronization
+func (s *SynchronizerImpl) GetSyncStatus(ctx context.Context) (*SyncStatus, error) {
+	progress := s.l1Sync.GetProgress()
+	res := &SyncStatus{
+		Synced:                  s.IsSynced(),
+		L1LastBlockToSync:       progress.BlockPoints.L1LastBlockToSync,
+		L1FinalizedBlockNumber:  progress.BlockPoints.L1FinalizedBlockNumber,
+		LastVirtualBatchNumbers: map[uint64]uint64{},
+		PercentCompleted:        progress.PercentCompleted,
+		BlocksPerSecond:         progress.BlocksPerSecond,
+		EstimatedTimeToSync:     progress.EstimatedTimeToSync,
+	}
+	s.lastErrorMutex.Lock()
+	res.LastError = s.lastError
+	res.LastErrorTime = s.lastErrorTime
+	s.lastErrorMutex.Unlock()
+
+	lastBlock, err := s.getLastL1BlockOnStorage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting last L1 block. Err: %w", err)
+	}
+	if lastBlock != nil {
+		res.LastL1BlockSynced = lastBlock.BlockNumber
+	}
+	res.UncheckedBlocks, err = s.storage.GetUncheckedBlocksCount(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unchecked blocks count. Err: %w", err)
+	}
+	for _, rollupID := range s.GetRollupIDs() {
+		lastBatchNumber, err := s.storage.GetLastestVirtualBatchNumber(ctx, rollupID, nil, nil)
+		if err != nil && !errors.Is(err, entities.ErrNotFound) {
+			return nil, fmt.Errorf("error getting last virtual batch of rollupID %d. Err: %w", rollupID, err)
+		}
+		res.LastVirtualBatchNumbers[rollupID] = lastBatchNumber
+	}
+	lastLeaf, err := s.storage.GetLatestL1InfoTreeLeaf(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting last L1InfoTree leaf. Err: %w", err)
+	}
+	if lastLeaf != nil {
+		index := lastLeaf.L1InfoTreeIndex
+		res.LastL1InfoTreeIndex = &index
+	}
+	return res, nil
+}
+
+func (s *SynchronizerImpl) setLastError(err error) {
+	s.lastErrorMutex.Lock()
+	defer s.lastErrorMutex.Unlock()
+	s.lastError = err
+	s.lastErrorTime = time.Now()
 }
 
 func (s *SynchronizerImpl) SetCallbackOnReorgDone(callback func(reorgData ReorgExecutionResult)) {
+	s.reorgCallbackMutex.Lock()
+	defer s.reorgCallbackMutex.Unlock()
 	s.reorgCallback = callback
 }
 
-// OnReorgExecuted this is a CB setted to state reorg
-func (s *SynchronizerImpl) OnReorgExecuted(reorg model.ReorgExecutionResult) {
-	log.Infof("Reorg executed! %s", reorg.String())
-	if s.reorgCallback != nil {
-		param := ReorgExecutionResult{
-			FirstL1BlockNumberValidAfterReorg: &reorg.Request.FirstL1BlockNumberToKeep,
-			ReasonError:                       reorg.Request.ReasonError,
-		}
+// AddCallbackOnReorgDone adds a callback called synchronously after each reorg is committed, so it must not block.
+// It returns an id to remove it
+func (s *SynchronizerImpl) AddCallbackOnReorgDone(callback ReorgCallback) uint64 {
+	return s.reorgNotifier.addCallback(callback)
+}
+
+// RemoveCallbackOnReorgDone removes a callback added by AddCallbackOnReorgDone
+func (s *SynchronizerImpl) RemoveCallbackOnReorgDone(id uint64) {
+	s.reorgNotifier.removeCallback(id)
+}
+
+// AddPreReorgHook adds a hook called before executing each reorg, if it returns an error the reorg
+// is aborted. It returns an id to remove it
+func (s *SynchronizerImpl) AddPreReorgHook(hook PreReorgHook) uint64 {
+	return s.reorgNotifier.addPreReorgHook(hook)
+}
+
+// RemovePreReorgHook removes a hook added by AddPreReorgHook
+func (s *SynchronizerImpl) RemovePreReorgHook(id uint64) {
+	s.reorgNotifier.removePreReorgHook(id)
+}
+
+// onReorgExecuted notifies the reorg committed to the subscribers
+func (s *SynchronizerImpl) onReorgExecuted(reorgData ReorgExecutionResult) {
+	s.reorgNotifier.notifyReorgDone(reorgData)
+	s.reorgCallbackMutex.RLock()
+	reorgCallback := s.reorgCallback
+	s.reorgCallbackMutex.RUnlock()
+	if reorgCallback != nil {
 		log.Infof("Executing reorg callback in a goroutine")
-		go s.reorgCallback(param)
+		s.runningCallbacks.Add(1)
+		go func() {
+			defer s.runningCallbacks.Done()
+			reorgCallback(reorgData)
+		}()
 	}
+}
 
+// newReorgExecutionResult builds the data notified to the hooks and subscribers from the preview of the reorg
+func (s *SynchronizerImpl) newReorgExecutionResult(req model.ReorgRequest, impact *entities.ReorgImpact) ReorgExecutionResult {
+	firstL1BlockNumberValid := req.FirstL1BlockNumberToKeep
+	res := ReorgExecutionResult{
+		FirstL1BlockNumberValidAfterReorg: &firstL1BlockNumberValid,
+		ReasonError:                       req.ReasonError,
+		FirstL1BlockNumberDeleted:         impact.FirstDeletedBlockNumber,
+		OldBlockHash:                      impact.FirstDeletedBlockHash,
+		DeletedVirtualBatches:             impact.VirtualBatchRanges,
+		DeletedL1InfoTreeLeaves:           impact.L1InfoTreeIndexRange,
+	}
+	if impact.Blocks > 0 {
+		header, err := s.etherMan.HeaderByNumber(s.ctx, new(big.Int).SetUint64(impact.FirstDeletedBlockNumber))
+		if err != nil || header == nil {
+			log.Warnf("networkID: %d, error getting the new hash of block %d from L1, it's not notified. Err: %v", s.networkID, impact.FirstDeletedBlockNumber, err)
+		} else {
+			res.NewBlockHash = header.Hash()
+		}
+	}
+	return res
 }
 
 func (s *SynchronizerImpl) CheckStorage(ctx context.Context) error {
@@ -192,96 +412,398 @@ const (
 	FlagReturnOnSync SyncExecutionFlags = 1 << iota
 	FlagReturnBeforeReorg
 	FlagReturnAfterReorg
+	// FlagReturnAfterIteration returns after the first call to L1Syncer.SyncBlocks, returning its error
+	FlagReturnAfterIteration
 )
 
+// SyncTarget limits the synchronization, when it's reached the synchronizer is considered synced.
+// A zero value means no limit
+type SyncTarget struct {
+	// L1BlockNumber is the last L1 block to synchronize
+	L1BlockNumber uint64
+	// RollupID of BatchNumber, 0 means the main rollup
+	RollupID uint64
+	// BatchNumber is the virtual batch that must be synchronized
+	BatchNumber uint64
+}
+
+// SetSyncTarget sets the target of the synchronization, SyncTarget{} removes it
+func (s *SynchronizerImpl) SetSyncTarget(target SyncTarget) {
+	log.Infof("NetworkID: %d, setting sync target: %+v", s.networkID, target)
+	s.l1Sync.SetMaxL1BlockToSync(target.L1BlockNumber)
+	if target.BatchNumber == 0 {
+		s.l1Sync.SetTargetReachedChecker(nil)
+		return
+	}
+	rollupID := target.RollupID
+	if rollupID == 0 {
+		rollupID = uint64(s.etherMan.GetRollupID())
+	}
+	s.l1Sync.SetTargetReachedChecker(func(ctx context.Context) (bool, error) {
+		lastBatchNumber, err := s.storage.GetLastestVirtualBatchNumber(ctx, rollupID, nil, nil)
+		if errors.Is(err, entities.ErrNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("error getting last virtual batch of rollupID %d to check sync target. Err: %w", rollupID, err)
+		}
+		return lastBatchNumber >= target.BatchNumber, nil
+	})
+}
+
+// Pause stops the ingestion after the block range in progress, until Resume is called
+func (s *SynchronizerImpl) Pause() {
+	s.pauseMutex.Lock()
+	defer s.pauseMutex.Unlock()
+	if s.resumeCh == nil {
+		log.Infof("NetworkID: %d, pausing synchronizer", s.networkID)
+		s.resumeCh = make(chan struct{})
+	}
+	s.l1Sync.SetPaused(true)
+}
+
+// Resume continues the synchronization paused by Pause
+func (s *SynchronizerImpl) Resume() {
+	s.pauseMutex.Lock()
+	defer s.pauseMutex.Unlock()
+	if s.resumeCh != nil {
+		log.Infof("NetworkID: %d, resuming synchronizer", s.networkID)
+		close(s.resumeCh)
+		s.resumeCh = nil
+	}
+	s.l1Sync.SetPaused(false)
+}
+
+// IsPaused returns true if the synchronizer is paused
+func (s *SynchronizerImpl) IsPaused() bool {
+	return s.getResumeChannel() != nil
+}
+
+func (s *SynchronizerImpl) getResumeChannel() chan struct{} {
+	s.pauseMutex.Lock()
+	defer s.pauseMutex.Unlock()
+	return s.resumeCh
+}
+
+// waitWhilePaused returns false if the synchronizer must finish
+func (s *SynchronizerImpl) waitWhilePaused() bool {
+	resumeCh := s.getResumeChannel()
+	if resumeCh == nil {
+		return true
+	}
+	log.Infof("NetworkID: %d, synchronizer paused, waiting to be resumed", s.networkID)
+	select {
+	case <-resumeCh:
+		return true
+	case <-s.ctx.Done():
+		return false
+	case <-s.stopCh:
+		return false
+	}
+}
+
 // Sync function will read the last state synced and will continue from that point.
 // Sync() will read blockchain events to detect rollup updates
-func (s *SynchronizerImpl) Sync(executionFlags SyncExecutionFlags) error {
+func (s *SynchronizerImpl) Sync(executionFlags SyncExecutionFlags) (err error) {
+	if !s.startRunningSync() {
+		log.Infof("NetworkID: %d, Synchronization not started, shutdown requested", s.networkID)
+		return nil
+	}
+	defer func() {
+		s.finishRunningSync(err)
+	}()
+	return s.sync(executionFlags)
+}
+
+// startRunningSync registers a Sync on runningSync, it returns false if Shutdown has been requested
+func (s *SynchronizerImpl) startRunningSync() bool {
+	s.runningMutex.Lock()
+	defer s.runningMutex.Unlock()
+	if s.isStopRequested() {
+		return false
+	}
+	s.runningSync.Add(1)
+	return true
+}
+
+func (s *SynchronizerImpl) finishRunningSync(err error) {
+	s.syncResultMutex.Lock()
+	s.syncResult = err
+	s.syncResultMutex.Unlock()
+	s.runningSync.Done()
+}
+
+func (s *SynchronizerImpl) getSyncResult() error {
+	s.syncResultMutex.Lock()
+	defer s.syncResultMutex.Unlock()
+	return s.syncResult
+}
+
+func (s *SynchronizerImpl) sync(executionFlags SyncExecutionFlags) error {
 	// If there is no lastEthereumBlock means that sync from the beginning is necessary. If not, it continues from the retrieved ethereum block
 	// Get the latest synced block. If there is no block on db, use genesis block
 	log.Infof("Synchronization started")
-	s.synced = false
+	s.setSyncedStatus(false)
+	// The first iteration is immediate, once synced it waits SyncInterval between iterations
+	waitDuration := time.Duration(0)
 
+	if s.preRollupSyncer != nil {
+		err := s.preRollupSyncer.SynchronizePreGenesisRollupEvents(s.ctx)
+		if err != nil {
+			return s.onCriticalError(fmt.Errorf("networkID: %d, error synchronizing the events previous to the rollup genesis. Error: %w", s.networkID, err))
+		}
+	}
 	lastBlockSynced, err := s.getLastL1BlockOnStorage(s.ctx)
 	if err != nil {
-		log.Fatalf("networkID: %d, unexpected error getting the latest block. Error: %s", s.networkID, err.Error())
-	} else {
-		log.Infof("networkID: %d, continuing from the last block stored on DB. lastBlockSynced: %+v", s.networkID, lastBlockSynced)
+		return s.onCriticalError(fmt.Errorf("networkID: %d, unexpected error getting the latest block. Error: %w", s.networkID, err))
 	}
+	log.Infof("networkID: %d, continuing from the last block stored on DB. lastBlockSynced: %+v", s.networkID, lastBlockSynced)
 	log.Infof("NetworkID: %d, initial lastBlockSynced: %+v", s.networkID, lastBlockSynced)
+	if s.newHeadsWatcher != nil {
+		s.newHeadsWatcher.start(s.ctx)
+	}
+	// restartIteration skips the wait before the next iteration, unless there are too many consecutiveDeSyncs
+	restartIteration := false
+	consecutiveDeSyncs := 0
+	// reorgFailed makes the next iteration wait SyncInterval, so a reorg that fails or is aborted is not retried in a loop
+	reorgFailed := false
 	for {
+		if s.isStopRequested() {
+			log.Infof("NetworkID: %d, Synchronization finished, stop requested", s.networkID)
+			return nil
+		}
+		if !s.waitWhilePaused() {
+			log.Infof("NetworkID: %d, Synchronization finished while paused", s.networkID)
+			return nil
+		}
+		iterationWaitDuration := waitDuration
+		if restartIteration {
+			iterationWaitDuration = 0
+			if consecutiveDeSyncs > maxDeSyncRestartsWithoutWait {
+				log.Warnf("NetworkID: %d, %d consecutive iterations restarted, waiting %s before the next one", s.networkID, consecutiveDeSyncs, s.cfg.SyncInterval.String())
+				iterationWaitDuration = s.cfg.SyncInterval.Duration
+			}
+			restartIteration = false
+		}
+		if reorgFailed {
+			iterationWaitDuration = s.cfg.SyncInterval.Duration
+			reorgFailed = false
+		}
 		select {
 		case <-s.ctx.Done():
 			log.Infof("synchronizer ctx done")
 			return nil
-		case <-time.After(waitDuration):
+		case <-s.stopCh:
+			log.Infof("NetworkID: %d, Synchronization finished, stop requested", s.networkID)
+			return nil
+		case <-time.After(iterationWaitDuration):
 			log.Debugf("syncing...")
-			//Sync L1Blocks
-
-			var isSynced bool
-			if lastBlockSynced, isSynced, err = s.l1Sync.SyncBlocks(s.ctx, lastBlockSynced); err != nil {
-				log.Warnf("networkID: %d, error syncing blocks: %v", s.networkID, err)
-				reorgError := common.CastReorgError(err)
-				if reorgError != nil {
-					if (executionFlags & FlagReturnBeforeReorg) != 0 {
-						log.Infof("NetworkID: %d, Synchronization finished, returning before executing reorg %+v", s.networkID, reorgError)
-						return err
-					}
-					err = s.executeReorg(reorgError)
-					if err != nil {
-						log.Errorf("networkID: %d, error resetting the state to a previous block. Error: %v", s.networkID, err)
-						continue
-					}
-					if (executionFlags & FlagReturnAfterReorg) != 0 {
-						log.Infof("NetworkID: %d, Synchronization finished, returning after reorg %+v", s.networkID, reorgError)
-						return err
-					}
+		case <-s.newHeadsWatcher.newHeads():
+			log.Debugf("syncing because of a new L1 block...")
+		}
+		//Sync L1Blocks
+		var isSynced bool
+		var iterationErr error
+		lastBlockSynced, isSynced, err = s.l1Sync.SyncBlocks(s.ctx, lastBlockSynced)
+		if errors.Is(err, l1_check_block.ErrDeSync) && (executionFlags&FlagReturnAfterIteration) == 0 {
+			// The blocks have changed while they were checked, so the check is not valid
+			log.Infof("NetworkID: %d, %v, restarting the iteration", s.networkID, err)
+			lastBlockSynced, err = s.getLastL1BlockOnStorage(s.ctx)
+			if err != nil {
+				return s.onCriticalError(fmt.Errorf("networkID: %d, error getting lastBlockSynced to restart the iteration. Error: %w", s.networkID, err))
+			}
+			restartIteration = true
+			consecutiveDeSyncs++
+			continue
+		}
+		consecutiveDeSyncs = 0
+		if errors.Is(err, l1sync.ErrFromBlockBackwards) {
+			return s.onCriticalError(fmt.Errorf("networkID: %d, error syncing blocks. Error: %w", s.networkID, err))
+		}
+		if err != nil {
+			log.Warnf("networkID: %d, error syncing blocks: %v", s.networkID, err)
+			s.setLastError(err)
+			iterationErr = err
+			reorgError := common.CastReorgError(err)
+			if reorgError != nil {
+				if (executionFlags & FlagReturnBeforeReorg) != 0 {
+					log.Infof("NetworkID: %d, Synchronization finished, returning before executing reorg %+v", s.networkID, reorgError)
+					return err
 				}
-
-				lastBlockSynced, err = s.getLastL1BlockOnStorage(s.ctx)
-				if err != nil {
-					log.Fatalf("networkID: %d, error getting lastBlockSynced to resume the synchronization... Error: ", s.networkID, err)
+				err = s.executeReorg(reorgError)
+				var reorgRejected *model.ReorgRejectedError
+				if errors.As(err, &reorgRejected) {
+					return s.onCriticalError(fmt.Errorf("networkID: %d, %w", s.networkID, err))
 				}
-				if s.ctx.Err() != nil {
+				if err != nil {
+					log.Errorf("networkID: %d, error resetting the state to a previous block, retrying in %s. Error: %v", s.networkID, s.cfg.SyncInterval.String(), err)
+					if (executionFlags & FlagReturnAfterIteration) != 0 {
+						return err
+					}
+					reorgFailed = true
 					continue
 				}
-			}
-			s.setSyncedStatus(isSynced)
-			if s.synced {
-				log.Infof("NetworkID %d Synced!   lastBlockSynced:%d ", s.networkID, lastBlockSynced.BlockNumber)
-				if (executionFlags & FlagReturnOnSync) != 0 {
-					log.Infof("NetworkID: %d, Synchronization finished, returning because returnOnSync=true", s.networkID)
-					return nil
+				if (executionFlags & FlagReturnAfterReorg) != 0 {
+					log.Infof("NetworkID: %d, Synchronization finished, returning after reorg %+v", s.networkID, reorgError)
+					return err
 				}
-				waitDuration = s.cfg.SyncInterval.Duration
 			}
+
+			lastBlockSynced, err = s.getLastL1BlockOnStorage(s.ctx)
+			if err != nil {
+				return s.onCriticalError(fmt.Errorf("networkID: %d, error getting lastBlockSynced to resume the synchronization. Error: %w", s.networkID, err))
+			}
+			if s.ctx.Err() != nil && (executionFlags&FlagReturnAfterIteration) == 0 {
+				continue
+			}
+		}
+		s.setSyncedStatus(isSynced)
+		if isSynced {
+			log.Infof("NetworkID %d Synced!   lastBlockSynced:%d ", s.networkID, lastBlockSynced.BlockNumber)
+			if (executionFlags & FlagReturnOnSync) != 0 {
+				log.Infof("NetworkID: %d, Synchronization finished, returning because returnOnSync=true", s.networkID)
+				return nil
+			}
+			waitDuration = s.cfg.SyncInterval.Duration
+		}
+		if (executionFlags & FlagReturnAfterIteration) != 0 {
+			log.Infof("NetworkID: %d, Synchronization finished, returning after one iteration", s.networkID)
+			return iterationErr
 		}
 	}
 }
 
+// SetCriticalErrorHandler sets the handler called when the synchronizer can't continue,
+// after calling it Sync returns the error. By default is common.CriticalErrorReturn
+func (s *SynchronizerImpl) SetCriticalErrorHandler(handler syncinterfaces.CriticalErrorHandler) {
+	s.criticalErrorHandlerMutex.Lock()
+	defer s.criticalErrorHandlerMutex.Unlock()
+	s.criticalErrorHandler = handler
+}
+
+func (s *SynchronizerImpl) onCriticalError(err error) error {
+	if s.ctx.Err() != nil {
+		// The error is caused by the synchronizer being stopped
+		log.Infof("NetworkID: %d, Synchronization finished, ctx done. Err: %v", s.networkID, err)
+		return nil
+	}
+	s.setLastError(err)
+	s.criticalErrorHandlerMutex.Lock()
+	handler := s.criticalErrorHandler
+	s.criticalErrorHandlerMutex.Unlock()
+	if handler == nil {
+		handler = common.NewCriticalErrorReturn()
+	}
+	// The handler can block until ctx is done (e.g. CriticalErrorHalt), so it's also done on Shutdown
+	handlerCtx, cancel := s.newContextDoneOnStop()
+	defer cancel()
+	handler.CriticalError(handlerCtx, err)
+	return err
+}
+
+// newContextDoneOnStop returns a child of the synchronizer ctx that is also done when Shutdown is called
+func (s *SynchronizerImpl) newContextDoneOnStop() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(s.ctx)
+	go func() {
+		select {
+		case <-s.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
 func (s *SynchronizerImpl) setSyncedStatus(synced bool) {
-	s.synced = synced
+	s.synced.Store(synced)
 }
 
-// Stop function stops the synchronizer
+// Stop function stops the synchronizer, the iteration in progress is aborted
 func (s *SynchronizerImpl) Stop() {
 	s.cancelCtx()
 }
+
+// Shutdown stops the synchronizer after the current iteration finishes (committing or
+// rolling back its DB transaction) and waits for the reorg callbacks in progress.
+// If ctx is done before, the iteration is aborted and the ctx error is returned. It also
+// returns the error that Sync finished with
+func (s *SynchronizerImpl) Shutdown(ctx context.Context) error {
+	s.stopOnce.Do(func() {
+		s.runningMutex.Lock()
+		close(s.stopCh)
+		s.runningMutex.Unlock()
+		if s.l1Sync != nil {
+			s.l1Sync.RequestStop()
+		}
+	})
+	done := make(chan struct{})
+	go func() {
+		s.runningSync.Wait()
+		s.runningCallbacks.Wait()
+		close(done)
+	}()
+	var err error
+	select {
+	case <-done:
+		log.Infof("NetworkID: %d, Synchronizer shutdown cleanly", s.networkID)
+	case <-ctx.Done():
+		err = fmt.Errorf("shutdown deadline reached, aborting current iteration. Err: %w", ctx.Err())
+		log.Warnf("NetworkID: %d, %s", s.networkID, err.Error())
+		s.cancelCtx()
+		select {
+		case <-done:
+		case <-time.After(shutdownAbortTimeout):
+			err = fmt.Errorf("the aborted iteration or the reorg callbacks didn't finish after %s: %w", shutdownAbortTimeout, err)
+			log.Errorf("NetworkID: %d, %s", s.networkID, err.Error())
+		}
+	}
+	s.cancelCtx()
+	if s.backgroundBlockChecker != nil {
+		s.backgroundBlockChecker.Stop()
+	}
+	return errors.Join(err, s.getSyncResult())
+}
+
+func (s *SynchronizerImpl) isStopRequested() bool {
+	select {
+	case <-s.stopCh:
+		return true
+	default:
+		return false
+	}
+}
 func (s *SynchronizerImpl) executeReorg(reorgError *common.ReorgError) error {
 	if reorgError == nil {
 		return nil
 	}
-	dbTx, err := s.state.BeginTransaction(s.ctx)
-	if err != nil {
-		log.Errorf("networkID: %d, error starting a db transaction to execute reorg. Error: %v", s.networkID, err)
-		return err
-	}
-
 	req := model.ReorgRequest{
 		FirstL1BlockNumberToKeep: reorgError.BlockNumber - 1, // Previous block to last bad block
 		ReasonError:              reorgError,
+		MaxDepth:                 s.cfg.MaxReorgDepth,
+	}
+	// The hooks (that can request L1) are called before opening the DB transaction with a preview of the impact.
+	// ExecuteReorg previews it and checks the guards again inside the transaction, because the blocks can change
+	// meanwhile (e.g. checked by the background block checker)
+	var reorgData ReorgExecutionResult
+	impact, err := s.state.PreviewReorg(s.ctx, req.FirstL1BlockNumberToKeep, nil)
+	if err == nil {
+		// The hooks are not called for a reorg that is going to be rejected
+		err = model.CheckReorgGuards(req, impact)
+	}
+	if err == nil {
+		reorgData = s.newReorgExecutionResult(req, impact)
+		err = s.reorgNotifier.runPreReorgHooks(s.ctx, reorgData)
+	}
+	if err != nil {
+		log.Errorf("networkID: %d, reorg not executed. Error: %v", s.networkID, err)
+		return err
 	}
 
+	dbTx, err := s.state.BeginTransaction(s.ctx)
+	if err != nil {
+		log.Errorf("networkID: %d, error starting a db transaction to execute reorg. Error: %v", s.networkID, err)
+		return err
+	}
 	result := s.state.ExecuteReorg(s.ctx, req, dbTx)
 	if !result.IsSuccess() {
 		log.Errorf("networkID: %d, error executing reorg. Error: %v", s.networkID, result.ExecutionError)
@@ -289,12 +811,22 @@ func (s *SynchronizerImpl) executeReorg(reorgError *common.ReorgError) error {
 		_ = dbTx.Rollback(s.ctx)
 		return result.ExecutionError
 	}
-	// This commit is going to launch the callback to OnReorgExecuted
 	errCommit := dbTx.Commit(s.ctx)
 	if errCommit != nil {
 		log.Errorf("networkID: %d, error committing reorg. Error: %v", s.networkID, errCommit)
 		return errCommit
 	}
+	log.Infof("networkID: %d, reorg executed! %s", s.networkID, result.String())
+	if result.Impact != nil {
+		// The data deleted is the one previewed inside the transaction
+		reorgData.FirstL1BlockNumberDeleted = result.Impact.FirstDeletedBlockNumber
+		reorgData.OldBlockHash = result.Impact.FirstDeletedBlockHash
+		reorgData.DeletedVirtualBatches = result.Impact.VirtualBatchRanges
+		reorgData.DeletedL1InfoTreeLeaves = result.Impact.L1InfoTreeIndexRange
+	}
+	if s.backgroundBlockChecker != nil {
+		s.backgroundBlockChecker.OnReorgExecuted()
+	}
+	s.onReorgExecuted(reorgData)
 	return nil
-
 }
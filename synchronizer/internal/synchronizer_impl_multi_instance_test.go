@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/config/types"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	syncconfig "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/config"
+	l1sync "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_sync"
+	mock_syncinterfaces "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSyncImplTwoInstancesSideBySide runs two synchronizers concurrently while
+// they are queried and stopped from other goroutines. It must pass with -race
+func TestSyncImplTwoInstancesSideBySide(t *testing.T) {
+	instances := []*testDataSyncImpl{newTestDataSyncImpl(t), newTestDataSyncImpl(t)}
+	for i, testData := range instances {
+		// Each instance have a different SyncInterval, it must not affect the other one
+		testData.sut.cfg.SyncInterval = types.NewDuration(time.Duration(i+1) * time.Millisecond)
+		block := entities.L1Block{BlockNumber: uint64(100 * (i + 1))}
+		testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(&block, nil).Maybe()
+		testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(&block, true, nil).Maybe()
+		testData.mockL1Syncer.EXPECT().GetProgress().Return(l1sync.SyncProgress{}).Maybe()
+		testData.mockStorage.EXPECT().GetUncheckedBlocksCount(mock.Anything, mock.Anything).Return(uint64(0), nil).Maybe()
+		testData.mockEtherman.EXPECT().GetRollupIDs().Return([]uint{1}).Maybe()
+		testData.mockStorage.EXPECT().GetLastestVirtualBatchNumber(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(uint64(0), nil).Maybe()
+		testData.mockStorage.EXPECT().GetLatestL1InfoTreeLeaf(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	}
+
+	var wgSync sync.WaitGroup
+	for _, testData := range instances {
+		wgSync.Add(1)
+		go func(testData *testDataSyncImpl) {
+			defer wgSync.Done()
+			err := testData.sut.Sync(0)
+			assert.NoError(t, err)
+		}(testData)
+	}
+
+	var wgQueries sync.WaitGroup
+	for _, testData := range instances {
+		wgQueries.Add(1)
+		go func(testData *testDataSyncImpl) {
+			defer wgQueries.Done()
+			assert.Eventually(t, testData.sut.IsSynced, time.Second, time.Millisecond)
+			for i := 0; i < 10; i++ {
+				testData.sut.SetCallbackOnReorgDone(func(ReorgExecutionResult) {})
+				status, err := testData.sut.GetSyncStatus(testData.ctx)
+				if assert.NoError(t, err) {
+					assert.True(t, status.Synced)
+				}
+			}
+		}(testData)
+	}
+	wgQueries.Wait()
+
+	for _, testData := range instances {
+		testData.sut.Stop()
+	}
+	wgSync.Wait()
+}
+
+// TestSyncImplTwoInstancesSharingStateAreIsolated builds two synchronizers with NewSynchronizerImpl over
+// the same state, storage and L1 client, the status and the subscriptions of each one must not leak into the other
+func TestSyncImplTwoInstancesSharingStateAreIsolated(t *testing.T) {
+	ctx := context.TODO()
+	mockStorage := mock_syncinterfaces.NewStorageInterface(t)
+	mockState := mock_syncinterfaces.NewStateInterface(t)
+	mockEtherman := mock_syncinterfaces.NewEthermanFullInterface(t)
+	mockEtherman.EXPECT().GetRollupIDs().Return([]uint{1}).Maybe()
+	mockEtherman.EXPECT().GetRollupID().Return(uint(1)).Maybe()
+	mockEtherman.EXPECT().HasRollupManager().Return(true).Maybe()
+	mockEtherman.EXPECT().GetRollupData(mock.Anything, uint32(1)).Return(&etherman.RollupData{RollupID: 1, ForkID: 9}, nil).Maybe()
+	cfg := syncconfig.Config{GenesisBlockNumber: 123, SyncChunkSize: 10, SyncUpToBlock: "latest", BlockFinality: "finalized"}
+	instances := make([]*SynchronizerImpl, 2)
+	for i := range instances {
+		var err error
+		instances[i], err = NewSynchronizerImpl(ctx, mockStorage, mockState, mockEtherman, nil, cfg)
+		require.NoError(t, err)
+	}
+	first, second := instances[0], instances[1]
+
+	first.setSyncedStatus(true)
+	first.setLastError(errors.New("first instance error"))
+	first.Pause()
+	require.False(t, second.IsSynced())
+	require.False(t, second.IsPaused())
+	second.lastErrorMutex.Lock()
+	require.NoError(t, second.lastError)
+	second.lastErrorMutex.Unlock()
+
+	var firstCalls, secondCalls atomic.Int32
+	first.AddCallbackOnReorgDone(func(ReorgExecutionResult) { firstCalls.Add(1) })
+	first.SetCallbackOnReorgDone(func(ReorgExecutionResult) { firstCalls.Add(1) })
+	first.AddPreReorgHook(func(context.Context, ReorgExecutionResult) error { firstCalls.Add(1); return nil })
+	first.SetCriticalErrorHandler(criticalErrorHandlerFunc(func(context.Context, error) { firstCalls.Add(1) }))
+	secondID := second.AddCallbackOnReorgDone(func(ReorgExecutionResult) { secondCalls.Add(1) })
+	require.Equal(t, uint64(1), secondID, "the ids of the subscriptions are per instance")
+
+	require.NoError(t, second.reorgNotifier.runPreReorgHooks(ctx, ReorgExecutionResult{}))
+	second.onReorgExecuted(ReorgExecutionResult{})
+	second.runningCallbacks.Wait()
+	require.Error(t, second.onCriticalError(errors.New("second instance critical error")))
+	require.Equal(t, int32(0), firstCalls.Load())
+	require.Equal(t, int32(1), secondCalls.Load())
+
+	require.NoError(t, first.Shutdown(ctx))
+	require.False(t, second.isStopRequested(), "the shutdown of an instance must not stop the other one")
+	require.NoError(t, second.ctx.Err())
+	second.Stop()
+}
+
+type criticalErrorHandlerFunc func(ctx context.Context, err error)
+
+func (f criticalErrorHandlerFunc) CriticalError(ctx context.Context, err error) {
+	f(ctx, err)
+}
@@ -3,15 +3,25 @@ package internal
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/config/types"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
 	mock_entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities/mocks"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/common"
 	syncconfig "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/config"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_check_block"
+	l1sync "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_sync"
 	mock_syncinterfaces "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces/mocks"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -66,12 +76,440 @@ func TestSyncImplReturnsAfterReorg(t *testing.T) {
 		ExecutionTime:     time.Now(),
 		ExecutionDuration: time.Second,
 	}
+	impact := &entities.ReorgImpact{FirstL1BlockNumberToKeep: 122}
+	testData.mockState.EXPECT().PreviewReorg(testData.ctx, uint64(122), nil).Return(impact, nil)
 	testData.mockState.EXPECT().ExecuteReorg(testData.ctx, reorgReq, testData.mockTx).Return(reorgResult)
 	testData.mockTx.EXPECT().Commit(testData.ctx).Return(nil)
 	err := testData.sut.Sync(FlagReturnAfterReorg | FlagReturnOnSync)
 	require.NoError(t, err)
 }
 
+func TestSyncImplGetSyncStatus(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.mockL1Syncer.EXPECT().GetProgress().Return(l1sync.SyncProgress{
+		BlockPoints:      l1sync.BlockPoints{L1LastBlockToSync: 200, L1FinalizedBlockNumber: 180},
+		LastBlockSynced:  150,
+		PercentCompleted: 35.0,
+		BlocksPerSecond:  2.5,
+	})
+	testData.mockStorage.EXPECT().GetLastBlock(testData.ctx, mock.Anything).Return(&entities.L1Block{BlockNumber: 150}, nil)
+	testData.mockStorage.EXPECT().GetUncheckedBlocksCount(testData.ctx, mock.Anything).Return(uint64(3), nil)
+	testData.mockEtherman.EXPECT().GetRollupIDs().Return([]uint{1, 2})
+	testData.mockStorage.EXPECT().GetLastestVirtualBatchNumber(testData.ctx, uint64(1), mock.Anything, mock.Anything).Return(uint64(10), nil)
+	testData.mockStorage.EXPECT().GetLastestVirtualBatchNumber(testData.ctx, uint64(2), mock.Anything, mock.Anything).Return(uint64(0), entities.ErrNotFound)
+	testData.mockStorage.EXPECT().GetLatestL1InfoTreeLeaf(testData.ctx, mock.Anything).Return(&entities.L1InfoTreeLeaf{L1InfoTreeIndex: 7}, nil)
+	lastErr := fmt.Errorf("test error")
+	testData.sut.setLastError(lastErr)
+
+	status, err := testData.sut.GetSyncStatus(testData.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), status.LastL1BlockSynced)
+	require.Equal(t, uint64(200), status.L1LastBlockToSync)
+	require.Equal(t, uint64(180), status.L1FinalizedBlockNumber)
+	require.Equal(t, uint64(3), status.UncheckedBlocks)
+	require.Equal(t, map[uint64]uint64{1: 10, 2: 0}, status.LastVirtualBatchNumbers)
+	require.Equal(t, uint32(7), *status.LastL1InfoTreeIndex)
+	require.Equal(t, 2.5, status.BlocksPerSecond)
+	require.ErrorIs(t, status.LastError, lastErr)
+}
+
+// Shutdown waits for the iteration in progress to finish
+type testBackgroundBlockChecker struct {
+	reorgsExecuted int
+	stops          int
+}
+
+func (c *testBackgroundBlockChecker) OnReorgExecuted() { c.reorgsExecuted++ }
+func (c *testBackgroundBlockChecker) Stop()            { c.stops++ }
+
+// The background block checker discards its results after a reorg and it's stopped on Shutdown
+func TestSyncImplBackgroundBlockChecker(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	checker := &testBackgroundBlockChecker{}
+	testData.sut.backgroundBlockChecker = checker
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	errReorg := common.NewReorgError(123, fmt.Errorf("reorg"))
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(nil, false, errReorg).Once()
+	testData.mockState.EXPECT().BeginTransaction(testData.ctx).Return(testData.mockTx, nil)
+	testData.mockState.EXPECT().PreviewReorg(testData.ctx, uint64(122), nil).Return(&entities.ReorgImpact{FirstL1BlockNumberToKeep: 122}, nil)
+	testData.mockState.EXPECT().ExecuteReorg(testData.ctx, mock.Anything, testData.mockTx).Return(model.ReorgExecutionResult{})
+	testData.mockTx.EXPECT().Commit(testData.ctx).Return(nil)
+	err := testData.sut.Sync(FlagReturnAfterReorg)
+	require.NoError(t, err)
+	require.Equal(t, 1, checker.reorgsExecuted)
+	require.Equal(t, 0, checker.stops)
+
+	testData.mockL1Syncer.EXPECT().RequestStop().Return()
+	require.NoError(t, testData.sut.Shutdown(context.Background()))
+	require.Equal(t, 1, checker.stops)
+}
+
+func TestSyncImplShutdownWaitsCurrentIteration(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	iterationStarted := make(chan struct{})
+	releaseIteration := make(chan struct{})
+	iterationFinished := false
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		close(iterationStarted)
+		<-releaseIteration
+		iterationFinished = true
+		return &entities.L1Block{BlockNumber: 123}, false, nil
+	}).Once()
+	testData.mockL1Syncer.EXPECT().RequestStop().Return()
+	syncReturned := make(chan error)
+	go func() {
+		syncReturned <- testData.sut.Sync(0)
+	}()
+	<-iterationStarted
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(releaseIteration)
+	}()
+
+	err := testData.sut.Shutdown(context.Background())
+	require.NoError(t, err)
+	require.True(t, iterationFinished)
+	require.NoError(t, <-syncReturned)
+	require.Error(t, testData.ctx.Err())
+}
+
+// If the deadline is reached the iteration is aborted
+func TestSyncImplShutdownDeadlineAbortsIteration(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	iterationStarted := make(chan struct{})
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		close(iterationStarted)
+		<-ctx.Done()
+		return nil, false, ctx.Err()
+	}).Once()
+	testData.mockL1Syncer.EXPECT().RequestStop().Return()
+	go func() {
+		_ = testData.sut.Sync(0)
+	}()
+	<-iterationStarted
+	ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := testData.sut.Shutdown(ctxShutdown)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// A Sync called after Shutdown doesn't run, so Shutdown never reports a clean stop with an iteration in progress
+func TestSyncImplShutdownBeforeSyncStarts(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.mockL1Syncer.EXPECT().RequestStop().Return()
+
+	require.NoError(t, testData.sut.Shutdown(context.Background()))
+	require.NoError(t, testData.sut.Sync(0))
+}
+
+// Shutdown returns the error that Sync finished with
+func TestSyncImplShutdownReturnsSyncError(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	errStorage := fmt.Errorf("storage error")
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound).Once()
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, errStorage).Once()
+	iterationStarted := make(chan struct{})
+	releaseIteration := make(chan struct{})
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		close(iterationStarted)
+		<-releaseIteration
+		return nil, false, fmt.Errorf("L1 error")
+	}).Once()
+	testData.mockL1Syncer.EXPECT().RequestStop().Return()
+	syncReturned := make(chan error)
+	go func() {
+		syncReturned <- testData.sut.Sync(0)
+	}()
+	<-iterationStarted
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(releaseIteration)
+	}()
+
+	err := testData.sut.Shutdown(context.Background())
+	require.ErrorIs(t, err, errStorage)
+	require.ErrorIs(t, <-syncReturned, errStorage)
+}
+
+// Once the deadline is reached Shutdown still waits (bounded) for the reorg callbacks in progress
+func TestSyncImplShutdownDeadlineWaitsReorgCallbacks(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.mockL1Syncer.EXPECT().RequestStop().Return()
+	releaseCallback := make(chan struct{})
+	var callbackFinished atomic.Bool
+	testData.sut.SetCallbackOnReorgDone(func(ReorgExecutionResult) {
+		<-releaseCallback
+		callbackFinished.Store(true)
+	})
+	testData.sut.onReorgExecuted(ReorgExecutionResult{})
+	ctxShutdown, cancel := context.WithCancel(context.Background())
+	cancel()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(releaseCallback)
+	}()
+
+	err := testData.sut.Shutdown(ctxShutdown)
+	require.ErrorIs(t, err, context.Canceled)
+	require.True(t, callbackFinished.Load())
+}
+
+func TestSyncImplReturnsAfterIteration(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	block := entities.L1Block{
+		BlockNumber: 123,
+	}
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(&block, false, nil).Once()
+	err := testData.sut.Sync(FlagReturnAfterIteration)
+	require.NoError(t, err)
+	require.False(t, testData.sut.IsSynced())
+}
+
+func TestSyncImplReturnsAfterIterationWithError(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	errIteration := fmt.Errorf("error")
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(nil, false, errIteration).Once()
+	err := testData.sut.Sync(FlagReturnAfterIteration)
+	require.ErrorIs(t, err, errIteration)
+}
+
+// The L1 blocks changed while they were checked, so the iteration is restarted without waiting SyncInterval
+func TestSyncImplRestartsIterationOnDeSync(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.sut.cfg.SyncInterval = types.NewDuration(time.Hour)
+	block := entities.L1Block{BlockNumber: 123}
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(&block, nil)
+	testData.mockEtherman.EXPECT().SubscribeNewHead(mock.Anything, mock.Anything).Return(nil, etherman.ErrSubscriptionNotSupported).Maybe()
+	testData.sut.newHeadsWatcher = newNewHeadsWatcher(testData.mockEtherman, time.Hour)
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		// Once synced it waits SyncInterval, so the next iteration is woken up by a new L1 block
+		testData.sut.newHeadsWatcher.wakeUpCh <- struct{}{}
+		return &block, true, nil
+	}).Once()
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(nil, false, l1_check_block.ErrDeSync).Once()
+	// The iteration is restarted without waiting SyncInterval nor a new L1 block
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, &block).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		testData.sut.Stop()
+		return &block, true, nil
+	}).Once()
+
+	err := testData.sut.Sync(0)
+	require.NoError(t, err)
+	require.Nil(t, testData.sut.lastError)
+}
+
+// After maxDeSyncRestartsWithoutWait consecutive ErrDeSync it waits SyncInterval before restarting the iteration
+func TestSyncImplWaitsAfterConsecutiveDeSyncs(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	syncInterval := 50 * time.Millisecond
+	testData.sut.cfg.SyncInterval = types.NewDuration(syncInterval)
+	block := entities.L1Block{BlockNumber: 123}
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(&block, nil)
+	var lastDeSync time.Time
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		lastDeSync = time.Now()
+		return nil, false, l1_check_block.ErrDeSync
+	}).Times(maxDeSyncRestartsWithoutWait + 1)
+	var restartedAt time.Time
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, &block).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		restartedAt = time.Now()
+		testData.sut.Stop()
+		return &block, true, nil
+	}).Once()
+
+	err := testData.sut.Sync(0)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, restartedAt.Sub(lastDeSync), syncInterval)
+}
+
+func TestSyncImplPauseResume(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	testData.mockL1Syncer.EXPECT().SetPaused(true).Return()
+	testData.mockL1Syncer.EXPECT().SetPaused(false).Return()
+	var resumed atomic.Bool
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		assert.True(t, resumed.Load())
+		return &entities.L1Block{BlockNumber: 123}, true, nil
+	}).Once()
+
+	testData.sut.Pause()
+	require.True(t, testData.sut.IsPaused())
+	syncReturned := make(chan error)
+	go func() {
+		syncReturned <- testData.sut.Sync(FlagReturnOnSync)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	resumed.Store(true)
+	testData.sut.Resume()
+	require.False(t, testData.sut.IsPaused())
+	require.NoError(t, <-syncReturned)
+}
+
+func TestSyncImplPausedAndStopped(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	testData.mockL1Syncer.EXPECT().SetPaused(true).Return()
+	testData.sut.Pause()
+	syncReturned := make(chan error)
+	go func() {
+		syncReturned <- testData.sut.Sync(0)
+	}()
+	testData.sut.Stop()
+	require.NoError(t, <-syncReturned)
+}
+
+func TestSyncImplSetSyncTargetBatchNumber(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	var checker l1sync.TargetReachedCheckerFunc
+	testData.mockL1Syncer.EXPECT().SetMaxL1BlockToSync(uint64(1000)).Return()
+	testData.mockL1Syncer.EXPECT().SetTargetReachedChecker(mock.Anything).Run(func(newChecker l1sync.TargetReachedCheckerFunc) {
+		checker = newChecker
+	})
+	testData.mockEtherman.EXPECT().GetRollupID().Return(uint(1))
+	testData.sut.SetSyncTarget(SyncTarget{L1BlockNumber: 1000, BatchNumber: 5})
+	require.NotNil(t, checker)
+
+	testData.mockStorage.EXPECT().GetLastestVirtualBatchNumber(testData.ctx, uint64(1), mock.Anything, mock.Anything).Return(uint64(0), entities.ErrNotFound).Once()
+	reached, err := checker(testData.ctx)
+	require.NoError(t, err)
+	require.False(t, reached)
+
+	testData.mockStorage.EXPECT().GetLastestVirtualBatchNumber(testData.ctx, uint64(1), mock.Anything, mock.Anything).Return(uint64(5), nil).Once()
+	reached, err = checker(testData.ctx)
+	require.NoError(t, err)
+	require.True(t, reached)
+}
+
+type testCriticalErrorHandler struct {
+	errs []error
+}
+
+func (h *testCriticalErrorHandler) CriticalError(ctx context.Context, err error) {
+	h.errs = append(h.errs, err)
+}
+
+func TestSyncImplCriticalErrorGettingLastBlock(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	handler := &testCriticalErrorHandler{}
+	testData.sut.SetCriticalErrorHandler(handler)
+	errStorage := fmt.Errorf("storage error")
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, errStorage)
+	err := testData.sut.Sync(0)
+	require.ErrorIs(t, err, errStorage)
+	require.Equal(t, 1, len(handler.errs))
+	require.ErrorIs(t, handler.errs[0], errStorage)
+}
+
+// If the synchronizer is stopped the errors are not critical
+func TestSyncImplCriticalErrorIgnoredIfStopped(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	handler := &testCriticalErrorHandler{}
+	testData.sut.SetCriticalErrorHandler(handler)
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound).Once()
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		testData.sut.Stop()
+		return nil, false, ctx.Err()
+	}).Once()
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, context.Canceled).Once()
+	err := testData.sut.Sync(0)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(handler.errs))
+}
+
+// A wrong range on the L1 syncer is a critical error instead of exiting the process
+func TestSyncImplFromBlockBackwardsIsCritical(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	handler := &testCriticalErrorHandler{}
+	testData.sut.SetCriticalErrorHandler(handler)
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound).Once()
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(nil, false, l1sync.ErrFromBlockBackwards).Once()
+	err := testData.sut.Sync(0)
+	require.ErrorIs(t, err, l1sync.ErrFromBlockBackwards)
+	require.Equal(t, 1, len(handler.errs))
+	require.ErrorIs(t, handler.errs[0], l1sync.ErrFromBlockBackwards)
+}
+
+// The halt handler finishes on Shutdown, without waiting for the deadline
+func TestSyncImplCriticalErrorHaltFinishesOnShutdown(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	halt := common.NewCriticalErrorHalt(time.Second)
+	halted := make(chan struct{})
+	testData.sut.SetCriticalErrorHandler(criticalErrorHandlerFunc(func(ctx context.Context, err error) {
+		close(halted)
+		halt.CriticalError(ctx, err)
+	}))
+	errStorage := fmt.Errorf("storage error")
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, errStorage).Once()
+	syncErr := make(chan error, 1)
+	go func() {
+		syncErr <- testData.sut.Sync(0)
+	}()
+	<-halted
+	testData.mockL1Syncer.EXPECT().RequestStop().Once()
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	err := testData.sut.Shutdown(ctx)
+	require.ErrorIs(t, err, errStorage)
+	require.NoError(t, ctx.Err())
+	require.ErrorIs(t, <-syncErr, errStorage)
+}
+
+// A reorg rejected by the guards doesn't open a DB transaction and is raised as a critical error with the preview of the reorg
+func TestSyncImplReorgRejectedIsCritical(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	handler := &testCriticalErrorHandler{}
+	testData.sut.SetCriticalErrorHandler(handler)
+	hookCalled := false
+	testData.sut.AddPreReorgHook(func(ctx context.Context, reorgData ReorgExecutionResult) error {
+		hookCalled = true
+		return nil
+	})
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	errReorg := common.NewReorgError(123, fmt.Errorf("reorg"))
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(nil, false, errReorg)
+	impact := entities.ReorgImpact{FirstL1BlockNumberToKeep: 122, LastL1BlockNumber: 200, Blocks: 10, CheckedBlocks: 2, FirstDeletedBlockNumber: 130}
+	testData.mockState.EXPECT().PreviewReorg(testData.ctx, uint64(122), nil).Return(&impact, nil)
+
+	err := testData.sut.Sync(0)
+	require.ErrorIs(t, err, model.ErrReorgCheckedBlocks)
+	require.Equal(t, 1, len(handler.errs))
+	var reorgRejected *model.ReorgRejectedError
+	require.ErrorAs(t, handler.errs[0], &reorgRejected)
+	require.Equal(t, impact, reorgRejected.Impact)
+	require.False(t, hookCalled, "the hooks are not called for a rejected reorg")
+}
+
+// The blocks can be checked after the preview, so the guards checked inside the transaction can reject the reorg
+func TestSyncImplReorgRejectedInsideTxIsCritical(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	handler := &testCriticalErrorHandler{}
+	testData.sut.SetCriticalErrorHandler(handler)
+	callbackCalled := false
+	testData.sut.AddCallbackOnReorgDone(func(reorgData ReorgExecutionResult) {
+		callbackCalled = true
+	})
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	errReorg := common.NewReorgError(123, fmt.Errorf("reorg"))
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(nil, false, errReorg)
+	testData.mockState.EXPECT().PreviewReorg(testData.ctx, uint64(122), nil).Return(&entities.ReorgImpact{FirstL1BlockNumberToKeep: 122}, nil)
+	testData.mockState.EXPECT().BeginTransaction(testData.ctx).Return(testData.mockTx, nil)
+	impactInTx := entities.ReorgImpact{FirstL1BlockNumberToKeep: 122, Blocks: 10, CheckedBlocks: 1}
+	errRejected := &model.ReorgRejectedError{Err: model.ErrReorgCheckedBlocks, Impact: impactInTx}
+	testData.mockState.EXPECT().ExecuteReorg(testData.ctx, mock.Anything, testData.mockTx).Return(model.ReorgExecutionResult{ExecutionError: errRejected, Impact: &impactInTx})
+	testData.mockTx.EXPECT().Rollback(testData.ctx).Return(nil)
+
+	err := testData.sut.Sync(0)
+	require.ErrorIs(t, err, model.ErrReorgCheckedBlocks)
+	require.Equal(t, 1, len(handler.errs))
+	require.False(t, callbackCalled)
+}
+
 // --- HELPER FUNCTIONS ----------------------------------------------
 type testDataSyncImpl struct {
 	mockStorage             *mock_syncinterfaces.StorageInterface
@@ -110,6 +548,7 @@ func newTestDataSyncImpl(t *testing.T) *testDataSyncImpl {
 		storageChecker:      mockStorageChecker,
 		l1Sync:              mockL1Syncer,
 		blockRangeProcessor: mockBlockRangeProcessor,
+		stopCh:              make(chan struct{}),
 	}
 	return &testDataSyncImpl{
 		mockStorage:             mockStorage,
@@ -123,3 +562,153 @@ func newTestDataSyncImpl(t *testing.T) *testDataSyncImpl {
 		ctx:                     ctx,
 	}
 }
+
+func TestSyncImplPreReorgHookAbortsReorg(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	errHook := fmt.Errorf("not ready")
+	testData.sut.AddPreReorgHook(func(ctx context.Context, reorgData ReorgExecutionResult) error {
+		return errHook
+	})
+	callbackCalled := false
+	testData.sut.AddCallbackOnReorgDone(func(reorgData ReorgExecutionResult) {
+		callbackCalled = true
+	})
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	errReorg := common.NewReorgError(123, fmt.Errorf("reorg"))
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(nil, false, errReorg)
+	testData.mockState.EXPECT().PreviewReorg(testData.ctx, uint64(122), nil).Return(&entities.ReorgImpact{FirstL1BlockNumberToKeep: 122}, nil)
+
+	err := testData.sut.Sync(FlagReturnAfterIteration)
+	require.ErrorIs(t, err, ErrReorgAborted)
+	require.ErrorIs(t, err, errHook)
+	require.False(t, callbackCalled)
+}
+
+// A reorg aborted by a hook is retried after SyncInterval, not in a loop
+func TestSyncImplWaitsAfterReorgAborted(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	syncInterval := 50 * time.Millisecond
+	testData.sut.cfg.SyncInterval = types.NewDuration(syncInterval)
+	hookCalls := 0
+	testData.sut.AddPreReorgHook(func(ctx context.Context, reorgData ReorgExecutionResult) error {
+		hookCalls++
+		return fmt.Errorf("not ready")
+	})
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	errReorg := common.NewReorgError(123, fmt.Errorf("reorg"))
+	var abortedAt time.Time
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		abortedAt = time.Now()
+		return nil, false, errReorg
+	}).Once()
+	var retriedAt time.Time
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).RunAndReturn(func(ctx context.Context, lastBlock *entities.L1Block) (*entities.L1Block, bool, error) {
+		retriedAt = time.Now()
+		testData.sut.Stop()
+		return &entities.L1Block{BlockNumber: 122}, true, nil
+	}).Once()
+	testData.mockState.EXPECT().PreviewReorg(testData.ctx, uint64(122), nil).Return(&entities.ReorgImpact{FirstL1BlockNumberToKeep: 122}, nil).Once()
+
+	err := testData.sut.Sync(0)
+	require.NoError(t, err)
+	require.Equal(t, 1, hookCalls)
+	require.GreaterOrEqual(t, retriedAt.Sub(abortedAt), syncInterval)
+}
+
+// All the subscribers receive the blocks, batches and leaves deleted. The hooks receive the same data before the reorg
+func TestSyncImplReorgNotifiesAllSubscribers(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	var hookData ReorgExecutionResult
+	testData.sut.AddPreReorgHook(func(ctx context.Context, reorgData ReorgExecutionResult) error {
+		hookData = reorgData
+		return nil
+	})
+	var received []ReorgExecutionResult
+	testData.sut.AddCallbackOnReorgDone(func(reorgData ReorgExecutionResult) {
+		received = append(received, reorgData)
+	})
+	id := testData.sut.AddCallbackOnReorgDone(func(reorgData ReorgExecutionResult) {
+		received = append(received, reorgData)
+	})
+	testData.sut.AddCallbackOnReorgDone(func(reorgData ReorgExecutionResult) {
+		received = append(received, reorgData)
+	})
+	testData.sut.RemoveCallbackOnReorgDone(id)
+	testData.mockStorage.EXPECT().GetLastBlock(mock.Anything, mock.Anything).Return(nil, entities.ErrNotFound)
+	errReorg := common.NewReorgError(123, fmt.Errorf("reorg"))
+	testData.mockL1Syncer.EXPECT().SyncBlocks(testData.ctx, mock.Anything).Return(nil, false, errReorg)
+	testData.mockState.EXPECT().BeginTransaction(testData.ctx).Return(testData.mockTx, nil)
+	impact := &entities.ReorgImpact{
+		FirstL1BlockNumberToKeep: 122,
+		LastL1BlockNumber:        130,
+		Blocks:                   2,
+		FirstDeletedBlockNumber:  125,
+		FirstDeletedBlockHash:    ethCommon.HexToHash("0x125"),
+		VirtualBatchRanges:       []entities.BatchNumberRange{{RollupID: 1, FromBatchNumber: 10, ToBatchNumber: 12}},
+		L1InfoTreeIndexRange:     &entities.L1InfoTreeIndexRange{FromIndex: 5, ToIndex: 6},
+	}
+	testData.mockState.EXPECT().PreviewReorg(testData.ctx, uint64(122), nil).Return(impact, nil)
+	newHeader := &ethTypes.Header{Number: big.NewInt(125), Extra: []byte("new")}
+	testData.mockEtherman.EXPECT().HeaderByNumber(testData.ctx, big.NewInt(125)).Return(newHeader, nil)
+	testData.mockState.EXPECT().ExecuteReorg(testData.ctx, mock.Anything, testData.mockTx).Return(model.ReorgExecutionResult{Impact: impact})
+	testData.mockTx.EXPECT().Commit(testData.ctx).Return(nil)
+
+	err := testData.sut.Sync(FlagReturnAfterReorg)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(received))
+	require.Equal(t, hookData, received[0])
+	require.Equal(t, uint64(122), *received[0].FirstL1BlockNumberValidAfterReorg)
+	require.Equal(t, uint64(125), received[0].FirstL1BlockNumberDeleted)
+	require.Equal(t, ethCommon.HexToHash("0x125"), received[0].OldBlockHash)
+	require.Equal(t, newHeader.Hash(), received[0].NewBlockHash)
+	require.Equal(t, impact.VirtualBatchRanges, received[0].DeletedVirtualBatches)
+	require.Equal(t, impact.L1InfoTreeIndexRange, received[0].DeletedL1InfoTreeLeaves)
+}
+
+func TestL1EventProcessorSupportsPreEtrogForkIDs(t *testing.T) {
+	mockState := mock_syncinterfaces.NewStateInterface(t)
+	sut := newL1EventProcessor(mockState, actions.NewDefaultForkIdRegistry())
+	for forkID := actions.ForkIdType(1); forkID <= actions.ForkID11; forkID++ {
+		for _, event := range []etherman.EventOrder{etherman.SequenceBatchesOrder, etherman.GlobalExitRootsOrder,
+			etherman.TrustedVerifyBatchOrder, etherman.VerifyBatchPreEtrogOrder, etherman.ForkIDsOrder} {
+			require.NotNil(t, sut.Get(forkID, event), "forkID %d event %s", forkID, event)
+		}
+	}
+	require.Equal(t, "ProcessorL1SequenceBatchesPreEtrog", sut.Get(actions.ForkIDIncaberry, etherman.SequenceBatchesOrder).Name())
+	require.Equal(t, "ProcessorL1SequenceBatchesEtrog", sut.Get(actions.ForkIDEtrog, etherman.SequenceBatchesOrder).Name())
+}
+
+type extraProcessorForTest struct {
+	actions.ProcessorBase[extraProcessorForTest]
+}
+
+func (p *extraProcessorForTest) Process(ctx context.Context, forkId actions.ForkIdType, order etherman.Order, l1Block *etherman.Block, dbTx entities.Tx) error {
+	return nil
+}
+
+func TestL1EventProcessorExtraProcessors(t *testing.T) {
+	customEvent := etherman.EventOrder("CustomSequenceBatches")
+	customProcessor := &extraProcessorForTest{ProcessorBase: actions.ProcessorBase[extraProcessorForTest]{
+		SupportedEvent:    []etherman.EventOrder{customEvent},
+		SupportedForkdIds: &actions.ForksIdAll}}
+	replaceProcessor := &extraProcessorForTest{ProcessorBase: actions.ProcessorBase[extraProcessorForTest]{
+		SupportedEvent:      []etherman.EventOrder{etherman.SequenceBatchesOrder},
+		SupportedForkFamily: &actions.ForkFamiliesOnlyElderberry}}
+	sut := newL1EventProcessor(mock_syncinterfaces.NewStateInterface(t), actions.NewDefaultForkIdRegistry(), customProcessor, replaceProcessor)
+
+	require.Equal(t, customProcessor, sut.Get(actions.ForkID11, customEvent))
+	require.Equal(t, replaceProcessor, sut.Get(actions.ForkID11, etherman.SequenceBatchesOrder), "replaces the built-in processor")
+	require.Equal(t, "ProcessorL1SequenceBatchesEtrog", sut.Get(actions.ForkIDEtrog, etherman.SequenceBatchesOrder).Name())
+}
+
+func TestL1EventProcessorExtraProcessorForAllForkIdsIsShadowedByFamilies(t *testing.T) {
+	wildcardProcessor := &extraProcessorForTest{ProcessorBase: actions.ProcessorBase[extraProcessorForTest]{
+		SupportedEvent:    []etherman.EventOrder{etherman.SequenceBatchesOrder},
+		SupportedForkdIds: &actions.ForksIdAll}}
+	sut := newL1EventProcessor(mock_syncinterfaces.NewStateInterface(t), actions.NewDefaultForkIdRegistry(), wildcardProcessor)
+
+	require.True(t, sut.ShadowedForEvent(wildcardProcessor, etherman.SequenceBatchesOrder))
+	require.Equal(t, "ProcessorL1SequenceBatchesEtrog", sut.Get(actions.ForkIDEtrog, etherman.SequenceBatchesOrder).Name(), "the family processor takes precedence")
+	require.Equal(t, "ProcessorL1SequenceBatchesElderberry", sut.Get(actions.ForkIDElderberry, etherman.SequenceBatchesOrder).Name(), "the family processor takes precedence")
+	require.Equal(t, wildcardProcessor, sut.Get(actions.WildcardForkId, etherman.SequenceBatchesOrder))
+}
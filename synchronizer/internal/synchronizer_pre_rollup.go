@@ -0,0 +1,165 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_check_block"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+type syncPreRollupEtherman interface {
+	syncinterfaces.EthermanPreRollup
+	GetL1BlockByNumber(ctx context.Context, blockNumber uint64) (*etherman.Block, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethTypes.Header, error)
+}
+
+type syncPreRollupState interface {
+	GetLastBlock(ctx context.Context, dbTx stateTxType) (*entities.L1Block, error)
+	syncinterfaces.StatePreRollupSyncStatusManager
+}
+
+// SyncPreRollup synchronizes the L1InfoTree events from the LxLy upgrade to the rollup genesis.
+// The L1InfoTree is shared by all the rollups, so the leaves added before the rollup genesis
+// are required to assign the right indexes to the following ones.
+// It implements syncinterfaces.SyncPreRollupSyncer
+type SyncPreRollup struct {
+	etherman                    syncPreRollupEtherman
+	state                       syncPreRollupState
+	blockRangeProcessor         syncinterfaces.BlockRangeProcessor
+	finalizedBlockNumberFetcher l1_check_block.L1BlockNumberFetcher
+	syncChunkSize               uint64
+	genesisBlockNumber          uint64
+}
+
+// NewSyncPreRollup creates a new SyncPreRollup
+func NewSyncPreRollup(
+	etherman syncPreRollupEtherman,
+	state syncPreRollupState,
+	blockRangeProcessor syncinterfaces.BlockRangeProcessor,
+	finalizedBlockNumberFetcher l1_check_block.L1BlockNumberFetcher,
+	syncChunkSize uint64,
+	genesisBlockNumber uint64,
+) *SyncPreRollup {
+	return &SyncPreRollup{
+		etherman:                    etherman,
+		state:                       state,
+		blockRangeProcessor:         blockRangeProcessor,
+		finalizedBlockNumberFetcher: finalizedBlockNumberFetcher,
+		syncChunkSize:               max(syncChunkSize, 1),
+		genesisBlockNumber:          genesisBlockNumber,
+	}
+}
+
+// SynchronizePreGenesisRollupEvents synchronizes the L1InfoTree events previous to the rollup genesis.
+// The completion is stored in the KV storage, so it's only executed once
+func (s *SyncPreRollup) SynchronizePreGenesisRollupEvents(ctx context.Context) error {
+	status, err := s.state.GetPreRollupSyncStatus(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error getting pre rollup genesis sync status. Err: %w", err)
+	}
+	if status != nil {
+		log.Infof("Pre rollup genesis synchronization already done: %s", status.String())
+		if status.Skipped {
+			log.Warnf("The L1InfoTree leaves previous to block %d are missing, the pre rollup genesis synchronization have been skipped", status.GenesisBlockNumber)
+		}
+		return nil
+	}
+	lxLyUpgradeBlockNumber, err := s.etherman.GetL1BlockUpgradeLxLy(ctx, &s.genesisBlockNumber)
+	if errors.Is(err, etherman.ErrNotFound) {
+		log.Infof("LxLy upgrade not found up to genesis block %d, there are no events previous to the rollup genesis", s.genesisBlockNumber)
+		return s.setDone(ctx, s.genesisBlockNumber)
+	}
+	if err != nil {
+		return fmt.Errorf("error getting LxLy upgrade block. Err: %w", err)
+	}
+	if lxLyUpgradeBlockNumber >= s.genesisBlockNumber {
+		log.Infof("LxLy upgrade block %d is the rollup genesis, there are no events previous to the rollup genesis", lxLyUpgradeBlockNumber)
+		return s.setDone(ctx, lxLyUpgradeBlockNumber)
+	}
+	lastBlock, err := s.state.GetLastBlock(ctx, nil)
+	if err != nil && !errors.Is(err, entities.ErrNotFound) {
+		return fmt.Errorf("error getting last block on storage. Err: %w", err)
+	}
+	fromBlock := lxLyUpgradeBlockNumber
+	lastBlockStored := uint64(0)
+	if lastBlock != nil {
+		if lastBlock.BlockNumber >= s.genesisBlockNumber {
+			log.Warnf("Storage already contains blocks after the rollup genesis (last block: %d), skipping pre rollup genesis synchronization. The L1InfoTree leaves previous to block %d are missing",
+				lastBlock.BlockNumber, s.genesisBlockNumber)
+			return s.setStatus(ctx, entities.PreRollupSyncStatus{
+				LxLyUpgradeBlockNumber: lxLyUpgradeBlockNumber,
+				GenesisBlockNumber:     s.genesisBlockNumber,
+				Skipped:                true,
+			})
+		}
+		// Continue a previous execution
+		lastBlockStored = lastBlock.BlockNumber
+		fromBlock = max(fromBlock, lastBlock.BlockNumber+1)
+	}
+	finalizedBlockNumber, err := s.finalizedBlockNumberFetcher.BlockNumber(ctx, s.etherman)
+	if err != nil {
+		return fmt.Errorf("error getting finalized block number. Err: %w", err)
+	}
+	lastBlockToSync := s.genesisBlockNumber - 1
+	log.Infof("Starting pre rollup genesis synchronization of L1InfoTree from block %d to %d (LxLy upgrade: %d)", fromBlock, lastBlockToSync, lxLyUpgradeBlockNumber)
+	for blockRangeFrom := fromBlock; blockRangeFrom <= lastBlockToSync; {
+		blockRangeTo := min(blockRangeFrom+s.syncChunkSize-1, lastBlockToSync)
+		log.Infof("Pre rollup genesis: syncing L1InfoTree events from block %d to %d", blockRangeFrom, blockRangeTo)
+		blocks, order, err := s.etherman.GetRollupInfoByBlockRangePreviousRollupGenesis(ctx, blockRangeFrom, &blockRangeTo)
+		if err != nil {
+			return fmt.Errorf("error getting pre rollup genesis events from block %d to %d. Err: %w", blockRangeFrom, blockRangeTo, err)
+		}
+		if len(blocks) > 0 {
+			err = s.blockRangeProcessor.ProcessBlockRange(ctx, blocks, order, finalizedBlockNumber)
+			if err != nil {
+				return fmt.Errorf("error processing pre rollup genesis events from block %d to %d. Err: %w", blockRangeFrom, blockRangeTo, err)
+			}
+			lastBlockStored = blocks[len(blocks)-1].BlockNumber
+		}
+		blockRangeFrom = blockRangeTo + 1
+	}
+	if lastBlockStored != lastBlockToSync {
+		// The block previous to genesis is stored, so the synchronization continues from genesis
+		err = s.storeCheckpointBlock(ctx, lastBlockToSync, finalizedBlockNumber)
+		if err != nil {
+			return err
+		}
+	}
+	return s.setDone(ctx, lxLyUpgradeBlockNumber)
+}
+
+func (s *SyncPreRollup) storeCheckpointBlock(ctx context.Context, blockNumber uint64, finalizedBlockNumber uint64) error {
+	block, err := s.etherman.GetL1BlockByNumber(ctx, blockNumber)
+	if err != nil {
+		return fmt.Errorf("error getting block %d. Err: %w", blockNumber, err)
+	}
+	err = s.blockRangeProcessor.ProcessBlockRange(ctx, []etherman.Block{*block}, nil, finalizedBlockNumber)
+	if err != nil {
+		return fmt.Errorf("error storing block %d. Err: %w", blockNumber, err)
+	}
+	return nil
+}
+
+func (s *SyncPreRollup) setDone(ctx context.Context, lxLyUpgradeBlockNumber uint64) error {
+	return s.setStatus(ctx, entities.PreRollupSyncStatus{
+		LxLyUpgradeBlockNumber: lxLyUpgradeBlockNumber,
+		GenesisBlockNumber:     s.genesisBlockNumber,
+	})
+}
+
+// setStatus stores the status so the pre rollup genesis synchronization is not executed again
+func (s *SyncPreRollup) setStatus(ctx context.Context, status entities.PreRollupSyncStatus) error {
+	log.Infof("Pre rollup genesis synchronization done: %s", status.String())
+	err := s.state.SetPreRollupSyncStatus(ctx, status, nil)
+	if err != nil {
+		return fmt.Errorf("error storing pre rollup genesis sync status. Err: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock_l1_check_block "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_check_block/mocks"
+	mock_syncinterfaces "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces/mocks"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testDataSyncPreRollup struct {
+	mockEtherman            *mock_syncinterfaces.EthermanFullInterface
+	mockState               *mock_syncinterfaces.StateInterface
+	mockBlockRangeProcessor *mock_syncinterfaces.BlockRangeProcessor
+	mockFinalizedFetcher    *mock_l1_check_block.L1BlockNumberFetcher
+	sut                     *SyncPreRollup
+	ctx                     context.Context
+}
+
+func newTestDataSyncPreRollup(t *testing.T) *testDataSyncPreRollup {
+	mockEtherman := mock_syncinterfaces.NewEthermanFullInterface(t)
+	mockState := mock_syncinterfaces.NewStateInterface(t)
+	mockBlockRangeProcessor := mock_syncinterfaces.NewBlockRangeProcessor(t)
+	mockFinalizedFetcher := mock_l1_check_block.NewL1BlockNumberFetcher(t)
+	return &testDataSyncPreRollup{
+		mockEtherman:            mockEtherman,
+		mockState:               mockState,
+		mockBlockRangeProcessor: mockBlockRangeProcessor,
+		mockFinalizedFetcher:    mockFinalizedFetcher,
+		sut:                     NewSyncPreRollup(mockEtherman, mockState, mockBlockRangeProcessor, mockFinalizedFetcher, 100, 300),
+		ctx:                     context.TODO(),
+	}
+}
+
+func TestSyncPreRollupAlreadyDone(t *testing.T) {
+	testData := newTestDataSyncPreRollup(t)
+	testData.mockState.EXPECT().GetPreRollupSyncStatus(testData.ctx, mock.Anything).Return(&entities.PreRollupSyncStatus{LxLyUpgradeBlockNumber: 100, GenesisBlockNumber: 300}, nil)
+
+	err := testData.sut.SynchronizePreGenesisRollupEvents(testData.ctx)
+	require.NoError(t, err)
+}
+
+func TestSyncPreRollupLxLyIsGenesis(t *testing.T) {
+	testData := newTestDataSyncPreRollup(t)
+	testData.mockState.EXPECT().GetPreRollupSyncStatus(testData.ctx, mock.Anything).Return(nil, nil)
+	testData.mockEtherman.EXPECT().GetL1BlockUpgradeLxLy(testData.ctx, mock.Anything).Return(uint64(300), nil)
+	testData.mockState.EXPECT().SetPreRollupSyncStatus(testData.ctx, entities.PreRollupSyncStatus{LxLyUpgradeBlockNumber: 300, GenesisBlockNumber: 300}, mock.Anything).Return(nil)
+
+	err := testData.sut.SynchronizePreGenesisRollupEvents(testData.ctx)
+	require.NoError(t, err)
+}
+
+// The blocks from LxLy upgrade to genesis-1 are synchronized in chunks and the block previous
+// to genesis is stored as checkpoint
+func TestSyncPreRollupSyncFromLxLyToGenesis(t *testing.T) {
+	testData := newTestDataSyncPreRollup(t)
+	testData.mockState.EXPECT().GetPreRollupSyncStatus(testData.ctx, mock.Anything).Return(nil, nil)
+	testData.mockEtherman.EXPECT().GetL1BlockUpgradeLxLy(testData.ctx, mock.Anything).Return(uint64(150), nil)
+	testData.mockState.EXPECT().GetLastBlock(testData.ctx, mock.Anything).Return(nil, entities.ErrNotFound)
+	testData.mockFinalizedFetcher.EXPECT().BlockNumber(testData.ctx, mock.Anything).Return(uint64(1000), nil)
+	blocks := []etherman.Block{{BlockNumber: 160, BlockHash: common.HexToHash("0x160")}}
+	order := map[common.Hash][]etherman.Order{blocks[0].BlockHash: {{Name: etherman.L1InfoTreeOrder, Pos: 0}}}
+	toBlock := uint64(249)
+	testData.mockEtherman.EXPECT().GetRollupInfoByBlockRangePreviousRollupGenesis(testData.ctx, uint64(150), &toBlock).Return(blocks, order, nil)
+	toBlock2 := uint64(299)
+	testData.mockEtherman.EXPECT().GetRollupInfoByBlockRangePreviousRollupGenesis(testData.ctx, uint64(250), &toBlock2).Return(nil, nil, nil)
+	testData.mockBlockRangeProcessor.EXPECT().ProcessBlockRange(testData.ctx, blocks, order, uint64(1000)).Return(nil).Once()
+	checkpointBlock := &etherman.Block{BlockNumber: 299}
+	testData.mockEtherman.EXPECT().GetL1BlockByNumber(testData.ctx, uint64(299)).Return(checkpointBlock, nil)
+	testData.mockBlockRangeProcessor.EXPECT().ProcessBlockRange(testData.ctx, []etherman.Block{*checkpointBlock}, mock.Anything, uint64(1000)).Return(nil).Once()
+	testData.mockState.EXPECT().SetPreRollupSyncStatus(testData.ctx, entities.PreRollupSyncStatus{LxLyUpgradeBlockNumber: 150, GenesisBlockNumber: 300}, mock.Anything).Return(nil)
+
+	err := testData.sut.SynchronizePreGenesisRollupEvents(testData.ctx)
+	require.NoError(t, err)
+}
+
+// A previous execution stopped in the middle, it continues from the last block stored
+func TestSyncPreRollupContinuesFromLastBlock(t *testing.T) {
+	testData := newTestDataSyncPreRollup(t)
+	testData.mockState.EXPECT().GetPreRollupSyncStatus(testData.ctx, mock.Anything).Return(nil, nil)
+	testData.mockEtherman.EXPECT().GetL1BlockUpgradeLxLy(testData.ctx, mock.Anything).Return(uint64(150), nil)
+	testData.mockState.EXPECT().GetLastBlock(testData.ctx, mock.Anything).Return(&entities.L1Block{BlockNumber: 260}, nil)
+	testData.mockFinalizedFetcher.EXPECT().BlockNumber(testData.ctx, mock.Anything).Return(uint64(1000), nil)
+	blocks := []etherman.Block{{BlockNumber: 299}}
+	toBlock := uint64(299)
+	testData.mockEtherman.EXPECT().GetRollupInfoByBlockRangePreviousRollupGenesis(testData.ctx, uint64(261), &toBlock).Return(blocks, nil, nil)
+	testData.mockBlockRangeProcessor.EXPECT().ProcessBlockRange(testData.ctx, blocks, mock.Anything, uint64(1000)).Return(nil).Once()
+	testData.mockState.EXPECT().SetPreRollupSyncStatus(testData.ctx, entities.PreRollupSyncStatus{LxLyUpgradeBlockNumber: 150, GenesisBlockNumber: 300}, mock.Anything).Return(nil)
+
+	err := testData.sut.SynchronizePreGenesisRollupEvents(testData.ctx)
+	require.NoError(t, err)
+}
+
+// If the storage have blocks after genesis the leaves can't be inserted, so it's marked as skipped
+func TestSyncPreRollupStorageAfterGenesis(t *testing.T) {
+	testData := newTestDataSyncPreRollup(t)
+	testData.mockState.EXPECT().GetPreRollupSyncStatus(testData.ctx, mock.Anything).Return(nil, nil)
+	testData.mockEtherman.EXPECT().GetL1BlockUpgradeLxLy(testData.ctx, mock.Anything).Return(uint64(150), nil)
+	testData.mockState.EXPECT().GetLastBlock(testData.ctx, mock.Anything).Return(&entities.L1Block{BlockNumber: 400}, nil)
+	testData.mockState.EXPECT().SetPreRollupSyncStatus(testData.ctx, entities.PreRollupSyncStatus{LxLyUpgradeBlockNumber: 150, GenesisBlockNumber: 300, Skipped: true}, mock.Anything).Return(nil)
+
+	err := testData.sut.SynchronizePreGenesisRollupEvents(testData.ctx)
+	require.NoError(t, err)
+}
+
+func TestSyncPreRollupErrorIsNotMarkedAsDone(t *testing.T) {
+	testData := newTestDataSyncPreRollup(t)
+	errFetch := fmt.Errorf("error")
+	testData.mockState.EXPECT().GetPreRollupSyncStatus(testData.ctx, mock.Anything).Return(nil, nil)
+	testData.mockEtherman.EXPECT().GetL1BlockUpgradeLxLy(testData.ctx, mock.Anything).Return(uint64(150), nil)
+	testData.mockState.EXPECT().GetLastBlock(testData.ctx, mock.Anything).Return(nil, entities.ErrNotFound)
+	testData.mockFinalizedFetcher.EXPECT().BlockNumber(testData.ctx, mock.Anything).Return(uint64(1000), nil)
+	testData.mockEtherman.EXPECT().GetRollupInfoByBlockRangePreviousRollupGenesis(testData.ctx, uint64(150), mock.Anything).Return(nil, nil, errFetch)
+
+	err := testData.sut.SynchronizePreGenesisRollupEvents(testData.ctx)
+	require.ErrorIs(t, err, errFetch)
+}
+
+func TestSyncImplPreRollupErrorIsCritical(t *testing.T) {
+	testData := newTestDataSyncImpl(t)
+	preRollupData := newTestDataSyncPreRollup(t)
+	testData.sut.preRollupSyncer = preRollupData.sut
+	errStatus := fmt.Errorf("error")
+	preRollupData.mockState.EXPECT().GetPreRollupSyncStatus(mock.Anything, mock.Anything).Return(nil, errStatus)
+
+	err := testData.sut.Sync(FlagReturnOnSync)
+	require.ErrorIs(t, err, errStatus)
+}
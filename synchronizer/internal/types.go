@@ -1,13 +1,42 @@
 package internal
 
 import (
+	"time"
+
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type stateTxType = entities.Tx
+type BatchNumberRange = entities.BatchNumberRange
+type L1InfoTreeIndexRange = entities.L1InfoTreeIndexRange
 
 type ReorgExecutionResult struct {
 	// FirstL1BlockNumberValidAfterReorg is the first block or nil if the reorg have delete all blocks
 	FirstL1BlockNumberValidAfterReorg *uint64
 	ReasonError                       error
+	// FirstL1BlockNumberDeleted is the first block stored that is deleted, OldBlockHash is its hash on DB and
+	// NewBlockHash its hash on L1 after the reorg (zero if it can't be retrieved)
+	FirstL1BlockNumberDeleted uint64
+	OldBlockHash              common.Hash
+	NewBlockHash              common.Hash
+	// DeletedVirtualBatches are the ranges of virtual batches deleted per rollup
+	DeletedVirtualBatches []BatchNumberRange
+	// DeletedL1InfoTreeLeaves is the range of L1InfoTree leaves deleted, nil if there are no leaves deleted
+	DeletedL1InfoTreeLeaves *L1InfoTreeIndexRange
+}
+
+type SyncStatus struct {
+	Synced                  bool
+	LastL1BlockSynced       uint64
+	L1LastBlockToSync       uint64
+	L1FinalizedBlockNumber  uint64
+	UncheckedBlocks         uint64
+	LastVirtualBatchNumbers map[uint64]uint64
+	LastL1InfoTreeIndex     *uint32
+	PercentCompleted        float64
+	BlocksPerSecond         float64
+	EstimatedTimeToSync     time.Duration
+	LastError               error
+	LastErrorTime           time.Time
 }
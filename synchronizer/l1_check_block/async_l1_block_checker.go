@@ -3,6 +3,11 @@ package l1_check_block
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/common"
 )
 
 type IterationResult struct {
@@ -36,3 +41,152 @@ type L1BlockCheckerIntegrator interface {
 	OnResetState(ctx context.Context)
 	CheckReorgWrapper(ctx context.Context, reorgFirstBlockOk *L1Block, badBlockNumber uint64, errReportedByReorgFunc error) (*L1Block, uint64, error)
 }
+
+// L1BlockChecker is a checker of the L1 blocks stored, it returns a ReorgError if a block have changed
+type L1BlockChecker interface {
+	Step(ctx context.Context) error
+}
+
+// AsyncCheck runs a L1BlockChecker in its own goroutine until a reorg is detected or it's stopped,
+// so the synchronization is not stalled while the unchecked blocks are verified. It implements AsyncL1BlockChecker
+type AsyncCheck struct {
+	checker               L1BlockChecker
+	timeBetweenIterations time.Duration
+
+	mutex      sync.Mutex
+	isRunning  bool
+	cancel     context.CancelFunc
+	lastResult *IterationResult
+	running    sync.WaitGroup
+	// reorgsExecuted is increased by OnReorgExecuted, the results of the checks launched
+	// before are discarded
+	reorgsExecuted uint64
+}
+
+// NewAsyncCheck creates a new AsyncCheck, timeBetweenIterations is the wait after checking all the pending blocks
+func NewAsyncCheck(checker L1BlockChecker, timeBetweenIterations time.Duration) *AsyncCheck {
+	return &AsyncCheck{
+		checker:               checker,
+		timeBetweenIterations: timeBetweenIterations,
+	}
+}
+
+// Name is a method that returns the name of the checker
+func (a *AsyncCheck) Name() string {
+	return logPrefix + ":async_check: "
+}
+
+// Run launches the checker in background, if it's already running it does nothing.
+// onFinish (can be nil) is called when it finishes, the result can be retrieved with GetResult
+func (a *AsyncCheck) Run(ctx context.Context, onFinish func()) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.isRunning {
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	a.isRunning = true
+	a.cancel = cancel
+	reorgsExecuted := a.reorgsExecuted
+	a.running.Add(1)
+	go func() {
+		defer a.running.Done()
+		defer cancel()
+		result := a.loop(ctx)
+		log.Debugf("%s finished: %s", a.Name(), result.String())
+		a.mutex.Lock()
+		if reorgsExecuted == a.reorgsExecuted {
+			a.lastResult = &result
+		} else {
+			log.Infof("%s discarding result of a check launched before the last reorg: %s", a.Name(), result.String())
+		}
+		a.isRunning = false
+		a.cancel = nil
+		a.mutex.Unlock()
+		if onFinish != nil {
+			onFinish()
+		}
+	}()
+}
+
+// RunSynchronous executes a single step of the checker in the calling goroutine
+func (a *AsyncCheck) RunSynchronous(ctx context.Context) IterationResult {
+	return a.step(ctx)
+}
+
+// Stop cancels the checker in progress and waits until it finishes
+func (a *AsyncCheck) Stop() {
+	a.mutex.Lock()
+	cancel := a.cancel
+	a.mutex.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+	a.running.Wait()
+}
+
+// GetResult returns the result of the last execution finished and clears it, nil if there is no new result
+func (a *AsyncCheck) GetResult() *IterationResult {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	result := a.lastResult
+	a.lastResult = nil
+	return result
+}
+
+// OnReorgExecuted discards the result of the checks launched before a reorg, because the blocks
+// that they have checked could have been deleted. A reorg detected by them would be stale
+func (a *AsyncCheck) OnReorgExecuted() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.reorgsExecuted++
+	a.lastResult = nil
+}
+
+// Step allows to use AsyncCheck as the block checker of the synchronization loop. It returns a ReorgError
+// if the checker running in background have detected a reorg, otherwise it launches the checker if it's not running.
+// After a reorg the checker is not relaunched until the next call, so the reorg can be executed before
+func (a *AsyncCheck) Step(ctx context.Context) error {
+	result := a.GetResult()
+	if result != nil && result.ReorgDetected {
+		log.Warnf("%s reorg detected in background: %s", a.Name(), result.String())
+		return common.NewReorgError(result.BlockNumber, fmt.Errorf("%s", result.ReorgMessage))
+	}
+	a.Run(ctx, nil)
+	return nil
+}
+
+func (a *AsyncCheck) loop(ctx context.Context) IterationResult {
+	for {
+		result := a.step(ctx)
+		if result.ReorgDetected {
+			return result
+		}
+		if ctx.Err() != nil {
+			return IterationResult{Err: ctx.Err()}
+		}
+		if result.Err != nil {
+			log.Warnf("%s error checking blocks, retrying in %s. Err: %v", a.Name(), a.timeBetweenIterations, result.Err)
+		}
+		select {
+		case <-ctx.Done():
+			return IterationResult{Err: ctx.Err()}
+		case <-time.After(a.timeBetweenIterations):
+		}
+	}
+}
+
+func (a *AsyncCheck) step(ctx context.Context) IterationResult {
+	err := a.checker.Step(ctx)
+	if err == nil {
+		return IterationResult{}
+	}
+	if reorgErr := common.CastReorgError(err); reorgErr != nil {
+		return IterationResult{
+			ReorgDetected: true,
+			BlockNumber:   reorgErr.BlockNumber,
+			ReorgMessage:  reorgErr.Err.Error(),
+		}
+	}
+	return IterationResult{Err: err}
+}
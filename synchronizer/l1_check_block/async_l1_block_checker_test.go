@@ -0,0 +1,118 @@
+package l1_check_block_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	commonsync "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/common"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_check_block"
+	mock_l1_check_block "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_check_block/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncCheckRunUntilReorg(t *testing.T) {
+	mockChecker := mock_l1_check_block.NewL1BlockChecker(t)
+	sut := l1_check_block.NewAsyncCheck(mockChecker, time.Millisecond)
+	mockChecker.EXPECT().Step(mock.Anything).Return(nil).Twice()
+	mockChecker.EXPECT().Step(mock.Anything).Return(commonsync.NewReorgError(123, fmt.Errorf("reorg"))).Once()
+	finished := make(chan struct{})
+
+	sut.Run(context.TODO(), func() { close(finished) })
+	<-finished
+	result := sut.GetResult()
+	require.NotNil(t, result)
+	require.True(t, result.ReorgDetected)
+	require.Equal(t, uint64(123), result.BlockNumber)
+	require.Nil(t, sut.GetResult(), "the result is cleared once retrieved")
+}
+
+func TestAsyncCheckRetriesOnError(t *testing.T) {
+	mockChecker := mock_l1_check_block.NewL1BlockChecker(t)
+	sut := l1_check_block.NewAsyncCheck(mockChecker, time.Millisecond)
+	mockChecker.EXPECT().Step(mock.Anything).Return(fmt.Errorf("error")).Once()
+	mockChecker.EXPECT().Step(mock.Anything).Return(commonsync.NewReorgError(123, fmt.Errorf("reorg"))).Once()
+	finished := make(chan struct{})
+
+	sut.Run(context.TODO(), func() { close(finished) })
+	<-finished
+	require.True(t, sut.GetResult().ReorgDetected)
+}
+
+func TestAsyncCheckStop(t *testing.T) {
+	mockChecker := mock_l1_check_block.NewL1BlockChecker(t)
+	sut := l1_check_block.NewAsyncCheck(mockChecker, time.Hour)
+	stepCalled := make(chan struct{})
+	mockChecker.EXPECT().Step(mock.Anything).Run(func(ctx context.Context) { close(stepCalled) }).Return(nil).Once()
+
+	sut.Run(context.TODO(), nil)
+	<-stepCalled
+	sut.Stop()
+	result := sut.GetResult()
+	require.NotNil(t, result)
+	require.False(t, result.ReorgDetected)
+	require.ErrorIs(t, result.Err, context.Canceled)
+}
+
+// Step launches the checker in background and returns the reorg detected as a ReorgError
+func TestAsyncCheckStepReturnsReorgError(t *testing.T) {
+	mockChecker := mock_l1_check_block.NewL1BlockChecker(t)
+	sut := l1_check_block.NewAsyncCheck(mockChecker, time.Millisecond)
+	mockChecker.EXPECT().Step(mock.Anything).Return(commonsync.NewReorgError(123, fmt.Errorf("reorg"))).Once()
+
+	err := sut.Step(context.TODO())
+	require.NoError(t, err)
+	var reorgErr *commonsync.ReorgError
+	require.Eventually(t, func() bool {
+		err = sut.Step(context.TODO())
+		reorgErr = commonsync.CastReorgError(err)
+		return reorgErr != nil
+	}, time.Second, time.Millisecond)
+	require.Equal(t, uint64(123), reorgErr.BlockNumber)
+}
+
+// A reorg detected before executing another reorg is stale, it's discarded
+func TestAsyncCheckOnReorgExecutedDiscardsResult(t *testing.T) {
+	mockChecker := mock_l1_check_block.NewL1BlockChecker(t)
+	sut := l1_check_block.NewAsyncCheck(mockChecker, time.Millisecond)
+	mockChecker.EXPECT().Step(mock.Anything).Return(commonsync.NewReorgError(123, fmt.Errorf("reorg"))).Once()
+	finished := make(chan struct{})
+
+	sut.Run(context.TODO(), func() { close(finished) })
+	<-finished
+	sut.OnReorgExecuted()
+	require.Nil(t, sut.GetResult())
+}
+
+// The result of a check in progress while a reorg is executed is discarded
+func TestAsyncCheckOnReorgExecutedDiscardsCheckInProgress(t *testing.T) {
+	mockChecker := mock_l1_check_block.NewL1BlockChecker(t)
+	sut := l1_check_block.NewAsyncCheck(mockChecker, time.Millisecond)
+	stepCalled := make(chan struct{})
+	reorgExecuted := make(chan struct{})
+	mockChecker.EXPECT().Step(mock.Anything).Run(func(ctx context.Context) {
+		close(stepCalled)
+		<-reorgExecuted
+	}).Return(commonsync.NewReorgError(123, fmt.Errorf("reorg"))).Once()
+	finished := make(chan struct{})
+
+	sut.Run(context.TODO(), func() { close(finished) })
+	<-stepCalled
+	sut.OnReorgExecuted()
+	close(reorgExecuted)
+	<-finished
+	require.Nil(t, sut.GetResult())
+}
+
+func TestAsyncCheckRunSynchronous(t *testing.T) {
+	mockChecker := mock_l1_check_block.NewL1BlockChecker(t)
+	sut := l1_check_block.NewAsyncCheck(mockChecker, time.Millisecond)
+	errStep := fmt.Errorf("error")
+	mockChecker.EXPECT().Step(mock.Anything).Return(errStep).Once()
+
+	result := sut.RunSynchronous(context.TODO())
+	require.ErrorIs(t, result.Err, errStep)
+	require.False(t, result.ReorgDetected)
+}
@@ -0,0 +1,32 @@
+package l1_check_block
+
+import (
+	"context"
+)
+
+// MultipleChecker executes several checkers in order, it stops on the first error
+type MultipleChecker struct {
+	checkers []L1BlockChecker
+}
+
+// NewMultipleChecker creates a new MultipleChecker, the nil checkers are ignored
+func NewMultipleChecker(checkers ...L1BlockChecker) *MultipleChecker {
+	res := &MultipleChecker{}
+	for _, checker := range checkers {
+		if checker != nil {
+			res.checkers = append(res.checkers, checker)
+		}
+	}
+	return res
+}
+
+// Step executes a step of each checker
+func (m *MultipleChecker) Step(ctx context.Context) error {
+	for _, checker := range m.checkers {
+		err := checker.Step(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package l1_check_block_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_check_block"
+	mock_l1_check_block "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_check_block/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultipleCheckerExecutesAllInOrder(t *testing.T) {
+	ctx := context.TODO()
+	first := mock_l1_check_block.NewL1BlockChecker(t)
+	second := mock_l1_check_block.NewL1BlockChecker(t)
+	calls := []string{}
+	first.EXPECT().Step(ctx).Run(func(context.Context) { calls = append(calls, "first") }).Return(nil).Once()
+	second.EXPECT().Step(ctx).Run(func(context.Context) { calls = append(calls, "second") }).Return(nil).Once()
+	sut := l1_check_block.NewMultipleChecker(first, nil, second)
+
+	require.NoError(t, sut.Step(ctx))
+	require.Equal(t, []string{"first", "second"}, calls)
+}
+
+func TestMultipleCheckerStopsOnError(t *testing.T) {
+	ctx := context.TODO()
+	first := mock_l1_check_block.NewL1BlockChecker(t)
+	second := mock_l1_check_block.NewL1BlockChecker(t)
+	errStep := fmt.Errorf("error")
+	first.EXPECT().Step(ctx).Return(errStep).Once()
+	sut := l1_check_block.NewMultipleChecker(first, second)
+
+	require.ErrorIs(t, sut.Step(ctx), errStep)
+}
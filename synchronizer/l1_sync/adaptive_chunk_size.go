@@ -0,0 +1,86 @@
+package l1sync
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	syncmetrics "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/metrics"
+)
+
+const (
+	// A response faster than fastResponseMaxDuration and with less logs than smallResponseMaxLogs
+	// makes the chunk size grow
+	fastResponseMaxDuration = 2 * time.Second
+	smallResponseMaxLogs    = 1000
+)
+
+// adaptiveChunkSize is the number of blocks requested on each range, it grows when the responses
+// are small and fast, and shrinks when the L1 node rejects the range (too many results or range limit)
+type adaptiveChunkSize struct {
+	mutex sync.Mutex
+	// networkID is the label of the metric
+	networkID uint
+	minSize   uint64
+	maxSize   uint64
+	current   uint64
+}
+
+// newAdaptiveChunkSize creates a chunk size between minSize and maxSize, if they are 0 the initial value is used
+func newAdaptiveChunkSize(networkID uint, initial, minSize, maxSize uint64) *adaptiveChunkSize {
+	if minSize == 0 || minSize > initial {
+		minSize = initial
+	}
+	if maxSize < initial {
+		maxSize = initial
+	}
+	res := &adaptiveChunkSize{
+		networkID: networkID,
+		minSize:   max(minSize, 1),
+		maxSize:   max(maxSize, 1),
+		current:   max(initial, 1),
+	}
+	syncmetrics.SyncChunkSize(res.networkID, res.current)
+	return res
+}
+
+func (a *adaptiveChunkSize) get() uint64 {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.current
+}
+
+// onResponse grows the chunk size if the response is small and fast
+func (a *adaptiveChunkSize) onResponse(numLogs int, duration time.Duration) {
+	if numLogs >= smallResponseMaxLogs || duration >= fastResponseMaxDuration {
+		return
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	newSize := min(a.current*2, a.maxSize) //nolint:gomnd
+	a.setCurrent(newSize)
+}
+
+// onError shrinks the chunk size if the error means that the range is too large,
+// returns true if the size have been reduced
+func (a *adaptiveChunkSize) onError(err error) bool {
+	if !errors.Is(err, etherman.ErrFilterLogsRangeTooLarge) {
+		return false
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	newSize := max(a.current/2, a.minSize) //nolint:gomnd
+	return a.setCurrent(newSize)
+}
+
+func (a *adaptiveChunkSize) setCurrent(newSize uint64) bool {
+	if newSize == a.current {
+		return false
+	}
+	log.Infof("SyncChunkSize changed from %d to %d", a.current, newSize)
+	a.current = newSize
+	syncmetrics.SyncChunkSize(a.networkID, newSize)
+	return true
+}
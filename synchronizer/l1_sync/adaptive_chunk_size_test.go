@@ -0,0 +1,44 @@
+package l1sync
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveChunkSizeGrowsOnSmallAndFastResponses(t *testing.T) {
+	sut := newAdaptiveChunkSize(0, 100, 10, 300)
+	sut.onResponse(10, time.Millisecond)
+	require.Equal(t, uint64(200), sut.get())
+	sut.onResponse(10, time.Millisecond)
+	require.Equal(t, uint64(300), sut.get())
+	// Big or slow responses doesn't change it
+	sut = newAdaptiveChunkSize(0, 100, 10, 300)
+	sut.onResponse(smallResponseMaxLogs, time.Millisecond)
+	sut.onResponse(10, fastResponseMaxDuration)
+	require.Equal(t, uint64(100), sut.get())
+}
+
+func TestAdaptiveChunkSizeShrinksOnRangeTooLarge(t *testing.T) {
+	sut := newAdaptiveChunkSize(0, 100, 30, 300)
+	require.False(t, sut.onError(fmt.Errorf("connection refused")))
+	require.Equal(t, uint64(100), sut.get())
+	errRange := fmt.Errorf("%w: query returned more than 10000 results", etherman.ErrFilterLogsRangeTooLarge)
+	require.True(t, sut.onError(errRange))
+	require.Equal(t, uint64(50), sut.get())
+	require.True(t, sut.onError(errRange))
+	require.Equal(t, uint64(30), sut.get())
+	require.False(t, sut.onError(errRange))
+	require.Equal(t, uint64(30), sut.get())
+}
+
+func TestAdaptiveChunkSizeWithoutBoundsIsFixed(t *testing.T) {
+	sut := newAdaptiveChunkSize(0, 100, 0, 0)
+	sut.onResponse(0, time.Millisecond)
+	require.Equal(t, uint64(100), sut.get())
+	require.False(t, sut.onError(etherman.ErrFilterLogsRangeTooLarge))
+	require.Equal(t, uint64(100), sut.get())
+}
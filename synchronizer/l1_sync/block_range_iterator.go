@@ -1,8 +1,13 @@
 package l1sync
 
 import (
+	"errors"
 	"fmt"
-	"log"
+)
+
+var (
+	// ErrFromBlockBackwards is returned by NextRange if the fromBlock is before the current one
+	ErrFromBlockBackwards = errors.New("fromBlock is less than the current fromBlock")
 )
 
 type BlockRange struct {
@@ -34,17 +39,18 @@ func NewBlockRangeIterator(fromBlock, syncChunkSize uint64, maximumBlock uint64)
 		SyncChunkSize: syncChunkSize,
 		MaximumBlock:  maximumBlock,
 	}
-	res = res.NextRange(fromBlock)
+	// It can't fail because fromBlock is the current one
+	res, _ = res.NextRange(fromBlock)
 	return res
 }
 func (i *BlockRangeIterator) IsLastRange() bool {
 	return i.toBlock >= i.MaximumBlock
 }
 
-func (i *BlockRangeIterator) NextRange(fromBlock uint64) *BlockRangeIterator {
+func (i *BlockRangeIterator) NextRange(fromBlock uint64) (*BlockRangeIterator, error) {
 	// The FromBlock is the new block (can be the previous one if no blocks found in the range)
 	if fromBlock < i.fromBlock {
-		log.Fatal("FromBlock is less than the current fromBlock")
+		return i, fmt.Errorf("%w: fromBlock %d, current fromBlock %d", ErrFromBlockBackwards, fromBlock, i.fromBlock)
 	}
 	i.fromBlock = fromBlock
 	// Extend toBlock by sync chunk size
@@ -54,9 +60,21 @@ func (i *BlockRangeIterator) NextRange(fromBlock uint64) *BlockRangeIterator {
 		i.toBlock = i.MaximumBlock
 	}
 	if i.fromBlock > i.toBlock {
-		return nil
+		return nil, nil
+	}
+	return i, nil
+}
+
+// ShrinkRange sets the SyncChunkSize and reduces the current range to it,
+// returns false if the current range is already smaller
+func (i *BlockRangeIterator) ShrinkRange(syncChunkSize uint64) bool {
+	i.SyncChunkSize = syncChunkSize
+	newToBlock := min(i.fromBlock+syncChunkSize, i.MaximumBlock)
+	if newToBlock >= i.toBlock {
+		return false
 	}
-	return i
+	i.toBlock = newToBlock
+	return true
 }
 
 func (i *BlockRangeIterator) GetRange(overlappedFirst bool) BlockRange {
@@ -10,7 +10,8 @@ import (
 func TestBlockRange_WhenFromIsGEThanMaximumIsLastRange(t *testing.T) {
 	it := l1sync.NewBlockRangeIterator(100, 10, 120)
 	require.False(t, it.IsLastRange())
-	it = it.NextRange(100)
+	it, err := it.NextRange(100)
+	require.NoError(t, err)
 	require.True(t, it.IsLastRange())
 }
 
@@ -45,12 +46,14 @@ func TestBlockRange_EachNextRangeExtendToBlockByChunckIndependentlyFrom(t *testi
 	chunck := uint64(10)
 	lastBlock := uint64(200)
 	it := l1sync.NewBlockRangeIterator(fromBlock, chunck, lastBlock)
-	it = it.NextRange(fromBlock)
+	it, err := it.NextRange(fromBlock)
+	require.NoError(t, err)
 	require.NotNil(t, it)
 	br := it.GetRange(false)
 	require.Equal(t, fromBlock+2*chunck, br.ToBlock)
 
-	it = it.NextRange(fromBlock + 5)
+	it, err = it.NextRange(fromBlock + 5)
+	require.NoError(t, err)
 	require.NotNil(t, it)
 	br = it.GetRange(false)
 	require.Equal(t, fromBlock+3*chunck, br.ToBlock)
@@ -61,12 +64,14 @@ func TestBlockRange_ToBlockIsCappedToMaximumBlock(t *testing.T) {
 	chunck := uint64(10)
 	lastBlock := uint64(110)
 	it := l1sync.NewBlockRangeIterator(fromBlock, chunck, lastBlock)
-	it = it.NextRange(fromBlock)
+	it, err := it.NextRange(fromBlock)
+	require.NoError(t, err)
 	require.NotNil(t, it)
 	br := it.GetRange(false)
 	require.Equal(t, fromBlock+1*chunck, br.ToBlock)
 
-	it = it.NextRange(fromBlock + 5)
+	it, err = it.NextRange(fromBlock + 5)
+	require.NoError(t, err)
 	require.NotNil(t, it)
 	br = it.GetRange(false)
 	require.Equal(t, fromBlock+1*chunck, br.ToBlock)
@@ -79,3 +84,22 @@ func TestBlockRange_SettingWrongFromBlockReturnsNil(t *testing.T) {
 	it := l1sync.NewBlockRangeIterator(fromBlock, chunck, lastBlock)
 	require.Nil(t, it)
 }
+
+func TestBlockRange_ShrinkRange(t *testing.T) {
+	it := l1sync.NewBlockRangeIterator(100, 100, 1000)
+	require.True(t, it.ShrinkRange(10))
+	require.Equal(t, l1sync.BlockRange{FromBlock: 101, ToBlock: 110}, it.GetRange(false))
+	// Already smaller than the new chunk size
+	require.False(t, it.ShrinkRange(20))
+	it, err := it.NextRange(100)
+	require.NoError(t, err)
+	require.Equal(t, l1sync.BlockRange{FromBlock: 101, ToBlock: 130}, it.GetRange(false))
+}
+
+func TestBlockRange_NextRangeWithFromBlockBackwardsReturnsError(t *testing.T) {
+	it := l1sync.NewBlockRangeIterator(100, 10, 200)
+	it, err := it.NextRange(105)
+	require.NoError(t, err)
+	_, err = it.NextRange(104)
+	require.ErrorIs(t, err, l1sync.ErrFromBlockBackwards)
+}
@@ -0,0 +1,217 @@
+package l1sync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
+	syncommon "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/common"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type rangeFetchFunc func(ctx context.Context, blockRange BlockRange) ([]etherman.Block, map[common.Hash][]etherman.Order, error)
+
+type rangeFetchResult struct {
+	blockRange BlockRange
+	blocks     []etherman.Block
+	order      map[common.Hash][]etherman.Order
+	err        error
+}
+
+// parallelRangeFetcher retrieves block ranges concurrently and returns them in the same order
+// that have been scheduled. The number of ranges scheduled and not consumed is bounded by maxPending
+type parallelRangeFetcher struct {
+	ctx        context.Context
+	fetch      rangeFetchFunc
+	workers    chan struct{}
+	maxPending int
+	pending    []chan rangeFetchResult
+	wg         sync.WaitGroup
+}
+
+func newParallelRangeFetcher(ctx context.Context, fetch rangeFetchFunc, numWorkers, maxPending uint64) *parallelRangeFetcher {
+	return &parallelRangeFetcher{
+		ctx:        ctx,
+		fetch:      fetch,
+		workers:    make(chan struct{}, numWorkers),
+		maxPending: int(maxPending),
+	}
+}
+
+func (f *parallelRangeFetcher) canSchedule() bool {
+	return len(f.pending) < f.maxPending
+}
+
+func (f *parallelRangeFetcher) schedule(blockRange BlockRange) {
+	resultCh := make(chan rangeFetchResult, 1)
+	f.pending = append(f.pending, resultCh)
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		select {
+		case f.workers <- struct{}{}:
+		case <-f.ctx.Done():
+			resultCh <- rangeFetchResult{blockRange: blockRange, err: f.ctx.Err()}
+			return
+		}
+		defer func() { <-f.workers }()
+		blocks, order, err := f.fetch(f.ctx, blockRange)
+		resultCh <- rangeFetchResult{blockRange: blockRange, blocks: blocks, order: order, err: err}
+	}()
+}
+
+// next waits for the oldest range scheduled, returns false if there are no pending ranges
+func (f *parallelRangeFetcher) next() (rangeFetchResult, bool) {
+	if len(f.pending) == 0 {
+		return rangeFetchResult{}, false
+	}
+	resultCh := f.pending[0]
+	f.pending = f.pending[1:]
+	return <-resultCh, true
+}
+
+// wait for all the requests in progress, the results are discarded
+func (f *parallelRangeFetcher) wait() {
+	f.wg.Wait()
+	f.pending = nil
+}
+
+func (s *L1SequentialSync) isParallelFetchEnabled() bool {
+	return s.cfg.ParallelFetchWorkers > 1
+}
+
+func (s *L1SequentialSync) getParallelFetchMaxPendingRanges() uint64 {
+	if s.cfg.ParallelFetchMaxPendingRanges == 0 {
+		return 2 * s.cfg.ParallelFetchWorkers //nolint:gomnd
+	}
+	return max(s.cfg.ParallelFetchMaxPendingRanges, s.cfg.ParallelFetchWorkers)
+}
+
+// syncFinalizedRangesInParallel retrieves the ranges of blocks in (fromBlock, lastBlockToFetch] concurrently
+// and process them in order. It returns the last block synced and the last block processed, that is
+// the fromBlock for the next ranges. It returns before processing a range if a stop, pause or target
+// is reached, SyncBlocks checks it again before the next range
+func (s *L1SequentialSync) syncFinalizedRangesInParallel(ctx context.Context, fromBlock, lastBlockToFetch, finalizedBlockNumber uint64,
+	lastEthBlockSynced *stateBlockType) (*stateBlockType, uint64, error) {
+	if lastBlockToFetch <= fromBlock+s.chunkSize.get() {
+		// Just one range, it's not worth
+		return lastEthBlockSynced, fromBlock, nil
+	}
+	log.Infof("Syncing finalized blocks in parallel from %d to %d with %d workers", fromBlock, lastBlockToFetch, s.cfg.ParallelFetchWorkers)
+	fetchCtx, cancel := context.WithCancel(ctx)
+	fetcher := newParallelRangeFetcher(fetchCtx, s.retrieveOverlappedRangeFromL1, s.cfg.ParallelFetchWorkers, s.getParallelFetchMaxPendingRanges())
+	defer func() {
+		cancel()
+		fetcher.wait()
+	}()
+	lastBlockScheduled := fromBlock
+	rangesProcessed := 0
+	for {
+		for fetcher.canSchedule() && lastBlockScheduled < lastBlockToFetch {
+			blockRange := BlockRange{
+				FromBlock: lastBlockScheduled + 1,
+				ToBlock:   min(lastBlockScheduled+s.chunkSize.get(), lastBlockToFetch),
+			}
+			if lastBlockScheduled > fromBlock {
+				// The last block of the previous range is requested again to check that it has not changed
+				blockRange.FromBlock = lastBlockScheduled
+				blockRange.OverlappedFirstBlock = true
+			}
+			fetcher.schedule(blockRange)
+			lastBlockScheduled = blockRange.ToBlock
+		}
+		result, ok := fetcher.next()
+		if !ok {
+			break
+		}
+		if result.err != nil {
+			return lastEthBlockSynced, fromBlock, result.err
+		}
+		if s.stopRequested.Load() || s.paused.Load() {
+			break
+		}
+		targetReached, err := s.isTargetReached(ctx)
+		if err != nil {
+			return lastEthBlockSynced, fromBlock, err
+		}
+		if targetReached {
+			break
+		}
+		err = s.checkReorgsOnPreviousL1Blocks(ctx)
+		if err != nil {
+			return lastEthBlockSynced, fromBlock, err
+		}
+		blocks, err := s.checkOverlappedBlockOfParallelRange(result.blockRange, result.blocks, result.order, lastEthBlockSynced)
+		if err != nil {
+			return lastEthBlockSynced, fromBlock, err
+		}
+		blockRange := BlockRange{FromBlock: fromBlock + 1, ToBlock: result.blockRange.ToBlock}
+		log.Infof("Processing range retrieved in parallel: %s", blockRange.String())
+		lastEthBlockSynced, _, err = s.processRetrievedData(ctx, blockRange, blocks, result.order, finalizedBlockNumber, lastEthBlockSynced)
+		if err != nil {
+			return lastEthBlockSynced, fromBlock, err
+		}
+		fromBlock = result.blockRange.ToBlock
+		rangesProcessed++
+		s.progress.setLastBlockSynced(fromBlock)
+	}
+	if rangesProcessed > 0 && s.cfg.AllowEmptyBlocksAsCheckPoints && (lastEthBlockSynced == nil || lastEthBlockSynced.BlockNumber < fromBlock) {
+		// Store the last block processed, so the next call to SyncBlocks doesn't request again the empty ranges.
+		// If it fails the empty ranges are requested again
+		emptyBlock, err := s.createBlockWithoutRollupInfo(ctx, fromBlock, finalizedBlockNumber)
+		if err != nil {
+			log.Warnf("error creating empty block %d after syncing in parallel, the empty ranges will be requested again. Err: %v", fromBlock, err)
+		}
+		if emptyBlock != nil {
+			log.Infof("Creating empty block  BlockNumber: %d", emptyBlock.BlockNumber)
+			lastEthBlockSynced = emptyBlock
+		}
+	}
+	return lastEthBlockSynced, fromBlock, nil
+}
+
+// retrieveOverlappedRangeFromL1 retrieves a range scheduled by syncFinalizedRangesInParallel. The overlapped
+// block can't be validated here because the previous range could be not processed yet, so it's
+// checked by checkOverlappedBlockOfParallelRange
+func (s *L1SequentialSync) retrieveOverlappedRangeFromL1(ctx context.Context, blockRange BlockRange) ([]etherman.Block, map[common.Hash][]etherman.Order, error) {
+	blockRange.OverlappedFirstBlock = false
+	return s.retrieveDataFromL1AndValidate(ctx, blockRange)
+}
+
+// checkOverlappedBlockOfParallelRange compares the overlapped block of a range retrieved in parallel with the
+// last block processed and returns the blocks without the overlapped one. GetRollupInfoByBlockRange only returns
+// blocks with logs, so if the overlapped block has been processed with events it must be the first block
+// received with the same hash, and if it has been processed without events it must not be received with events.
+func (s *L1SequentialSync) checkOverlappedBlockOfParallelRange(blockRange BlockRange, blocks []etherman.Block, order map[common.Hash][]etherman.Order,
+	lastEthBlockSynced *stateBlockType) ([]etherman.Block, error) {
+	if !blockRange.OverlappedFirstBlock {
+		return blocks, nil
+	}
+	overlappedBlockNumber := blockRange.FromBlock
+	var initBlockReceived *etherman.Block
+	if len(blocks) > 0 && blocks[0].BlockNumber == overlappedBlockNumber {
+		initBlockReceived = &blocks[0]
+	}
+	var err error
+	if lastEthBlockSynced != nil && lastEthBlockSynced.BlockNumber == overlappedBlockNumber {
+		if initBlockReceived == nil && lastEthBlockSynced.HasEvents {
+			err = fmt.Errorf("reorg detected in block %d while querying GetRollupInfoByBlockRange. Expected response must include overlapped block", overlappedBlockNumber)
+		} else if initBlockReceived != nil && initBlockReceived.BlockHash != lastEthBlockSynced.BlockHash {
+			err = fmt.Errorf("reorg detected in block %d while querying GetRollupInfoByBlockRange. Overlapped block hash %s mismatch processed one %s",
+				overlappedBlockNumber, initBlockReceived.BlockHash.String(), lastEthBlockSynced.BlockHash.String())
+		}
+	} else if initBlockReceived != nil && initBlockReceived.HasEvents() {
+		err = fmt.Errorf("reorg detected in block %d while querying GetRollupInfoByBlockRange. Overlapped block has events but it was processed without", overlappedBlockNumber)
+	}
+	if err != nil {
+		log.Error(err.Error())
+		return blocks, syncommon.NewReorgError(overlappedBlockNumber, err)
+	}
+	if initBlockReceived != nil {
+		delete(order, initBlockReceived.BlockHash)
+		blocks = removeBlockElement(blocks, 0)
+	}
+	return blocks, nil
+}
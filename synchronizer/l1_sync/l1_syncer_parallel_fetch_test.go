@@ -0,0 +1,158 @@
+package l1sync_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	syncommon "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/common"
+	l1sync "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_sync"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newParallelL1SyncData(t *testing.T) *testL1SyncData {
+	testData := newL1SyncDataWithConfig(t, l1sync.L1SequentialSyncConfig{
+		SyncChunkSize:        100,
+		GenesisBlockNumber:   123,
+		ParallelFetchWorkers: 3,
+	})
+	testData.lastEthBlock = &entities.L1Block{
+		BlockNumber: 100,
+		HasEvents:   true,
+		Checked:     true,
+	}
+	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
+		L1LastBlockToSync:      400,
+		L1FinalizedBlockNumber: 400,
+	}, nil)
+	return testData
+}
+
+// The ranges are retrieved concurrently (the first one is the slowest) but are processed in order
+func TestSyncBlocksParallelProcessInOrder(t *testing.T) {
+	testData := newParallelL1SyncData(t)
+	delays := map[uint64]time.Duration{101: 30 * time.Millisecond, 200: 10 * time.Millisecond, 300: 0}
+	for fromBlock, delay := range delays {
+		delay := delay
+		blockNumber := (fromBlock/100)*100 + 50
+		testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, fromBlock, mock.Anything).RunAndReturn(
+			func(ctx context.Context, fromBlock uint64, toBlock *uint64) ([]etherman.Block, map[common.Hash][]etherman.Order, error) {
+				time.Sleep(delay)
+				return []etherman.Block{{BlockNumber: blockNumber}}, nil, nil
+			}).Once()
+	}
+	processed := []uint64{}
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, mock.Anything, mock.Anything, uint64(400)).Run(
+		func(ctx context.Context, blocks []etherman.Block, order map[common.Hash][]etherman.Order, finalizedBlockNumber uint64) {
+			processed = append(processed, blocks[0].BlockNumber)
+		}).Return(nil)
+
+	resBlock, synced, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	require.NoError(t, err)
+	require.True(t, synced)
+	require.Equal(t, []uint64{150, 250, 350}, processed)
+	require.Equal(t, uint64(350), resBlock.BlockNumber)
+}
+
+// If a range fails the previous ones are processed and the rest are discarded
+func TestSyncBlocksParallelFetchError(t *testing.T) {
+	testData := newParallelL1SyncData(t)
+	errFetch := fmt.Errorf("error")
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(101), mock.Anything).Return([]etherman.Block{{BlockNumber: 150}}, nil, nil).Once()
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(200), mock.Anything).Return(nil, nil, errFetch).Once()
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(300), mock.Anything).Return([]etherman.Block{{BlockNumber: 350}}, nil, nil).Maybe()
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, []etherman.Block{{BlockNumber: 150}}, mock.Anything, uint64(400)).Return(nil).Once()
+
+	resBlock, synced, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	require.ErrorIs(t, err, errFetch)
+	require.False(t, synced)
+	require.Equal(t, uint64(150), resBlock.BlockNumber)
+}
+
+// The last block of a range is requested again with the next one, if its hash has changed it's a reorg
+func TestSyncBlocksParallelOverlappedBlockHashMismatch(t *testing.T) {
+	testData := newParallelL1SyncData(t)
+	blockProcessed := etherman.Block{BlockNumber: 200, BlockHash: common.HexToHash("0x01"), GlobalExitRoots: []etherman.GlobalExitRoot{{}}}
+	blockReorged := etherman.Block{BlockNumber: 200, BlockHash: common.HexToHash("0x02"), GlobalExitRoots: []etherman.GlobalExitRoot{{}}}
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(101), mock.Anything).Return([]etherman.Block{blockProcessed}, nil, nil).Once()
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(200), mock.Anything).Return([]etherman.Block{blockReorged, {BlockNumber: 250}}, nil, nil).Once()
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(300), mock.Anything).Return(nil, nil, nil).Maybe()
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, []etherman.Block{blockProcessed}, mock.Anything, uint64(400)).Return(nil).Once()
+
+	resBlock, synced, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	require.True(t, syncommon.IsReorgError(err))
+	require.Equal(t, uint64(200), syncommon.CastReorgError(err).BlockNumber)
+	require.False(t, synced)
+	require.Equal(t, blockProcessed.BlockHash, resBlock.BlockHash)
+}
+
+// The overlapped block is discarded if it's the same that have been processed
+func TestSyncBlocksParallelOverlappedBlockMatch(t *testing.T) {
+	testData := newParallelL1SyncData(t)
+	block200 := etherman.Block{BlockNumber: 200, BlockHash: common.HexToHash("0x01")}
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(101), mock.Anything).Return([]etherman.Block{block200}, nil, nil).Once()
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(200), mock.Anything).Return([]etherman.Block{block200, {BlockNumber: 250}}, nil, nil).Once()
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(300), mock.Anything).Return(nil, nil, nil).Once()
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, []etherman.Block{block200}, mock.Anything, uint64(400)).Return(nil).Once()
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, []etherman.Block{{BlockNumber: 250}}, mock.Anything, uint64(400)).Return(nil).Once()
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, []etherman.Block(nil), mock.Anything, uint64(400)).Return(nil).Once()
+
+	resBlock, synced, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	require.NoError(t, err)
+	require.True(t, synced)
+	require.Equal(t, uint64(250), resBlock.BlockNumber)
+}
+
+// The overlapped block has been processed without events (it's not the last block processed) but now it has events
+func TestSyncBlocksParallelOverlappedBlockWithNewEvents(t *testing.T) {
+	testData := newParallelL1SyncData(t)
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(101), mock.Anything).Return([]etherman.Block{{BlockNumber: 150}}, nil, nil).Once()
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(200), mock.Anything).Return([]etherman.Block{{BlockNumber: 200, GlobalExitRoots: []etherman.GlobalExitRoot{{}}}}, nil, nil).Once()
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, uint64(300), mock.Anything).Return(nil, nil, nil).Maybe()
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, []etherman.Block{{BlockNumber: 150}}, mock.Anything, uint64(400)).Return(nil).Once()
+
+	_, _, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	require.True(t, syncommon.IsReorgError(err))
+	require.Equal(t, uint64(200), syncommon.CastReorgError(err).BlockNumber)
+}
+
+// The number of concurrent requests to L1 is bounded by the number of workers
+func TestSyncBlocksParallelRespectsNumWorkers(t *testing.T) {
+	testData := newL1SyncDataWithConfig(t, l1sync.L1SequentialSyncConfig{
+		SyncChunkSize:                 10,
+		GenesisBlockNumber:            123,
+		ParallelFetchWorkers:          2,
+		ParallelFetchMaxPendingRanges: 4,
+	})
+	lastEthBlock := &entities.L1Block{BlockNumber: 100, Checked: true}
+	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
+		L1LastBlockToSync:      200,
+		L1FinalizedBlockNumber: 200,
+	}, nil)
+	var mutex sync.Mutex
+	inProgress, maxInProgress := 0, 0
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, fromBlock uint64, toBlock *uint64) ([]etherman.Block, map[common.Hash][]etherman.Order, error) {
+			mutex.Lock()
+			inProgress++
+			maxInProgress = max(maxInProgress, inProgress)
+			mutex.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			mutex.Lock()
+			inProgress--
+			mutex.Unlock()
+			return nil, nil, nil
+		}).Times(10)
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, mock.Anything, mock.Anything, uint64(200)).Return(nil).Times(10)
+
+	_, synced, err := testData.sut.SyncBlocks(testData.ctx, lastEthBlock)
+	require.NoError(t, err)
+	require.True(t, synced)
+	require.LessOrEqual(t, maxInProgress, 2)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
@@ -53,12 +55,34 @@ type L1SequentialSync struct {
 	reorgManager        ReorgManager
 	cfg                 L1SequentialSyncConfig
 	blockChecker        BlockChecker
+	progress            *syncProgressTracker
+	chunkSize           *adaptiveChunkSize
+	stopRequested       atomic.Bool
+	paused              atomic.Bool
+	// maxL1BlockToSync bounds the BlockRangeIterator maximum (0 means no limit)
+	maxL1BlockToSync     atomic.Uint64
+	targetReachedChecker atomic.Pointer[TargetReachedCheckerFunc]
 }
 
+// TargetReachedCheckerFunc returns true if the synchronization have reached the target (e.g. a batch number)
+type TargetReachedCheckerFunc func(ctx context.Context) (bool, error)
+
 type L1SequentialSyncConfig struct {
-	SyncChunkSize                 uint64
+	// NetworkID is used to label the metrics
+	NetworkID     uint
+	SyncChunkSize uint64
+	// MinSyncChunkSize and MaxSyncChunkSize are the bounds of the adaptive chunk size,
+	// 0 means SyncChunkSize
+	MinSyncChunkSize              uint64
+	MaxSyncChunkSize              uint64
 	GenesisBlockNumber            uint64
 	AllowEmptyBlocksAsCheckPoints bool
+	// ParallelFetchWorkers is the number of block ranges requested concurrently to L1
+	// for finalized blocks. 0 or 1 means sequential
+	ParallelFetchWorkers uint64
+	// ParallelFetchMaxPendingRanges is the maximum number of block ranges retrieved and
+	// not processed yet, it bounds the memory used. 0 means 2*ParallelFetchWorkers
+	ParallelFetchMaxPendingRanges uint64
 }
 
 func NewL1SequentialSync(blockPointsRetriever BlockPointsRetriever,
@@ -76,7 +100,55 @@ func NewL1SequentialSync(blockPointsRetriever BlockPointsRetriever,
 		reorgManager:         reorgManager,
 		blockChecker:         blockChecker,
 		cfg:                  cfg,
+		progress:             newSyncProgressTracker(cfg.GenesisBlockNumber, time.Now),
+		chunkSize:            newAdaptiveChunkSize(cfg.NetworkID, cfg.SyncChunkSize, cfg.MinSyncChunkSize, cfg.MaxSyncChunkSize),
+	}
+}
+
+// RequestStop makes SyncBlocks return after finishing the block range in progress
+func (s *L1SequentialSync) RequestStop() {
+	s.stopRequested.Store(true)
+}
+
+// SetPaused makes SyncBlocks return after finishing the block range in progress until is unpaused
+func (s *L1SequentialSync) SetPaused(paused bool) {
+	s.paused.Store(paused)
+}
+
+// SetMaxL1BlockToSync sets the last L1 block to synchronize, 0 means no limit
+func (s *L1SequentialSync) SetMaxL1BlockToSync(blockNumber uint64) {
+	s.maxL1BlockToSync.Store(blockNumber)
+}
+
+// SetTargetReachedChecker sets a function that is checked before each block range, if returns true
+// SyncBlocks returns as synced. nil removes it
+func (s *L1SequentialSync) SetTargetReachedChecker(checker TargetReachedCheckerFunc) {
+	if checker == nil {
+		s.targetReachedChecker.Store(nil)
+		return
 	}
+	s.targetReachedChecker.Store(&checker)
+}
+
+func (s *L1SequentialSync) getMaximumBlockToSync(blockPoints BlockPoints) uint64 {
+	maxL1BlockToSync := s.maxL1BlockToSync.Load()
+	if maxL1BlockToSync != 0 && maxL1BlockToSync < blockPoints.L1LastBlockToSync {
+		return maxL1BlockToSync
+	}
+	return blockPoints.L1LastBlockToSync
+}
+
+func (s *L1SequentialSync) isTargetReached(ctx context.Context) (bool, error) {
+	checker := s.targetReachedChecker.Load()
+	if checker == nil {
+		return false, nil
+	}
+	return (*checker)(ctx)
+}
+
+// GetProgress returns a snapshot of the progress of the synchronization
+func (s *L1SequentialSync) GetProgress() SyncProgress {
+	return s.progress.getProgress()
 }
 
 type BlockPointsRetrieverImplementation struct {
@@ -123,6 +195,10 @@ func (s *L1SequentialSync) SyncBlocks(ctx context.Context, lastEthBlockSynced *s
 	if err != nil {
 		return lastEthBlockSynced, false, err
 	}
+	s.progress.setBlockPoints(blockPoints)
+	if lastEthBlockSynced != nil {
+		s.progress.setLastBlockSynced(lastEthBlockSynced.BlockNumber)
+	}
 	if blockPoints.L1FinalizedBlockNumber > blockPoints.L1LastBlockToSync {
 		log.Warnf("Finalized block number %d is greater than last block to sync %d", blockPoints.L1FinalizedBlockNumber, blockPoints.L1LastBlockToSync)
 	}
@@ -134,35 +210,74 @@ func (s *L1SequentialSync) SyncBlocks(ctx context.Context, lastEthBlockSynced *s
 		// fromBlock contains the first block in the DB, therefore if we do not have any it is as if we had the one before genesis
 		fromBlock = s.cfg.GenesisBlockNumber - 1
 	}
-	blockRangeIterator := NewBlockRangeIterator(fromBlock, s.cfg.SyncChunkSize, blockPoints.L1LastBlockToSync)
+	maximumBlockToSync := s.getMaximumBlockToSync(blockPoints)
+	if s.isParallelFetchEnabled() && !lastEthBlockSynced.IsUnsafeAndHaveRollupdata() {
+		// The finalized blocks can't be reorged, so they are retrieved in parallel. The rest of the blocks
+		// are synced sequentially, starting from the last block processed
+		lastEthBlockSynced, fromBlock, err = s.syncFinalizedRangesInParallel(ctx, fromBlock,
+			min(maximumBlockToSync, blockPoints.L1FinalizedBlockNumber), blockPoints.L1FinalizedBlockNumber, lastEthBlockSynced)
+		if err != nil {
+			return lastEthBlockSynced, false, err
+		}
+		if fromBlock >= maximumBlockToSync {
+			log.Infof("Synced up to %d retrieving ranges in parallel", fromBlock)
+			return lastEthBlockSynced, true, nil
+		}
+	}
+	blockRangeIterator := NewBlockRangeIterator(fromBlock, s.chunkSize.get(), maximumBlockToSync)
 
 	for {
+		if s.stopRequested.Load() || s.paused.Load() {
+			log.Infof("Stop or pause requested, returning before syncing next range. lastEthBlockSynced: %s", lastEthBlockSynced.String())
+			return lastEthBlockSynced, false, nil
+		}
+		targetReached, err := s.isTargetReached(ctx)
+		if err != nil {
+			return lastEthBlockSynced, false, err
+		}
+		if targetReached {
+			log.Infof("Target reached, returning as synced. lastEthBlockSynced: %s", lastEthBlockSynced.String())
+			return lastEthBlockSynced, true, nil
+		}
 		log.Debugf("Check that old blocks haven't changed...")
-		err := s.checkReorgsOnPreviousL1Blocks(ctx)
+		err = s.checkReorgsOnPreviousL1Blocks(ctx)
 		if err != nil {
 			return lastEthBlockSynced, false, err
 		}
 		if blockRangeIterator == nil {
-			log.Debugf("Nothing to do starting from %d to %d. Skipping...", fromBlock, blockPoints.L1LastBlockToSync)
+			log.Debugf("Nothing to do starting from %d to %d. Skipping...", fromBlock, maximumBlockToSync)
 			return lastEthBlockSynced, true, nil
 		}
 		blockRange := blockRangeIterator.GetRange(lastEthBlockSynced.IsUnsafeAndHaveRollupdata())
 		log.Infof("Syncing %s Progress: %s", blockRangeIterator.String(), s.calculateProgress(blockRange, blockPoints))
 		synced := false
 		lastEthBlockSynced, synced, err = s.iteration(ctx, blockRange, blockPoints.L1FinalizedBlockNumber, lastEthBlockSynced)
+		if errors.Is(err, etherman.ErrFilterLogsRangeTooLarge) && blockRangeIterator.ShrinkRange(s.chunkSize.get()) {
+			log.Warnf("Range %s too large, retrying with a smaller one: %s", blockRange.String(), blockRangeIterator.String())
+			continue
+		}
 		if err != nil {
 			return lastEthBlockSynced, false, err
 		}
+		if lastEthBlockSynced != nil {
+			s.progress.setLastBlockSynced(lastEthBlockSynced.BlockNumber)
+		}
 		if synced {
 			return lastEthBlockSynced, true, nil
 		}
 		if blockRangeIterator.IsLastRange() {
 			break
 		}
+		blockRangeIterator.SyncChunkSize = s.chunkSize.get()
+		nextFromBlock := fromBlock
 		if lastEthBlockSynced != nil {
-			blockRangeIterator = blockRangeIterator.NextRange(lastEthBlockSynced.BlockNumber)
-		} else {
-			blockRangeIterator = blockRangeIterator.NextRange(fromBlock)
+			// After the parallel stage the last block with rollup info can be before fromBlock
+			nextFromBlock = max(lastEthBlockSynced.BlockNumber, fromBlock)
+		}
+		blockRangeIterator, err = blockRangeIterator.NextRange(nextFromBlock)
+		if err != nil {
+			log.Errorf("error getting the next range. Err: %v", err)
+			return lastEthBlockSynced, false, err
 		}
 	}
 	return lastEthBlockSynced, true, nil
@@ -218,6 +333,13 @@ func (s *L1SequentialSync) iteration(ctx context.Context, blockRange BlockRange,
 	if err != nil {
 		return lastEthBlockSynced, false, err
 	}
+	return s.processRetrievedData(ctx, blockRange, blocks, order, finalizedBlockNumber, lastEthBlockSynced)
+}
+
+// processRetrievedData checks reorgs and process the data returned by retrieveDataFromL1AndValidate
+func (s *L1SequentialSync) processRetrievedData(ctx context.Context, blockRange BlockRange, blocks []etherman.Block, order map[common.Hash][]etherman.Order,
+	finalizedBlockNumber uint64, lastEthBlockSynced *stateBlockType) (*stateBlockType, bool, error) {
+	var err error
 	blocks, initBlockReceived := s.extractInitialBlock(blockRange, blocks)
 	if lastEthBlockSynced != nil {
 		lastEthBlockSynced, err = s.checkReorgs(lastEthBlockSynced, initBlockReceived)
@@ -251,6 +373,15 @@ func (s *L1SequentialSync) iteration(ctx context.Context, blockRange BlockRange,
 	return lastEthBlockSynced, false, nil
 }
 
+// countLogs returns the number of logs received for the blocks
+func countLogs(blocks []etherman.Block) int {
+	res := 0
+	for _, block := range blocks {
+		res += block.NumLogs
+	}
+	return res
+}
+
 func logOrders(mapOrders map[common.Hash][]etherman.Order) {
 	for blockHash, orders := range mapOrders {
 		for i, order := range orders {
@@ -261,11 +392,14 @@ func logOrders(mapOrders map[common.Hash][]etherman.Order) {
 
 func (s *L1SequentialSync) retrieveDataFromL1AndValidate(ctx context.Context, blockRange BlockRange) ([]etherman.Block, map[common.Hash][]etherman.Order, error) {
 	toBlock := blockRange.ToBlock
+	start := time.Now()
 	blocks, order, err := s.etherMan.GetRollupInfoByBlockRange(ctx, blockRange.FromBlock, &toBlock)
 	if err != nil {
 		log.Errorf("error getting rollup info by block range.  Err: %v", err)
+		s.chunkSize.onError(err)
 		return nil, nil, err
 	}
+	s.chunkSize.onResponse(countLogs(blocks), time.Since(start))
 	logOrders(order)
 	if blockRange.OverlappedFirstBlock {
 		err = s.checkResponseGetRollupInfoByBlockRangeForOverlappedFirstBlock(blocks, blockRange.FromBlock)
@@ -325,28 +459,32 @@ func (s *L1SequentialSync) CreateBlockWithoutRollupInfoIfNeeded(ctx context.Cont
 	if !s.cfg.AllowEmptyBlocksAsCheckPoints {
 		return nil, nil
 	}
-	proposedBlockNumber := blockRange.FromBlock + s.cfg.SyncChunkSize
+	proposedBlockNumber := blockRange.FromBlock + s.chunkSize.get()
 
 	if !blockRange.InsideRange(proposedBlockNumber) {
 		return nil, nil
 	}
 	if entities.IsBlockFinalized(proposedBlockNumber, finalizedBlockNumber) {
-		// Create a block without rollup info
-		emptyBlock, err := s.etherMan.GetL1BlockByNumber(ctx, proposedBlockNumber)
-		if err != nil || emptyBlock == nil {
-			log.Warnf("error getting block %d from the blockchain. Error: %v", proposedBlockNumber, err)
-			return nil, err
-		}
-		err = s.blockRangeProcessor.ProcessBlockRange(ctx, []etherman.Block{*emptyBlock}, nil, finalizedBlockNumber)
-		if err != nil {
-			log.Warnf("error processing the block range. Err: %v", err)
-			return nil, err
-		}
-		return entities.NewL1BlockFromEthermanBlock(emptyBlock, true), nil
+		return s.createBlockWithoutRollupInfo(ctx, proposedBlockNumber, finalizedBlockNumber)
 	}
 	return nil, nil
 }
 
+// createBlockWithoutRollupInfo stores a finalized block without rollup info, so it can be used as checkpoint
+func (s *L1SequentialSync) createBlockWithoutRollupInfo(ctx context.Context, blockNumber uint64, finalizedBlockNumber uint64) (*stateBlockType, error) {
+	emptyBlock, err := s.etherMan.GetL1BlockByNumber(ctx, blockNumber)
+	if err != nil || emptyBlock == nil {
+		log.Warnf("error getting block %d from the blockchain. Error: %v", blockNumber, err)
+		return nil, err
+	}
+	err = s.blockRangeProcessor.ProcessBlockRange(ctx, []etherman.Block{*emptyBlock}, nil, finalizedBlockNumber)
+	if err != nil {
+		log.Warnf("error processing the block range. Err: %v", err)
+		return nil, err
+	}
+	return entities.NewL1BlockFromEthermanBlock(emptyBlock, true), nil
+}
+
 func removeBlockElement(slice []etherman.Block, s int) []etherman.Block {
 	ret := make([]etherman.Block, 0)
 	ret = append(ret, slice[:s]...)
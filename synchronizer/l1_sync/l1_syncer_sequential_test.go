@@ -67,6 +67,146 @@ func TestSyncBlocksSequentialNothingToDo(t *testing.T) {
 	require.Equal(t, resBlock, testData.lastEthBlock)
 }
 
+func TestSyncBlocksSequentialStopRequestedReturnsWithoutSyncing(t *testing.T) {
+	testData := newL1SyncData(t)
+	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
+		L1LastBlockToSync:      200,
+		L1FinalizedBlockNumber: 200,
+	}, nil)
+	testData.sut.RequestStop()
+	resBlock, synced, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	require.NoError(t, err)
+	require.False(t, synced)
+	require.Equal(t, testData.lastEthBlock, resBlock)
+}
+
+func TestSyncBlocksSequentialPausedReturnsWithoutSyncing(t *testing.T) {
+	testData := newL1SyncData(t)
+	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
+		L1LastBlockToSync:      200,
+		L1FinalizedBlockNumber: 200,
+	}, nil)
+	testData.sut.SetPaused(true)
+	resBlock, synced, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	require.NoError(t, err)
+	require.False(t, synced)
+	require.Equal(t, testData.lastEthBlock, resBlock)
+}
+
+func TestSyncBlocksSequentialMaxL1BlockToSyncReached(t *testing.T) {
+	testData := newL1SyncData(t)
+	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
+		L1LastBlockToSync:      200,
+		L1FinalizedBlockNumber: 200,
+	}, nil)
+	// lastEthBlock is 100, so the target is already surpassed
+	testData.sut.SetMaxL1BlockToSync(99)
+	resBlock, synced, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	require.NoError(t, err)
+	require.True(t, synced)
+	require.Equal(t, testData.lastEthBlock, resBlock)
+}
+
+func TestSyncBlocksSequentialMaxL1BlockToSyncLimitsRange(t *testing.T) {
+	testData := newL1SyncData(t)
+	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
+		L1LastBlockToSync:      300,
+		L1FinalizedBlockNumber: 300,
+	}, nil)
+	testData.sut.SetMaxL1BlockToSync(150)
+	toBlock := uint64(150)
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(testData.ctx, uint64(100), &toBlock).Return(nil, nil, nil)
+	_, _, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	// The response doesn't contain the first block, but the range requested is the bounded one
+	require.Error(t, err)
+}
+
+func TestSyncBlocksSequentialTargetReached(t *testing.T) {
+	testData := newL1SyncData(t)
+	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
+		L1LastBlockToSync:      200,
+		L1FinalizedBlockNumber: 200,
+	}, nil)
+	testData.sut.SetTargetReachedChecker(func(ctx context.Context) (bool, error) {
+		return true, nil
+	})
+	resBlock, synced, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	require.NoError(t, err)
+	require.True(t, synced)
+	require.Equal(t, testData.lastEthBlock, resBlock)
+}
+
+func TestSyncBlocksSequentialTargetCheckerError(t *testing.T) {
+	testData := newL1SyncData(t)
+	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
+		L1LastBlockToSync:      200,
+		L1FinalizedBlockNumber: 200,
+	}, nil)
+	testData.sut.SetTargetReachedChecker(func(ctx context.Context) (bool, error) {
+		return false, fmt.Errorf("error")
+	})
+	_, synced, err := testData.sut.SyncBlocks(testData.ctx, testData.lastEthBlock)
+	require.Error(t, err)
+	require.False(t, synced)
+}
+
+// The L1 node rejects the range, so it's retried with a smaller one
+func TestSyncBlocksSequentialRangeTooLargeIsRetried(t *testing.T) {
+	testData := newL1SyncDataWithConfig(t, l1sync.L1SequentialSyncConfig{
+		SyncChunkSize:      100,
+		MinSyncChunkSize:   25,
+		GenesisBlockNumber: 123,
+	})
+	lastEthBlock := &entities.L1Block{BlockNumber: 100, Checked: true}
+	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
+		L1LastBlockToSync:      200,
+		L1FinalizedBlockNumber: 200,
+	}, nil)
+	errRange := fmt.Errorf("%w: query returned more than 10000 results", etherman.ErrFilterLogsRangeTooLarge)
+	toBlock := uint64(200)
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(testData.ctx, uint64(101), &toBlock).Return(nil, nil, errRange).Once()
+	toBlockRetry := uint64(150)
+	blocks := []etherman.Block{{BlockNumber: 140}}
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(testData.ctx, uint64(101), &toBlockRetry).Return(blocks, nil, nil).Once()
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, blocks, map[common.Hash][]etherman.Order(nil), uint64(200)).Return(nil).Once()
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(testData.ctx, uint64(141), &toBlock).Return(nil, nil, nil).Once()
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, []etherman.Block(nil), map[common.Hash][]etherman.Order(nil), uint64(200)).Return(nil).Once()
+
+	resBlock, synced, err := testData.sut.SyncBlocks(testData.ctx, lastEthBlock)
+	require.NoError(t, err)
+	require.True(t, synced)
+	require.Equal(t, uint64(140), resBlock.BlockNumber)
+}
+
+// The range doesn't grow if the response have a lot of logs, although they don't produce orders
+func TestSyncBlocksSequentialManyLogsDoesntGrowRange(t *testing.T) {
+	testData := newL1SyncDataWithConfig(t, l1sync.L1SequentialSyncConfig{
+		SyncChunkSize:      50,
+		MaxSyncChunkSize:   200,
+		GenesisBlockNumber: 123,
+	})
+	lastEthBlock := &entities.L1Block{BlockNumber: 100, Checked: true}
+	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
+		L1LastBlockToSync:      300,
+		L1FinalizedBlockNumber: 300,
+	}, nil)
+	toBlock := uint64(150)
+	blocks := []etherman.Block{{BlockNumber: 140, NumLogs: 5000}}
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(testData.ctx, uint64(101), &toBlock).Return(blocks, nil, nil).Once()
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, blocks, map[common.Hash][]etherman.Order(nil), uint64(300)).Return(nil).Once()
+	// It would be 141-250 if the range had grown
+	toBlockNext := uint64(200)
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(testData.ctx, uint64(141), &toBlockNext).Return(nil, nil, nil).Once()
+	// An empty response makes it grow
+	toBlockGrown := uint64(300)
+	testData.mockEth.EXPECT().GetRollupInfoByBlockRange(testData.ctx, uint64(141), &toBlockGrown).Return(nil, nil, nil).Once()
+	testData.mockBlockProcessor.EXPECT().ProcessBlockRange(testData.ctx, []etherman.Block(nil), map[common.Hash][]etherman.Order(nil), uint64(300)).Return(nil).Twice()
+
+	_, synced, err := testData.sut.SyncBlocks(testData.ctx, lastEthBlock)
+	require.NoError(t, err)
+	require.True(t, synced)
+}
+
 func TestSyncBlocksSequentialReorgMissingFirstBlockOnRollupResponse(t *testing.T) {
 	testData := newL1SyncData(t)
 	testData.mockBlockRetriever.EXPECT().GetL1BlockPoints(testData.ctx).Return(l1sync.BlockPoints{
@@ -121,15 +261,18 @@ type testL1SyncData struct {
 }
 
 func newL1SyncData(t *testing.T) *testL1SyncData {
+	return newL1SyncDataWithConfig(t, l1sync.L1SequentialSyncConfig{
+		SyncChunkSize:      100,
+		GenesisBlockNumber: 123,
+	})
+}
+
+func newL1SyncDataWithConfig(t *testing.T, cfg l1sync.L1SequentialSyncConfig) *testL1SyncData {
 	mockBlock := mock_l1sync.NewBlockPointsRetriever(t)
 	mockEth := mock_l1sync.NewEthermanInterface(t)
 	mockState := mock_l1sync.NewStateL1SeqInterface(t)
 	mockBlockProcessor := mock_l1sync.NewBlockRangeProcessor(t)
 	mockReorg := mock_l1sync.NewReorgManager(t)
-	cfg := l1sync.L1SequentialSyncConfig{
-		SyncChunkSize:      100,
-		GenesisBlockNumber: 123,
-	}
 	sut := l1sync.NewL1SequentialSync(mockBlock, mockEth, mockState, mockBlockProcessor, mockReorg, nil, cfg)
 	ctx := context.TODO()
 	lastEthBlock := &entities.L1Block{
@@ -0,0 +1,91 @@
+package l1sync
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SyncProgress is a snapshot of the progress of the L1 synchronization
+type SyncProgress struct {
+	// BlockPoints used on the last call to SyncBlocks
+	BlockPoints BlockPoints
+	// LastBlockSynced is the last L1 block processed
+	LastBlockSynced uint64
+	// PercentCompleted of the blocks from genesis to BlockPoints.L1LastBlockToSync
+	PercentCompleted float64
+	// BlocksPerSecond is the throughput since the synchronization started
+	BlocksPerSecond float64
+	// EstimatedTimeToSync is the ETA to reach BlockPoints.L1LastBlockToSync (0 if unknown or synced)
+	EstimatedTimeToSync time.Duration
+}
+
+func (p SyncProgress) String() string {
+	return fmt.Sprintf("Percent: %3.1f LastBlockSynced: %d LastBlockToSync: %d Blocks/s: %.2f ETA: %s",
+		p.PercentCompleted, p.LastBlockSynced, p.BlockPoints.L1LastBlockToSync, p.BlocksPerSecond, p.EstimatedTimeToSync.String())
+}
+
+type timeProvider func() time.Time
+
+// syncProgressTracker computes the progress and throughput of the synchronization
+type syncProgressTracker struct {
+	mutex              sync.RWMutex
+	now                timeProvider
+	genesisBlockNumber uint64
+	blockPoints        BlockPoints
+	startTime          time.Time
+	startBlock         uint64
+	started            bool
+	lastTime           time.Time
+	lastBlockSynced    uint64
+}
+
+func newSyncProgressTracker(genesisBlockNumber uint64, now timeProvider) *syncProgressTracker {
+	return &syncProgressTracker{
+		genesisBlockNumber: genesisBlockNumber,
+		now:                now,
+	}
+}
+
+func (t *syncProgressTracker) setBlockPoints(blockPoints BlockPoints) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.blockPoints = blockPoints
+}
+
+func (t *syncProgressTracker) setLastBlockSynced(blockNumber uint64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	now := t.now()
+	if !t.started || blockNumber < t.lastBlockSynced {
+		// First measure or a reorg have moved back the last block, so restart the throughput
+		t.started = true
+		t.startTime = now
+		t.startBlock = blockNumber
+	}
+	t.lastTime = now
+	t.lastBlockSynced = blockNumber
+}
+
+func (t *syncProgressTracker) getProgress() SyncProgress {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	res := SyncProgress{
+		BlockPoints:     t.blockPoints,
+		LastBlockSynced: t.lastBlockSynced,
+	}
+	if t.blockPoints.L1LastBlockToSync > t.genesisBlockNumber && t.lastBlockSynced >= t.genesisBlockNumber {
+		totalBlocksToProcess := t.blockPoints.L1LastBlockToSync - t.genesisBlockNumber
+		blocksProcessed := t.lastBlockSynced - t.genesisBlockNumber
+		res.PercentCompleted = min(float64(blocksProcessed*100)/float64(totalBlocksToProcess), 100) //nolint:gomnd
+	}
+	elapsed := t.lastTime.Sub(t.startTime)
+	if t.started && elapsed > 0 {
+		res.BlocksPerSecond = float64(t.lastBlockSynced-t.startBlock) / elapsed.Seconds()
+	}
+	if res.BlocksPerSecond > 0 && t.blockPoints.L1LastBlockToSync > t.lastBlockSynced {
+		pendingBlocks := float64(t.blockPoints.L1LastBlockToSync - t.lastBlockSynced)
+		res.EstimatedTimeToSync = time.Duration(pendingBlocks / res.BlocksPerSecond * float64(time.Second))
+	}
+	return res
+}
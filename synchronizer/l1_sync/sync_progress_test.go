@@ -0,0 +1,37 @@
+package l1sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncProgressTrackerThroughputAndETA(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sut := newSyncProgressTracker(100, func() time.Time { return now })
+	sut.setBlockPoints(BlockPoints{L1LastBlockToSync: 300, L1FinalizedBlockNumber: 250})
+	sut.setLastBlockSynced(100)
+	now = now.Add(10 * time.Second)
+	sut.setLastBlockSynced(200)
+
+	progress := sut.getProgress()
+	require.Equal(t, uint64(200), progress.LastBlockSynced)
+	require.Equal(t, 50.0, progress.PercentCompleted)
+	require.Equal(t, 10.0, progress.BlocksPerSecond)
+	require.Equal(t, 10*time.Second, progress.EstimatedTimeToSync)
+}
+
+func TestSyncProgressTrackerRestartsThroughputAfterGoingBack(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sut := newSyncProgressTracker(100, func() time.Time { return now })
+	sut.setBlockPoints(BlockPoints{L1LastBlockToSync: 300})
+	sut.setLastBlockSynced(200)
+	now = now.Add(10 * time.Second)
+	// A reorg moves back the last block synced
+	sut.setLastBlockSynced(150)
+
+	progress := sut.getProgress()
+	require.Equal(t, 0.0, progress.BlocksPerSecond)
+	require.Equal(t, time.Duration(0), progress.EstimatedTimeToSync)
+}
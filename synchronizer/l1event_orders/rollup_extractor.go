@@ -0,0 +1,34 @@
+package l1event_orders
+
+import (
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+)
+
+// GetRollupIDFromL1EventOrder returns the rollupID affected by the given event
+// There are event that are not related to a rollup (e.g. L1InfoTree), in that case it returns false
+func GetRollupIDFromL1EventOrder(event etherman.EventOrder, l1Block *etherman.Block, position int) (uint64, bool) {
+	switch event {
+	case etherman.SequenceBatchesOrder, etherman.InitialSequenceBatchesOrder:
+		if len(l1Block.SequencedBatches[position]) == 0 {
+			return 0, false
+		}
+		return uint64(l1Block.SequencedBatches[position][0].RollupID), true
+	case etherman.UpdateEtrogSequenceOrder:
+		return uint64(l1Block.UpdateEtrogSequence.RollupID), true
+	case etherman.ForkIDsOrder:
+		return uint64(l1Block.ForkIDs[position].RollupID), true
+	case etherman.ForcedBatchesOrder:
+		return uint64(l1Block.ForcedBatches[position].RollupID), true
+	case etherman.SequenceForceBatchesOrder:
+		if len(l1Block.SequencedForceBatches[position]) == 0 {
+			return 0, false
+		}
+		return uint64(l1Block.SequencedForceBatches[position][0].RollupID), true
+	case etherman.TrustedVerifyBatchOrder, etherman.VerifyBatchOrder, etherman.VerifyBatchPreEtrogOrder:
+		return uint64(l1Block.VerifiedBatches[position].RollupID), true
+	case etherman.GovernanceEventsOrder:
+		rollupID := l1Block.GovernanceEvents[position].RollupID
+		return uint64(rollupID), rollupID != etherman.GovernanceRollupManagerID
+	}
+	return 0, false
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Prefix for the metrics of the synchronizer package.
+	Prefix = "synchronizer_"
+
+	// SyncChunkSizeName is the name of the gauge with the current number of L1 blocks requested on each range.
+	SyncChunkSizeName = Prefix + "sync_chunk_size"
+
+	// NetworkIDLabelName is the name of the label with the networkID of the synchronizer.
+	NetworkIDLabelName = "networkID"
+)
+
+// Register the metrics for the synchronizer package.
+func Register() {
+	gaugeVecs := []metrics.GaugeVecOpts{
+		{
+			GaugeOpts: prometheus.GaugeOpts{
+				Name: SyncChunkSizeName,
+				Help: "[SYNCHRONIZER] current number of L1 blocks requested on each range",
+			},
+			Labels: []string{NetworkIDLabelName},
+		},
+	}
+
+	metrics.RegisterGaugeVecs(gaugeVecs...)
+}
+
+// SyncChunkSize sets the current number of L1 blocks requested on each range by the synchronizer of networkID.
+func SyncChunkSize(networkID uint, size uint64) {
+	metrics.GaugeVecSet(SyncChunkSizeName, strconv.FormatUint(uint64(networkID), 10), float64(size))
+}
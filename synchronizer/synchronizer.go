@@ -9,6 +9,8 @@ import (
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
 	internal "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/internal"
 	"github.com/ethereum/go-ethereum/common"
@@ -17,6 +19,8 @@ import (
 var (
 	// ErrNotFound is used when the object is not found
 	ErrNotFound = errors.New("not found")
+	// ErrReorgAborted is returned when a PreReorgHook aborts a reorg
+	ErrReorgAborted = internal.ErrReorgAborted
 )
 
 type L1InfoTreeLeaf struct {
@@ -35,13 +39,65 @@ type SynchronizerRunner interface {
 	// If returnOnSync is true, it will return when the synchronizer is synced,
 	//  otherwise it will keep running
 	Sync(returnOnSync bool) error
-	// Stop stops the synchronizer
+	// Stop stops the synchronizer, the iteration in progress is aborted
 	Stop()
+	// Shutdown waits for the current iteration and the reorg callbacks to finish (or ctx to be done),
+	// then stops the synchronizer and closes the DB and L1 connections. After that the
+	// synchronizer can't be used. It returns the error that Sync finished with
+	Shutdown(ctx context.Context) error
+	// SyncWithOptions is like Sync but allows to bound the synchronization. It's a blocking call
+	SyncWithOptions(options SyncOptions) error
+	// Pause stops the ingestion after the block range in progress, without stopping the synchronizer
+	Pause()
+	// Resume continues the synchronization paused by Pause
+	Resume()
+	// IsPaused returns true if the synchronizer is paused
+	IsPaused() bool
+}
+
+// SyncOptions are the execution options for SyncWithOptions
+type SyncOptions struct {
+	// ReturnOnSync returns when the synchronizer is synced (or the target is reached)
+	ReturnOnSync bool
+	// SingleIteration returns after one iteration of synchronization, returning its error
+	SingleIteration bool
+	// TargetL1BlockNumber is the last L1 block to synchronize (0 means no limit)
+	TargetL1BlockNumber uint64
+	// TargetRollupID is the rollup of TargetBatchNumber (0 means the configured rollup)
+	TargetRollupID uint64
+	// TargetBatchNumber is a virtual batch, once is synchronized the synchronizer is synced (0 means no limit)
+	TargetBatchNumber uint64
+}
+
+// SyncStatus is a snapshot of the status of the synchronization
+type SyncStatus struct {
+	Synced bool
+	// LastL1BlockSynced is the last L1 block stored on DB
+	LastL1BlockSynced uint64
+	// L1LastBlockToSync is the target block of the last iteration (depends on SyncUpToBlock)
+	L1LastBlockToSync uint64
+	// L1FinalizedBlockNumber is the finalized block of the last iteration (depends on BlockFinality)
+	L1FinalizedBlockNumber uint64
+	// UncheckedBlocks is the number of blocks stored that are not finalized yet
+	UncheckedBlocks uint64
+	// LastVirtualBatchNumbers is the last virtual batch per rollupID
+	LastVirtualBatchNumbers map[uint64]uint64
+	// LastL1InfoTreeIndex is nil if there are no leaves yet
+	LastL1InfoTreeIndex *uint32
+	PercentCompleted    float64
+	BlocksPerSecond     float64
+	// EstimatedTimeToSync is the ETA to reach L1LastBlockToSync (0 if unknown or synced)
+	EstimatedTimeToSync time.Duration
+	// LastError is the last error produced during the synchronization and when it happened
+	LastError     error
+	LastErrorTime time.Time
 }
 
 type SynchornizerStatusQuerier interface {
 	// IsSynced returns true if the synchronizer is synced or false if it's not
 	IsSynced() bool
+	// GetSyncStatus returns a snapshot of the status of the synchronization
+	GetSyncStatus(ctx context.Context) (*SyncStatus, error)
 }
 
 type SynchronizerL1InfoTreeQuerier interface {
@@ -68,6 +124,7 @@ type SynchronizerBlockQuerier interface {
 }
 
 type SequencedBatches struct {
+	RollupID        uint64
 	FromBatchNumber uint64
 	ToBatchNumber   uint64
 	L1BlockNumber   uint64
@@ -78,10 +135,12 @@ type SequencedBatches struct {
 	Source          string
 }
 type SynchronizerSequencedBatchesQuerier interface {
-	GetSequenceByBatchNumber(ctx context.Context, batchNumber uint64) (*SequencedBatches, error)
+	// GetSequenceByBatchNumber returns the sequence of the rollup that contains the batch
+	GetSequenceByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64) (*SequencedBatches, error)
 }
 
 type VirtualBatch struct {
+	RollupID                uint64
 	BatchNumber             uint64
 	ForkID                  uint64
 	BatchL2Data             []byte
@@ -94,24 +153,337 @@ type VirtualBatch struct {
 	ReceivedAt              time.Time
 	BatchTimestamp          *time.Time // This is optional depend on ForkID
 	ExtraInfo               *string
+	GlobalExitRoot          *common.Hash // Only for forkIDs previous to Etrog, the next ones use L1InfoRoot
 }
 
 type SynchronizerVirtualBatchesQuerier interface {
-	GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber uint64) (*VirtualBatch, error)
-	GetLastestVirtualBatchNumber(ctx context.Context) (uint64, error)
+	// GetVirtualBatchByBatchNumber returns the virtual batch of the rollup
+	GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64) (*VirtualBatch, error)
+	// GetLastestVirtualBatchNumber returns the last virtual batch number of the rollup
+	GetLastestVirtualBatchNumber(ctx context.Context, rollupID uint64) (uint64, error)
+}
+
+// VerifiedBatch is a verification of batches on L1, all the batches of the rollup up to BatchNumber are verified
+type VerifiedBatch struct {
+	RollupID    uint64
+	BatchNumber uint64 // Last batch verified
+	BlockNumber uint64 // Linked to sync.block table
+	StateRoot   common.Hash
+	Aggregator  common.Address
+	TxHash      common.Hash // Hash of tx inside L1Block that emit this log
+	IsTrusted   bool        // Verified by the trusted aggregator
+	ReceivedAt  time.Time
+}
+
+type SynchronizerVerifiedBatchesQuerier interface {
+	// GetLastVerifiedBatch returns the last verification of the rollup, nil if there are no verifications yet
+	GetLastVerifiedBatch(ctx context.Context, rollupID uint64) (*VerifiedBatch, error)
+	// GetVerifiedBatchByNumber returns the verification that includes batchNumber, nil if it's not verified yet.
+	// The batches are verified in ranges, so the StateRoot belongs to the BatchNumber of the result that can be greater than batchNumber
+	GetVerifiedBatchByNumber(ctx context.Context, rollupID uint64, batchNumber uint64) (*VerifiedBatch, error)
+	// IsBatchVerified returns true if the batch of the rollup is verified on L1
+	IsBatchVerified(ctx context.Context, rollupID uint64, batchNumber uint64) (bool, error)
+}
+
+// ForcedBatch is a batch forced by a user on L1
+type ForcedBatch struct {
+	RollupID          uint64
+	ForcedBatchNumber uint64
+	BlockNumber       uint64 // Linked to sync.block table
+	GlobalExitRoot    common.Hash
+	RawTxsData        []byte
+	ForcerAddr        common.Address
+	ForcedAt          time.Time
+	// SequencedBatchNumber is the batch that have sequenced this forced batch, nil if it's pending
+	SequencedBatchNumber *uint64
+}
+
+type SynchronizerForcedBatchesQuerier interface {
+	// GetForcedBatch returns the forced batch of the rollup, nil if it's not found
+	GetForcedBatch(ctx context.Context, rollupID uint64, forcedBatchNumber uint64) (*ForcedBatch, error)
+	// GetPendingForcedBatches returns the forced batches of the rollup that have not been sequenced yet, oldest first
+	GetPendingForcedBatches(ctx context.Context, rollupID uint64) ([]ForcedBatch, error)
+}
+
+// GlobalExitRoot is an update of the GlobalExitRoot on L1 previous to LxLy, after LxLy the updates are leaves of L1InfoTree
+type GlobalExitRoot struct {
+	BlockNumber     uint64 // Linked to sync.block table
+	GlobalExitRoot  common.Hash
+	MainnetExitRoot common.Hash
+	RollupExitRoot  common.Hash
+	Timestamp       time.Time // Timestamp of the L1 block
+}
+
+type SynchronizerGlobalExitRootsQuerier interface {
+	// GetLatestGlobalExitRoot returns the last update of GlobalExitRoot previous to LxLy, nil if there are no updates
+	GetLatestGlobalExitRoot(ctx context.Context) (*GlobalExitRoot, error)
+	// GetGlobalExitRoot returns the update to globalExitRoot previous to LxLy, nil if it's not found
+	GetGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash) (*GlobalExitRoot, error)
+}
+
+// GovernanceParameter is a parameter of a rollup or of the RollupManager changed on L1 by a governance event
+type GovernanceParameter = etherman.GovernanceParameter
+
+const (
+	GovernanceTrustedSequencer         = etherman.GovernanceTrustedSequencer
+	GovernanceTrustedSequencerURL      = etherman.GovernanceTrustedSequencerURL
+	GovernanceForceBatchAddress        = etherman.GovernanceForceBatchAddress
+	GovernanceForceBatchTimeout        = etherman.GovernanceForceBatchTimeout
+	GovernancePendingAdmin             = etherman.GovernancePendingAdmin
+	GovernanceAdmin                    = etherman.GovernanceAdmin
+	GovernanceEmergencyState           = etherman.GovernanceEmergencyState
+	GovernanceBatchFee                 = etherman.GovernanceBatchFee
+	GovernanceMultiplierBatchFee       = etherman.GovernanceMultiplierBatchFee
+	GovernanceVerifyBatchTimeTarget    = etherman.GovernanceVerifyBatchTimeTarget
+	GovernanceTrustedAggregator        = etherman.GovernanceTrustedAggregator
+	GovernanceTrustedAggregatorTimeout = etherman.GovernanceTrustedAggregatorTimeout
+	GovernancePendingStateTimeout      = etherman.GovernancePendingStateTimeout
+	// GovernanceRollupManagerID is the rollupID to query the parameters of the RollupManager
+	GovernanceRollupManagerID = uint64(etherman.GovernanceRollupManagerID)
+)
+
+// GovernanceEvent is a change on L1 of a parameter of a rollup or of the RollupManager
+type GovernanceEvent struct {
+	RollupID    uint64 // GovernanceRollupManagerID for the parameters of the RollupManager
+	BlockNumber uint64 // Linked to sync.block table
+	TxHash      common.Hash
+	Contract    common.Address // Contract that emits the event
+	Parameter   GovernanceParameter
+	Value       string // New value: addresses in hex, numbers in decimal and true/false for the emergency state
+	ReceivedAt  time.Time
+}
+
+type SynchronizerGovernanceQuerier interface {
+	// GetGovernanceValueAtBlock returns the change that set the value of the parameter as of blockNumber, nil if it have not been changed yet
+	GetGovernanceValueAtBlock(ctx context.Context, rollupID uint64, parameter GovernanceParameter, blockNumber uint64) (*GovernanceEvent, error)
+	// GetGovernanceHistory returns all the changes of the parameter, oldest first
+	GetGovernanceHistory(ctx context.Context, rollupID uint64, parameter GovernanceParameter) ([]GovernanceEvent, error)
+	// GetTrustedSequencerURLHistory returns all the changes of the TrustedSequencerURL of the rollup, oldest first
+	GetTrustedSequencerURLHistory(ctx context.Context, rollupID uint64) ([]GovernanceEvent, error)
+	// IsEmergencyState returns true if the RollupManager is in emergency state on the last L1 block synchronized
+	IsEmergencyState(ctx context.Context) (bool, error)
+}
+
+// RollupType is a type of rollup registered on the RollupManager
+type RollupType struct {
+	RollupTypeID            uint64
+	BlockNumber             uint64 // Linked to sync.block table
+	TxHash                  common.Hash
+	ConsensusImplementation common.Address
+	Verifier                common.Address
+	ForkID                  uint64
+	RollupCompatibilityID   uint64
+	Genesis                 common.Hash
+	Description             string
+	// ObsoleteBlockNumber is the block that have marked the type as obsolete, nil if it's not obsolete
+	ObsoleteBlockNumber *uint64
+	ReceivedAt          time.Time
+}
+
+// IsObsolete returns true if no new rollups can be created with this type
+func (r *RollupType) IsObsolete() bool {
+	return r.ObsoleteBlockNumber != nil
+}
+
+// Rollup is a rollup created or added on the RollupManager
+type Rollup struct {
+	RollupID        uint64
+	BlockNumber     uint64 // Block of the creation, linked to sync.block table
+	TxHash          common.Hash
+	RollupAddress   common.Address
+	ChainID         uint64
+	GasTokenAddress common.Address
+	// RollupTypeID and ForkID are the current type of the rollup.
+	// RollupTypeID is 0 if the rollup have been added with AddExistingRollup and not updated since
+	RollupTypeID uint64
+	ForkID       uint64
+	ReceivedAt   time.Time
+}
+
+// SynchronizerRollupRegistryQuerier is an interface to query the rollup types and rollups of the RollupManager.
+// The ones registered before the genesis block are not available
+type SynchronizerRollupRegistryQuerier interface {
+	// GetRollupType returns the rollup type, nil if it's not found
+	GetRollupType(ctx context.Context, rollupTypeID uint64) (*RollupType, error)
+	// GetRollupTypes returns all the rollup types ordered by rollupTypeID
+	GetRollupTypes(ctx context.Context) ([]RollupType, error)
+	// GetRollup returns the rollup with its current type, nil if it's not found
+	GetRollup(ctx context.Context, rollupID uint64) (*Rollup, error)
+	// GetRollups returns all the rollups with its current type ordered by rollupID
+	GetRollups(ctx context.Context) ([]Rollup, error)
+}
+
+// AuditEventType is the kind of change of the proxy, the roles or the owner of a contract
+type AuditEventType = etherman.AuditEventType
+
+const (
+	AuditAdminChanged         = etherman.AuditAdminChanged
+	AuditUpgraded             = etherman.AuditUpgraded
+	AuditBeaconUpgraded       = etherman.AuditBeaconUpgraded
+	AuditRoleGranted          = etherman.AuditRoleGranted
+	AuditRoleRevoked          = etherman.AuditRoleRevoked
+	AuditRoleAdminChanged     = etherman.AuditRoleAdminChanged
+	AuditOwnershipTransferred = etherman.AuditOwnershipTransferred
+)
+
+// AuditEvent is a change on L1 of the proxy, the roles or the owner of a contract
+type AuditEvent struct {
+	BlockNumber       uint64 // Linked to sync.block table
+	TxHash            common.Hash
+	Contract          common.Address // Contract that emits the event
+	EventType         AuditEventType
+	Implementation    common.Address // Upgraded and BeaconUpgraded
+	Role              common.Hash    // RoleGranted, RoleRevoked and RoleAdminChanged
+	PreviousAdminRole common.Hash    // RoleAdminChanged
+	AdminRole         common.Hash    // RoleAdminChanged
+	PreviousAccount   common.Address // Previous admin (AdminChanged) or owner (OwnershipTransferred)
+	Account           common.Address // Account of the role, new admin or new owner
+	Sender            common.Address // RoleGranted and RoleRevoked
+	ReceivedAt        time.Time
+}
+
+type SynchronizerAuditQuerier interface {
+	// GetAuditTrail returns the changes of the proxies, roles and owners of all the contracts from fromBlockNumber, oldest first
+	GetAuditTrail(ctx context.Context, fromBlockNumber uint64) ([]AuditEvent, error)
+	// GetContractAuditTrail returns the changes of the proxy, roles and owner of the contract from fromBlockNumber, oldest first
+	GetContractAuditTrail(ctx context.Context, contract common.Address, fromBlockNumber uint64) ([]AuditEvent, error)
+}
+
+// PendingState is a verification of batches by a non trusted aggregator, it's not consolidated
+// until the pending state timeout is reached or the trusted aggregator verifies the batches
+type PendingState struct {
+	RollupID    uint64
+	BatchNumber uint64 // Last batch verified
+	BlockNumber uint64 // Block of the verification, linked to sync.block table
+	TxHash      common.Hash
+	StateRoot   common.Hash
+	ExitRoot    common.Hash // Not set previous to LxLy
+	Aggregator  common.Address
+	// ConsolidatedBlockNumber is the block that have consolidated the pending state, nil if it's not consolidated
+	ConsolidatedBlockNumber *uint64
+	// OverriddenBlockNumber is the block of the OverridePendingState that have discarded the pending state, nil if it's not overridden
+	OverriddenBlockNumber *uint64
+	// OverrideStateRoot is the StateRoot proved by the trusted aggregator, it's only set for the overridden batch
+	OverrideStateRoot *common.Hash
+	// NonDeterministicBlockNumber is the block that have proved that the StateRoot is non deterministic, nil if it's not proved
+	NonDeterministicBlockNumber *uint64
+	// ProvedStateRoot is the StateRoot proved for the same batch, set with NonDeterministicBlockNumber
+	ProvedStateRoot *common.Hash
+	ReceivedAt      time.Time
+}
+
+// VerificationFrontier is the last batch of a rollup with a consolidated verification and the pending states after it
+type VerificationFrontier struct {
+	RollupID                uint64
+	ConsolidatedBatchNumber uint64
+	// PendingBatchNumber is the last batch verified, it's equal to ConsolidatedBatchNumber if there are no pending states
+	PendingBatchNumber uint64
+	PendingStates      []PendingState // Ordered by BatchNumber
+}
+
+type SynchronizerPendingStateQuerier interface {
+	// GetVerificationFrontier returns the last consolidated batch of the rollup and the pending states after it
+	GetVerificationFrontier(ctx context.Context, rollupID uint64) (*VerificationFrontier, error)
+	// GetPendingState returns the pending state of the verification of batchNumber, nil if it's not found or
+	// it have been verified by the trusted aggregator
+	GetPendingState(ctx context.Context, rollupID uint64, batchNumber uint64) (*PendingState, error)
+}
+
+// SynchronizerRollupsQuerier is an interface to know which rollups are synchronized
+type SynchronizerRollupsQuerier interface {
+	// GetRollupIDs returns the rollupIDs synchronized, the first one is the main rollup (ZkEVMAddr)
+	GetRollupIDs() []uint64
+}
+
+// BatchNumberRange is a range of batches of a rollup, both included
+type BatchNumberRange struct {
+	RollupID        uint64
+	FromBatchNumber uint64
+	ToBatchNumber   uint64
+}
+
+// L1InfoTreeIndexRange is a range of L1InfoTree leaves, both included
+type L1InfoTreeIndexRange struct {
+	FromIndex uint32
+	ToIndex   uint32
 }
 
 type ReorgExecutionResult struct {
 	// FirstL1BlockNumberValidAfterReorg is the first block or nil if the reorg have delete all blocks
 	FirstL1BlockNumberValidAfterReorg *uint64
 	ReasonError                       error
+	// FirstL1BlockNumberDeleted is the first block stored that is deleted, OldBlockHash is its hash on DB and
+	// NewBlockHash its hash on L1 after the reorg (zero if it can't be retrieved)
+	FirstL1BlockNumberDeleted uint64
+	OldBlockHash              common.Hash
+	NewBlockHash              common.Hash
+	// DeletedVirtualBatches are the ranges of virtual batches deleted per rollup
+	DeletedVirtualBatches []BatchNumberRange
+	// DeletedL1InfoTreeLeaves is the range of L1InfoTree leaves deleted, nil if there are no leaves deleted
+	DeletedL1InfoTreeLeaves *L1InfoTreeIndexRange
 }
 
+// PreReorgHook is called before executing a reorg with the data that is going to be deleted.
+// If it returns an error the reorg is not executed and it's retried on the next iteration
+type PreReorgHook func(ctx context.Context, reorgData ReorgExecutionResult) error
+
+// ReorgLogEntry is a reorg executed and stored on DB
+type ReorgLogEntry struct {
+	ReorgID   uint64
+	Timestamp time.Time
+	// FirstL1BlockNumberKept is the last block kept, the following ones were deleted
+	FirstL1BlockNumberKept  uint64
+	Reason                  string
+	DeletedBlocks           uint64
+	DeletedVirtualBatches   uint64
+	DeletedL1InfoTreeLeaves uint64
+	Duration                time.Duration
+	SyncVersion             string // Version of the synchronizer that executed the reorg
+}
+
+// ReorgImpact is the data deleted by a reorg
+type ReorgImpact struct {
+	// FirstL1BlockNumberToKeep is the last block kept, the following ones are deleted
+	FirstL1BlockNumberToKeep uint64
+	// LastL1BlockNumber is the highest block deleted, 0 if there are no blocks to delete
+	LastL1BlockNumber uint64
+	Blocks            uint64
+	// CheckedBlocks are the blocks deleted that were already finalized, a reorg that deletes them is rejected
+	CheckedBlocks    uint64
+	SequencedBatches uint64
+	VirtualBatches   uint64
+	L1InfoTreeLeaves uint64
+	// FirstDeletedBlockNumber and FirstDeletedBlockHash are the first block deleted, zero if there are no blocks to delete
+	FirstDeletedBlockNumber uint64
+	FirstDeletedBlockHash   common.Hash
+	// VirtualBatchRanges are the virtual batches deleted per rollup
+	VirtualBatchRanges []BatchNumberRange
+	// L1InfoTreeIndexRange are the L1InfoTree leaves deleted, nil if there are no leaves to delete
+	L1InfoTreeIndexRange *L1InfoTreeIndexRange
+}
+
+// ReorgRejectedError is the critical error raised when a reorg exceeds MaxReorgDepth or deletes
+// checked blocks. Nothing is deleted and the field Impact is the preview of the reorg
+type ReorgRejectedError = model.ReorgRejectedError
+
 // SynchronizerReorgSupporter is an interface that give support to the reorgs detected on L1
 type SynchronizerReorgSupporter interface {
 	// SetCallbackOnReorgDone sets a callback that will be called when the reorg is done
 	// to disable it you can set nil
 	SetCallbackOnReorgDone(callback func(reorgData ReorgExecutionResult))
+	// AddCallbackOnReorgDone adds a callback called after each reorg is committed, without replacing the
+	// previous ones. It's called synchronously by the synchronizer so it must not block
+	AddCallbackOnReorgDone(callback func(reorgData ReorgExecutionResult)) SubscriptionID
+	// RemoveCallbackOnReorgDone removes a callback added by AddCallbackOnReorgDone
+	RemoveCallbackOnReorgDone(id SubscriptionID)
+	// AddPreReorgHook adds a hook called before executing each reorg, it can abort the reorg returning an error
+	AddPreReorgHook(hook PreReorgHook) SubscriptionID
+	// RemovePreReorgHook removes a hook added by AddPreReorgHook
+	RemovePreReorgHook(id SubscriptionID)
+	// GetReorgHistory returns the reorgs executed since fromTimestamp, oldest first. limit 0 means no limit
+	GetReorgHistory(ctx context.Context, fromTimestamp time.Time, limit uint64) ([]ReorgLogEntry, error)
+	// PreviewReorg returns the data that a reorg keeping up to firstL1BlockNumberToKeep would delete, it doesn't delete anything
+	PreviewReorg(ctx context.Context, firstL1BlockNumberToKeep uint64) (*ReorgImpact, error)
 }
 
 type Synchronizer interface {
@@ -121,20 +493,34 @@ type Synchronizer interface {
 	SynchronizerSequencedBatchesQuerier
 	SynchronizerReorgSupporter
 	SynchronizerVirtualBatchesQuerier
+	SynchronizerVerifiedBatchesQuerier
+	SynchronizerForcedBatchesQuerier
+	SynchronizerGlobalExitRootsQuerier
+	SynchronizerGovernanceQuerier
+	SynchronizerRollupRegistryQuerier
+	SynchronizerAuditQuerier
+	SynchronizerPendingStateQuerier
 	SynchronizerBlockQuerier
+	SynchronizerRollupsQuerier
+	SynchronizerEventsSubscriber
 }
 
-func NewSynchronizerFromConfigfile(ctx context.Context, configFile string) (Synchronizer, error) {
+func NewSynchronizerFromConfigfile(ctx context.Context, configFile string, opts ...Option) (Synchronizer, error) {
 	config, err := config.LoadFile(configFile)
 	if err != nil || config == nil {
 		log.Error("Error loading config", err)
 		return nil, err
 	}
 	log.Init(config.Log)
-	return NewSynchronizer(ctx, *config)
+	return NewSynchronizer(ctx, *config, opts...)
 }
 
-func NewSynchronizer(ctx context.Context, config config.Config) (Synchronizer, error) {
+func NewSynchronizer(ctx context.Context, config config.Config, opts ...Option) (Synchronizer, error) {
+	options := newSynchronizerOptions(opts...)
+	if err := options.validate(); err != nil {
+		log.Error(err)
+		return nil, err
+	}
 	configStorage := pgstorage.Config{
 		Name:     config.DB.Name,
 		User:     config.DB.User,
@@ -157,14 +543,45 @@ func NewSynchronizer(ctx context.Context, config config.Config) (Synchronizer, e
 		log.Error("Error creating etherman", err)
 		return nil, err
 	}
+	err = options.registerOnEtherman(etherman)
+	if err != nil {
+		log.Error("Error registering custom events on etherman", err)
+		return nil, err
+	}
 	state := state.NewState(storage)
+	etherman.SetRollupTypeReader(&stateRollupTypeReader{state: state})
 	storageCompatibilityChecker := internal.NewSanityStorageCheckerImpl(state, etherman, config.Synchronizer.OverrideStorageCheck)
-	sync, err := internal.NewSynchronizerImpl(ctx, storage, state, etherman, storageCompatibilityChecker, config.Synchronizer)
+	sync, err := internal.NewSynchronizerImpl(ctx, storage, state, etherman, storageCompatibilityChecker, config.Synchronizer,
+		options.l1EventProcessors...)
 	if err != nil {
 		log.Error("Error creating synchronizer", err)
 		return nil, err
 	}
+	sync.SetCriticalErrorHandler(options.criticalErrorHandler)
+
+	eventBus := NewSyncEventBus()
+	state.AddOnStateEventCallback(eventBus.OnStateEvent)
+	sync.AddCallbackOnReorgDone(func(reorgData internal.ReorgExecutionResult) {
+		eventBus.OnReorgExecuted(newReorgExecutionResult(reorgData))
+	})
 
-	syncAdapter := NewSynchronizerAdapter(NewSyncrhronizerQueries(state, storage, ctx), sync)
+	syncAdapter := NewSynchronizerAdapter(NewSyncrhronizerQueries(state, storage, ctx), eventBus, sync,
+		etherman.Close, storage.Close)
 	return syncAdapter, nil
 }
+
+// stateRollupTypeReader gives etherman access to the rollup types stored on the state
+type stateRollupTypeReader struct {
+	state interface {
+		GetRollupType(ctx context.Context, rollupTypeID uint64, dbTx entities.Tx) (*entities.RollupType, error)
+	}
+}
+
+// GetRollupTypeForkID returns the forkID of the rollup type stored, found is false if it's not stored
+func (r *stateRollupTypeReader) GetRollupTypeForkID(ctx context.Context, rollupTypeID uint64) (uint64, bool, error) {
+	rollupType, err := r.state.GetRollupType(ctx, rollupTypeID, nil)
+	if err != nil || rollupType == nil {
+		return 0, false, err
+	}
+	return rollupType.ForkID, true, nil
+}
@@ -1,32 +1,106 @@
 package synchronizer
 
 import (
+	"context"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
 	internal "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/internal"
 )
 
+// internalSynchronizer is the part of internal.SynchronizerImpl used by the adapter
+type internalSynchronizer interface {
+	Sync(executionFlags internal.SyncExecutionFlags) error
+	SetSyncTarget(target internal.SyncTarget)
+	Stop()
+	Shutdown(ctx context.Context) error
+	Pause()
+	Resume()
+	IsPaused() bool
+	IsSynced() bool
+	GetSyncStatus(ctx context.Context) (*internal.SyncStatus, error)
+	GetRollupIDs() []uint64
+	SetCallbackOnReorgDone(callback func(reorgData internal.ReorgExecutionResult))
+	AddCallbackOnReorgDone(callback internal.ReorgCallback) uint64
+	RemoveCallbackOnReorgDone(id uint64)
+	AddPreReorgHook(hook internal.PreReorgHook) uint64
+	RemovePreReorgHook(id uint64)
+}
+
 type SynchronizerAdapter struct {
 	*SyncrhronizerQueries
-	internalSyncrhonizer *internal.SynchronizerImpl
+	*SyncEventBus
+	internalSyncrhonizer internalSynchronizer
+	// closers release the resources (DB pool, L1 client) on Shutdown
+	closers []func()
 }
 
-func NewSynchronizerAdapter(queries *SyncrhronizerQueries, sync *internal.SynchronizerImpl) *SynchronizerAdapter {
+func NewSynchronizerAdapter(queries *SyncrhronizerQueries, eventBus *SyncEventBus, sync *internal.SynchronizerImpl, closers ...func()) *SynchronizerAdapter {
 	return &SynchronizerAdapter{
 		SyncrhronizerQueries: queries,
+		SyncEventBus:         eventBus,
 		internalSyncrhonizer: sync,
+		closers:              closers,
 	}
 }
 
 func (s *SynchronizerAdapter) SetCallbackOnReorgDone(callback func(reorgData ReorgExecutionResult)) {
+	if callback == nil {
+		s.internalSyncrhonizer.SetCallbackOnReorgDone(nil)
+		return
+	}
 	s.internalSyncrhonizer.SetCallbackOnReorgDone(
 		func(nreorgData internal.ReorgExecutionResult) {
-			callback(ReorgExecutionResult{
-				FirstL1BlockNumberValidAfterReorg: nreorgData.FirstL1BlockNumberValidAfterReorg,
-				ReasonError:                       nreorgData.ReasonError,
-			})
+			callback(newReorgExecutionResult(nreorgData))
 		})
 
 }
 
+func (s *SynchronizerAdapter) AddCallbackOnReorgDone(callback func(reorgData ReorgExecutionResult)) SubscriptionID {
+	return SubscriptionID(s.internalSyncrhonizer.AddCallbackOnReorgDone(
+		func(nreorgData internal.ReorgExecutionResult) {
+			callback(newReorgExecutionResult(nreorgData))
+		}))
+}
+
+func (s *SynchronizerAdapter) RemoveCallbackOnReorgDone(id SubscriptionID) {
+	s.internalSyncrhonizer.RemoveCallbackOnReorgDone(uint64(id))
+}
+
+func (s *SynchronizerAdapter) AddPreReorgHook(hook PreReorgHook) SubscriptionID {
+	return SubscriptionID(s.internalSyncrhonizer.AddPreReorgHook(
+		func(ctx context.Context, nreorgData internal.ReorgExecutionResult) error {
+			return hook(ctx, newReorgExecutionResult(nreorgData))
+		}))
+}
+
+func (s *SynchronizerAdapter) RemovePreReorgHook(id SubscriptionID) {
+	s.internalSyncrhonizer.RemovePreReorgHook(uint64(id))
+}
+
+func newReorgExecutionResult(nreorgData internal.ReorgExecutionResult) ReorgExecutionResult {
+	res := ReorgExecutionResult{
+		FirstL1BlockNumberValidAfterReorg: nreorgData.FirstL1BlockNumberValidAfterReorg,
+		ReasonError:                       nreorgData.ReasonError,
+		FirstL1BlockNumberDeleted:         nreorgData.FirstL1BlockNumberDeleted,
+		OldBlockHash:                      nreorgData.OldBlockHash,
+		NewBlockHash:                      nreorgData.NewBlockHash,
+		DeletedVirtualBatches:             newBatchNumberRanges(nreorgData.DeletedVirtualBatches),
+	}
+	if nreorgData.DeletedL1InfoTreeLeaves != nil {
+		leaves := L1InfoTreeIndexRange(*nreorgData.DeletedL1InfoTreeLeaves)
+		res.DeletedL1InfoTreeLeaves = &leaves
+	}
+	return res
+}
+
+func newBatchNumberRanges(ranges []entities.BatchNumberRange) []BatchNumberRange {
+	var res []BatchNumberRange
+	for _, batchRange := range ranges {
+		res = append(res, BatchNumberRange(batchRange))
+	}
+	return res
+}
+
 func (s *SynchronizerAdapter) Sync(returnOnSync bool) error {
 	var flags internal.SyncExecutionFlags
 	if returnOnSync {
@@ -35,10 +109,61 @@ func (s *SynchronizerAdapter) Sync(returnOnSync bool) error {
 	return s.internalSyncrhonizer.Sync(flags)
 }
 
+func (s *SynchronizerAdapter) SyncWithOptions(options SyncOptions) error {
+	s.internalSyncrhonizer.SetSyncTarget(internal.SyncTarget{
+		L1BlockNumber: options.TargetL1BlockNumber,
+		RollupID:      options.TargetRollupID,
+		BatchNumber:   options.TargetBatchNumber,
+	})
+	// The target only applies to this call
+	defer s.internalSyncrhonizer.SetSyncTarget(internal.SyncTarget{})
+	var flags internal.SyncExecutionFlags
+	if options.ReturnOnSync {
+		flags |= internal.FlagReturnOnSync
+	}
+	if options.SingleIteration {
+		flags |= internal.FlagReturnAfterIteration
+	}
+	return s.internalSyncrhonizer.Sync(flags)
+}
+
+func (s *SynchronizerAdapter) Pause() {
+	s.internalSyncrhonizer.Pause()
+}
+
+func (s *SynchronizerAdapter) Resume() {
+	s.internalSyncrhonizer.Resume()
+}
+
+func (s *SynchronizerAdapter) IsPaused() bool {
+	return s.internalSyncrhonizer.IsPaused()
+}
+
 func (s *SynchronizerAdapter) Stop() {
 	s.internalSyncrhonizer.Stop()
 }
 
+func (s *SynchronizerAdapter) Shutdown(ctx context.Context) error {
+	err := s.internalSyncrhonizer.Shutdown(ctx)
+	for _, closer := range s.closers {
+		closer()
+	}
+	return err
+}
+
 func (s *SynchronizerAdapter) IsSynced() bool {
 	return s.internalSyncrhonizer.IsSynced()
 }
+
+func (s *SynchronizerAdapter) GetSyncStatus(ctx context.Context) (*SyncStatus, error) {
+	status, err := s.internalSyncrhonizer.GetSyncStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := SyncStatus(*status)
+	return &res, nil
+}
+
+func (s *SynchronizerAdapter) GetRollupIDs() []uint64 {
+	return s.internalSyncrhonizer.GetRollupIDs()
+}
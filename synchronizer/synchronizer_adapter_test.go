@@ -0,0 +1,40 @@
+package synchronizer
+
+import (
+	"testing"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/internal"
+	"github.com/stretchr/testify/require"
+)
+
+// internalSynchronizerFake records the sync target that each Sync call runs with
+type internalSynchronizerFake struct {
+	internalSynchronizer
+	target        internal.SyncTarget
+	targetsOnSync []internal.SyncTarget
+	flagsOnSync   []internal.SyncExecutionFlags
+}
+
+func (f *internalSynchronizerFake) SetSyncTarget(target internal.SyncTarget) {
+	f.target = target
+}
+
+func (f *internalSynchronizerFake) Sync(executionFlags internal.SyncExecutionFlags) error {
+	f.targetsOnSync = append(f.targetsOnSync, f.target)
+	f.flagsOnSync = append(f.flagsOnSync, executionFlags)
+	return nil
+}
+
+func TestSyncWithOptionsTargetDoesntApplyToNextSync(t *testing.T) {
+	fake := &internalSynchronizerFake{}
+	sut := &SynchronizerAdapter{internalSyncrhonizer: fake}
+
+	err := sut.SyncWithOptions(SyncOptions{TargetL1BlockNumber: 100, TargetBatchNumber: 5, SingleIteration: true})
+	require.NoError(t, err)
+	err = sut.Sync(true)
+	require.NoError(t, err)
+
+	require.Equal(t, []internal.SyncTarget{{L1BlockNumber: 100, BatchNumber: 5}, {}}, fake.targetsOnSync)
+	require.Equal(t, []internal.SyncExecutionFlags{internal.FlagReturnAfterIteration, internal.FlagReturnOnSync}, fake.flagsOnSync)
+	require.Equal(t, internal.SyncTarget{}, fake.target)
+}
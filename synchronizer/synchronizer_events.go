@@ -0,0 +1,220 @@
+package synchronizer
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/model"
+)
+
+// SyncEventType is the kind of new data synchronized from L1
+type SyncEventType int
+
+const (
+	// EventNewL1InfoTreeLeaf a new leaf of L1InfoTree. Field: L1InfoTreeLeaf
+	EventNewL1InfoTreeLeaf SyncEventType = iota
+	// EventNewSequencedBatches a new sequence of batches. Field: SequencedBatches
+	EventNewSequencedBatches
+	// EventNewVirtualBatch a new virtual batch. Field: VirtualBatch
+	EventNewVirtualBatch
+	// EventNewForkID a new forkID. Field: ForkID
+	EventNewForkID
+	// EventBlockFinalized a L1 block is marked as Checked (is not going to be reorged). Field: L1Block
+	EventBlockFinalized
+	// EventReorg a reorg have been executed. Field: Reorg
+	EventReorg
+	// EventNewVerifiedBatch a new verification of batches. Field: VerifiedBatch
+	EventNewVerifiedBatch
+	// EventNewForcedBatch a new forced batch. Field: ForcedBatch
+	EventNewForcedBatch
+	// EventNewGlobalExitRoot a new update of GlobalExitRoot previous to LxLy. Field: GlobalExitRoot
+	EventNewGlobalExitRoot
+	// EventNewGovernanceEvent a parameter of a rollup or of the RollupManager have been changed. Field: GovernanceEvent
+	EventNewGovernanceEvent
+	// EventNewAuditEvent the proxy, a role or the owner of a contract have been changed. Field: AuditEvent
+	EventNewAuditEvent
+)
+
+func (t SyncEventType) String() string {
+	switch t {
+	case EventNewL1InfoTreeLeaf:
+		return "NewL1InfoTreeLeaf"
+	case EventNewSequencedBatches:
+		return "NewSequencedBatches"
+	case EventNewVirtualBatch:
+		return "NewVirtualBatch"
+	case EventNewForkID:
+		return "NewForkID"
+	case EventBlockFinalized:
+		return "BlockFinalized"
+	case EventReorg:
+		return "Reorg"
+	case EventNewVerifiedBatch:
+		return "NewVerifiedBatch"
+	case EventNewForcedBatch:
+		return "NewForcedBatch"
+	case EventNewGlobalExitRoot:
+		return "NewGlobalExitRoot"
+	case EventNewGovernanceEvent:
+		return "NewGovernanceEvent"
+	case EventNewAuditEvent:
+		return "NewAuditEvent"
+	}
+	return fmt.Sprintf("Unknown(%d)", int(t))
+}
+
+type ForkIDInterval struct {
+	RollupID        uint64
+	FromBatchNumber uint64
+	ToBatchNumber   uint64
+	ForkId          uint64
+	Version         string
+	BlockNumber     uint64
+}
+
+// SyncEvent is the new data synchronized, only the field related to Type is set
+type SyncEvent struct {
+	Type             SyncEventType
+	L1InfoTreeLeaf   *L1InfoTreeLeaf
+	SequencedBatches *SequencedBatches
+	VirtualBatch     *VirtualBatch
+	ForkID           *ForkIDInterval
+	L1Block          *L1Block
+	Reorg            *ReorgExecutionResult
+	VerifiedBatch    *VerifiedBatch
+	ForcedBatch      *ForcedBatch
+	GlobalExitRoot   *GlobalExitRoot
+	GovernanceEvent  *GovernanceEvent
+	AuditEvent       *AuditEvent
+}
+
+// SyncEventCallback is called synchronously by the synchronizer, so it must not block
+type SyncEventCallback func(event SyncEvent)
+
+// SubscriptionID identifies a subscription to be able to unsubscribe
+type SubscriptionID uint64
+
+// SynchronizerEventsSubscriber allows to be notified of new data instead of polling.
+// The events are emitted once the data is committed on DB
+type SynchronizerEventsSubscriber interface {
+	// Subscribe registers a callback for the given event types, if no types are given
+	// all events are delivered
+	Subscribe(callback SyncEventCallback, eventTypes ...SyncEventType) SubscriptionID
+	// Unsubscribe removes a subscription
+	Unsubscribe(id SubscriptionID)
+}
+
+type syncEventSubscription struct {
+	id         SubscriptionID
+	callback   SyncEventCallback
+	eventTypes map[SyncEventType]struct{}
+}
+
+func (s *syncEventSubscription) isInterested(eventType SyncEventType) bool {
+	if len(s.eventTypes) == 0 {
+		return true
+	}
+	_, ok := s.eventTypes[eventType]
+	return ok
+}
+
+// SyncEventBus dispatches the events to the subscribers
+type SyncEventBus struct {
+	mutex         sync.RWMutex
+	lastID        SubscriptionID
+	subscriptions []*syncEventSubscription
+}
+
+func NewSyncEventBus() *SyncEventBus {
+	return &SyncEventBus{}
+}
+
+// Subscribe registers a callback for the given event types (all if empty)
+func (b *SyncEventBus) Subscribe(callback SyncEventCallback, eventTypes ...SyncEventType) SubscriptionID {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.lastID++
+	subscription := &syncEventSubscription{
+		id:         b.lastID,
+		callback:   callback,
+		eventTypes: map[SyncEventType]struct{}{},
+	}
+	for _, eventType := range eventTypes {
+		subscription.eventTypes[eventType] = struct{}{}
+	}
+	b.subscriptions = append(b.subscriptions, subscription)
+	return subscription.id
+}
+
+// Unsubscribe removes a subscription
+func (b *SyncEventBus) Unsubscribe(id SubscriptionID) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	subscriptions := make([]*syncEventSubscription, 0, len(b.subscriptions))
+	for _, subscription := range b.subscriptions {
+		if subscription.id != id {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	b.subscriptions = subscriptions
+}
+
+// Publish sends the event to all the subscribers interested
+func (b *SyncEventBus) Publish(event SyncEvent) {
+	b.mutex.RLock()
+	subscriptions := b.subscriptions
+	b.mutex.RUnlock()
+	for _, subscription := range subscriptions {
+		if subscription.isInterested(event.Type) {
+			subscription.callback(event)
+		}
+	}
+}
+
+// OnStateEvent is the callback for state.AddOnStateEventCallback
+func (b *SyncEventBus) OnStateEvent(stateEvent model.StateEvent) {
+	var event SyncEvent
+	switch stateEvent.Type {
+	case model.StateEventNewL1InfoTreeLeaf:
+		leaf := L1InfoTreeLeaf(*stateEvent.L1InfoTreeLeaf)
+		event = SyncEvent{Type: EventNewL1InfoTreeLeaf, L1InfoTreeLeaf: &leaf}
+	case model.StateEventNewSequencedBatches:
+		sequence := SequencedBatches(*stateEvent.SequencedBatches)
+		event = SyncEvent{Type: EventNewSequencedBatches, SequencedBatches: &sequence}
+	case model.StateEventNewVirtualBatch:
+		batch := VirtualBatch(*stateEvent.VirtualBatch)
+		event = SyncEvent{Type: EventNewVirtualBatch, VirtualBatch: &batch}
+	case model.StateEventNewForkID:
+		forkID := ForkIDInterval(*stateEvent.ForkID)
+		event = SyncEvent{Type: EventNewForkID, ForkID: &forkID}
+	case model.StateEventBlockChecked:
+		block := L1Block(*stateEvent.L1Block)
+		event = SyncEvent{Type: EventBlockFinalized, L1Block: &block}
+	case model.StateEventNewVerifiedBatch:
+		verifiedBatch := VerifiedBatch(*stateEvent.VerifiedBatch)
+		event = SyncEvent{Type: EventNewVerifiedBatch, VerifiedBatch: &verifiedBatch}
+	case model.StateEventNewForcedBatch:
+		forcedBatch := ForcedBatch(*stateEvent.ForcedBatch)
+		event = SyncEvent{Type: EventNewForcedBatch, ForcedBatch: &forcedBatch}
+	case model.StateEventNewGlobalExitRoot:
+		globalExitRoot := GlobalExitRoot(*stateEvent.GlobalExitRoot)
+		event = SyncEvent{Type: EventNewGlobalExitRoot, GlobalExitRoot: &globalExitRoot}
+	case model.StateEventNewGovernanceEvent:
+		governanceEvent := GovernanceEvent(*stateEvent.GovernanceEvent)
+		event = SyncEvent{Type: EventNewGovernanceEvent, GovernanceEvent: &governanceEvent}
+	case model.StateEventNewAuditEvent:
+		auditEvent := AuditEvent(*stateEvent.AuditEvent)
+		event = SyncEvent{Type: EventNewAuditEvent, AuditEvent: &auditEvent}
+	default:
+		return
+	}
+	b.Publish(event)
+}
+
+// OnReorgExecuted is the callback for the reorgs committed
+func (b *SyncEventBus) OnReorgExecuted(reorg ReorgExecutionResult) {
+	b.Publish(SyncEvent{
+		Type:  EventReorg,
+		Reorg: &reorg,
+	})
+}
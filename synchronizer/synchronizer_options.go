@@ -0,0 +1,119 @@
+package synchronizer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/actions"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/common"
+	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/syncinterfaces"
+)
+
+// CriticalErrorHandler is called when the synchronizer can't continue (e.g. the DB is not reachable).
+// After calling it Sync returns the error
+type CriticalErrorHandler = syncinterfaces.CriticalErrorHandler
+
+// NewCriticalErrorHalt returns a handler that blocks the synchronizer until it's stopped, logging the
+// error every timeBetweenLogs
+func NewCriticalErrorHalt(timeBetweenLogs time.Duration) CriticalErrorHandler {
+	return common.NewCriticalErrorHalt(timeBetweenLogs)
+}
+
+// NewCriticalErrorReturn returns a handler that just makes Sync return the error. It's the default one
+func NewCriticalErrorReturn() CriticalErrorHandler {
+	return common.NewCriticalErrorReturn()
+}
+
+// NewCriticalErrorExit returns a handler that exits the process
+func NewCriticalErrorExit() CriticalErrorHandler {
+	return common.NewCriticalErrorExit()
+}
+
+// Option customizes the synchronizer created by NewSynchronizer
+type Option func(*synchronizerOptions)
+
+type synchronizerOptions struct {
+	criticalErrorHandler    CriticalErrorHandler
+	sequenceBatchesDecoders []etherman.SequenceBatchesDecoder
+	eventLogParsers         []etherman.EventLogParser
+	l1EventProcessors       []actions.L1EventProcessor
+}
+
+// WithCriticalErrorHandler sets the handler of the critical errors
+func WithCriticalErrorHandler(handler CriticalErrorHandler) Option {
+	return func(opts *synchronizerOptions) {
+		opts.criticalErrorHandler = handler
+	}
+}
+
+// WithSequenceBatchesDecoder adds a decoder for the calldata of the sequences (e.g. the sequence
+// methods of a fork of the rollup contracts). The built-in decoders are tried first
+func WithSequenceBatchesDecoder(decoder etherman.SequenceBatchesDecoder) Option {
+	return func(opts *synchronizerOptions) {
+		opts.sequenceBatchesDecoders = append(opts.sequenceBatchesDecoders, decoder)
+	}
+}
+
+// WithEventLogParser adds a custom event of the L1 contracts, the decoded event is stored in
+// Block.CustomEvents and it must be processed by a processor added with WithL1EventProcessor
+func WithEventLogParser(parser etherman.EventLogParser) Option {
+	return func(opts *synchronizerOptions) {
+		opts.eventLogParsers = append(opts.eventLogParsers, parser)
+	}
+}
+
+// WithL1EventProcessor adds a processor of L1 events, it replaces the built-in processor for the
+// same forkId (or family of forkIds) and event. The processor of an event is looked up first by the
+// specific forkId, then by its family and last the wildcard (actions.ForksIdAll), so a processor for
+// all the forkIds is not used on the forkIds with a built-in processor for the event (e.g.
+// SequenceBatches on etrog and elderberry); a warning is logged in that case
+func WithL1EventProcessor(processor actions.L1EventProcessor) Option {
+	return func(opts *synchronizerOptions) {
+		opts.l1EventProcessors = append(opts.l1EventProcessors, processor)
+	}
+}
+
+// validate checks that all the custom events have a processor
+func (o *synchronizerOptions) validate() error {
+	for _, parser := range o.eventLogParsers {
+		if !o.hasL1EventProcessor(parser.Order) {
+			return fmt.Errorf("the custom event %s has no processor, add one with WithL1EventProcessor", parser.Order)
+		}
+	}
+	return nil
+}
+
+func (o *synchronizerOptions) hasL1EventProcessor(event etherman.EventOrder) bool {
+	for _, processor := range o.l1EventProcessors {
+		for _, supported := range processor.SupportedEvents() {
+			if supported == event {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// registerOnEtherman adds the custom decoders and events to the etherman client
+func (o *synchronizerOptions) registerOnEtherman(ethMan *etherman.Client) error {
+	for _, decoder := range o.sequenceBatchesDecoders {
+		ethMan.AddSequenceBatchesDecoder(decoder)
+	}
+	for _, parser := range o.eventLogParsers {
+		if err := ethMan.RegisterEventLogParser(parser); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newSynchronizerOptions(opts ...Option) synchronizerOptions {
+	res := synchronizerOptions{
+		criticalErrorHandler: NewCriticalErrorReturn(),
+	}
+	for _, opt := range opts {
+		opt(&res)
+	}
+	return res
+}
@@ -3,6 +3,7 @@ package synchronizer
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/log"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
@@ -15,6 +16,25 @@ type stateSyncQueries interface {
 	GetL1InfoRootPerLeafIndex(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx) (common.Hash, error)
 	GetL1InfoLeafPerIndex(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx) (*entities.L1InfoTreeLeaf, error)
 	GetL1InfoTreeLeaves(ctx context.Context, indexLeaves []uint32, dbTx entities.Tx) (map[uint32]entities.L1InfoTreeLeaf, error)
+	PreviewReorg(ctx context.Context, firstL1BlockNumberToKeep uint64, dbTx entities.Tx) (*entities.ReorgImpact, error)
+	GetLastVerifiedBatch(ctx context.Context, rollupID uint64, dbTx entities.Tx) (*entities.VerifiedBatch, error)
+	GetVerifiedBatchByNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.VerifiedBatch, error)
+	IsBatchVerified(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (bool, error)
+	GetForcedBatch(ctx context.Context, rollupID uint64, forcedBatchNumber uint64, dbTx entities.Tx) (*entities.ForcedBatch, error)
+	GetPendingForcedBatches(ctx context.Context, rollupID uint64, dbTx entities.Tx) ([]entities.ForcedBatch, error)
+	GetLatestGlobalExitRoot(ctx context.Context, dbTx entities.Tx) (*entities.GlobalExitRoot, error)
+	GetGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash, dbTx entities.Tx) (*entities.GlobalExitRoot, error)
+	GetGovernanceEventAtBlock(ctx context.Context, rollupID uint64, parameter string, blockNumber uint64, dbTx entities.Tx) (*entities.GovernanceEvent, error)
+	GetGovernanceHistory(ctx context.Context, rollupID uint64, parameter string, dbTx entities.Tx) ([]entities.GovernanceEvent, error)
+	IsEmergencyState(ctx context.Context, dbTx entities.Tx) (bool, error)
+	GetRollupType(ctx context.Context, rollupTypeID uint64, dbTx entities.Tx) (*entities.RollupType, error)
+	GetRollupTypes(ctx context.Context, dbTx entities.Tx) ([]entities.RollupType, error)
+	GetRollup(ctx context.Context, rollupID uint64, dbTx entities.Tx) (*entities.Rollup, error)
+	GetRollups(ctx context.Context, dbTx entities.Tx) ([]entities.Rollup, error)
+	GetAuditTrail(ctx context.Context, fromBlockNumber uint64, dbTx entities.Tx) ([]entities.AuditEvent, error)
+	GetContractAuditTrail(ctx context.Context, contract common.Address, fromBlockNumber uint64, dbTx entities.Tx) ([]entities.AuditEvent, error)
+	GetVerificationFrontier(ctx context.Context, rollupID uint64, dbTx entities.Tx) (*entities.VerificationFrontier, error)
+	GetPendingState(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.PendingState, error)
 }
 
 type storageSyncQueries interface {
@@ -22,6 +42,7 @@ type storageSyncQueries interface {
 	syncinterfaces.StorageSequenceBatchesInterface
 	syncinterfaces.StorageVirtualBatchInterface
 	syncinterfaces.StorageBlockReaderInterface
+	syncinterfaces.StorageReorgLogInterface
 }
 
 type SyncrhronizerQueries struct {
@@ -73,8 +94,8 @@ func (s *SyncrhronizerQueries) GetL1InfoTreeLeaves(ctx context.Context, indexLea
 	return returnLeaves, nil
 }
 
-func (s *SyncrhronizerQueries) GetSequenceByBatchNumber(ctx context.Context, batchNumber uint64) (*SequencedBatches, error) {
-	sequence, err := s.storage.GetSequenceByBatchNumber(ctx, batchNumber, nil)
+func (s *SyncrhronizerQueries) GetSequenceByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64) (*SequencedBatches, error) {
+	sequence, err := s.storage.GetSequenceByBatchNumber(ctx, rollupID, batchNumber, nil)
 	if sequence == nil {
 		return nil, err
 	}
@@ -82,8 +103,8 @@ func (s *SyncrhronizerQueries) GetSequenceByBatchNumber(ctx context.Context, bat
 	return &res, err
 }
 
-func (s *SyncrhronizerQueries) GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber uint64) (*VirtualBatch, error) {
-	virtualBatch, err := s.storage.GetVirtualBatchByBatchNumber(ctx, batchNumber, nil)
+func (s *SyncrhronizerQueries) GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64) (*VirtualBatch, error) {
+	virtualBatch, err := s.storage.GetVirtualBatchByBatchNumber(ctx, rollupID, batchNumber, nil)
 	if virtualBatch == nil {
 		return nil, err
 	}
@@ -91,14 +112,146 @@ func (s *SyncrhronizerQueries) GetVirtualBatchByBatchNumber(ctx context.Context,
 	return &res, err
 }
 
-func (s *SyncrhronizerQueries) GetLastestVirtualBatchNumber(ctx context.Context) (uint64, error) {
-	lastBatchNumber, err := s.storage.GetLastestVirtualBatchNumber(ctx, nil, nil)
+func (s *SyncrhronizerQueries) GetLastestVirtualBatchNumber(ctx context.Context, rollupID uint64) (uint64, error) {
+	lastBatchNumber, err := s.storage.GetLastestVirtualBatchNumber(ctx, rollupID, nil, nil)
 	if err != nil {
 		return 0, err
 	}
 	return lastBatchNumber, nil
 }
 
+func (s *SyncrhronizerQueries) GetLastVerifiedBatch(ctx context.Context, rollupID uint64) (*VerifiedBatch, error) {
+	verifiedBatch, err := s.state.GetLastVerifiedBatch(ctx, rollupID, nil)
+	if verifiedBatch == nil {
+		return nil, err
+	}
+	res := VerifiedBatch(*verifiedBatch)
+	return &res, err
+}
+
+func (s *SyncrhronizerQueries) GetVerifiedBatchByNumber(ctx context.Context, rollupID uint64, batchNumber uint64) (*VerifiedBatch, error) {
+	verifiedBatch, err := s.state.GetVerifiedBatchByNumber(ctx, rollupID, batchNumber, nil)
+	if verifiedBatch == nil {
+		return nil, err
+	}
+	res := VerifiedBatch(*verifiedBatch)
+	return &res, err
+}
+
+func (s *SyncrhronizerQueries) IsBatchVerified(ctx context.Context, rollupID uint64, batchNumber uint64) (bool, error) {
+	return s.state.IsBatchVerified(ctx, rollupID, batchNumber, nil)
+}
+
+func (s *SyncrhronizerQueries) GetForcedBatch(ctx context.Context, rollupID uint64, forcedBatchNumber uint64) (*ForcedBatch, error) {
+	forcedBatch, err := s.state.GetForcedBatch(ctx, rollupID, forcedBatchNumber, nil)
+	if forcedBatch == nil {
+		return nil, err
+	}
+	res := ForcedBatch(*forcedBatch)
+	return &res, err
+}
+
+func (s *SyncrhronizerQueries) GetPendingForcedBatches(ctx context.Context, rollupID uint64) ([]ForcedBatch, error) {
+	forcedBatches, err := s.state.GetPendingForcedBatches(ctx, rollupID, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]ForcedBatch, len(forcedBatches))
+	for i, forcedBatch := range forcedBatches {
+		res[i] = ForcedBatch(forcedBatch)
+	}
+	return res, nil
+}
+
+func (s *SyncrhronizerQueries) GetLatestGlobalExitRoot(ctx context.Context) (*GlobalExitRoot, error) {
+	globalExitRoot, err := s.state.GetLatestGlobalExitRoot(ctx, nil)
+	if globalExitRoot == nil {
+		return nil, err
+	}
+	res := GlobalExitRoot(*globalExitRoot)
+	return &res, err
+}
+
+func (s *SyncrhronizerQueries) GetGlobalExitRoot(ctx context.Context, globalExitRoot common.Hash) (*GlobalExitRoot, error) {
+	update, err := s.state.GetGlobalExitRoot(ctx, globalExitRoot, nil)
+	if update == nil {
+		return nil, err
+	}
+	res := GlobalExitRoot(*update)
+	return &res, err
+}
+
+func (s *SyncrhronizerQueries) GetGovernanceValueAtBlock(ctx context.Context, rollupID uint64, parameter GovernanceParameter, blockNumber uint64) (*GovernanceEvent, error) {
+	governanceEvent, err := s.state.GetGovernanceEventAtBlock(ctx, rollupID, parameter, blockNumber, nil)
+	if governanceEvent == nil {
+		return nil, err
+	}
+	res := GovernanceEvent(*governanceEvent)
+	return &res, err
+}
+
+func (s *SyncrhronizerQueries) GetGovernanceHistory(ctx context.Context, rollupID uint64, parameter GovernanceParameter) ([]GovernanceEvent, error) {
+	governanceEvents, err := s.state.GetGovernanceHistory(ctx, rollupID, parameter, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]GovernanceEvent, 0, len(governanceEvents))
+	for _, governanceEvent := range governanceEvents {
+		res = append(res, GovernanceEvent(governanceEvent))
+	}
+	return res, nil
+}
+
+func (s *SyncrhronizerQueries) GetTrustedSequencerURLHistory(ctx context.Context, rollupID uint64) ([]GovernanceEvent, error) {
+	return s.GetGovernanceHistory(ctx, rollupID, GovernanceTrustedSequencerURL)
+}
+
+func (s *SyncrhronizerQueries) IsEmergencyState(ctx context.Context) (bool, error) {
+	return s.state.IsEmergencyState(ctx, nil)
+}
+
+func (s *SyncrhronizerQueries) GetRollupType(ctx context.Context, rollupTypeID uint64) (*RollupType, error) {
+	rollupType, err := s.state.GetRollupType(ctx, rollupTypeID, nil)
+	if rollupType == nil {
+		return nil, err
+	}
+	res := RollupType(*rollupType)
+	return &res, err
+}
+
+func (s *SyncrhronizerQueries) GetRollupTypes(ctx context.Context) ([]RollupType, error) {
+	rollupTypes, err := s.state.GetRollupTypes(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]RollupType, 0, len(rollupTypes))
+	for _, rollupType := range rollupTypes {
+		res = append(res, RollupType(rollupType))
+	}
+	return res, nil
+}
+
+func (s *SyncrhronizerQueries) GetRollup(ctx context.Context, rollupID uint64) (*Rollup, error) {
+	rollup, err := s.state.GetRollup(ctx, rollupID, nil)
+	if rollup == nil {
+		return nil, err
+	}
+	res := Rollup(*rollup)
+	return &res, err
+}
+
+func (s *SyncrhronizerQueries) GetRollups(ctx context.Context) ([]Rollup, error) {
+	rollups, err := s.state.GetRollups(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]Rollup, 0, len(rollups))
+	for _, rollup := range rollups {
+		res = append(res, Rollup(rollup))
+	}
+	return res, nil
+}
+
 func (s *SyncrhronizerQueries) GetL1BlockByNumber(ctx context.Context, blockNumber uint64) (*L1Block, error) {
 	block, err := s.storage.GetBlockByNumber(ctx, blockNumber, nil)
 	if block == nil {
@@ -116,3 +269,86 @@ func (s *SyncrhronizerQueries) GetLastL1Block(ctx context.Context) (*L1Block, er
 	res := L1Block(*block)
 	return &res, err
 }
+
+func (s *SyncrhronizerQueries) GetReorgHistory(ctx context.Context, fromTimestamp time.Time, limit uint64) ([]ReorgLogEntry, error) {
+	entries, err := s.storage.GetReorgLogs(ctx, fromTimestamp, limit, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]ReorgLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, ReorgLogEntry(entry))
+	}
+	return res, nil
+}
+
+func (s *SyncrhronizerQueries) GetAuditTrail(ctx context.Context, fromBlockNumber uint64) ([]AuditEvent, error) {
+	auditEvents, err := s.state.GetAuditTrail(ctx, fromBlockNumber, nil)
+	return newAuditEvents(auditEvents), err
+}
+
+func (s *SyncrhronizerQueries) GetContractAuditTrail(ctx context.Context, contract common.Address, fromBlockNumber uint64) ([]AuditEvent, error) {
+	auditEvents, err := s.state.GetContractAuditTrail(ctx, contract, fromBlockNumber, nil)
+	return newAuditEvents(auditEvents), err
+}
+
+func newAuditEvents(auditEvents []entities.AuditEvent) []AuditEvent {
+	if auditEvents == nil {
+		return nil
+	}
+	res := make([]AuditEvent, 0, len(auditEvents))
+	for _, auditEvent := range auditEvents {
+		res = append(res, AuditEvent(auditEvent))
+	}
+	return res
+}
+
+func (s *SyncrhronizerQueries) GetVerificationFrontier(ctx context.Context, rollupID uint64) (*VerificationFrontier, error) {
+	frontier, err := s.state.GetVerificationFrontier(ctx, rollupID, nil)
+	if frontier == nil {
+		return nil, err
+	}
+	res := VerificationFrontier{
+		RollupID:                frontier.RollupID,
+		ConsolidatedBatchNumber: frontier.ConsolidatedBatchNumber,
+		PendingBatchNumber:      frontier.PendingBatchNumber,
+		PendingStates:           make([]PendingState, 0, len(frontier.PendingStates)),
+	}
+	for _, pendingState := range frontier.PendingStates {
+		res.PendingStates = append(res.PendingStates, PendingState(pendingState))
+	}
+	return &res, err
+}
+
+func (s *SyncrhronizerQueries) GetPendingState(ctx context.Context, rollupID uint64, batchNumber uint64) (*PendingState, error) {
+	pendingState, err := s.state.GetPendingState(ctx, rollupID, batchNumber, nil)
+	if pendingState == nil {
+		return nil, err
+	}
+	res := PendingState(*pendingState)
+	return &res, err
+}
+
+func (s *SyncrhronizerQueries) PreviewReorg(ctx context.Context, firstL1BlockNumberToKeep uint64) (*ReorgImpact, error) {
+	impact, err := s.state.PreviewReorg(ctx, firstL1BlockNumberToKeep, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := ReorgImpact{
+		FirstL1BlockNumberToKeep: impact.FirstL1BlockNumberToKeep,
+		LastL1BlockNumber:        impact.LastL1BlockNumber,
+		Blocks:                   impact.Blocks,
+		CheckedBlocks:            impact.CheckedBlocks,
+		SequencedBatches:         impact.SequencedBatches,
+		VirtualBatches:           impact.VirtualBatches,
+		L1InfoTreeLeaves:         impact.L1InfoTreeLeaves,
+		FirstDeletedBlockNumber:  impact.FirstDeletedBlockNumber,
+		FirstDeletedBlockHash:    impact.FirstDeletedBlockHash,
+		VirtualBatchRanges:       newBatchNumberRanges(impact.VirtualBatchRanges),
+	}
+	if impact.L1InfoTreeIndexRange != nil {
+		leaves := L1InfoTreeIndexRange(*impact.L1InfoTreeIndexRange)
+		res.L1InfoTreeIndexRange = &leaves
+	}
+	return &res, nil
+}
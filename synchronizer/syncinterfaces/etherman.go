@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	ethTypes "github.com/ethereum/go-ethereum/core/types"
 )
@@ -21,6 +22,8 @@ type EthermanFullInterface interface {
 	GetLatestVerifiedBatchNum() (uint64, error)
 	EthermanPreRollup
 	EthermanChainQuerier
+	EthermanNewHeadSubscriber
+	EthermanRollupDataQuerier
 }
 
 type EthermanGetLatestBatchNumber interface {
@@ -34,5 +37,15 @@ type EthermanPreRollup interface {
 
 type EthermanChainQuerier interface {
 	GetRollupID() uint
+	GetRollupIDs() []uint
 	GetL1ChainID() uint64
 }
+
+type EthermanNewHeadSubscriber interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *ethTypes.Header) (ethereum.Subscription, error)
+}
+
+type EthermanRollupDataQuerier interface {
+	HasRollupManager() bool
+	GetRollupData(ctx context.Context, rollupID uint32) (*etherman.RollupData, error)
+}
@@ -1,7 +1,21 @@
 package syncinterfaces
 
-import "context"
+import (
+	"context"
+
+	l1sync "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_sync"
+)
 
 type L1Syncer interface {
 	SyncBlocks(ctx context.Context, lastEthBlockSynced *stateL1BlockType) (*stateL1BlockType, bool, error)
+	// GetProgress returns a snapshot of the progress of the synchronization
+	GetProgress() l1sync.SyncProgress
+	// RequestStop makes SyncBlocks return after finishing the block range in progress
+	RequestStop()
+	// SetPaused makes SyncBlocks return after finishing the block range in progress until is unpaused
+	SetPaused(paused bool)
+	// SetMaxL1BlockToSync sets the last L1 block to synchronize, 0 means no limit
+	SetMaxL1BlockToSync(blockNumber uint64)
+	// SetTargetReachedChecker sets a function checked before each block range, if returns true SyncBlocks returns as synced
+	SetTargetReachedChecker(checker l1sync.TargetReachedCheckerFunc)
 }
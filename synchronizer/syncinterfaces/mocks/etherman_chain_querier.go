@@ -107,6 +107,53 @@ func (_c *EthermanChainQuerier_GetRollupID_Call) RunAndReturn(run func() uint) *
 	return _c
 }
 
+// GetRollupIDs provides a mock function with given fields:
+func (_m *EthermanChainQuerier) GetRollupIDs() []uint {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollupIDs")
+	}
+
+	var r0 []uint
+	if rf, ok := ret.Get(0).(func() []uint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	return r0
+}
+
+// EthermanChainQuerier_GetRollupIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollupIDs'
+type EthermanChainQuerier_GetRollupIDs_Call struct {
+	*mock.Call
+}
+
+// GetRollupIDs is a helper method to define mock.On call
+func (_e *EthermanChainQuerier_Expecter) GetRollupIDs() *EthermanChainQuerier_GetRollupIDs_Call {
+	return &EthermanChainQuerier_GetRollupIDs_Call{Call: _e.mock.On("GetRollupIDs")}
+}
+
+func (_c *EthermanChainQuerier_GetRollupIDs_Call) Run(run func()) *EthermanChainQuerier_GetRollupIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *EthermanChainQuerier_GetRollupIDs_Call) Return(_a0 []uint) *EthermanChainQuerier_GetRollupIDs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EthermanChainQuerier_GetRollupIDs_Call) RunAndReturn(run func() []uint) *EthermanChainQuerier_GetRollupIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewEthermanChainQuerier creates a new instance of EthermanChainQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEthermanChainQuerier(t interface {
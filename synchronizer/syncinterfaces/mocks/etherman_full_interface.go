@@ -8,6 +8,8 @@ import (
 
 	common "github.com/ethereum/go-ethereum/common"
 
+	ethereum "github.com/ethereum/go-ethereum"
+
 	etherman "github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
 
 	mock "github.com/stretchr/testify/mock"
@@ -358,6 +360,65 @@ func (_c *EthermanFullInterface_GetLatestVerifiedBatchNum_Call) RunAndReturn(run
 	return _c
 }
 
+// GetRollupData provides a mock function with given fields: ctx, rollupID
+func (_m *EthermanFullInterface) GetRollupData(ctx context.Context, rollupID uint32) (*etherman.RollupData, error) {
+	ret := _m.Called(ctx, rollupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollupData")
+	}
+
+	var r0 *etherman.RollupData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) (*etherman.RollupData, error)); ok {
+		return rf(ctx, rollupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) *etherman.RollupData); ok {
+		r0 = rf(ctx, rollupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.RollupData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32) error); ok {
+		r1 = rf(ctx, rollupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EthermanFullInterface_GetRollupData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollupData'
+type EthermanFullInterface_GetRollupData_Call struct {
+	*mock.Call
+}
+
+// GetRollupData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint32
+func (_e *EthermanFullInterface_Expecter) GetRollupData(ctx interface{}, rollupID interface{}) *EthermanFullInterface_GetRollupData_Call {
+	return &EthermanFullInterface_GetRollupData_Call{Call: _e.mock.On("GetRollupData", ctx, rollupID)}
+}
+
+func (_c *EthermanFullInterface_GetRollupData_Call) Run(run func(ctx context.Context, rollupID uint32)) *EthermanFullInterface_GetRollupData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32))
+	})
+	return _c
+}
+
+func (_c *EthermanFullInterface_GetRollupData_Call) Return(_a0 *etherman.RollupData, _a1 error) *EthermanFullInterface_GetRollupData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EthermanFullInterface_GetRollupData_Call) RunAndReturn(run func(context.Context, uint32) (*etherman.RollupData, error)) *EthermanFullInterface_GetRollupData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRollupID provides a mock function with given fields:
 func (_m *EthermanFullInterface) GetRollupID() uint {
 	ret := _m.Called()
@@ -403,6 +464,53 @@ func (_c *EthermanFullInterface_GetRollupID_Call) RunAndReturn(run func() uint)
 	return _c
 }
 
+// GetRollupIDs provides a mock function with given fields:
+func (_m *EthermanFullInterface) GetRollupIDs() []uint {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollupIDs")
+	}
+
+	var r0 []uint
+	if rf, ok := ret.Get(0).(func() []uint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	return r0
+}
+
+// EthermanFullInterface_GetRollupIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollupIDs'
+type EthermanFullInterface_GetRollupIDs_Call struct {
+	*mock.Call
+}
+
+// GetRollupIDs is a helper method to define mock.On call
+func (_e *EthermanFullInterface_Expecter) GetRollupIDs() *EthermanFullInterface_GetRollupIDs_Call {
+	return &EthermanFullInterface_GetRollupIDs_Call{Call: _e.mock.On("GetRollupIDs")}
+}
+
+func (_c *EthermanFullInterface_GetRollupIDs_Call) Run(run func()) *EthermanFullInterface_GetRollupIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *EthermanFullInterface_GetRollupIDs_Call) Return(_a0 []uint) *EthermanFullInterface_GetRollupIDs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EthermanFullInterface_GetRollupIDs_Call) RunAndReturn(run func() []uint) *EthermanFullInterface_GetRollupIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRollupInfoByBlockRange provides a mock function with given fields: ctx, fromBlock, toBlock
 func (_m *EthermanFullInterface) GetRollupInfoByBlockRange(ctx context.Context, fromBlock uint64, toBlock *uint64) ([]etherman.Block, map[common.Hash][]etherman.Order, error) {
 	ret := _m.Called(ctx, fromBlock, toBlock)
@@ -596,6 +704,51 @@ func (_c *EthermanFullInterface_GetTrustedSequencerURL_Call) RunAndReturn(run fu
 	return _c
 }
 
+// HasRollupManager provides a mock function with given fields:
+func (_m *EthermanFullInterface) HasRollupManager() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasRollupManager")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EthermanFullInterface_HasRollupManager_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasRollupManager'
+type EthermanFullInterface_HasRollupManager_Call struct {
+	*mock.Call
+}
+
+// HasRollupManager is a helper method to define mock.On call
+func (_e *EthermanFullInterface_Expecter) HasRollupManager() *EthermanFullInterface_HasRollupManager_Call {
+	return &EthermanFullInterface_HasRollupManager_Call{Call: _e.mock.On("HasRollupManager")}
+}
+
+func (_c *EthermanFullInterface_HasRollupManager_Call) Run(run func()) *EthermanFullInterface_HasRollupManager_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *EthermanFullInterface_HasRollupManager_Call) Return(_a0 bool) *EthermanFullInterface_HasRollupManager_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EthermanFullInterface_HasRollupManager_Call) RunAndReturn(run func() bool) *EthermanFullInterface_HasRollupManager_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // HeaderByNumber provides a mock function with given fields: ctx, number
 func (_m *EthermanFullInterface) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
 	ret := _m.Called(ctx, number)
@@ -655,6 +808,65 @@ func (_c *EthermanFullInterface_HeaderByNumber_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// SubscribeNewHead provides a mock function with given fields: ctx, ch
+func (_m *EthermanFullInterface) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	ret := _m.Called(ctx, ch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeNewHead")
+	}
+
+	var r0 ethereum.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, chan<- *types.Header) (ethereum.Subscription, error)); ok {
+		return rf(ctx, ch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, chan<- *types.Header) ethereum.Subscription); ok {
+		r0 = rf(ctx, ch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ethereum.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, chan<- *types.Header) error); ok {
+		r1 = rf(ctx, ch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EthermanFullInterface_SubscribeNewHead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeNewHead'
+type EthermanFullInterface_SubscribeNewHead_Call struct {
+	*mock.Call
+}
+
+// SubscribeNewHead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ch chan<- *types.Header
+func (_e *EthermanFullInterface_Expecter) SubscribeNewHead(ctx interface{}, ch interface{}) *EthermanFullInterface_SubscribeNewHead_Call {
+	return &EthermanFullInterface_SubscribeNewHead_Call{Call: _e.mock.On("SubscribeNewHead", ctx, ch)}
+}
+
+func (_c *EthermanFullInterface_SubscribeNewHead_Call) Run(run func(ctx context.Context, ch chan<- *types.Header)) *EthermanFullInterface_SubscribeNewHead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(chan<- *types.Header))
+	})
+	return _c
+}
+
+func (_c *EthermanFullInterface_SubscribeNewHead_Call) Return(_a0 ethereum.Subscription, _a1 error) *EthermanFullInterface_SubscribeNewHead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EthermanFullInterface_SubscribeNewHead_Call) RunAndReturn(run func(context.Context, chan<- *types.Header) (ethereum.Subscription, error)) *EthermanFullInterface_SubscribeNewHead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // VerifyGenBlockNumber provides a mock function with given fields: ctx, genBlockNumber
 func (_m *EthermanFullInterface) VerifyGenBlockNumber(ctx context.Context, genBlockNumber uint64) (bool, error) {
 	ret := _m.Called(ctx, genBlockNumber)
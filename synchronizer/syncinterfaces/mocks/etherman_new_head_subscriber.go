@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/ethereum/go-ethereum/core/types"
+)
+
+// EthermanNewHeadSubscriber is an autogenerated mock type for the EthermanNewHeadSubscriber type
+type EthermanNewHeadSubscriber struct {
+	mock.Mock
+}
+
+type EthermanNewHeadSubscriber_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EthermanNewHeadSubscriber) EXPECT() *EthermanNewHeadSubscriber_Expecter {
+	return &EthermanNewHeadSubscriber_Expecter{mock: &_m.Mock}
+}
+
+// SubscribeNewHead provides a mock function with given fields: ctx, ch
+func (_m *EthermanNewHeadSubscriber) SubscribeNewHead(ctx context.Context, ch chan<- *types.Header) (ethereum.Subscription, error) {
+	ret := _m.Called(ctx, ch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeNewHead")
+	}
+
+	var r0 ethereum.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, chan<- *types.Header) (ethereum.Subscription, error)); ok {
+		return rf(ctx, ch)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, chan<- *types.Header) ethereum.Subscription); ok {
+		r0 = rf(ctx, ch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ethereum.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, chan<- *types.Header) error); ok {
+		r1 = rf(ctx, ch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EthermanNewHeadSubscriber_SubscribeNewHead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeNewHead'
+type EthermanNewHeadSubscriber_SubscribeNewHead_Call struct {
+	*mock.Call
+}
+
+// SubscribeNewHead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ch chan<- *types.Header
+func (_e *EthermanNewHeadSubscriber_Expecter) SubscribeNewHead(ctx interface{}, ch interface{}) *EthermanNewHeadSubscriber_SubscribeNewHead_Call {
+	return &EthermanNewHeadSubscriber_SubscribeNewHead_Call{Call: _e.mock.On("SubscribeNewHead", ctx, ch)}
+}
+
+func (_c *EthermanNewHeadSubscriber_SubscribeNewHead_Call) Run(run func(ctx context.Context, ch chan<- *types.Header)) *EthermanNewHeadSubscriber_SubscribeNewHead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(chan<- *types.Header))
+	})
+	return _c
+}
+
+func (_c *EthermanNewHeadSubscriber_SubscribeNewHead_Call) Return(_a0 ethereum.Subscription, _a1 error) *EthermanNewHeadSubscriber_SubscribeNewHead_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EthermanNewHeadSubscriber_SubscribeNewHead_Call) RunAndReturn(run func(context.Context, chan<- *types.Header) (ethereum.Subscription, error)) *EthermanNewHeadSubscriber_SubscribeNewHead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEthermanNewHeadSubscriber creates a new instance of EthermanNewHeadSubscriber. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEthermanNewHeadSubscriber(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EthermanNewHeadSubscriber {
+	mock := &EthermanNewHeadSubscriber{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
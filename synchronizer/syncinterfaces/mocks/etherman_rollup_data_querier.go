@@ -0,0 +1,141 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	etherman "github.com/0xPolygonHermez/zkevm-synchronizer-l1/etherman"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EthermanRollupDataQuerier is an autogenerated mock type for the EthermanRollupDataQuerier type
+type EthermanRollupDataQuerier struct {
+	mock.Mock
+}
+
+type EthermanRollupDataQuerier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EthermanRollupDataQuerier) EXPECT() *EthermanRollupDataQuerier_Expecter {
+	return &EthermanRollupDataQuerier_Expecter{mock: &_m.Mock}
+}
+
+// GetRollupData provides a mock function with given fields: ctx, rollupID
+func (_m *EthermanRollupDataQuerier) GetRollupData(ctx context.Context, rollupID uint32) (*etherman.RollupData, error) {
+	ret := _m.Called(ctx, rollupID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRollupData")
+	}
+
+	var r0 *etherman.RollupData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) (*etherman.RollupData, error)); ok {
+		return rf(ctx, rollupID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) *etherman.RollupData); ok {
+		r0 = rf(ctx, rollupID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*etherman.RollupData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32) error); ok {
+		r1 = rf(ctx, rollupID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EthermanRollupDataQuerier_GetRollupData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRollupData'
+type EthermanRollupDataQuerier_GetRollupData_Call struct {
+	*mock.Call
+}
+
+// GetRollupData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint32
+func (_e *EthermanRollupDataQuerier_Expecter) GetRollupData(ctx interface{}, rollupID interface{}) *EthermanRollupDataQuerier_GetRollupData_Call {
+	return &EthermanRollupDataQuerier_GetRollupData_Call{Call: _e.mock.On("GetRollupData", ctx, rollupID)}
+}
+
+func (_c *EthermanRollupDataQuerier_GetRollupData_Call) Run(run func(ctx context.Context, rollupID uint32)) *EthermanRollupDataQuerier_GetRollupData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32))
+	})
+	return _c
+}
+
+func (_c *EthermanRollupDataQuerier_GetRollupData_Call) Return(_a0 *etherman.RollupData, _a1 error) *EthermanRollupDataQuerier_GetRollupData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *EthermanRollupDataQuerier_GetRollupData_Call) RunAndReturn(run func(context.Context, uint32) (*etherman.RollupData, error)) *EthermanRollupDataQuerier_GetRollupData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasRollupManager provides a mock function with given fields:
+func (_m *EthermanRollupDataQuerier) HasRollupManager() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasRollupManager")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EthermanRollupDataQuerier_HasRollupManager_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasRollupManager'
+type EthermanRollupDataQuerier_HasRollupManager_Call struct {
+	*mock.Call
+}
+
+// HasRollupManager is a helper method to define mock.On call
+func (_e *EthermanRollupDataQuerier_Expecter) HasRollupManager() *EthermanRollupDataQuerier_HasRollupManager_Call {
+	return &EthermanRollupDataQuerier_HasRollupManager_Call{Call: _e.mock.On("HasRollupManager")}
+}
+
+func (_c *EthermanRollupDataQuerier_HasRollupManager_Call) Run(run func()) *EthermanRollupDataQuerier_HasRollupManager_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *EthermanRollupDataQuerier_HasRollupManager_Call) Return(_a0 bool) *EthermanRollupDataQuerier_HasRollupManager_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EthermanRollupDataQuerier_HasRollupManager_Call) RunAndReturn(run func() bool) *EthermanRollupDataQuerier_HasRollupManager_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewEthermanRollupDataQuerier creates a new instance of EthermanRollupDataQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEthermanRollupDataQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EthermanRollupDataQuerier {
+	mock := &EthermanRollupDataQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	l1sync "github.com/0xPolygonHermez/zkevm-synchronizer-l1/synchronizer/l1_sync"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -22,6 +24,182 @@ func (_m *L1Syncer) EXPECT() *L1Syncer_Expecter {
 	return &L1Syncer_Expecter{mock: &_m.Mock}
 }
 
+// GetProgress provides a mock function with given fields:
+func (_m *L1Syncer) GetProgress() l1sync.SyncProgress {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProgress")
+	}
+
+	var r0 l1sync.SyncProgress
+	if rf, ok := ret.Get(0).(func() l1sync.SyncProgress); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(l1sync.SyncProgress)
+	}
+
+	return r0
+}
+
+// L1Syncer_GetProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProgress'
+type L1Syncer_GetProgress_Call struct {
+	*mock.Call
+}
+
+// GetProgress is a helper method to define mock.On call
+func (_e *L1Syncer_Expecter) GetProgress() *L1Syncer_GetProgress_Call {
+	return &L1Syncer_GetProgress_Call{Call: _e.mock.On("GetProgress")}
+}
+
+func (_c *L1Syncer_GetProgress_Call) Run(run func()) *L1Syncer_GetProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *L1Syncer_GetProgress_Call) Return(_a0 l1sync.SyncProgress) *L1Syncer_GetProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *L1Syncer_GetProgress_Call) RunAndReturn(run func() l1sync.SyncProgress) *L1Syncer_GetProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestStop provides a mock function with given fields:
+func (_m *L1Syncer) RequestStop() {
+	_m.Called()
+}
+
+// L1Syncer_RequestStop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestStop'
+type L1Syncer_RequestStop_Call struct {
+	*mock.Call
+}
+
+// RequestStop is a helper method to define mock.On call
+func (_e *L1Syncer_Expecter) RequestStop() *L1Syncer_RequestStop_Call {
+	return &L1Syncer_RequestStop_Call{Call: _e.mock.On("RequestStop")}
+}
+
+func (_c *L1Syncer_RequestStop_Call) Run(run func()) *L1Syncer_RequestStop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *L1Syncer_RequestStop_Call) Return() *L1Syncer_RequestStop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *L1Syncer_RequestStop_Call) RunAndReturn(run func()) *L1Syncer_RequestStop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMaxL1BlockToSync provides a mock function with given fields: blockNumber
+func (_m *L1Syncer) SetMaxL1BlockToSync(blockNumber uint64) {
+	_m.Called(blockNumber)
+}
+
+// L1Syncer_SetMaxL1BlockToSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMaxL1BlockToSync'
+type L1Syncer_SetMaxL1BlockToSync_Call struct {
+	*mock.Call
+}
+
+// SetMaxL1BlockToSync is a helper method to define mock.On call
+//   - blockNumber uint64
+func (_e *L1Syncer_Expecter) SetMaxL1BlockToSync(blockNumber interface{}) *L1Syncer_SetMaxL1BlockToSync_Call {
+	return &L1Syncer_SetMaxL1BlockToSync_Call{Call: _e.mock.On("SetMaxL1BlockToSync", blockNumber)}
+}
+
+func (_c *L1Syncer_SetMaxL1BlockToSync_Call) Run(run func(blockNumber uint64)) *L1Syncer_SetMaxL1BlockToSync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uint64))
+	})
+	return _c
+}
+
+func (_c *L1Syncer_SetMaxL1BlockToSync_Call) Return() *L1Syncer_SetMaxL1BlockToSync_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *L1Syncer_SetMaxL1BlockToSync_Call) RunAndReturn(run func(uint64)) *L1Syncer_SetMaxL1BlockToSync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPaused provides a mock function with given fields: paused
+func (_m *L1Syncer) SetPaused(paused bool) {
+	_m.Called(paused)
+}
+
+// L1Syncer_SetPaused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPaused'
+type L1Syncer_SetPaused_Call struct {
+	*mock.Call
+}
+
+// SetPaused is a helper method to define mock.On call
+//   - paused bool
+func (_e *L1Syncer_Expecter) SetPaused(paused interface{}) *L1Syncer_SetPaused_Call {
+	return &L1Syncer_SetPaused_Call{Call: _e.mock.On("SetPaused", paused)}
+}
+
+func (_c *L1Syncer_SetPaused_Call) Run(run func(paused bool)) *L1Syncer_SetPaused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(bool))
+	})
+	return _c
+}
+
+func (_c *L1Syncer_SetPaused_Call) Return() *L1Syncer_SetPaused_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *L1Syncer_SetPaused_Call) RunAndReturn(run func(bool)) *L1Syncer_SetPaused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTargetReachedChecker provides a mock function with given fields: checker
+func (_m *L1Syncer) SetTargetReachedChecker(checker l1sync.TargetReachedCheckerFunc) {
+	_m.Called(checker)
+}
+
+// L1Syncer_SetTargetReachedChecker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTargetReachedChecker'
+type L1Syncer_SetTargetReachedChecker_Call struct {
+	*mock.Call
+}
+
+// SetTargetReachedChecker is a helper method to define mock.On call
+//   - checker l1sync.TargetReachedCheckerFunc
+func (_e *L1Syncer_Expecter) SetTargetReachedChecker(checker interface{}) *L1Syncer_SetTargetReachedChecker_Call {
+	return &L1Syncer_SetTargetReachedChecker_Call{Call: _e.mock.On("SetTargetReachedChecker", checker)}
+}
+
+func (_c *L1Syncer_SetTargetReachedChecker_Call) Run(run func(checker l1sync.TargetReachedCheckerFunc)) *L1Syncer_SetTargetReachedChecker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(l1sync.TargetReachedCheckerFunc))
+	})
+	return _c
+}
+
+func (_c *L1Syncer_SetTargetReachedChecker_Call) Return() *L1Syncer_SetTargetReachedChecker_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *L1Syncer_SetTargetReachedChecker_Call) RunAndReturn(run func(l1sync.TargetReachedCheckerFunc)) *L1Syncer_SetTargetReachedChecker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncBlocks provides a mock function with given fields: ctx, lastEthBlockSynced
 func (_m *L1Syncer) SyncBlocks(ctx context.Context, lastEthBlockSynced *entities.L1Block) (*entities.L1Block, bool, error) {
 	ret := _m.Called(ctx, lastEthBlockSynced)
@@ -0,0 +1,85 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// stateAuditEventManager is an autogenerated mock type for the stateAuditEventManager type
+type stateAuditEventManager struct {
+	mock.Mock
+}
+
+type stateAuditEventManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *stateAuditEventManager) EXPECT() *stateAuditEventManager_Expecter {
+	return &stateAuditEventManager_Expecter{mock: &_m.Mock}
+}
+
+// AddAuditEvent provides a mock function with given fields: ctx, auditEvent, dbTx
+func (_m *stateAuditEventManager) AddAuditEvent(ctx context.Context, auditEvent *entities.AuditEvent, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, auditEvent, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAuditEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.AuditEvent, entities.Tx) error); ok {
+		r0 = rf(ctx, auditEvent, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stateAuditEventManager_AddAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAuditEvent'
+type stateAuditEventManager_AddAuditEvent_Call struct {
+	*mock.Call
+}
+
+// AddAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - auditEvent *entities.AuditEvent
+//   - dbTx entities.Tx
+func (_e *stateAuditEventManager_Expecter) AddAuditEvent(ctx interface{}, auditEvent interface{}, dbTx interface{}) *stateAuditEventManager_AddAuditEvent_Call {
+	return &stateAuditEventManager_AddAuditEvent_Call{Call: _e.mock.On("AddAuditEvent", ctx, auditEvent, dbTx)}
+}
+
+func (_c *stateAuditEventManager_AddAuditEvent_Call) Run(run func(ctx context.Context, auditEvent *entities.AuditEvent, dbTx entities.Tx)) *stateAuditEventManager_AddAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.AuditEvent), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateAuditEventManager_AddAuditEvent_Call) Return(_a0 error) *stateAuditEventManager_AddAuditEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stateAuditEventManager_AddAuditEvent_Call) RunAndReturn(run func(context.Context, *entities.AuditEvent, entities.Tx) error) *stateAuditEventManager_AddAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newStateAuditEventManager creates a new instance of stateAuditEventManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newStateAuditEventManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *stateAuditEventManager {
+	mock := &stateAuditEventManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,133 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// stateForcedBatchManager is an autogenerated mock type for the stateForcedBatchManager type
+type stateForcedBatchManager struct {
+	mock.Mock
+}
+
+type stateForcedBatchManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *stateForcedBatchManager) EXPECT() *stateForcedBatchManager_Expecter {
+	return &stateForcedBatchManager_Expecter{mock: &_m.Mock}
+}
+
+// AddForcedBatch provides a mock function with given fields: ctx, forcedBatch, dbTx
+func (_m *stateForcedBatchManager) AddForcedBatch(ctx context.Context, forcedBatch *entities.ForcedBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, forcedBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddForcedBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ForcedBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, forcedBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stateForcedBatchManager_AddForcedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddForcedBatch'
+type stateForcedBatchManager_AddForcedBatch_Call struct {
+	*mock.Call
+}
+
+// AddForcedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - forcedBatch *entities.ForcedBatch
+//   - dbTx entities.Tx
+func (_e *stateForcedBatchManager_Expecter) AddForcedBatch(ctx interface{}, forcedBatch interface{}, dbTx interface{}) *stateForcedBatchManager_AddForcedBatch_Call {
+	return &stateForcedBatchManager_AddForcedBatch_Call{Call: _e.mock.On("AddForcedBatch", ctx, forcedBatch, dbTx)}
+}
+
+func (_c *stateForcedBatchManager_AddForcedBatch_Call) Run(run func(ctx context.Context, forcedBatch *entities.ForcedBatch, dbTx entities.Tx)) *stateForcedBatchManager_AddForcedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ForcedBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateForcedBatchManager_AddForcedBatch_Call) Return(_a0 error) *stateForcedBatchManager_AddForcedBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stateForcedBatchManager_AddForcedBatch_Call) RunAndReturn(run func(context.Context, *entities.ForcedBatch, entities.Tx) error) *stateForcedBatchManager_AddForcedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OnSequencedForceBatchesOnL1 provides a mock function with given fields: ctx, sequencedForceBatches, dbTx
+func (_m *stateForcedBatchManager) OnSequencedForceBatchesOnL1(ctx context.Context, sequencedForceBatches []*entities.SequencedForceBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, sequencedForceBatches, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OnSequencedForceBatchesOnL1")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*entities.SequencedForceBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, sequencedForceBatches, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stateForcedBatchManager_OnSequencedForceBatchesOnL1_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnSequencedForceBatchesOnL1'
+type stateForcedBatchManager_OnSequencedForceBatchesOnL1_Call struct {
+	*mock.Call
+}
+
+// OnSequencedForceBatchesOnL1 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sequencedForceBatches []*entities.SequencedForceBatch
+//   - dbTx entities.Tx
+func (_e *stateForcedBatchManager_Expecter) OnSequencedForceBatchesOnL1(ctx interface{}, sequencedForceBatches interface{}, dbTx interface{}) *stateForcedBatchManager_OnSequencedForceBatchesOnL1_Call {
+	return &stateForcedBatchManager_OnSequencedForceBatchesOnL1_Call{Call: _e.mock.On("OnSequencedForceBatchesOnL1", ctx, sequencedForceBatches, dbTx)}
+}
+
+func (_c *stateForcedBatchManager_OnSequencedForceBatchesOnL1_Call) Run(run func(ctx context.Context, sequencedForceBatches []*entities.SequencedForceBatch, dbTx entities.Tx)) *stateForcedBatchManager_OnSequencedForceBatchesOnL1_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*entities.SequencedForceBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateForcedBatchManager_OnSequencedForceBatchesOnL1_Call) Return(_a0 error) *stateForcedBatchManager_OnSequencedForceBatchesOnL1_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stateForcedBatchManager_OnSequencedForceBatchesOnL1_Call) RunAndReturn(run func(context.Context, []*entities.SequencedForceBatch, entities.Tx) error) *stateForcedBatchManager_OnSequencedForceBatchesOnL1_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newStateForcedBatchManager creates a new instance of stateForcedBatchManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newStateForcedBatchManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *stateForcedBatchManager {
+	mock := &stateForcedBatchManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
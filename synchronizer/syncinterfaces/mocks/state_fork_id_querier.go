@@ -22,17 +22,17 @@ func (_m *StateForkIdQuerier) EXPECT() *StateForkIdQuerier_Expecter {
 	return &StateForkIdQuerier_Expecter{mock: &_m.Mock}
 }
 
-// GetForkIDByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *StateForkIdQuerier) GetForkIDByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) uint64 {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetForkIDByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *StateForkIdQuerier) GetForkIDByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) uint64 {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetForkIDByBatchNumber")
 	}
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) uint64); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) uint64); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
@@ -47,15 +47,16 @@ type StateForkIdQuerier_GetForkIDByBatchNumber_Call struct {
 
 // GetForkIDByBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *StateForkIdQuerier_Expecter) GetForkIDByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StateForkIdQuerier_GetForkIDByBatchNumber_Call {
-	return &StateForkIdQuerier_GetForkIDByBatchNumber_Call{Call: _e.mock.On("GetForkIDByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *StateForkIdQuerier_Expecter) GetForkIDByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *StateForkIdQuerier_GetForkIDByBatchNumber_Call {
+	return &StateForkIdQuerier_GetForkIDByBatchNumber_Call{Call: _e.mock.On("GetForkIDByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *StateForkIdQuerier_GetForkIDByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *StateForkIdQuerier_GetForkIDByBatchNumber_Call {
+func (_c *StateForkIdQuerier_GetForkIDByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *StateForkIdQuerier_GetForkIDByBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
@@ -65,22 +66,22 @@ func (_c *StateForkIdQuerier_GetForkIDByBatchNumber_Call) Return(_a0 uint64) *St
 	return _c
 }
 
-func (_c *StateForkIdQuerier_GetForkIDByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) uint64) *StateForkIdQuerier_GetForkIDByBatchNumber_Call {
+func (_c *StateForkIdQuerier_GetForkIDByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) uint64) *StateForkIdQuerier_GetForkIDByBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetForkIDByBlockNumber provides a mock function with given fields: ctx, blockNumber, dbTx
-func (_m *StateForkIdQuerier) GetForkIDByBlockNumber(ctx context.Context, blockNumber uint64, dbTx entities.Tx) uint64 {
-	ret := _m.Called(ctx, blockNumber, dbTx)
+// GetForkIDByBlockNumber provides a mock function with given fields: ctx, rollupID, blockNumber, dbTx
+func (_m *StateForkIdQuerier) GetForkIDByBlockNumber(ctx context.Context, rollupID uint64, blockNumber uint64, dbTx entities.Tx) uint64 {
+	ret := _m.Called(ctx, rollupID, blockNumber, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetForkIDByBlockNumber")
 	}
 
 	var r0 uint64
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) uint64); ok {
-		r0 = rf(ctx, blockNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) uint64); ok {
+		r0 = rf(ctx, rollupID, blockNumber, dbTx)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
@@ -95,15 +96,16 @@ type StateForkIdQuerier_GetForkIDByBlockNumber_Call struct {
 
 // GetForkIDByBlockNumber is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - blockNumber uint64
 //   - dbTx entities.Tx
-func (_e *StateForkIdQuerier_Expecter) GetForkIDByBlockNumber(ctx interface{}, blockNumber interface{}, dbTx interface{}) *StateForkIdQuerier_GetForkIDByBlockNumber_Call {
-	return &StateForkIdQuerier_GetForkIDByBlockNumber_Call{Call: _e.mock.On("GetForkIDByBlockNumber", ctx, blockNumber, dbTx)}
+func (_e *StateForkIdQuerier_Expecter) GetForkIDByBlockNumber(ctx interface{}, rollupID interface{}, blockNumber interface{}, dbTx interface{}) *StateForkIdQuerier_GetForkIDByBlockNumber_Call {
+	return &StateForkIdQuerier_GetForkIDByBlockNumber_Call{Call: _e.mock.On("GetForkIDByBlockNumber", ctx, rollupID, blockNumber, dbTx)}
 }
 
-func (_c *StateForkIdQuerier_GetForkIDByBlockNumber_Call) Run(run func(ctx context.Context, blockNumber uint64, dbTx entities.Tx)) *StateForkIdQuerier_GetForkIDByBlockNumber_Call {
+func (_c *StateForkIdQuerier_GetForkIDByBlockNumber_Call) Run(run func(ctx context.Context, rollupID uint64, blockNumber uint64, dbTx entities.Tx)) *StateForkIdQuerier_GetForkIDByBlockNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
@@ -113,7 +115,7 @@ func (_c *StateForkIdQuerier_GetForkIDByBlockNumber_Call) Return(_a0 uint64) *St
 	return _c
 }
 
-func (_c *StateForkIdQuerier_GetForkIDByBlockNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) uint64) *StateForkIdQuerier_GetForkIDByBlockNumber_Call {
+func (_c *StateForkIdQuerier_GetForkIDByBlockNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) uint64) *StateForkIdQuerier_GetForkIDByBlockNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
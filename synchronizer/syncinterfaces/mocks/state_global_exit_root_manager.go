@@ -0,0 +1,85 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// stateGlobalExitRootManager is an autogenerated mock type for the stateGlobalExitRootManager type
+type stateGlobalExitRootManager struct {
+	mock.Mock
+}
+
+type stateGlobalExitRootManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *stateGlobalExitRootManager) EXPECT() *stateGlobalExitRootManager_Expecter {
+	return &stateGlobalExitRootManager_Expecter{mock: &_m.Mock}
+}
+
+// AddGlobalExitRoot provides a mock function with given fields: ctx, globalExitRoot, dbTx
+func (_m *stateGlobalExitRootManager) AddGlobalExitRoot(ctx context.Context, globalExitRoot *entities.GlobalExitRoot, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, globalExitRoot, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGlobalExitRoot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.GlobalExitRoot, entities.Tx) error); ok {
+		r0 = rf(ctx, globalExitRoot, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stateGlobalExitRootManager_AddGlobalExitRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGlobalExitRoot'
+type stateGlobalExitRootManager_AddGlobalExitRoot_Call struct {
+	*mock.Call
+}
+
+// AddGlobalExitRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - globalExitRoot *entities.GlobalExitRoot
+//   - dbTx entities.Tx
+func (_e *stateGlobalExitRootManager_Expecter) AddGlobalExitRoot(ctx interface{}, globalExitRoot interface{}, dbTx interface{}) *stateGlobalExitRootManager_AddGlobalExitRoot_Call {
+	return &stateGlobalExitRootManager_AddGlobalExitRoot_Call{Call: _e.mock.On("AddGlobalExitRoot", ctx, globalExitRoot, dbTx)}
+}
+
+func (_c *stateGlobalExitRootManager_AddGlobalExitRoot_Call) Run(run func(ctx context.Context, globalExitRoot *entities.GlobalExitRoot, dbTx entities.Tx)) *stateGlobalExitRootManager_AddGlobalExitRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.GlobalExitRoot), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateGlobalExitRootManager_AddGlobalExitRoot_Call) Return(_a0 error) *stateGlobalExitRootManager_AddGlobalExitRoot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stateGlobalExitRootManager_AddGlobalExitRoot_Call) RunAndReturn(run func(context.Context, *entities.GlobalExitRoot, entities.Tx) error) *stateGlobalExitRootManager_AddGlobalExitRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newStateGlobalExitRootManager creates a new instance of stateGlobalExitRootManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newStateGlobalExitRootManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *stateGlobalExitRootManager {
+	mock := &stateGlobalExitRootManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
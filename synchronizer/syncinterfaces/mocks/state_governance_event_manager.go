@@ -0,0 +1,85 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// stateGovernanceEventManager is an autogenerated mock type for the stateGovernanceEventManager type
+type stateGovernanceEventManager struct {
+	mock.Mock
+}
+
+type stateGovernanceEventManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *stateGovernanceEventManager) EXPECT() *stateGovernanceEventManager_Expecter {
+	return &stateGovernanceEventManager_Expecter{mock: &_m.Mock}
+}
+
+// AddGovernanceEvent provides a mock function with given fields: ctx, governanceEvent, dbTx
+func (_m *stateGovernanceEventManager) AddGovernanceEvent(ctx context.Context, governanceEvent *entities.GovernanceEvent, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, governanceEvent, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGovernanceEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.GovernanceEvent, entities.Tx) error); ok {
+		r0 = rf(ctx, governanceEvent, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stateGovernanceEventManager_AddGovernanceEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGovernanceEvent'
+type stateGovernanceEventManager_AddGovernanceEvent_Call struct {
+	*mock.Call
+}
+
+// AddGovernanceEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - governanceEvent *entities.GovernanceEvent
+//   - dbTx entities.Tx
+func (_e *stateGovernanceEventManager_Expecter) AddGovernanceEvent(ctx interface{}, governanceEvent interface{}, dbTx interface{}) *stateGovernanceEventManager_AddGovernanceEvent_Call {
+	return &stateGovernanceEventManager_AddGovernanceEvent_Call{Call: _e.mock.On("AddGovernanceEvent", ctx, governanceEvent, dbTx)}
+}
+
+func (_c *stateGovernanceEventManager_AddGovernanceEvent_Call) Run(run func(ctx context.Context, governanceEvent *entities.GovernanceEvent, dbTx entities.Tx)) *stateGovernanceEventManager_AddGovernanceEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.GovernanceEvent), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateGovernanceEventManager_AddGovernanceEvent_Call) Return(_a0 error) *stateGovernanceEventManager_AddGovernanceEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stateGovernanceEventManager_AddGovernanceEvent_Call) RunAndReturn(run func(context.Context, *entities.GovernanceEvent, entities.Tx) error) *stateGovernanceEventManager_AddGovernanceEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newStateGovernanceEventManager creates a new instance of stateGovernanceEventManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newStateGovernanceEventManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *stateGovernanceEventManager {
+	mock := &stateGovernanceEventManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -27,6 +27,54 @@ func (_m *StateInterface) EXPECT() *StateInterface_Expecter {
 	return &StateInterface_Expecter{mock: &_m.Mock}
 }
 
+// AddAuditEvent provides a mock function with given fields: ctx, auditEvent, dbTx
+func (_m *StateInterface) AddAuditEvent(ctx context.Context, auditEvent *entities.AuditEvent, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, auditEvent, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAuditEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.AuditEvent, entities.Tx) error); ok {
+		r0 = rf(ctx, auditEvent, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateInterface_AddAuditEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAuditEvent'
+type StateInterface_AddAuditEvent_Call struct {
+	*mock.Call
+}
+
+// AddAuditEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - auditEvent *entities.AuditEvent
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) AddAuditEvent(ctx interface{}, auditEvent interface{}, dbTx interface{}) *StateInterface_AddAuditEvent_Call {
+	return &StateInterface_AddAuditEvent_Call{Call: _e.mock.On("AddAuditEvent", ctx, auditEvent, dbTx)}
+}
+
+func (_c *StateInterface_AddAuditEvent_Call) Run(run func(ctx context.Context, auditEvent *entities.AuditEvent, dbTx entities.Tx)) *StateInterface_AddAuditEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.AuditEvent), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_AddAuditEvent_Call) Return(_a0 error) *StateInterface_AddAuditEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateInterface_AddAuditEvent_Call) RunAndReturn(run func(context.Context, *entities.AuditEvent, entities.Tx) error) *StateInterface_AddAuditEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddBlock provides a mock function with given fields: ctx, block, dbTx
 func (_m *StateInterface) AddBlock(ctx context.Context, block *entities.L1Block, dbTx entities.Tx) error {
 	ret := _m.Called(ctx, block, dbTx)
@@ -75,6 +123,54 @@ func (_c *StateInterface_AddBlock_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// AddForcedBatch provides a mock function with given fields: ctx, forcedBatch, dbTx
+func (_m *StateInterface) AddForcedBatch(ctx context.Context, forcedBatch *entities.ForcedBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, forcedBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddForcedBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.ForcedBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, forcedBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateInterface_AddForcedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddForcedBatch'
+type StateInterface_AddForcedBatch_Call struct {
+	*mock.Call
+}
+
+// AddForcedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - forcedBatch *entities.ForcedBatch
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) AddForcedBatch(ctx interface{}, forcedBatch interface{}, dbTx interface{}) *StateInterface_AddForcedBatch_Call {
+	return &StateInterface_AddForcedBatch_Call{Call: _e.mock.On("AddForcedBatch", ctx, forcedBatch, dbTx)}
+}
+
+func (_c *StateInterface_AddForcedBatch_Call) Run(run func(ctx context.Context, forcedBatch *entities.ForcedBatch, dbTx entities.Tx)) *StateInterface_AddForcedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.ForcedBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_AddForcedBatch_Call) Return(_a0 error) *StateInterface_AddForcedBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateInterface_AddForcedBatch_Call) RunAndReturn(run func(context.Context, *entities.ForcedBatch, entities.Tx) error) *StateInterface_AddForcedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddForkID provides a mock function with given fields: ctx, newForkID, dbTx
 func (_m *StateInterface) AddForkID(ctx context.Context, newForkID entities.ForkIDInterval, dbTx entities.Tx) error {
 	ret := _m.Called(ctx, newForkID, dbTx)
@@ -123,6 +219,102 @@ func (_c *StateInterface_AddForkID_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AddGlobalExitRoot provides a mock function with given fields: ctx, globalExitRoot, dbTx
+func (_m *StateInterface) AddGlobalExitRoot(ctx context.Context, globalExitRoot *entities.GlobalExitRoot, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, globalExitRoot, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGlobalExitRoot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.GlobalExitRoot, entities.Tx) error); ok {
+		r0 = rf(ctx, globalExitRoot, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateInterface_AddGlobalExitRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGlobalExitRoot'
+type StateInterface_AddGlobalExitRoot_Call struct {
+	*mock.Call
+}
+
+// AddGlobalExitRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - globalExitRoot *entities.GlobalExitRoot
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) AddGlobalExitRoot(ctx interface{}, globalExitRoot interface{}, dbTx interface{}) *StateInterface_AddGlobalExitRoot_Call {
+	return &StateInterface_AddGlobalExitRoot_Call{Call: _e.mock.On("AddGlobalExitRoot", ctx, globalExitRoot, dbTx)}
+}
+
+func (_c *StateInterface_AddGlobalExitRoot_Call) Run(run func(ctx context.Context, globalExitRoot *entities.GlobalExitRoot, dbTx entities.Tx)) *StateInterface_AddGlobalExitRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.GlobalExitRoot), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_AddGlobalExitRoot_Call) Return(_a0 error) *StateInterface_AddGlobalExitRoot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateInterface_AddGlobalExitRoot_Call) RunAndReturn(run func(context.Context, *entities.GlobalExitRoot, entities.Tx) error) *StateInterface_AddGlobalExitRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddGovernanceEvent provides a mock function with given fields: ctx, governanceEvent, dbTx
+func (_m *StateInterface) AddGovernanceEvent(ctx context.Context, governanceEvent *entities.GovernanceEvent, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, governanceEvent, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddGovernanceEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.GovernanceEvent, entities.Tx) error); ok {
+		r0 = rf(ctx, governanceEvent, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateInterface_AddGovernanceEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddGovernanceEvent'
+type StateInterface_AddGovernanceEvent_Call struct {
+	*mock.Call
+}
+
+// AddGovernanceEvent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - governanceEvent *entities.GovernanceEvent
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) AddGovernanceEvent(ctx interface{}, governanceEvent interface{}, dbTx interface{}) *StateInterface_AddGovernanceEvent_Call {
+	return &StateInterface_AddGovernanceEvent_Call{Call: _e.mock.On("AddGovernanceEvent", ctx, governanceEvent, dbTx)}
+}
+
+func (_c *StateInterface_AddGovernanceEvent_Call) Run(run func(ctx context.Context, governanceEvent *entities.GovernanceEvent, dbTx entities.Tx)) *StateInterface_AddGovernanceEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.GovernanceEvent), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_AddGovernanceEvent_Call) Return(_a0 error) *StateInterface_AddGovernanceEvent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateInterface_AddGovernanceEvent_Call) RunAndReturn(run func(context.Context, *entities.GovernanceEvent, entities.Tx) error) *StateInterface_AddGovernanceEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddL1InfoTreeLeafAndAssignIndex provides a mock function with given fields: ctx, exitRoot, dbTx
 func (_m *StateInterface) AddL1InfoTreeLeafAndAssignIndex(ctx context.Context, exitRoot *entities.L1InfoTreeLeaf, dbTx entities.Tx) (*entities.L1InfoTreeLeaf, error) {
 	ret := _m.Called(ctx, exitRoot, dbTx)
@@ -216,411 +408,1221 @@ func (_c *StateInterface_AddOnReorgCallback_Call) RunAndReturn(run func(func(mod
 	return _c
 }
 
-// BeginTransaction provides a mock function with given fields: ctx
-func (_m *StateInterface) BeginTransaction(ctx context.Context) (entities.Tx, error) {
-	ret := _m.Called(ctx)
+// AddPendingState provides a mock function with given fields: ctx, pendingState, dbTx
+func (_m *StateInterface) AddPendingState(ctx context.Context, pendingState *entities.PendingState, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, pendingState, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BeginTransaction")
-	}
-
-	var r0 entities.Tx
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (entities.Tx, error)); ok {
-		return rf(ctx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context) entities.Tx); ok {
-		r0 = rf(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(entities.Tx)
-		}
+		panic("no return value specified for AddPendingState")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.PendingState, entities.Tx) error); ok {
+		r0 = rf(ctx, pendingState, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// StateInterface_BeginTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTransaction'
-type StateInterface_BeginTransaction_Call struct {
+// StateInterface_AddPendingState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPendingState'
+type StateInterface_AddPendingState_Call struct {
 	*mock.Call
 }
 
-// BeginTransaction is a helper method to define mock.On call
+// AddPendingState is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *StateInterface_Expecter) BeginTransaction(ctx interface{}) *StateInterface_BeginTransaction_Call {
-	return &StateInterface_BeginTransaction_Call{Call: _e.mock.On("BeginTransaction", ctx)}
+//   - pendingState *entities.PendingState
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) AddPendingState(ctx interface{}, pendingState interface{}, dbTx interface{}) *StateInterface_AddPendingState_Call {
+	return &StateInterface_AddPendingState_Call{Call: _e.mock.On("AddPendingState", ctx, pendingState, dbTx)}
 }
 
-func (_c *StateInterface_BeginTransaction_Call) Run(run func(ctx context.Context)) *StateInterface_BeginTransaction_Call {
+func (_c *StateInterface_AddPendingState_Call) Run(run func(ctx context.Context, pendingState *entities.PendingState, dbTx entities.Tx)) *StateInterface_AddPendingState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(*entities.PendingState), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_BeginTransaction_Call) Return(_a0 entities.Tx, _a1 error) *StateInterface_BeginTransaction_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StateInterface_AddPendingState_Call) Return(_a0 error) *StateInterface_AddPendingState_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_BeginTransaction_Call) RunAndReturn(run func(context.Context) (entities.Tx, error)) *StateInterface_BeginTransaction_Call {
+func (_c *StateInterface_AddPendingState_Call) RunAndReturn(run func(context.Context, *entities.PendingState, entities.Tx) error) *StateInterface_AddPendingState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExecuteReorg provides a mock function with given fields: ctx, reorgRequest, dbTx
-func (_m *StateInterface) ExecuteReorg(ctx context.Context, reorgRequest model.ReorgRequest, dbTx entities.Tx) model.ReorgExecutionResult {
-	ret := _m.Called(ctx, reorgRequest, dbTx)
+// AddRollup provides a mock function with given fields: ctx, rollup, dbTx
+func (_m *StateInterface) AddRollup(ctx context.Context, rollup *entities.Rollup, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollup, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExecuteReorg")
+		panic("no return value specified for AddRollup")
 	}
 
-	var r0 model.ReorgExecutionResult
-	if rf, ok := ret.Get(0).(func(context.Context, model.ReorgRequest, entities.Tx) model.ReorgExecutionResult); ok {
-		r0 = rf(ctx, reorgRequest, dbTx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Rollup, entities.Tx) error); ok {
+		r0 = rf(ctx, rollup, dbTx)
 	} else {
-		r0 = ret.Get(0).(model.ReorgExecutionResult)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// StateInterface_ExecuteReorg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteReorg'
-type StateInterface_ExecuteReorg_Call struct {
+// StateInterface_AddRollup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollup'
+type StateInterface_AddRollup_Call struct {
 	*mock.Call
 }
 
-// ExecuteReorg is a helper method to define mock.On call
+// AddRollup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - reorgRequest model.ReorgRequest
+//   - rollup *entities.Rollup
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) ExecuteReorg(ctx interface{}, reorgRequest interface{}, dbTx interface{}) *StateInterface_ExecuteReorg_Call {
-	return &StateInterface_ExecuteReorg_Call{Call: _e.mock.On("ExecuteReorg", ctx, reorgRequest, dbTx)}
+func (_e *StateInterface_Expecter) AddRollup(ctx interface{}, rollup interface{}, dbTx interface{}) *StateInterface_AddRollup_Call {
+	return &StateInterface_AddRollup_Call{Call: _e.mock.On("AddRollup", ctx, rollup, dbTx)}
 }
 
-func (_c *StateInterface_ExecuteReorg_Call) Run(run func(ctx context.Context, reorgRequest model.ReorgRequest, dbTx entities.Tx)) *StateInterface_ExecuteReorg_Call {
+func (_c *StateInterface_AddRollup_Call) Run(run func(ctx context.Context, rollup *entities.Rollup, dbTx entities.Tx)) *StateInterface_AddRollup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.ReorgRequest), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(*entities.Rollup), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_ExecuteReorg_Call) Return(_a0 model.ReorgExecutionResult) *StateInterface_ExecuteReorg_Call {
+func (_c *StateInterface_AddRollup_Call) Return(_a0 error) *StateInterface_AddRollup_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_ExecuteReorg_Call) RunAndReturn(run func(context.Context, model.ReorgRequest, entities.Tx) model.ReorgExecutionResult) *StateInterface_ExecuteReorg_Call {
+func (_c *StateInterface_AddRollup_Call) RunAndReturn(run func(context.Context, *entities.Rollup, entities.Tx) error) *StateInterface_AddRollup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetBlockByNumber provides a mock function with given fields: ctx, blockNumber, dbTx
-func (_m *StateInterface) GetBlockByNumber(ctx context.Context, blockNumber uint64, dbTx entities.Tx) (*entities.L1Block, error) {
-	ret := _m.Called(ctx, blockNumber, dbTx)
+// AddRollupType provides a mock function with given fields: ctx, rollupType, dbTx
+func (_m *StateInterface) AddRollupType(ctx context.Context, rollupType *entities.RollupType, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupType, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBlockByNumber")
-	}
-
-	var r0 *entities.L1Block
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
-		return rf(ctx, blockNumber, dbTx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
-		r0 = rf(ctx, blockNumber, dbTx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.L1Block)
-		}
+		panic("no return value specified for AddRollupType")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, blockNumber, dbTx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RollupType, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupType, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// StateInterface_GetBlockByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockByNumber'
-type StateInterface_GetBlockByNumber_Call struct {
+// StateInterface_AddRollupType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollupType'
+type StateInterface_AddRollupType_Call struct {
 	*mock.Call
 }
 
-// GetBlockByNumber is a helper method to define mock.On call
+// AddRollupType is a helper method to define mock.On call
 //   - ctx context.Context
-//   - blockNumber uint64
+//   - rollupType *entities.RollupType
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) GetBlockByNumber(ctx interface{}, blockNumber interface{}, dbTx interface{}) *StateInterface_GetBlockByNumber_Call {
-	return &StateInterface_GetBlockByNumber_Call{Call: _e.mock.On("GetBlockByNumber", ctx, blockNumber, dbTx)}
+func (_e *StateInterface_Expecter) AddRollupType(ctx interface{}, rollupType interface{}, dbTx interface{}) *StateInterface_AddRollupType_Call {
+	return &StateInterface_AddRollupType_Call{Call: _e.mock.On("AddRollupType", ctx, rollupType, dbTx)}
 }
 
-func (_c *StateInterface_GetBlockByNumber_Call) Run(run func(ctx context.Context, blockNumber uint64, dbTx entities.Tx)) *StateInterface_GetBlockByNumber_Call {
+func (_c *StateInterface_AddRollupType_Call) Run(run func(ctx context.Context, rollupType *entities.RollupType, dbTx entities.Tx)) *StateInterface_AddRollupType_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(*entities.RollupType), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_GetBlockByNumber_Call) Return(_a0 *entities.L1Block, _a1 error) *StateInterface_GetBlockByNumber_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StateInterface_AddRollupType_Call) Return(_a0 error) *StateInterface_AddRollupType_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_GetBlockByNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *StateInterface_GetBlockByNumber_Call {
+func (_c *StateInterface_AddRollupType_Call) RunAndReturn(run func(context.Context, *entities.RollupType, entities.Tx) error) *StateInterface_AddRollupType_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFirstUncheckedBlock provides a mock function with given fields: ctx, fromBlockNumber, dbTx
-func (_m *StateInterface) GetFirstUncheckedBlock(ctx context.Context, fromBlockNumber uint64, dbTx entities.Tx) (*entities.L1Block, error) {
-	ret := _m.Called(ctx, fromBlockNumber, dbTx)
+// AddVerifiedBatch provides a mock function with given fields: ctx, verifiedBatch, dbTx
+func (_m *StateInterface) AddVerifiedBatch(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, verifiedBatch, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetFirstUncheckedBlock")
+		panic("no return value specified for AddVerifiedBatch")
 	}
 
-	var r0 *entities.L1Block
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
-		return rf(ctx, fromBlockNumber, dbTx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
-		r0 = rf(ctx, fromBlockNumber, dbTx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.L1Block)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, fromBlockNumber, dbTx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.VerifiedBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, verifiedBatch, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// StateInterface_GetFirstUncheckedBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFirstUncheckedBlock'
-type StateInterface_GetFirstUncheckedBlock_Call struct {
+// StateInterface_AddVerifiedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVerifiedBatch'
+type StateInterface_AddVerifiedBatch_Call struct {
 	*mock.Call
 }
 
-// GetFirstUncheckedBlock is a helper method to define mock.On call
+// AddVerifiedBatch is a helper method to define mock.On call
 //   - ctx context.Context
-//   - fromBlockNumber uint64
+//   - verifiedBatch *entities.VerifiedBatch
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) GetFirstUncheckedBlock(ctx interface{}, fromBlockNumber interface{}, dbTx interface{}) *StateInterface_GetFirstUncheckedBlock_Call {
-	return &StateInterface_GetFirstUncheckedBlock_Call{Call: _e.mock.On("GetFirstUncheckedBlock", ctx, fromBlockNumber, dbTx)}
+func (_e *StateInterface_Expecter) AddVerifiedBatch(ctx interface{}, verifiedBatch interface{}, dbTx interface{}) *StateInterface_AddVerifiedBatch_Call {
+	return &StateInterface_AddVerifiedBatch_Call{Call: _e.mock.On("AddVerifiedBatch", ctx, verifiedBatch, dbTx)}
 }
 
-func (_c *StateInterface_GetFirstUncheckedBlock_Call) Run(run func(ctx context.Context, fromBlockNumber uint64, dbTx entities.Tx)) *StateInterface_GetFirstUncheckedBlock_Call {
+func (_c *StateInterface_AddVerifiedBatch_Call) Run(run func(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx)) *StateInterface_AddVerifiedBatch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(*entities.VerifiedBatch), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_GetFirstUncheckedBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *StateInterface_GetFirstUncheckedBlock_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StateInterface_AddVerifiedBatch_Call) Return(_a0 error) *StateInterface_AddVerifiedBatch_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_GetFirstUncheckedBlock_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *StateInterface_GetFirstUncheckedBlock_Call {
+func (_c *StateInterface_AddVerifiedBatch_Call) RunAndReturn(run func(context.Context, *entities.VerifiedBatch, entities.Tx) error) *StateInterface_AddVerifiedBatch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetForkIDByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *StateInterface) GetForkIDByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) uint64 {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// BeginTransaction provides a mock function with given fields: ctx
+func (_m *StateInterface) BeginTransaction(ctx context.Context) (entities.Tx, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginTransaction")
+	}
+
+	var r0 entities.Tx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (entities.Tx, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) entities.Tx); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(entities.Tx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_BeginTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginTransaction'
+type StateInterface_BeginTransaction_Call struct {
+	*mock.Call
+}
+
+// BeginTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StateInterface_Expecter) BeginTransaction(ctx interface{}) *StateInterface_BeginTransaction_Call {
+	return &StateInterface_BeginTransaction_Call{Call: _e.mock.On("BeginTransaction", ctx)}
+}
+
+func (_c *StateInterface_BeginTransaction_Call) Run(run func(ctx context.Context)) *StateInterface_BeginTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StateInterface_BeginTransaction_Call) Return(_a0 entities.Tx, _a1 error) *StateInterface_BeginTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_BeginTransaction_Call) RunAndReturn(run func(context.Context) (entities.Tx, error)) *StateInterface_BeginTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsolidatePendingStates provides a mock function with given fields: ctx, rollupID, batchNumber, blockNumber, dbTx
+func (_m *StateInterface) ConsolidatePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupID, batchNumber, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsolidatePendingStates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupID, batchNumber, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StateInterface_ConsolidatePendingStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsolidatePendingStates'
+type StateInterface_ConsolidatePendingStates_Call struct {
+	*mock.Call
+}
+
+// ConsolidatePendingStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) ConsolidatePendingStates(ctx interface{}, rollupID interface{}, batchNumber interface{}, blockNumber interface{}, dbTx interface{}) *StateInterface_ConsolidatePendingStates_Call {
+	return &StateInterface_ConsolidatePendingStates_Call{Call: _e.mock.On("ConsolidatePendingStates", ctx, rollupID, batchNumber, blockNumber, dbTx)}
+}
+
+func (_c *StateInterface_ConsolidatePendingStates_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx entities.Tx)) *StateInterface_ConsolidatePendingStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(uint64), args[4].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_ConsolidatePendingStates_Call) Return(_a0 error) *StateInterface_ConsolidatePendingStates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateInterface_ConsolidatePendingStates_Call) RunAndReturn(run func(context.Context, uint64, uint64, uint64, entities.Tx) error) *StateInterface_ConsolidatePendingStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExecuteReorg provides a mock function with given fields: ctx, reorgRequest, dbTx
+func (_m *StateInterface) ExecuteReorg(ctx context.Context, reorgRequest model.ReorgRequest, dbTx entities.Tx) model.ReorgExecutionResult {
+	ret := _m.Called(ctx, reorgRequest, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteReorg")
+	}
+
+	var r0 model.ReorgExecutionResult
+	if rf, ok := ret.Get(0).(func(context.Context, model.ReorgRequest, entities.Tx) model.ReorgExecutionResult); ok {
+		r0 = rf(ctx, reorgRequest, dbTx)
+	} else {
+		r0 = ret.Get(0).(model.ReorgExecutionResult)
+	}
+
+	return r0
+}
+
+// StateInterface_ExecuteReorg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteReorg'
+type StateInterface_ExecuteReorg_Call struct {
+	*mock.Call
+}
+
+// ExecuteReorg is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reorgRequest model.ReorgRequest
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) ExecuteReorg(ctx interface{}, reorgRequest interface{}, dbTx interface{}) *StateInterface_ExecuteReorg_Call {
+	return &StateInterface_ExecuteReorg_Call{Call: _e.mock.On("ExecuteReorg", ctx, reorgRequest, dbTx)}
+}
+
+func (_c *StateInterface_ExecuteReorg_Call) Run(run func(ctx context.Context, reorgRequest model.ReorgRequest, dbTx entities.Tx)) *StateInterface_ExecuteReorg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.ReorgRequest), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_ExecuteReorg_Call) Return(_a0 model.ReorgExecutionResult) *StateInterface_ExecuteReorg_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateInterface_ExecuteReorg_Call) RunAndReturn(run func(context.Context, model.ReorgRequest, entities.Tx) model.ReorgExecutionResult) *StateInterface_ExecuteReorg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockByNumber provides a mock function with given fields: ctx, blockNumber, dbTx
+func (_m *StateInterface) GetBlockByNumber(ctx context.Context, blockNumber uint64, dbTx entities.Tx) (*entities.L1Block, error) {
+	ret := _m.Called(ctx, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockByNumber")
+	}
+
+	var r0 *entities.L1Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
+		return rf(ctx, blockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
+		r0 = rf(ctx, blockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.L1Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, blockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_GetBlockByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockByNumber'
+type StateInterface_GetBlockByNumber_Call struct {
+	*mock.Call
+}
+
+// GetBlockByNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetBlockByNumber(ctx interface{}, blockNumber interface{}, dbTx interface{}) *StateInterface_GetBlockByNumber_Call {
+	return &StateInterface_GetBlockByNumber_Call{Call: _e.mock.On("GetBlockByNumber", ctx, blockNumber, dbTx)}
+}
+
+func (_c *StateInterface_GetBlockByNumber_Call) Run(run func(ctx context.Context, blockNumber uint64, dbTx entities.Tx)) *StateInterface_GetBlockByNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetBlockByNumber_Call) Return(_a0 *entities.L1Block, _a1 error) *StateInterface_GetBlockByNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_GetBlockByNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *StateInterface_GetBlockByNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFirstUncheckedBlock provides a mock function with given fields: ctx, fromBlockNumber, dbTx
+func (_m *StateInterface) GetFirstUncheckedBlock(ctx context.Context, fromBlockNumber uint64, dbTx entities.Tx) (*entities.L1Block, error) {
+	ret := _m.Called(ctx, fromBlockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFirstUncheckedBlock")
+	}
+
+	var r0 *entities.L1Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
+		return rf(ctx, fromBlockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
+		r0 = rf(ctx, fromBlockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.L1Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, fromBlockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_GetFirstUncheckedBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFirstUncheckedBlock'
+type StateInterface_GetFirstUncheckedBlock_Call struct {
+	*mock.Call
+}
+
+// GetFirstUncheckedBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromBlockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetFirstUncheckedBlock(ctx interface{}, fromBlockNumber interface{}, dbTx interface{}) *StateInterface_GetFirstUncheckedBlock_Call {
+	return &StateInterface_GetFirstUncheckedBlock_Call{Call: _e.mock.On("GetFirstUncheckedBlock", ctx, fromBlockNumber, dbTx)}
+}
+
+func (_c *StateInterface_GetFirstUncheckedBlock_Call) Run(run func(ctx context.Context, fromBlockNumber uint64, dbTx entities.Tx)) *StateInterface_GetFirstUncheckedBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetFirstUncheckedBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *StateInterface_GetFirstUncheckedBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_GetFirstUncheckedBlock_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *StateInterface_GetFirstUncheckedBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForkIDByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *StateInterface) GetForkIDByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) uint64 {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForkIDByBatchNumber")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) uint64); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// StateInterface_GetForkIDByBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForkIDByBatchNumber'
+type StateInterface_GetForkIDByBatchNumber_Call struct {
+	*mock.Call
+}
+
+// GetForkIDByBatchNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetForkIDByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *StateInterface_GetForkIDByBatchNumber_Call {
+	return &StateInterface_GetForkIDByBatchNumber_Call{Call: _e.mock.On("GetForkIDByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
+}
+
+func (_c *StateInterface_GetForkIDByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *StateInterface_GetForkIDByBatchNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetForkIDByBatchNumber_Call) Return(_a0 uint64) *StateInterface_GetForkIDByBatchNumber_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateInterface_GetForkIDByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) uint64) *StateInterface_GetForkIDByBatchNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetForkIDByBlockNumber provides a mock function with given fields: ctx, rollupID, blockNumber, dbTx
+func (_m *StateInterface) GetForkIDByBlockNumber(ctx context.Context, rollupID uint64, blockNumber uint64, dbTx entities.Tx) uint64 {
+	ret := _m.Called(ctx, rollupID, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForkIDByBlockNumber")
+	}
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) uint64); ok {
+		r0 = rf(ctx, rollupID, blockNumber, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	return r0
+}
+
+// StateInterface_GetForkIDByBlockNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForkIDByBlockNumber'
+type StateInterface_GetForkIDByBlockNumber_Call struct {
+	*mock.Call
+}
+
+// GetForkIDByBlockNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetForkIDByBlockNumber(ctx interface{}, rollupID interface{}, blockNumber interface{}, dbTx interface{}) *StateInterface_GetForkIDByBlockNumber_Call {
+	return &StateInterface_GetForkIDByBlockNumber_Call{Call: _e.mock.On("GetForkIDByBlockNumber", ctx, rollupID, blockNumber, dbTx)}
+}
+
+func (_c *StateInterface_GetForkIDByBlockNumber_Call) Run(run func(ctx context.Context, rollupID uint64, blockNumber uint64, dbTx entities.Tx)) *StateInterface_GetForkIDByBlockNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetForkIDByBlockNumber_Call) Return(_a0 uint64) *StateInterface_GetForkIDByBlockNumber_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StateInterface_GetForkIDByBlockNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) uint64) *StateInterface_GetForkIDByBlockNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetL1InfoLeafPerIndex provides a mock function with given fields: ctx, L1InfoTreeIndex, dbTx
+func (_m *StateInterface) GetL1InfoLeafPerIndex(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx) (*entities.L1InfoTreeLeaf, error) {
+	ret := _m.Called(ctx, L1InfoTreeIndex, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL1InfoLeafPerIndex")
+	}
+
+	var r0 *entities.L1InfoTreeLeaf
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) (*entities.L1InfoTreeLeaf, error)); ok {
+		return rf(ctx, L1InfoTreeIndex, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) *entities.L1InfoTreeLeaf); ok {
+		r0 = rf(ctx, L1InfoTreeIndex, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.L1InfoTreeLeaf)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, entities.Tx) error); ok {
+		r1 = rf(ctx, L1InfoTreeIndex, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_GetL1InfoLeafPerIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetL1InfoLeafPerIndex'
+type StateInterface_GetL1InfoLeafPerIndex_Call struct {
+	*mock.Call
+}
+
+// GetL1InfoLeafPerIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - L1InfoTreeIndex uint32
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetL1InfoLeafPerIndex(ctx interface{}, L1InfoTreeIndex interface{}, dbTx interface{}) *StateInterface_GetL1InfoLeafPerIndex_Call {
+	return &StateInterface_GetL1InfoLeafPerIndex_Call{Call: _e.mock.On("GetL1InfoLeafPerIndex", ctx, L1InfoTreeIndex, dbTx)}
+}
+
+func (_c *StateInterface_GetL1InfoLeafPerIndex_Call) Run(run func(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx)) *StateInterface_GetL1InfoLeafPerIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetL1InfoLeafPerIndex_Call) Return(_a0 *entities.L1InfoTreeLeaf, _a1 error) *StateInterface_GetL1InfoLeafPerIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_GetL1InfoLeafPerIndex_Call) RunAndReturn(run func(context.Context, uint32, entities.Tx) (*entities.L1InfoTreeLeaf, error)) *StateInterface_GetL1InfoLeafPerIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetL1InfoRootPerLeafIndex provides a mock function with given fields: ctx, L1InfoTreeIndex, dbTx
+func (_m *StateInterface) GetL1InfoRootPerLeafIndex(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx) (common.Hash, error) {
+	ret := _m.Called(ctx, L1InfoTreeIndex, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL1InfoRootPerLeafIndex")
+	}
+
+	var r0 common.Hash
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) (common.Hash, error)); ok {
+		return rf(ctx, L1InfoTreeIndex, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) common.Hash); ok {
+		r0 = rf(ctx, L1InfoTreeIndex, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(common.Hash)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, entities.Tx) error); ok {
+		r1 = rf(ctx, L1InfoTreeIndex, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_GetL1InfoRootPerLeafIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetL1InfoRootPerLeafIndex'
+type StateInterface_GetL1InfoRootPerLeafIndex_Call struct {
+	*mock.Call
+}
+
+// GetL1InfoRootPerLeafIndex is a helper method to define mock.On call
+//   - ctx context.Context
+//   - L1InfoTreeIndex uint32
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetL1InfoRootPerLeafIndex(ctx interface{}, L1InfoTreeIndex interface{}, dbTx interface{}) *StateInterface_GetL1InfoRootPerLeafIndex_Call {
+	return &StateInterface_GetL1InfoRootPerLeafIndex_Call{Call: _e.mock.On("GetL1InfoRootPerLeafIndex", ctx, L1InfoTreeIndex, dbTx)}
+}
+
+func (_c *StateInterface_GetL1InfoRootPerLeafIndex_Call) Run(run func(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx)) *StateInterface_GetL1InfoRootPerLeafIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint32), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetL1InfoRootPerLeafIndex_Call) Return(_a0 common.Hash, _a1 error) *StateInterface_GetL1InfoRootPerLeafIndex_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_GetL1InfoRootPerLeafIndex_Call) RunAndReturn(run func(context.Context, uint32, entities.Tx) (common.Hash, error)) *StateInterface_GetL1InfoRootPerLeafIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetL1InfoTreeLeaves provides a mock function with given fields: ctx, indexLeaves, dbTx
+func (_m *StateInterface) GetL1InfoTreeLeaves(ctx context.Context, indexLeaves []uint32, dbTx entities.Tx) (map[uint32]entities.L1InfoTreeLeaf, error) {
+	ret := _m.Called(ctx, indexLeaves, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetL1InfoTreeLeaves")
+	}
+
+	var r0 map[uint32]entities.L1InfoTreeLeaf
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint32, entities.Tx) (map[uint32]entities.L1InfoTreeLeaf, error)); ok {
+		return rf(ctx, indexLeaves, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint32, entities.Tx) map[uint32]entities.L1InfoTreeLeaf); ok {
+		r0 = rf(ctx, indexLeaves, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint32]entities.L1InfoTreeLeaf)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint32, entities.Tx) error); ok {
+		r1 = rf(ctx, indexLeaves, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_GetL1InfoTreeLeaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetL1InfoTreeLeaves'
+type StateInterface_GetL1InfoTreeLeaves_Call struct {
+	*mock.Call
+}
+
+// GetL1InfoTreeLeaves is a helper method to define mock.On call
+//   - ctx context.Context
+//   - indexLeaves []uint32
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetL1InfoTreeLeaves(ctx interface{}, indexLeaves interface{}, dbTx interface{}) *StateInterface_GetL1InfoTreeLeaves_Call {
+	return &StateInterface_GetL1InfoTreeLeaves_Call{Call: _e.mock.On("GetL1InfoTreeLeaves", ctx, indexLeaves, dbTx)}
+}
+
+func (_c *StateInterface_GetL1InfoTreeLeaves_Call) Run(run func(ctx context.Context, indexLeaves []uint32, dbTx entities.Tx)) *StateInterface_GetL1InfoTreeLeaves_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]uint32), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetL1InfoTreeLeaves_Call) Return(_a0 map[uint32]entities.L1InfoTreeLeaf, _a1 error) *StateInterface_GetL1InfoTreeLeaves_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_GetL1InfoTreeLeaves_Call) RunAndReturn(run func(context.Context, []uint32, entities.Tx) (map[uint32]entities.L1InfoTreeLeaf, error)) *StateInterface_GetL1InfoTreeLeaves_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastBlock provides a mock function with given fields: ctx, dbTx
+func (_m *StateInterface) GetLastBlock(ctx context.Context, dbTx entities.Tx) (*entities.L1Block, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastBlock")
+	}
+
+	var r0 *entities.L1Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (*entities.L1Block, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) *entities.L1Block); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.L1Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_GetLastBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastBlock'
+type StateInterface_GetLastBlock_Call struct {
+	*mock.Call
+}
+
+// GetLastBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetLastBlock(ctx interface{}, dbTx interface{}) *StateInterface_GetLastBlock_Call {
+	return &StateInterface_GetLastBlock_Call{Call: _e.mock.On("GetLastBlock", ctx, dbTx)}
+}
+
+func (_c *StateInterface_GetLastBlock_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *StateInterface_GetLastBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetLastBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *StateInterface_GetLastBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_GetLastBlock_Call) RunAndReturn(run func(context.Context, entities.Tx) (*entities.L1Block, error)) *StateInterface_GetLastBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeafsByL1InfoRoot provides a mock function with given fields: ctx, l1InfoRoot, dbTx
+func (_m *StateInterface) GetLeafsByL1InfoRoot(ctx context.Context, l1InfoRoot common.Hash, dbTx entities.Tx) ([]entities.L1InfoTreeLeaf, error) {
+	ret := _m.Called(ctx, l1InfoRoot, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetForkIDByBatchNumber")
+		panic("no return value specified for GetLeafsByL1InfoRoot")
 	}
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) uint64); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	var r0 []entities.L1InfoTreeLeaf
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) ([]entities.L1InfoTreeLeaf, error)); ok {
+		return rf(ctx, l1InfoRoot, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) []entities.L1InfoTreeLeaf); ok {
+		r0 = rf(ctx, l1InfoRoot, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.L1InfoTreeLeaf)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, entities.Tx) error); ok {
+		r1 = rf(ctx, l1InfoRoot, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_GetLeafsByL1InfoRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeafsByL1InfoRoot'
+type StateInterface_GetLeafsByL1InfoRoot_Call struct {
+	*mock.Call
+}
+
+// GetLeafsByL1InfoRoot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - l1InfoRoot common.Hash
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetLeafsByL1InfoRoot(ctx interface{}, l1InfoRoot interface{}, dbTx interface{}) *StateInterface_GetLeafsByL1InfoRoot_Call {
+	return &StateInterface_GetLeafsByL1InfoRoot_Call{Call: _e.mock.On("GetLeafsByL1InfoRoot", ctx, l1InfoRoot, dbTx)}
+}
+
+func (_c *StateInterface_GetLeafsByL1InfoRoot_Call) Run(run func(ctx context.Context, l1InfoRoot common.Hash, dbTx entities.Tx)) *StateInterface_GetLeafsByL1InfoRoot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Hash), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetLeafsByL1InfoRoot_Call) Return(_a0 []entities.L1InfoTreeLeaf, _a1 error) *StateInterface_GetLeafsByL1InfoRoot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_GetLeafsByL1InfoRoot_Call) RunAndReturn(run func(context.Context, common.Hash, entities.Tx) ([]entities.L1InfoTreeLeaf, error)) *StateInterface_GetLeafsByL1InfoRoot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPreRollupSyncStatus provides a mock function with given fields: ctx, dbTx
+func (_m *StateInterface) GetPreRollupSyncStatus(ctx context.Context, dbTx entities.Tx) (*entities.PreRollupSyncStatus, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreRollupSyncStatus")
+	}
+
+	var r0 *entities.PreRollupSyncStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (*entities.PreRollupSyncStatus, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) *entities.PreRollupSyncStatus); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PreRollupSyncStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_GetPreRollupSyncStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreRollupSyncStatus'
+type StateInterface_GetPreRollupSyncStatus_Call struct {
+	*mock.Call
+}
+
+// GetPreRollupSyncStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetPreRollupSyncStatus(ctx interface{}, dbTx interface{}) *StateInterface_GetPreRollupSyncStatus_Call {
+	return &StateInterface_GetPreRollupSyncStatus_Call{Call: _e.mock.On("GetPreRollupSyncStatus", ctx, dbTx)}
+}
+
+func (_c *StateInterface_GetPreRollupSyncStatus_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *StateInterface_GetPreRollupSyncStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetPreRollupSyncStatus_Call) Return(_a0 *entities.PreRollupSyncStatus, _a1 error) *StateInterface_GetPreRollupSyncStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_GetPreRollupSyncStatus_Call) RunAndReturn(run func(context.Context, entities.Tx) (*entities.PreRollupSyncStatus, error)) *StateInterface_GetPreRollupSyncStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPreviousBlock provides a mock function with given fields: ctx, offset, dbTx
+func (_m *StateInterface) GetPreviousBlock(ctx context.Context, offset uint64, dbTx entities.Tx) (*entities.L1Block, error) {
+	ret := _m.Called(ctx, offset, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreviousBlock")
+	}
+
+	var r0 *entities.L1Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
+		return rf(ctx, offset, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
+		r0 = rf(ctx, offset, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.L1Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, offset, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_GetPreviousBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousBlock'
+type StateInterface_GetPreviousBlock_Call struct {
+	*mock.Call
+}
+
+// GetPreviousBlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - offset uint64
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetPreviousBlock(ctx interface{}, offset interface{}, dbTx interface{}) *StateInterface_GetPreviousBlock_Call {
+	return &StateInterface_GetPreviousBlock_Call{Call: _e.mock.On("GetPreviousBlock", ctx, offset, dbTx)}
+}
+
+func (_c *StateInterface_GetPreviousBlock_Call) Run(run func(ctx context.Context, offset uint64, dbTx entities.Tx)) *StateInterface_GetPreviousBlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetPreviousBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *StateInterface_GetPreviousBlock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_GetPreviousBlock_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *StateInterface_GetPreviousBlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUncheckedBlocks provides a mock function with given fields: ctx, fromBlockNumber, toBlockNumber, dbTx
+func (_m *StateInterface) GetUncheckedBlocks(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx entities.Tx) (*[]entities.L1Block, error) {
+	ret := _m.Called(ctx, fromBlockNumber, toBlockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUncheckedBlocks")
+	}
+
+	var r0 *[]entities.L1Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*[]entities.L1Block, error)); ok {
+		return rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *[]entities.L1Block); ok {
+		r0 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]entities.L1Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StateInterface_GetUncheckedBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUncheckedBlocks'
+type StateInterface_GetUncheckedBlocks_Call struct {
+	*mock.Call
+}
+
+// GetUncheckedBlocks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromBlockNumber uint64
+//   - toBlockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StateInterface_Expecter) GetUncheckedBlocks(ctx interface{}, fromBlockNumber interface{}, toBlockNumber interface{}, dbTx interface{}) *StateInterface_GetUncheckedBlocks_Call {
+	return &StateInterface_GetUncheckedBlocks_Call{Call: _e.mock.On("GetUncheckedBlocks", ctx, fromBlockNumber, toBlockNumber, dbTx)}
+}
+
+func (_c *StateInterface_GetUncheckedBlocks_Call) Run(run func(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx entities.Tx)) *StateInterface_GetUncheckedBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StateInterface_GetUncheckedBlocks_Call) Return(_a0 *[]entities.L1Block, _a1 error) *StateInterface_GetUncheckedBlocks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StateInterface_GetUncheckedBlocks_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*[]entities.L1Block, error)) *StateInterface_GetUncheckedBlocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OnSequencedBatchesOnL1 provides a mock function with given fields: ctx, seq, dbTx
+func (_m *StateInterface) OnSequencedBatchesOnL1(ctx context.Context, seq model.SequenceOfBatches, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, seq, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OnSequencedBatchesOnL1")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.SequenceOfBatches, entities.Tx) error); ok {
+		r0 = rf(ctx, seq, dbTx)
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// StateInterface_GetForkIDByBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForkIDByBatchNumber'
-type StateInterface_GetForkIDByBatchNumber_Call struct {
+// StateInterface_OnSequencedBatchesOnL1_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnSequencedBatchesOnL1'
+type StateInterface_OnSequencedBatchesOnL1_Call struct {
 	*mock.Call
 }
 
-// GetForkIDByBatchNumber is a helper method to define mock.On call
+// OnSequencedBatchesOnL1 is a helper method to define mock.On call
 //   - ctx context.Context
-//   - batchNumber uint64
+//   - seq model.SequenceOfBatches
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) GetForkIDByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StateInterface_GetForkIDByBatchNumber_Call {
-	return &StateInterface_GetForkIDByBatchNumber_Call{Call: _e.mock.On("GetForkIDByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *StateInterface_Expecter) OnSequencedBatchesOnL1(ctx interface{}, seq interface{}, dbTx interface{}) *StateInterface_OnSequencedBatchesOnL1_Call {
+	return &StateInterface_OnSequencedBatchesOnL1_Call{Call: _e.mock.On("OnSequencedBatchesOnL1", ctx, seq, dbTx)}
 }
 
-func (_c *StateInterface_GetForkIDByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *StateInterface_GetForkIDByBatchNumber_Call {
+func (_c *StateInterface_OnSequencedBatchesOnL1_Call) Run(run func(ctx context.Context, seq model.SequenceOfBatches, dbTx entities.Tx)) *StateInterface_OnSequencedBatchesOnL1_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(model.SequenceOfBatches), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_GetForkIDByBatchNumber_Call) Return(_a0 uint64) *StateInterface_GetForkIDByBatchNumber_Call {
+func (_c *StateInterface_OnSequencedBatchesOnL1_Call) Return(_a0 error) *StateInterface_OnSequencedBatchesOnL1_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_GetForkIDByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) uint64) *StateInterface_GetForkIDByBatchNumber_Call {
+func (_c *StateInterface_OnSequencedBatchesOnL1_Call) RunAndReturn(run func(context.Context, model.SequenceOfBatches, entities.Tx) error) *StateInterface_OnSequencedBatchesOnL1_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetForkIDByBlockNumber provides a mock function with given fields: ctx, blockNumber, dbTx
-func (_m *StateInterface) GetForkIDByBlockNumber(ctx context.Context, blockNumber uint64, dbTx entities.Tx) uint64 {
-	ret := _m.Called(ctx, blockNumber, dbTx)
+// OnSequencedForceBatchesOnL1 provides a mock function with given fields: ctx, sequencedForceBatches, dbTx
+func (_m *StateInterface) OnSequencedForceBatchesOnL1(ctx context.Context, sequencedForceBatches []*entities.SequencedForceBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, sequencedForceBatches, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetForkIDByBlockNumber")
+		panic("no return value specified for OnSequencedForceBatchesOnL1")
 	}
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) uint64); ok {
-		r0 = rf(ctx, blockNumber, dbTx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*entities.SequencedForceBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, sequencedForceBatches, dbTx)
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// StateInterface_GetForkIDByBlockNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetForkIDByBlockNumber'
-type StateInterface_GetForkIDByBlockNumber_Call struct {
+// StateInterface_OnSequencedForceBatchesOnL1_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnSequencedForceBatchesOnL1'
+type StateInterface_OnSequencedForceBatchesOnL1_Call struct {
 	*mock.Call
 }
 
-// GetForkIDByBlockNumber is a helper method to define mock.On call
+// OnSequencedForceBatchesOnL1 is a helper method to define mock.On call
 //   - ctx context.Context
-//   - blockNumber uint64
+//   - sequencedForceBatches []*entities.SequencedForceBatch
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) GetForkIDByBlockNumber(ctx interface{}, blockNumber interface{}, dbTx interface{}) *StateInterface_GetForkIDByBlockNumber_Call {
-	return &StateInterface_GetForkIDByBlockNumber_Call{Call: _e.mock.On("GetForkIDByBlockNumber", ctx, blockNumber, dbTx)}
+func (_e *StateInterface_Expecter) OnSequencedForceBatchesOnL1(ctx interface{}, sequencedForceBatches interface{}, dbTx interface{}) *StateInterface_OnSequencedForceBatchesOnL1_Call {
+	return &StateInterface_OnSequencedForceBatchesOnL1_Call{Call: _e.mock.On("OnSequencedForceBatchesOnL1", ctx, sequencedForceBatches, dbTx)}
 }
 
-func (_c *StateInterface_GetForkIDByBlockNumber_Call) Run(run func(ctx context.Context, blockNumber uint64, dbTx entities.Tx)) *StateInterface_GetForkIDByBlockNumber_Call {
+func (_c *StateInterface_OnSequencedForceBatchesOnL1_Call) Run(run func(ctx context.Context, sequencedForceBatches []*entities.SequencedForceBatch, dbTx entities.Tx)) *StateInterface_OnSequencedForceBatchesOnL1_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].([]*entities.SequencedForceBatch), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_GetForkIDByBlockNumber_Call) Return(_a0 uint64) *StateInterface_GetForkIDByBlockNumber_Call {
+func (_c *StateInterface_OnSequencedForceBatchesOnL1_Call) Return(_a0 error) *StateInterface_OnSequencedForceBatchesOnL1_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_GetForkIDByBlockNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) uint64) *StateInterface_GetForkIDByBlockNumber_Call {
+func (_c *StateInterface_OnSequencedForceBatchesOnL1_Call) RunAndReturn(run func(context.Context, []*entities.SequencedForceBatch, entities.Tx) error) *StateInterface_OnSequencedForceBatchesOnL1_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetL1InfoLeafPerIndex provides a mock function with given fields: ctx, L1InfoTreeIndex, dbTx
-func (_m *StateInterface) GetL1InfoLeafPerIndex(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx) (*entities.L1InfoTreeLeaf, error) {
-	ret := _m.Called(ctx, L1InfoTreeIndex, dbTx)
+// OverridePendingStates provides a mock function with given fields: ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx
+func (_m *StateInterface) OverridePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetL1InfoLeafPerIndex")
-	}
-
-	var r0 *entities.L1InfoTreeLeaf
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) (*entities.L1InfoTreeLeaf, error)); ok {
-		return rf(ctx, L1InfoTreeIndex, dbTx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) *entities.L1InfoTreeLeaf); ok {
-		r0 = rf(ctx, L1InfoTreeIndex, dbTx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.L1InfoTreeLeaf)
-		}
+		panic("no return value specified for OverridePendingStates")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint32, entities.Tx) error); ok {
-		r1 = rf(ctx, L1InfoTreeIndex, dbTx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, common.Hash, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// StateInterface_GetL1InfoLeafPerIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetL1InfoLeafPerIndex'
-type StateInterface_GetL1InfoLeafPerIndex_Call struct {
+// StateInterface_OverridePendingStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OverridePendingStates'
+type StateInterface_OverridePendingStates_Call struct {
 	*mock.Call
 }
 
-// GetL1InfoLeafPerIndex is a helper method to define mock.On call
+// OverridePendingStates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - L1InfoTreeIndex uint32
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - stateRoot common.Hash
+//   - blockNumber uint64
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) GetL1InfoLeafPerIndex(ctx interface{}, L1InfoTreeIndex interface{}, dbTx interface{}) *StateInterface_GetL1InfoLeafPerIndex_Call {
-	return &StateInterface_GetL1InfoLeafPerIndex_Call{Call: _e.mock.On("GetL1InfoLeafPerIndex", ctx, L1InfoTreeIndex, dbTx)}
+func (_e *StateInterface_Expecter) OverridePendingStates(ctx interface{}, rollupID interface{}, batchNumber interface{}, stateRoot interface{}, blockNumber interface{}, dbTx interface{}) *StateInterface_OverridePendingStates_Call {
+	return &StateInterface_OverridePendingStates_Call{Call: _e.mock.On("OverridePendingStates", ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)}
 }
 
-func (_c *StateInterface_GetL1InfoLeafPerIndex_Call) Run(run func(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx)) *StateInterface_GetL1InfoLeafPerIndex_Call {
+func (_c *StateInterface_OverridePendingStates_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx entities.Tx)) *StateInterface_OverridePendingStates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint32), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(common.Hash), args[4].(uint64), args[5].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_GetL1InfoLeafPerIndex_Call) Return(_a0 *entities.L1InfoTreeLeaf, _a1 error) *StateInterface_GetL1InfoLeafPerIndex_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StateInterface_OverridePendingStates_Call) Return(_a0 error) *StateInterface_OverridePendingStates_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_GetL1InfoLeafPerIndex_Call) RunAndReturn(run func(context.Context, uint32, entities.Tx) (*entities.L1InfoTreeLeaf, error)) *StateInterface_GetL1InfoLeafPerIndex_Call {
+func (_c *StateInterface_OverridePendingStates_Call) RunAndReturn(run func(context.Context, uint64, uint64, common.Hash, uint64, entities.Tx) error) *StateInterface_OverridePendingStates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetL1InfoRootPerLeafIndex provides a mock function with given fields: ctx, L1InfoTreeIndex, dbTx
-func (_m *StateInterface) GetL1InfoRootPerLeafIndex(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx) (common.Hash, error) {
-	ret := _m.Called(ctx, L1InfoTreeIndex, dbTx)
+// PreviewReorg provides a mock function with given fields: ctx, firstL1BlockNumberToKeep, dbTx
+func (_m *StateInterface) PreviewReorg(ctx context.Context, firstL1BlockNumberToKeep uint64, dbTx entities.Tx) (*entities.ReorgImpact, error) {
+	ret := _m.Called(ctx, firstL1BlockNumberToKeep, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetL1InfoRootPerLeafIndex")
+		panic("no return value specified for PreviewReorg")
 	}
 
-	var r0 common.Hash
+	var r0 *entities.ReorgImpact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) (common.Hash, error)); ok {
-		return rf(ctx, L1InfoTreeIndex, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.ReorgImpact, error)); ok {
+		return rf(ctx, firstL1BlockNumberToKeep, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, entities.Tx) common.Hash); ok {
-		r0 = rf(ctx, L1InfoTreeIndex, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.ReorgImpact); ok {
+		r0 = rf(ctx, firstL1BlockNumberToKeep, dbTx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(common.Hash)
+			r0 = ret.Get(0).(*entities.ReorgImpact)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint32, entities.Tx) error); ok {
-		r1 = rf(ctx, L1InfoTreeIndex, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, firstL1BlockNumberToKeep, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -628,286 +1630,243 @@ func (_m *StateInterface) GetL1InfoRootPerLeafIndex(ctx context.Context, L1InfoT
 	return r0, r1
 }
 
-// StateInterface_GetL1InfoRootPerLeafIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetL1InfoRootPerLeafIndex'
-type StateInterface_GetL1InfoRootPerLeafIndex_Call struct {
+// StateInterface_PreviewReorg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewReorg'
+type StateInterface_PreviewReorg_Call struct {
 	*mock.Call
 }
 
-// GetL1InfoRootPerLeafIndex is a helper method to define mock.On call
+// PreviewReorg is a helper method to define mock.On call
 //   - ctx context.Context
-//   - L1InfoTreeIndex uint32
+//   - firstL1BlockNumberToKeep uint64
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) GetL1InfoRootPerLeafIndex(ctx interface{}, L1InfoTreeIndex interface{}, dbTx interface{}) *StateInterface_GetL1InfoRootPerLeafIndex_Call {
-	return &StateInterface_GetL1InfoRootPerLeafIndex_Call{Call: _e.mock.On("GetL1InfoRootPerLeafIndex", ctx, L1InfoTreeIndex, dbTx)}
+func (_e *StateInterface_Expecter) PreviewReorg(ctx interface{}, firstL1BlockNumberToKeep interface{}, dbTx interface{}) *StateInterface_PreviewReorg_Call {
+	return &StateInterface_PreviewReorg_Call{Call: _e.mock.On("PreviewReorg", ctx, firstL1BlockNumberToKeep, dbTx)}
 }
 
-func (_c *StateInterface_GetL1InfoRootPerLeafIndex_Call) Run(run func(ctx context.Context, L1InfoTreeIndex uint32, dbTx entities.Tx)) *StateInterface_GetL1InfoRootPerLeafIndex_Call {
+func (_c *StateInterface_PreviewReorg_Call) Run(run func(ctx context.Context, firstL1BlockNumberToKeep uint64, dbTx entities.Tx)) *StateInterface_PreviewReorg_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint32), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_GetL1InfoRootPerLeafIndex_Call) Return(_a0 common.Hash, _a1 error) *StateInterface_GetL1InfoRootPerLeafIndex_Call {
+func (_c *StateInterface_PreviewReorg_Call) Return(_a0 *entities.ReorgImpact, _a1 error) *StateInterface_PreviewReorg_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *StateInterface_GetL1InfoRootPerLeafIndex_Call) RunAndReturn(run func(context.Context, uint32, entities.Tx) (common.Hash, error)) *StateInterface_GetL1InfoRootPerLeafIndex_Call {
+func (_c *StateInterface_PreviewReorg_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.ReorgImpact, error)) *StateInterface_PreviewReorg_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetL1InfoTreeLeaves provides a mock function with given fields: ctx, indexLeaves, dbTx
-func (_m *StateInterface) GetL1InfoTreeLeaves(ctx context.Context, indexLeaves []uint32, dbTx entities.Tx) (map[uint32]entities.L1InfoTreeLeaf, error) {
-	ret := _m.Called(ctx, indexLeaves, dbTx)
+// SetPendingStateNonDeterministic provides a mock function with given fields: ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx
+func (_m *StateInterface) SetPendingStateNonDeterministic(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetL1InfoTreeLeaves")
-	}
-
-	var r0 map[uint32]entities.L1InfoTreeLeaf
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, []uint32, entities.Tx) (map[uint32]entities.L1InfoTreeLeaf, error)); ok {
-		return rf(ctx, indexLeaves, dbTx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, []uint32, entities.Tx) map[uint32]entities.L1InfoTreeLeaf); ok {
-		r0 = rf(ctx, indexLeaves, dbTx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[uint32]entities.L1InfoTreeLeaf)
-		}
+		panic("no return value specified for SetPendingStateNonDeterministic")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, []uint32, entities.Tx) error); ok {
-		r1 = rf(ctx, indexLeaves, dbTx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, common.Hash, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// StateInterface_GetL1InfoTreeLeaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetL1InfoTreeLeaves'
-type StateInterface_GetL1InfoTreeLeaves_Call struct {
+// StateInterface_SetPendingStateNonDeterministic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPendingStateNonDeterministic'
+type StateInterface_SetPendingStateNonDeterministic_Call struct {
 	*mock.Call
 }
 
-// GetL1InfoTreeLeaves is a helper method to define mock.On call
+// SetPendingStateNonDeterministic is a helper method to define mock.On call
 //   - ctx context.Context
-//   - indexLeaves []uint32
+//   - storedStateRoot common.Hash
+//   - provedStateRoot common.Hash
+//   - blockNumber uint64
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) GetL1InfoTreeLeaves(ctx interface{}, indexLeaves interface{}, dbTx interface{}) *StateInterface_GetL1InfoTreeLeaves_Call {
-	return &StateInterface_GetL1InfoTreeLeaves_Call{Call: _e.mock.On("GetL1InfoTreeLeaves", ctx, indexLeaves, dbTx)}
+func (_e *StateInterface_Expecter) SetPendingStateNonDeterministic(ctx interface{}, storedStateRoot interface{}, provedStateRoot interface{}, blockNumber interface{}, dbTx interface{}) *StateInterface_SetPendingStateNonDeterministic_Call {
+	return &StateInterface_SetPendingStateNonDeterministic_Call{Call: _e.mock.On("SetPendingStateNonDeterministic", ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)}
 }
 
-func (_c *StateInterface_GetL1InfoTreeLeaves_Call) Run(run func(ctx context.Context, indexLeaves []uint32, dbTx entities.Tx)) *StateInterface_GetL1InfoTreeLeaves_Call {
+func (_c *StateInterface_SetPendingStateNonDeterministic_Call) Run(run func(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx entities.Tx)) *StateInterface_SetPendingStateNonDeterministic_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]uint32), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(common.Hash), args[2].(common.Hash), args[3].(uint64), args[4].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_GetL1InfoTreeLeaves_Call) Return(_a0 map[uint32]entities.L1InfoTreeLeaf, _a1 error) *StateInterface_GetL1InfoTreeLeaves_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StateInterface_SetPendingStateNonDeterministic_Call) Return(_a0 error) *StateInterface_SetPendingStateNonDeterministic_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_GetL1InfoTreeLeaves_Call) RunAndReturn(run func(context.Context, []uint32, entities.Tx) (map[uint32]entities.L1InfoTreeLeaf, error)) *StateInterface_GetL1InfoTreeLeaves_Call {
+func (_c *StateInterface_SetPendingStateNonDeterministic_Call) RunAndReturn(run func(context.Context, common.Hash, common.Hash, uint64, entities.Tx) error) *StateInterface_SetPendingStateNonDeterministic_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLastBlock provides a mock function with given fields: ctx, dbTx
-func (_m *StateInterface) GetLastBlock(ctx context.Context, dbTx entities.Tx) (*entities.L1Block, error) {
-	ret := _m.Called(ctx, dbTx)
+// SetPreRollupSyncStatus provides a mock function with given fields: ctx, status, dbTx
+func (_m *StateInterface) SetPreRollupSyncStatus(ctx context.Context, status entities.PreRollupSyncStatus, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, status, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLastBlock")
-	}
-
-	var r0 *entities.L1Block
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (*entities.L1Block, error)); ok {
-		return rf(ctx, dbTx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) *entities.L1Block); ok {
-		r0 = rf(ctx, dbTx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.L1Block)
-		}
+		panic("no return value specified for SetPreRollupSyncStatus")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
-		r1 = rf(ctx, dbTx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.PreRollupSyncStatus, entities.Tx) error); ok {
+		r0 = rf(ctx, status, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// StateInterface_GetLastBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastBlock'
-type StateInterface_GetLastBlock_Call struct {
+// StateInterface_SetPreRollupSyncStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPreRollupSyncStatus'
+type StateInterface_SetPreRollupSyncStatus_Call struct {
 	*mock.Call
 }
 
-// GetLastBlock is a helper method to define mock.On call
+// SetPreRollupSyncStatus is a helper method to define mock.On call
 //   - ctx context.Context
+//   - status entities.PreRollupSyncStatus
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) GetLastBlock(ctx interface{}, dbTx interface{}) *StateInterface_GetLastBlock_Call {
-	return &StateInterface_GetLastBlock_Call{Call: _e.mock.On("GetLastBlock", ctx, dbTx)}
+func (_e *StateInterface_Expecter) SetPreRollupSyncStatus(ctx interface{}, status interface{}, dbTx interface{}) *StateInterface_SetPreRollupSyncStatus_Call {
+	return &StateInterface_SetPreRollupSyncStatus_Call{Call: _e.mock.On("SetPreRollupSyncStatus", ctx, status, dbTx)}
 }
 
-func (_c *StateInterface_GetLastBlock_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *StateInterface_GetLastBlock_Call {
+func (_c *StateInterface_SetPreRollupSyncStatus_Call) Run(run func(ctx context.Context, status entities.PreRollupSyncStatus, dbTx entities.Tx)) *StateInterface_SetPreRollupSyncStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entities.Tx))
+		run(args[0].(context.Context), args[1].(entities.PreRollupSyncStatus), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_GetLastBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *StateInterface_GetLastBlock_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StateInterface_SetPreRollupSyncStatus_Call) Return(_a0 error) *StateInterface_SetPreRollupSyncStatus_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_GetLastBlock_Call) RunAndReturn(run func(context.Context, entities.Tx) (*entities.L1Block, error)) *StateInterface_GetLastBlock_Call {
+func (_c *StateInterface_SetPreRollupSyncStatus_Call) RunAndReturn(run func(context.Context, entities.PreRollupSyncStatus, entities.Tx) error) *StateInterface_SetPreRollupSyncStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLeafsByL1InfoRoot provides a mock function with given fields: ctx, l1InfoRoot, dbTx
-func (_m *StateInterface) GetLeafsByL1InfoRoot(ctx context.Context, l1InfoRoot common.Hash, dbTx entities.Tx) ([]entities.L1InfoTreeLeaf, error) {
-	ret := _m.Called(ctx, l1InfoRoot, dbTx)
+// SetRollupTypeObsolete provides a mock function with given fields: ctx, rollupTypeID, blockNumber, dbTx
+func (_m *StateInterface) SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupTypeID, blockNumber, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLeafsByL1InfoRoot")
-	}
-
-	var r0 []entities.L1InfoTreeLeaf
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) ([]entities.L1InfoTreeLeaf, error)); ok {
-		return rf(ctx, l1InfoRoot, dbTx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, entities.Tx) []entities.L1InfoTreeLeaf); ok {
-		r0 = rf(ctx, l1InfoRoot, dbTx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]entities.L1InfoTreeLeaf)
-		}
+		panic("no return value specified for SetRollupTypeObsolete")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, common.Hash, entities.Tx) error); ok {
-		r1 = rf(ctx, l1InfoRoot, dbTx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupTypeID, blockNumber, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// StateInterface_GetLeafsByL1InfoRoot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeafsByL1InfoRoot'
-type StateInterface_GetLeafsByL1InfoRoot_Call struct {
+// StateInterface_SetRollupTypeObsolete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRollupTypeObsolete'
+type StateInterface_SetRollupTypeObsolete_Call struct {
 	*mock.Call
 }
 
-// GetLeafsByL1InfoRoot is a helper method to define mock.On call
+// SetRollupTypeObsolete is a helper method to define mock.On call
 //   - ctx context.Context
-//   - l1InfoRoot common.Hash
+//   - rollupTypeID uint64
+//   - blockNumber uint64
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) GetLeafsByL1InfoRoot(ctx interface{}, l1InfoRoot interface{}, dbTx interface{}) *StateInterface_GetLeafsByL1InfoRoot_Call {
-	return &StateInterface_GetLeafsByL1InfoRoot_Call{Call: _e.mock.On("GetLeafsByL1InfoRoot", ctx, l1InfoRoot, dbTx)}
+func (_e *StateInterface_Expecter) SetRollupTypeObsolete(ctx interface{}, rollupTypeID interface{}, blockNumber interface{}, dbTx interface{}) *StateInterface_SetRollupTypeObsolete_Call {
+	return &StateInterface_SetRollupTypeObsolete_Call{Call: _e.mock.On("SetRollupTypeObsolete", ctx, rollupTypeID, blockNumber, dbTx)}
 }
 
-func (_c *StateInterface_GetLeafsByL1InfoRoot_Call) Run(run func(ctx context.Context, l1InfoRoot common.Hash, dbTx entities.Tx)) *StateInterface_GetLeafsByL1InfoRoot_Call {
+func (_c *StateInterface_SetRollupTypeObsolete_Call) Run(run func(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx entities.Tx)) *StateInterface_SetRollupTypeObsolete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(common.Hash), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_GetLeafsByL1InfoRoot_Call) Return(_a0 []entities.L1InfoTreeLeaf, _a1 error) *StateInterface_GetLeafsByL1InfoRoot_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StateInterface_SetRollupTypeObsolete_Call) Return(_a0 error) *StateInterface_SetRollupTypeObsolete_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_GetLeafsByL1InfoRoot_Call) RunAndReturn(run func(context.Context, common.Hash, entities.Tx) ([]entities.L1InfoTreeLeaf, error)) *StateInterface_GetLeafsByL1InfoRoot_Call {
+func (_c *StateInterface_SetRollupTypeObsolete_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) error) *StateInterface_SetRollupTypeObsolete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPreviousBlock provides a mock function with given fields: ctx, offset, dbTx
-func (_m *StateInterface) GetPreviousBlock(ctx context.Context, offset uint64, dbTx entities.Tx) (*entities.L1Block, error) {
-	ret := _m.Called(ctx, offset, dbTx)
+// UpdateCheckedBlockByNumber provides a mock function with given fields: ctx, blockNumber, newCheckedStatus, dbTx
+func (_m *StateInterface) UpdateCheckedBlockByNumber(ctx context.Context, blockNumber uint64, newCheckedStatus bool, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, blockNumber, newCheckedStatus, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPreviousBlock")
-	}
-
-	var r0 *entities.L1Block
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)); ok {
-		return rf(ctx, offset, dbTx)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.L1Block); ok {
-		r0 = rf(ctx, offset, dbTx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*entities.L1Block)
-		}
+		panic("no return value specified for UpdateCheckedBlockByNumber")
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, offset, dbTx)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, bool, entities.Tx) error); ok {
+		r0 = rf(ctx, blockNumber, newCheckedStatus, dbTx)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// StateInterface_GetPreviousBlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreviousBlock'
-type StateInterface_GetPreviousBlock_Call struct {
+// StateInterface_UpdateCheckedBlockByNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCheckedBlockByNumber'
+type StateInterface_UpdateCheckedBlockByNumber_Call struct {
 	*mock.Call
 }
 
-// GetPreviousBlock is a helper method to define mock.On call
+// UpdateCheckedBlockByNumber is a helper method to define mock.On call
 //   - ctx context.Context
-//   - offset uint64
+//   - blockNumber uint64
+//   - newCheckedStatus bool
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) GetPreviousBlock(ctx interface{}, offset interface{}, dbTx interface{}) *StateInterface_GetPreviousBlock_Call {
-	return &StateInterface_GetPreviousBlock_Call{Call: _e.mock.On("GetPreviousBlock", ctx, offset, dbTx)}
+func (_e *StateInterface_Expecter) UpdateCheckedBlockByNumber(ctx interface{}, blockNumber interface{}, newCheckedStatus interface{}, dbTx interface{}) *StateInterface_UpdateCheckedBlockByNumber_Call {
+	return &StateInterface_UpdateCheckedBlockByNumber_Call{Call: _e.mock.On("UpdateCheckedBlockByNumber", ctx, blockNumber, newCheckedStatus, dbTx)}
 }
 
-func (_c *StateInterface_GetPreviousBlock_Call) Run(run func(ctx context.Context, offset uint64, dbTx entities.Tx)) *StateInterface_GetPreviousBlock_Call {
+func (_c *StateInterface_UpdateCheckedBlockByNumber_Call) Run(run func(ctx context.Context, blockNumber uint64, newCheckedStatus bool, dbTx entities.Tx)) *StateInterface_UpdateCheckedBlockByNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(bool), args[3].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_GetPreviousBlock_Call) Return(_a0 *entities.L1Block, _a1 error) *StateInterface_GetPreviousBlock_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *StateInterface_UpdateCheckedBlockByNumber_Call) Return(_a0 error) *StateInterface_UpdateCheckedBlockByNumber_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_GetPreviousBlock_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.L1Block, error)) *StateInterface_GetPreviousBlock_Call {
+func (_c *StateInterface_UpdateCheckedBlockByNumber_Call) RunAndReturn(run func(context.Context, uint64, bool, entities.Tx) error) *StateInterface_UpdateCheckedBlockByNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// OnSequencedBatchesOnL1 provides a mock function with given fields: ctx, seq, dbTx
-func (_m *StateInterface) OnSequencedBatchesOnL1(ctx context.Context, seq model.SequenceOfBatches, dbTx entities.Tx) error {
-	ret := _m.Called(ctx, seq, dbTx)
+// UpdateRollup provides a mock function with given fields: ctx, rollupUpdate, dbTx
+func (_m *StateInterface) UpdateRollup(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupUpdate, dbTx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for OnSequencedBatchesOnL1")
+		panic("no return value specified for UpdateRollup")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.SequenceOfBatches, entities.Tx) error); ok {
-		r0 = rf(ctx, seq, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RollupUpdate, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupUpdate, dbTx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -915,32 +1874,32 @@ func (_m *StateInterface) OnSequencedBatchesOnL1(ctx context.Context, seq model.
 	return r0
 }
 
-// StateInterface_OnSequencedBatchesOnL1_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnSequencedBatchesOnL1'
-type StateInterface_OnSequencedBatchesOnL1_Call struct {
+// StateInterface_UpdateRollup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRollup'
+type StateInterface_UpdateRollup_Call struct {
 	*mock.Call
 }
 
-// OnSequencedBatchesOnL1 is a helper method to define mock.On call
+// UpdateRollup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - seq model.SequenceOfBatches
+//   - rollupUpdate *entities.RollupUpdate
 //   - dbTx entities.Tx
-func (_e *StateInterface_Expecter) OnSequencedBatchesOnL1(ctx interface{}, seq interface{}, dbTx interface{}) *StateInterface_OnSequencedBatchesOnL1_Call {
-	return &StateInterface_OnSequencedBatchesOnL1_Call{Call: _e.mock.On("OnSequencedBatchesOnL1", ctx, seq, dbTx)}
+func (_e *StateInterface_Expecter) UpdateRollup(ctx interface{}, rollupUpdate interface{}, dbTx interface{}) *StateInterface_UpdateRollup_Call {
+	return &StateInterface_UpdateRollup_Call{Call: _e.mock.On("UpdateRollup", ctx, rollupUpdate, dbTx)}
 }
 
-func (_c *StateInterface_OnSequencedBatchesOnL1_Call) Run(run func(ctx context.Context, seq model.SequenceOfBatches, dbTx entities.Tx)) *StateInterface_OnSequencedBatchesOnL1_Call {
+func (_c *StateInterface_UpdateRollup_Call) Run(run func(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx entities.Tx)) *StateInterface_UpdateRollup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.SequenceOfBatches), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(*entities.RollupUpdate), args[2].(entities.Tx))
 	})
 	return _c
 }
 
-func (_c *StateInterface_OnSequencedBatchesOnL1_Call) Return(_a0 error) *StateInterface_OnSequencedBatchesOnL1_Call {
+func (_c *StateInterface_UpdateRollup_Call) Return(_a0 error) *StateInterface_UpdateRollup_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *StateInterface_OnSequencedBatchesOnL1_Call) RunAndReturn(run func(context.Context, model.SequenceOfBatches, entities.Tx) error) *StateInterface_OnSequencedBatchesOnL1_Call {
+func (_c *StateInterface_UpdateRollup_Call) RunAndReturn(run func(context.Context, *entities.RollupUpdate, entities.Tx) error) *StateInterface_UpdateRollup_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,239 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	common "github.com/ethereum/go-ethereum/common"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// statePendingStateManager is an autogenerated mock type for the statePendingStateManager type
+type statePendingStateManager struct {
+	mock.Mock
+}
+
+type statePendingStateManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *statePendingStateManager) EXPECT() *statePendingStateManager_Expecter {
+	return &statePendingStateManager_Expecter{mock: &_m.Mock}
+}
+
+// AddPendingState provides a mock function with given fields: ctx, pendingState, dbTx
+func (_m *statePendingStateManager) AddPendingState(ctx context.Context, pendingState *entities.PendingState, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, pendingState, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPendingState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.PendingState, entities.Tx) error); ok {
+		r0 = rf(ctx, pendingState, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// statePendingStateManager_AddPendingState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPendingState'
+type statePendingStateManager_AddPendingState_Call struct {
+	*mock.Call
+}
+
+// AddPendingState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pendingState *entities.PendingState
+//   - dbTx entities.Tx
+func (_e *statePendingStateManager_Expecter) AddPendingState(ctx interface{}, pendingState interface{}, dbTx interface{}) *statePendingStateManager_AddPendingState_Call {
+	return &statePendingStateManager_AddPendingState_Call{Call: _e.mock.On("AddPendingState", ctx, pendingState, dbTx)}
+}
+
+func (_c *statePendingStateManager_AddPendingState_Call) Run(run func(ctx context.Context, pendingState *entities.PendingState, dbTx entities.Tx)) *statePendingStateManager_AddPendingState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.PendingState), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *statePendingStateManager_AddPendingState_Call) Return(_a0 error) *statePendingStateManager_AddPendingState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *statePendingStateManager_AddPendingState_Call) RunAndReturn(run func(context.Context, *entities.PendingState, entities.Tx) error) *statePendingStateManager_AddPendingState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsolidatePendingStates provides a mock function with given fields: ctx, rollupID, batchNumber, blockNumber, dbTx
+func (_m *statePendingStateManager) ConsolidatePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupID, batchNumber, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsolidatePendingStates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupID, batchNumber, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// statePendingStateManager_ConsolidatePendingStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsolidatePendingStates'
+type statePendingStateManager_ConsolidatePendingStates_Call struct {
+	*mock.Call
+}
+
+// ConsolidatePendingStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *statePendingStateManager_Expecter) ConsolidatePendingStates(ctx interface{}, rollupID interface{}, batchNumber interface{}, blockNumber interface{}, dbTx interface{}) *statePendingStateManager_ConsolidatePendingStates_Call {
+	return &statePendingStateManager_ConsolidatePendingStates_Call{Call: _e.mock.On("ConsolidatePendingStates", ctx, rollupID, batchNumber, blockNumber, dbTx)}
+}
+
+func (_c *statePendingStateManager_ConsolidatePendingStates_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx entities.Tx)) *statePendingStateManager_ConsolidatePendingStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(uint64), args[4].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *statePendingStateManager_ConsolidatePendingStates_Call) Return(_a0 error) *statePendingStateManager_ConsolidatePendingStates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *statePendingStateManager_ConsolidatePendingStates_Call) RunAndReturn(run func(context.Context, uint64, uint64, uint64, entities.Tx) error) *statePendingStateManager_ConsolidatePendingStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OverridePendingStates provides a mock function with given fields: ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx
+func (_m *statePendingStateManager) OverridePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OverridePendingStates")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, common.Hash, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// statePendingStateManager_OverridePendingStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OverridePendingStates'
+type statePendingStateManager_OverridePendingStates_Call struct {
+	*mock.Call
+}
+
+// OverridePendingStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - stateRoot common.Hash
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *statePendingStateManager_Expecter) OverridePendingStates(ctx interface{}, rollupID interface{}, batchNumber interface{}, stateRoot interface{}, blockNumber interface{}, dbTx interface{}) *statePendingStateManager_OverridePendingStates_Call {
+	return &statePendingStateManager_OverridePendingStates_Call{Call: _e.mock.On("OverridePendingStates", ctx, rollupID, batchNumber, stateRoot, blockNumber, dbTx)}
+}
+
+func (_c *statePendingStateManager_OverridePendingStates_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx entities.Tx)) *statePendingStateManager_OverridePendingStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(common.Hash), args[4].(uint64), args[5].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *statePendingStateManager_OverridePendingStates_Call) Return(_a0 error) *statePendingStateManager_OverridePendingStates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *statePendingStateManager_OverridePendingStates_Call) RunAndReturn(run func(context.Context, uint64, uint64, common.Hash, uint64, entities.Tx) error) *statePendingStateManager_OverridePendingStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPendingStateNonDeterministic provides a mock function with given fields: ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx
+func (_m *statePendingStateManager) SetPendingStateNonDeterministic(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPendingStateNonDeterministic")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, common.Hash, common.Hash, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// statePendingStateManager_SetPendingStateNonDeterministic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPendingStateNonDeterministic'
+type statePendingStateManager_SetPendingStateNonDeterministic_Call struct {
+	*mock.Call
+}
+
+// SetPendingStateNonDeterministic is a helper method to define mock.On call
+//   - ctx context.Context
+//   - storedStateRoot common.Hash
+//   - provedStateRoot common.Hash
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *statePendingStateManager_Expecter) SetPendingStateNonDeterministic(ctx interface{}, storedStateRoot interface{}, provedStateRoot interface{}, blockNumber interface{}, dbTx interface{}) *statePendingStateManager_SetPendingStateNonDeterministic_Call {
+	return &statePendingStateManager_SetPendingStateNonDeterministic_Call{Call: _e.mock.On("SetPendingStateNonDeterministic", ctx, storedStateRoot, provedStateRoot, blockNumber, dbTx)}
+}
+
+func (_c *statePendingStateManager_SetPendingStateNonDeterministic_Call) Run(run func(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx entities.Tx)) *statePendingStateManager_SetPendingStateNonDeterministic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(common.Hash), args[2].(common.Hash), args[3].(uint64), args[4].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *statePendingStateManager_SetPendingStateNonDeterministic_Call) Return(_a0 error) *statePendingStateManager_SetPendingStateNonDeterministic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *statePendingStateManager_SetPendingStateNonDeterministic_Call) RunAndReturn(run func(context.Context, common.Hash, common.Hash, uint64, entities.Tx) error) *statePendingStateManager_SetPendingStateNonDeterministic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newStatePendingStateManager creates a new instance of statePendingStateManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newStatePendingStateManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *statePendingStateManager {
+	mock := &statePendingStateManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
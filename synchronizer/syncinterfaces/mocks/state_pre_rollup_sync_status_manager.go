@@ -0,0 +1,144 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatePreRollupSyncStatusManager is an autogenerated mock type for the StatePreRollupSyncStatusManager type
+type StatePreRollupSyncStatusManager struct {
+	mock.Mock
+}
+
+type StatePreRollupSyncStatusManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StatePreRollupSyncStatusManager) EXPECT() *StatePreRollupSyncStatusManager_Expecter {
+	return &StatePreRollupSyncStatusManager_Expecter{mock: &_m.Mock}
+}
+
+// GetPreRollupSyncStatus provides a mock function with given fields: ctx, dbTx
+func (_m *StatePreRollupSyncStatusManager) GetPreRollupSyncStatus(ctx context.Context, dbTx entities.Tx) (*entities.PreRollupSyncStatus, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPreRollupSyncStatus")
+	}
+
+	var r0 *entities.PreRollupSyncStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (*entities.PreRollupSyncStatus, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) *entities.PreRollupSyncStatus); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.PreRollupSyncStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatePreRollupSyncStatusManager_GetPreRollupSyncStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPreRollupSyncStatus'
+type StatePreRollupSyncStatusManager_GetPreRollupSyncStatus_Call struct {
+	*mock.Call
+}
+
+// GetPreRollupSyncStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *StatePreRollupSyncStatusManager_Expecter) GetPreRollupSyncStatus(ctx interface{}, dbTx interface{}) *StatePreRollupSyncStatusManager_GetPreRollupSyncStatus_Call {
+	return &StatePreRollupSyncStatusManager_GetPreRollupSyncStatus_Call{Call: _e.mock.On("GetPreRollupSyncStatus", ctx, dbTx)}
+}
+
+func (_c *StatePreRollupSyncStatusManager_GetPreRollupSyncStatus_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *StatePreRollupSyncStatusManager_GetPreRollupSyncStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StatePreRollupSyncStatusManager_GetPreRollupSyncStatus_Call) Return(_a0 *entities.PreRollupSyncStatus, _a1 error) *StatePreRollupSyncStatusManager_GetPreRollupSyncStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StatePreRollupSyncStatusManager_GetPreRollupSyncStatus_Call) RunAndReturn(run func(context.Context, entities.Tx) (*entities.PreRollupSyncStatus, error)) *StatePreRollupSyncStatusManager_GetPreRollupSyncStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPreRollupSyncStatus provides a mock function with given fields: ctx, status, dbTx
+func (_m *StatePreRollupSyncStatusManager) SetPreRollupSyncStatus(ctx context.Context, status entities.PreRollupSyncStatus, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, status, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPreRollupSyncStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.PreRollupSyncStatus, entities.Tx) error); ok {
+		r0 = rf(ctx, status, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StatePreRollupSyncStatusManager_SetPreRollupSyncStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPreRollupSyncStatus'
+type StatePreRollupSyncStatusManager_SetPreRollupSyncStatus_Call struct {
+	*mock.Call
+}
+
+// SetPreRollupSyncStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - status entities.PreRollupSyncStatus
+//   - dbTx entities.Tx
+func (_e *StatePreRollupSyncStatusManager_Expecter) SetPreRollupSyncStatus(ctx interface{}, status interface{}, dbTx interface{}) *StatePreRollupSyncStatusManager_SetPreRollupSyncStatus_Call {
+	return &StatePreRollupSyncStatusManager_SetPreRollupSyncStatus_Call{Call: _e.mock.On("SetPreRollupSyncStatus", ctx, status, dbTx)}
+}
+
+func (_c *StatePreRollupSyncStatusManager_SetPreRollupSyncStatus_Call) Run(run func(ctx context.Context, status entities.PreRollupSyncStatus, dbTx entities.Tx)) *StatePreRollupSyncStatusManager_SetPreRollupSyncStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.PreRollupSyncStatus), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StatePreRollupSyncStatusManager_SetPreRollupSyncStatus_Call) Return(_a0 error) *StatePreRollupSyncStatusManager_SetPreRollupSyncStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StatePreRollupSyncStatusManager_SetPreRollupSyncStatus_Call) RunAndReturn(run func(context.Context, entities.PreRollupSyncStatus, entities.Tx) error) *StatePreRollupSyncStatusManager_SetPreRollupSyncStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStatePreRollupSyncStatusManager creates a new instance of StatePreRollupSyncStatusManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStatePreRollupSyncStatusManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StatePreRollupSyncStatusManager {
+	mock := &StatePreRollupSyncStatusManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -105,6 +105,66 @@ func (_c *stateReorgManager_ExecuteReorg_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// PreviewReorg provides a mock function with given fields: ctx, firstL1BlockNumberToKeep, dbTx
+func (_m *stateReorgManager) PreviewReorg(ctx context.Context, firstL1BlockNumberToKeep uint64, dbTx entities.Tx) (*entities.ReorgImpact, error) {
+	ret := _m.Called(ctx, firstL1BlockNumberToKeep, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewReorg")
+	}
+
+	var r0 *entities.ReorgImpact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.ReorgImpact, error)); ok {
+		return rf(ctx, firstL1BlockNumberToKeep, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.ReorgImpact); ok {
+		r0 = rf(ctx, firstL1BlockNumberToKeep, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.ReorgImpact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, firstL1BlockNumberToKeep, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// stateReorgManager_PreviewReorg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewReorg'
+type stateReorgManager_PreviewReorg_Call struct {
+	*mock.Call
+}
+
+// PreviewReorg is a helper method to define mock.On call
+//   - ctx context.Context
+//   - firstL1BlockNumberToKeep uint64
+//   - dbTx entities.Tx
+func (_e *stateReorgManager_Expecter) PreviewReorg(ctx interface{}, firstL1BlockNumberToKeep interface{}, dbTx interface{}) *stateReorgManager_PreviewReorg_Call {
+	return &stateReorgManager_PreviewReorg_Call{Call: _e.mock.On("PreviewReorg", ctx, firstL1BlockNumberToKeep, dbTx)}
+}
+
+func (_c *stateReorgManager_PreviewReorg_Call) Run(run func(ctx context.Context, firstL1BlockNumberToKeep uint64, dbTx entities.Tx)) *stateReorgManager_PreviewReorg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateReorgManager_PreviewReorg_Call) Return(_a0 *entities.ReorgImpact, _a1 error) *stateReorgManager_PreviewReorg_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *stateReorgManager_PreviewReorg_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.ReorgImpact, error)) *stateReorgManager_PreviewReorg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // newStateReorgManager creates a new instance of stateReorgManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newStateReorgManager(t interface {
@@ -0,0 +1,230 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// stateRollupRegistryManager is an autogenerated mock type for the stateRollupRegistryManager type
+type stateRollupRegistryManager struct {
+	mock.Mock
+}
+
+type stateRollupRegistryManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *stateRollupRegistryManager) EXPECT() *stateRollupRegistryManager_Expecter {
+	return &stateRollupRegistryManager_Expecter{mock: &_m.Mock}
+}
+
+// AddRollup provides a mock function with given fields: ctx, rollup, dbTx
+func (_m *stateRollupRegistryManager) AddRollup(ctx context.Context, rollup *entities.Rollup, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollup, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.Rollup, entities.Tx) error); ok {
+		r0 = rf(ctx, rollup, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stateRollupRegistryManager_AddRollup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollup'
+type stateRollupRegistryManager_AddRollup_Call struct {
+	*mock.Call
+}
+
+// AddRollup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollup *entities.Rollup
+//   - dbTx entities.Tx
+func (_e *stateRollupRegistryManager_Expecter) AddRollup(ctx interface{}, rollup interface{}, dbTx interface{}) *stateRollupRegistryManager_AddRollup_Call {
+	return &stateRollupRegistryManager_AddRollup_Call{Call: _e.mock.On("AddRollup", ctx, rollup, dbTx)}
+}
+
+func (_c *stateRollupRegistryManager_AddRollup_Call) Run(run func(ctx context.Context, rollup *entities.Rollup, dbTx entities.Tx)) *stateRollupRegistryManager_AddRollup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.Rollup), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateRollupRegistryManager_AddRollup_Call) Return(_a0 error) *stateRollupRegistryManager_AddRollup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stateRollupRegistryManager_AddRollup_Call) RunAndReturn(run func(context.Context, *entities.Rollup, entities.Tx) error) *stateRollupRegistryManager_AddRollup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRollupType provides a mock function with given fields: ctx, rollupType, dbTx
+func (_m *stateRollupRegistryManager) AddRollupType(ctx context.Context, rollupType *entities.RollupType, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupType, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRollupType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RollupType, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupType, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stateRollupRegistryManager_AddRollupType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRollupType'
+type stateRollupRegistryManager_AddRollupType_Call struct {
+	*mock.Call
+}
+
+// AddRollupType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupType *entities.RollupType
+//   - dbTx entities.Tx
+func (_e *stateRollupRegistryManager_Expecter) AddRollupType(ctx interface{}, rollupType interface{}, dbTx interface{}) *stateRollupRegistryManager_AddRollupType_Call {
+	return &stateRollupRegistryManager_AddRollupType_Call{Call: _e.mock.On("AddRollupType", ctx, rollupType, dbTx)}
+}
+
+func (_c *stateRollupRegistryManager_AddRollupType_Call) Run(run func(ctx context.Context, rollupType *entities.RollupType, dbTx entities.Tx)) *stateRollupRegistryManager_AddRollupType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.RollupType), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateRollupRegistryManager_AddRollupType_Call) Return(_a0 error) *stateRollupRegistryManager_AddRollupType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stateRollupRegistryManager_AddRollupType_Call) RunAndReturn(run func(context.Context, *entities.RollupType, entities.Tx) error) *stateRollupRegistryManager_AddRollupType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRollupTypeObsolete provides a mock function with given fields: ctx, rollupTypeID, blockNumber, dbTx
+func (_m *stateRollupRegistryManager) SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupTypeID, blockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRollupTypeObsolete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupTypeID, blockNumber, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stateRollupRegistryManager_SetRollupTypeObsolete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRollupTypeObsolete'
+type stateRollupRegistryManager_SetRollupTypeObsolete_Call struct {
+	*mock.Call
+}
+
+// SetRollupTypeObsolete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupTypeID uint64
+//   - blockNumber uint64
+//   - dbTx entities.Tx
+func (_e *stateRollupRegistryManager_Expecter) SetRollupTypeObsolete(ctx interface{}, rollupTypeID interface{}, blockNumber interface{}, dbTx interface{}) *stateRollupRegistryManager_SetRollupTypeObsolete_Call {
+	return &stateRollupRegistryManager_SetRollupTypeObsolete_Call{Call: _e.mock.On("SetRollupTypeObsolete", ctx, rollupTypeID, blockNumber, dbTx)}
+}
+
+func (_c *stateRollupRegistryManager_SetRollupTypeObsolete_Call) Run(run func(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx entities.Tx)) *stateRollupRegistryManager_SetRollupTypeObsolete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateRollupRegistryManager_SetRollupTypeObsolete_Call) Return(_a0 error) *stateRollupRegistryManager_SetRollupTypeObsolete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stateRollupRegistryManager_SetRollupTypeObsolete_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) error) *stateRollupRegistryManager_SetRollupTypeObsolete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRollup provides a mock function with given fields: ctx, rollupUpdate, dbTx
+func (_m *stateRollupRegistryManager) UpdateRollup(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, rollupUpdate, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRollup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.RollupUpdate, entities.Tx) error); ok {
+		r0 = rf(ctx, rollupUpdate, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stateRollupRegistryManager_UpdateRollup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRollup'
+type stateRollupRegistryManager_UpdateRollup_Call struct {
+	*mock.Call
+}
+
+// UpdateRollup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupUpdate *entities.RollupUpdate
+//   - dbTx entities.Tx
+func (_e *stateRollupRegistryManager_Expecter) UpdateRollup(ctx interface{}, rollupUpdate interface{}, dbTx interface{}) *stateRollupRegistryManager_UpdateRollup_Call {
+	return &stateRollupRegistryManager_UpdateRollup_Call{Call: _e.mock.On("UpdateRollup", ctx, rollupUpdate, dbTx)}
+}
+
+func (_c *stateRollupRegistryManager_UpdateRollup_Call) Run(run func(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx entities.Tx)) *stateRollupRegistryManager_UpdateRollup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.RollupUpdate), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateRollupRegistryManager_UpdateRollup_Call) Return(_a0 error) *stateRollupRegistryManager_UpdateRollup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stateRollupRegistryManager_UpdateRollup_Call) RunAndReturn(run func(context.Context, *entities.RollupUpdate, entities.Tx) error) *stateRollupRegistryManager_UpdateRollup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newStateRollupRegistryManager creates a new instance of stateRollupRegistryManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newStateRollupRegistryManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *stateRollupRegistryManager {
+	mock := &stateRollupRegistryManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
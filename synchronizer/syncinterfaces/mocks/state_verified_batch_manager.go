@@ -0,0 +1,85 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// stateVerifiedBatchManager is an autogenerated mock type for the stateVerifiedBatchManager type
+type stateVerifiedBatchManager struct {
+	mock.Mock
+}
+
+type stateVerifiedBatchManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *stateVerifiedBatchManager) EXPECT() *stateVerifiedBatchManager_Expecter {
+	return &stateVerifiedBatchManager_Expecter{mock: &_m.Mock}
+}
+
+// AddVerifiedBatch provides a mock function with given fields: ctx, verifiedBatch, dbTx
+func (_m *stateVerifiedBatchManager) AddVerifiedBatch(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx) error {
+	ret := _m.Called(ctx, verifiedBatch, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddVerifiedBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entities.VerifiedBatch, entities.Tx) error); ok {
+		r0 = rf(ctx, verifiedBatch, dbTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// stateVerifiedBatchManager_AddVerifiedBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddVerifiedBatch'
+type stateVerifiedBatchManager_AddVerifiedBatch_Call struct {
+	*mock.Call
+}
+
+// AddVerifiedBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - verifiedBatch *entities.VerifiedBatch
+//   - dbTx entities.Tx
+func (_e *stateVerifiedBatchManager_Expecter) AddVerifiedBatch(ctx interface{}, verifiedBatch interface{}, dbTx interface{}) *stateVerifiedBatchManager_AddVerifiedBatch_Call {
+	return &stateVerifiedBatchManager_AddVerifiedBatch_Call{Call: _e.mock.On("AddVerifiedBatch", ctx, verifiedBatch, dbTx)}
+}
+
+func (_c *stateVerifiedBatchManager_AddVerifiedBatch_Call) Run(run func(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx entities.Tx)) *stateVerifiedBatchManager_AddVerifiedBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entities.VerifiedBatch), args[2].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *stateVerifiedBatchManager_AddVerifiedBatch_Call) Return(_a0 error) *stateVerifiedBatchManager_AddVerifiedBatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *stateVerifiedBatchManager_AddVerifiedBatch_Call) RunAndReturn(run func(context.Context, *entities.VerifiedBatch, entities.Tx) error) *stateVerifiedBatchManager_AddVerifiedBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// newStateVerifiedBatchManager creates a new instance of stateVerifiedBatchManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newStateVerifiedBatchManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *stateVerifiedBatchManager {
+	mock := &stateVerifiedBatchManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
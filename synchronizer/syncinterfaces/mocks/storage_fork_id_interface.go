@@ -70,9 +70,9 @@ func (_c *StorageForkIDInterface_AddForkID_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// GetForkIDs provides a mock function with given fields: ctx, dbTx
-func (_m *StorageForkIDInterface) GetForkIDs(ctx context.Context, dbTx entities.Tx) ([]entities.ForkIDInterval, error) {
-	ret := _m.Called(ctx, dbTx)
+// GetForkIDs provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *StorageForkIDInterface) GetForkIDs(ctx context.Context, rollupID uint64, dbTx entities.Tx) ([]entities.ForkIDInterval, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetForkIDs")
@@ -80,19 +80,19 @@ func (_m *StorageForkIDInterface) GetForkIDs(ctx context.Context, dbTx entities.
 
 	var r0 []entities.ForkIDInterval
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.ForkIDInterval, error)); ok {
-		return rf(ctx, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) ([]entities.ForkIDInterval, error)); ok {
+		return rf(ctx, rollupID, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.ForkIDInterval); ok {
-		r0 = rf(ctx, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) []entities.ForkIDInterval); ok {
+		r0 = rf(ctx, rollupID, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entities.ForkIDInterval)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
-		r1 = rf(ctx, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -107,14 +107,15 @@ type StorageForkIDInterface_GetForkIDs_Call struct {
 
 // GetForkIDs is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - dbTx entities.Tx
-func (_e *StorageForkIDInterface_Expecter) GetForkIDs(ctx interface{}, dbTx interface{}) *StorageForkIDInterface_GetForkIDs_Call {
-	return &StorageForkIDInterface_GetForkIDs_Call{Call: _e.mock.On("GetForkIDs", ctx, dbTx)}
+func (_e *StorageForkIDInterface_Expecter) GetForkIDs(ctx interface{}, rollupID interface{}, dbTx interface{}) *StorageForkIDInterface_GetForkIDs_Call {
+	return &StorageForkIDInterface_GetForkIDs_Call{Call: _e.mock.On("GetForkIDs", ctx, rollupID, dbTx)}
 }
 
-func (_c *StorageForkIDInterface_GetForkIDs_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *StorageForkIDInterface_GetForkIDs_Call {
+func (_c *StorageForkIDInterface_GetForkIDs_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *StorageForkIDInterface_GetForkIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
 	})
 	return _c
 }
@@ -124,7 +125,7 @@ func (_c *StorageForkIDInterface_GetForkIDs_Call) Return(_a0 []entities.ForkIDIn
 	return _c
 }
 
-func (_c *StorageForkIDInterface_GetForkIDs_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.ForkIDInterval, error)) *StorageForkIDInterface_GetForkIDs_Call {
+func (_c *StorageForkIDInterface_GetForkIDs_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) ([]entities.ForkIDInterval, error)) *StorageForkIDInterface_GetForkIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
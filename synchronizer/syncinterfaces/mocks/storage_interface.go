@@ -10,6 +10,10 @@ import (
 	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
 
 	mock "github.com/stretchr/testify/mock"
+
+	pgstorage "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
+
+	time "time"
 )
 
 // StorageInterface is an autogenerated mock type for the StorageInterface type
@@ -396,9 +400,9 @@ func (_c *StorageInterface_GetFirstUncheckedBlock_Call) RunAndReturn(run func(co
 	return _c
 }
 
-// GetForkIDs provides a mock function with given fields: ctx, dbTx
-func (_m *StorageInterface) GetForkIDs(ctx context.Context, dbTx entities.Tx) ([]entities.ForkIDInterval, error) {
-	ret := _m.Called(ctx, dbTx)
+// GetForkIDs provides a mock function with given fields: ctx, rollupID, dbTx
+func (_m *StorageInterface) GetForkIDs(ctx context.Context, rollupID uint64, dbTx entities.Tx) ([]entities.ForkIDInterval, error) {
+	ret := _m.Called(ctx, rollupID, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetForkIDs")
@@ -406,19 +410,19 @@ func (_m *StorageInterface) GetForkIDs(ctx context.Context, dbTx entities.Tx) ([
 
 	var r0 []entities.ForkIDInterval
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) ([]entities.ForkIDInterval, error)); ok {
-		return rf(ctx, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) ([]entities.ForkIDInterval, error)); ok {
+		return rf(ctx, rollupID, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) []entities.ForkIDInterval); ok {
-		r0 = rf(ctx, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) []entities.ForkIDInterval); ok {
+		r0 = rf(ctx, rollupID, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entities.ForkIDInterval)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
-		r1 = rf(ctx, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -433,14 +437,15 @@ type StorageInterface_GetForkIDs_Call struct {
 
 // GetForkIDs is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - dbTx entities.Tx
-func (_e *StorageInterface_Expecter) GetForkIDs(ctx interface{}, dbTx interface{}) *StorageInterface_GetForkIDs_Call {
-	return &StorageInterface_GetForkIDs_Call{Call: _e.mock.On("GetForkIDs", ctx, dbTx)}
+func (_e *StorageInterface_Expecter) GetForkIDs(ctx interface{}, rollupID interface{}, dbTx interface{}) *StorageInterface_GetForkIDs_Call {
+	return &StorageInterface_GetForkIDs_Call{Call: _e.mock.On("GetForkIDs", ctx, rollupID, dbTx)}
 }
 
-func (_c *StorageInterface_GetForkIDs_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *StorageInterface_GetForkIDs_Call {
+func (_c *StorageInterface_GetForkIDs_Call) Run(run func(ctx context.Context, rollupID uint64, dbTx entities.Tx)) *StorageInterface_GetForkIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
 	})
 	return _c
 }
@@ -450,7 +455,7 @@ func (_c *StorageInterface_GetForkIDs_Call) Return(_a0 []entities.ForkIDInterval
 	return _c
 }
 
-func (_c *StorageInterface_GetForkIDs_Call) RunAndReturn(run func(context.Context, entities.Tx) ([]entities.ForkIDInterval, error)) *StorageInterface_GetForkIDs_Call {
+func (_c *StorageInterface_GetForkIDs_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) ([]entities.ForkIDInterval, error)) *StorageInterface_GetForkIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -574,6 +579,65 @@ func (_c *StorageInterface_GetLastBlock_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetLastestVirtualBatchNumber provides a mock function with given fields: ctx, rollupID, constrains, dbTx
+func (_m *StorageInterface) GetLastestVirtualBatchNumber(ctx context.Context, rollupID uint64, constrains *pgstorage.VirtualBatchConstraints, dbTx entities.Tx) (uint64, error) {
+	ret := _m.Called(ctx, rollupID, constrains, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastestVirtualBatchNumber")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *pgstorage.VirtualBatchConstraints, entities.Tx) (uint64, error)); ok {
+		return rf(ctx, rollupID, constrains, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *pgstorage.VirtualBatchConstraints, entities.Tx) uint64); ok {
+		r0 = rf(ctx, rollupID, constrains, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *pgstorage.VirtualBatchConstraints, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, constrains, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageInterface_GetLastestVirtualBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastestVirtualBatchNumber'
+type StorageInterface_GetLastestVirtualBatchNumber_Call struct {
+	*mock.Call
+}
+
+// GetLastestVirtualBatchNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - constrains *pgstorage.VirtualBatchConstraints
+//   - dbTx entities.Tx
+func (_e *StorageInterface_Expecter) GetLastestVirtualBatchNumber(ctx interface{}, rollupID interface{}, constrains interface{}, dbTx interface{}) *StorageInterface_GetLastestVirtualBatchNumber_Call {
+	return &StorageInterface_GetLastestVirtualBatchNumber_Call{Call: _e.mock.On("GetLastestVirtualBatchNumber", ctx, rollupID, constrains, dbTx)}
+}
+
+func (_c *StorageInterface_GetLastestVirtualBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, constrains *pgstorage.VirtualBatchConstraints, dbTx entities.Tx)) *StorageInterface_GetLastestVirtualBatchNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(*pgstorage.VirtualBatchConstraints), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageInterface_GetLastestVirtualBatchNumber_Call) Return(_a0 uint64, _a1 error) *StorageInterface_GetLastestVirtualBatchNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageInterface_GetLastestVirtualBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, *pgstorage.VirtualBatchConstraints, entities.Tx) (uint64, error)) *StorageInterface_GetLastestVirtualBatchNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLatestL1InfoTreeLeaf provides a mock function with given fields: ctx, dbTx
 func (_m *StorageInterface) GetLatestL1InfoTreeLeaf(ctx context.Context, dbTx entities.Tx) (*entities.L1InfoTreeLeaf, error) {
 	ret := _m.Called(ctx, dbTx)
@@ -753,9 +817,70 @@ func (_c *StorageInterface_GetPreviousBlock_Call) RunAndReturn(run func(context.
 	return _c
 }
 
-// GetSequenceByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *StorageInterface) GetSequenceByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) (*entities.SequencedBatches, error) {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetReorgLogs provides a mock function with given fields: ctx, fromTimestamp, limit, dbTx
+func (_m *StorageInterface) GetReorgLogs(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx entities.Tx) ([]entities.ReorgLogEntry, error) {
+	ret := _m.Called(ctx, fromTimestamp, limit, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReorgLogs")
+	}
+
+	var r0 []entities.ReorgLogEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64, entities.Tx) ([]entities.ReorgLogEntry, error)); ok {
+		return rf(ctx, fromTimestamp, limit, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64, entities.Tx) []entities.ReorgLogEntry); ok {
+		r0 = rf(ctx, fromTimestamp, limit, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ReorgLogEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, fromTimestamp, limit, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageInterface_GetReorgLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReorgLogs'
+type StorageInterface_GetReorgLogs_Call struct {
+	*mock.Call
+}
+
+// GetReorgLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromTimestamp time.Time
+//   - limit uint64
+//   - dbTx entities.Tx
+func (_e *StorageInterface_Expecter) GetReorgLogs(ctx interface{}, fromTimestamp interface{}, limit interface{}, dbTx interface{}) *StorageInterface_GetReorgLogs_Call {
+	return &StorageInterface_GetReorgLogs_Call{Call: _e.mock.On("GetReorgLogs", ctx, fromTimestamp, limit, dbTx)}
+}
+
+func (_c *StorageInterface_GetReorgLogs_Call) Run(run func(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx entities.Tx)) *StorageInterface_GetReorgLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageInterface_GetReorgLogs_Call) Return(_a0 []entities.ReorgLogEntry, _a1 error) *StorageInterface_GetReorgLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageInterface_GetReorgLogs_Call) RunAndReturn(run func(context.Context, time.Time, uint64, entities.Tx) ([]entities.ReorgLogEntry, error)) *StorageInterface_GetReorgLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSequenceByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *StorageInterface) GetSequenceByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.SequencedBatches, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetSequenceByBatchNumber")
@@ -763,19 +888,19 @@ func (_m *StorageInterface) GetSequenceByBatchNumber(ctx context.Context, batchN
 
 	var r0 *entities.SequencedBatches
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.SequencedBatches, error)); ok {
-		return rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.SequencedBatches, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.SequencedBatches); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.SequencedBatches); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.SequencedBatches)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -790,15 +915,16 @@ type StorageInterface_GetSequenceByBatchNumber_Call struct {
 
 // GetSequenceByBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *StorageInterface_Expecter) GetSequenceByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StorageInterface_GetSequenceByBatchNumber_Call {
-	return &StorageInterface_GetSequenceByBatchNumber_Call{Call: _e.mock.On("GetSequenceByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *StorageInterface_Expecter) GetSequenceByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *StorageInterface_GetSequenceByBatchNumber_Call {
+	return &StorageInterface_GetSequenceByBatchNumber_Call{Call: _e.mock.On("GetSequenceByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *StorageInterface_GetSequenceByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *StorageInterface_GetSequenceByBatchNumber_Call {
+func (_c *StorageInterface_GetSequenceByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *StorageInterface_GetSequenceByBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
@@ -808,7 +934,186 @@ func (_c *StorageInterface_GetSequenceByBatchNumber_Call) Return(_a0 *entities.S
 	return _c
 }
 
-func (_c *StorageInterface_GetSequenceByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.SequencedBatches, error)) *StorageInterface_GetSequenceByBatchNumber_Call {
+func (_c *StorageInterface_GetSequenceByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.SequencedBatches, error)) *StorageInterface_GetSequenceByBatchNumber_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUncheckedBlocks provides a mock function with given fields: ctx, fromBlockNumber, toBlockNumber, dbTx
+func (_m *StorageInterface) GetUncheckedBlocks(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx entities.Tx) (*[]entities.L1Block, error) {
+	ret := _m.Called(ctx, fromBlockNumber, toBlockNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUncheckedBlocks")
+	}
+
+	var r0 *[]entities.L1Block
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*[]entities.L1Block, error)); ok {
+		return rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *[]entities.L1Block); ok {
+		r0 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*[]entities.L1Block)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, fromBlockNumber, toBlockNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageInterface_GetUncheckedBlocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUncheckedBlocks'
+type StorageInterface_GetUncheckedBlocks_Call struct {
+	*mock.Call
+}
+
+// GetUncheckedBlocks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromBlockNumber uint64
+//   - toBlockNumber uint64
+//   - dbTx entities.Tx
+func (_e *StorageInterface_Expecter) GetUncheckedBlocks(ctx interface{}, fromBlockNumber interface{}, toBlockNumber interface{}, dbTx interface{}) *StorageInterface_GetUncheckedBlocks_Call {
+	return &StorageInterface_GetUncheckedBlocks_Call{Call: _e.mock.On("GetUncheckedBlocks", ctx, fromBlockNumber, toBlockNumber, dbTx)}
+}
+
+func (_c *StorageInterface_GetUncheckedBlocks_Call) Run(run func(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx entities.Tx)) *StorageInterface_GetUncheckedBlocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageInterface_GetUncheckedBlocks_Call) Return(_a0 *[]entities.L1Block, _a1 error) *StorageInterface_GetUncheckedBlocks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageInterface_GetUncheckedBlocks_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*[]entities.L1Block, error)) *StorageInterface_GetUncheckedBlocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUncheckedBlocksCount provides a mock function with given fields: ctx, dbTx
+func (_m *StorageInterface) GetUncheckedBlocksCount(ctx context.Context, dbTx entities.Tx) (uint64, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUncheckedBlocksCount")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (uint64, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) uint64); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageInterface_GetUncheckedBlocksCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUncheckedBlocksCount'
+type StorageInterface_GetUncheckedBlocksCount_Call struct {
+	*mock.Call
+}
+
+// GetUncheckedBlocksCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *StorageInterface_Expecter) GetUncheckedBlocksCount(ctx interface{}, dbTx interface{}) *StorageInterface_GetUncheckedBlocksCount_Call {
+	return &StorageInterface_GetUncheckedBlocksCount_Call{Call: _e.mock.On("GetUncheckedBlocksCount", ctx, dbTx)}
+}
+
+func (_c *StorageInterface_GetUncheckedBlocksCount_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *StorageInterface_GetUncheckedBlocksCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageInterface_GetUncheckedBlocksCount_Call) Return(_a0 uint64, _a1 error) *StorageInterface_GetUncheckedBlocksCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageInterface_GetUncheckedBlocksCount_Call) RunAndReturn(run func(context.Context, entities.Tx) (uint64, error)) *StorageInterface_GetUncheckedBlocksCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVirtualBatchByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *StorageInterface) GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.VirtualBatch, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVirtualBatchByBatchNumber")
+	}
+
+	var r0 *entities.VirtualBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.VirtualBatch, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.VirtualBatch); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entities.VirtualBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageInterface_GetVirtualBatchByBatchNumber_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVirtualBatchByBatchNumber'
+type StorageInterface_GetVirtualBatchByBatchNumber_Call struct {
+	*mock.Call
+}
+
+// GetVirtualBatchByBatchNumber is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollupID uint64
+//   - batchNumber uint64
+//   - dbTx entities.Tx
+func (_e *StorageInterface_Expecter) GetVirtualBatchByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *StorageInterface_GetVirtualBatchByBatchNumber_Call {
+	return &StorageInterface_GetVirtualBatchByBatchNumber_Call{Call: _e.mock.On("GetVirtualBatchByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
+}
+
+func (_c *StorageInterface_GetVirtualBatchByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *StorageInterface_GetVirtualBatchByBatchNumber_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageInterface_GetVirtualBatchByBatchNumber_Call) Return(_a0 *entities.VirtualBatch, _a1 error) *StorageInterface_GetVirtualBatchByBatchNumber_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageInterface_GetVirtualBatchByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.VirtualBatch, error)) *StorageInterface_GetVirtualBatchByBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,100 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// StorageReorgLogInterface is an autogenerated mock type for the StorageReorgLogInterface type
+type StorageReorgLogInterface struct {
+	mock.Mock
+}
+
+type StorageReorgLogInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StorageReorgLogInterface) EXPECT() *StorageReorgLogInterface_Expecter {
+	return &StorageReorgLogInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetReorgLogs provides a mock function with given fields: ctx, fromTimestamp, limit, dbTx
+func (_m *StorageReorgLogInterface) GetReorgLogs(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx entities.Tx) ([]entities.ReorgLogEntry, error) {
+	ret := _m.Called(ctx, fromTimestamp, limit, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReorgLogs")
+	}
+
+	var r0 []entities.ReorgLogEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64, entities.Tx) ([]entities.ReorgLogEntry, error)); ok {
+		return rf(ctx, fromTimestamp, limit, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64, entities.Tx) []entities.ReorgLogEntry); ok {
+		r0 = rf(ctx, fromTimestamp, limit, dbTx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entities.ReorgLogEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, fromTimestamp, limit, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageReorgLogInterface_GetReorgLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReorgLogs'
+type StorageReorgLogInterface_GetReorgLogs_Call struct {
+	*mock.Call
+}
+
+// GetReorgLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromTimestamp time.Time
+//   - limit uint64
+//   - dbTx entities.Tx
+func (_e *StorageReorgLogInterface_Expecter) GetReorgLogs(ctx interface{}, fromTimestamp interface{}, limit interface{}, dbTx interface{}) *StorageReorgLogInterface_GetReorgLogs_Call {
+	return &StorageReorgLogInterface_GetReorgLogs_Call{Call: _e.mock.On("GetReorgLogs", ctx, fromTimestamp, limit, dbTx)}
+}
+
+func (_c *StorageReorgLogInterface_GetReorgLogs_Call) Run(run func(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx entities.Tx)) *StorageReorgLogInterface_GetReorgLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(uint64), args[3].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageReorgLogInterface_GetReorgLogs_Call) Return(_a0 []entities.ReorgLogEntry, _a1 error) *StorageReorgLogInterface_GetReorgLogs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageReorgLogInterface_GetReorgLogs_Call) RunAndReturn(run func(context.Context, time.Time, uint64, entities.Tx) ([]entities.ReorgLogEntry, error)) *StorageReorgLogInterface_GetReorgLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStorageReorgLogInterface creates a new instance of StorageReorgLogInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStorageReorgLogInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StorageReorgLogInterface {
+	mock := &StorageReorgLogInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mock_syncinterfaces
+
+import (
+	context "context"
+
+	entities "github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StorageSyncStatusInterface is an autogenerated mock type for the StorageSyncStatusInterface type
+type StorageSyncStatusInterface struct {
+	mock.Mock
+}
+
+type StorageSyncStatusInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StorageSyncStatusInterface) EXPECT() *StorageSyncStatusInterface_Expecter {
+	return &StorageSyncStatusInterface_Expecter{mock: &_m.Mock}
+}
+
+// GetUncheckedBlocksCount provides a mock function with given fields: ctx, dbTx
+func (_m *StorageSyncStatusInterface) GetUncheckedBlocksCount(ctx context.Context, dbTx entities.Tx) (uint64, error) {
+	ret := _m.Called(ctx, dbTx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUncheckedBlocksCount")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) (uint64, error)); ok {
+		return rf(ctx, dbTx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, entities.Tx) uint64); ok {
+		r0 = rf(ctx, dbTx)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, entities.Tx) error); ok {
+		r1 = rf(ctx, dbTx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageSyncStatusInterface_GetUncheckedBlocksCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUncheckedBlocksCount'
+type StorageSyncStatusInterface_GetUncheckedBlocksCount_Call struct {
+	*mock.Call
+}
+
+// GetUncheckedBlocksCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dbTx entities.Tx
+func (_e *StorageSyncStatusInterface_Expecter) GetUncheckedBlocksCount(ctx interface{}, dbTx interface{}) *StorageSyncStatusInterface_GetUncheckedBlocksCount_Call {
+	return &StorageSyncStatusInterface_GetUncheckedBlocksCount_Call{Call: _e.mock.On("GetUncheckedBlocksCount", ctx, dbTx)}
+}
+
+func (_c *StorageSyncStatusInterface_GetUncheckedBlocksCount_Call) Run(run func(ctx context.Context, dbTx entities.Tx)) *StorageSyncStatusInterface_GetUncheckedBlocksCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(entities.Tx))
+	})
+	return _c
+}
+
+func (_c *StorageSyncStatusInterface_GetUncheckedBlocksCount_Call) Return(_a0 uint64, _a1 error) *StorageSyncStatusInterface_GetUncheckedBlocksCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageSyncStatusInterface_GetUncheckedBlocksCount_Call) RunAndReturn(run func(context.Context, entities.Tx) (uint64, error)) *StorageSyncStatusInterface_GetUncheckedBlocksCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStorageSyncStatusInterface creates a new instance of StorageSyncStatusInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStorageSyncStatusInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StorageSyncStatusInterface {
+	mock := &StorageSyncStatusInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
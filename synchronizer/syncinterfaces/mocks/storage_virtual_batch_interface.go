@@ -24,9 +24,9 @@ func (_m *StorageVirtualBatchInterface) EXPECT() *StorageVirtualBatchInterface_E
 	return &StorageVirtualBatchInterface_Expecter{mock: &_m.Mock}
 }
 
-// GetLastestVirtualBatchNumber provides a mock function with given fields: ctx, constrains, dbTx
-func (_m *StorageVirtualBatchInterface) GetLastestVirtualBatchNumber(ctx context.Context, constrains *pgstorage.VirtualBatchConstraints, dbTx entities.Tx) (uint64, error) {
-	ret := _m.Called(ctx, constrains, dbTx)
+// GetLastestVirtualBatchNumber provides a mock function with given fields: ctx, rollupID, constrains, dbTx
+func (_m *StorageVirtualBatchInterface) GetLastestVirtualBatchNumber(ctx context.Context, rollupID uint64, constrains *pgstorage.VirtualBatchConstraints, dbTx entities.Tx) (uint64, error) {
+	ret := _m.Called(ctx, rollupID, constrains, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLastestVirtualBatchNumber")
@@ -34,17 +34,17 @@ func (_m *StorageVirtualBatchInterface) GetLastestVirtualBatchNumber(ctx context
 
 	var r0 uint64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *pgstorage.VirtualBatchConstraints, entities.Tx) (uint64, error)); ok {
-		return rf(ctx, constrains, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *pgstorage.VirtualBatchConstraints, entities.Tx) (uint64, error)); ok {
+		return rf(ctx, rollupID, constrains, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *pgstorage.VirtualBatchConstraints, entities.Tx) uint64); ok {
-		r0 = rf(ctx, constrains, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, *pgstorage.VirtualBatchConstraints, entities.Tx) uint64); ok {
+		r0 = rf(ctx, rollupID, constrains, dbTx)
 	} else {
 		r0 = ret.Get(0).(uint64)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *pgstorage.VirtualBatchConstraints, entities.Tx) error); ok {
-		r1 = rf(ctx, constrains, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, *pgstorage.VirtualBatchConstraints, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, constrains, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,15 +59,16 @@ type StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call struct {
 
 // GetLastestVirtualBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - constrains *pgstorage.VirtualBatchConstraints
 //   - dbTx entities.Tx
-func (_e *StorageVirtualBatchInterface_Expecter) GetLastestVirtualBatchNumber(ctx interface{}, constrains interface{}, dbTx interface{}) *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call {
-	return &StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call{Call: _e.mock.On("GetLastestVirtualBatchNumber", ctx, constrains, dbTx)}
+func (_e *StorageVirtualBatchInterface_Expecter) GetLastestVirtualBatchNumber(ctx interface{}, rollupID interface{}, constrains interface{}, dbTx interface{}) *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call {
+	return &StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call{Call: _e.mock.On("GetLastestVirtualBatchNumber", ctx, rollupID, constrains, dbTx)}
 }
 
-func (_c *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call) Run(run func(ctx context.Context, constrains *pgstorage.VirtualBatchConstraints, dbTx entities.Tx)) *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call {
+func (_c *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, constrains *pgstorage.VirtualBatchConstraints, dbTx entities.Tx)) *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*pgstorage.VirtualBatchConstraints), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(*pgstorage.VirtualBatchConstraints), args[3].(entities.Tx))
 	})
 	return _c
 }
@@ -77,14 +78,14 @@ func (_c *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call) Return
 	return _c
 }
 
-func (_c *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call) RunAndReturn(run func(context.Context, *pgstorage.VirtualBatchConstraints, entities.Tx) (uint64, error)) *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call {
+func (_c *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, *pgstorage.VirtualBatchConstraints, entities.Tx) (uint64, error)) *StorageVirtualBatchInterface_GetLastestVirtualBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetVirtualBatchByBatchNumber provides a mock function with given fields: ctx, batchNumber, dbTx
-func (_m *StorageVirtualBatchInterface) GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber uint64, dbTx entities.Tx) (*entities.VirtualBatch, error) {
-	ret := _m.Called(ctx, batchNumber, dbTx)
+// GetVirtualBatchByBatchNumber provides a mock function with given fields: ctx, rollupID, batchNumber, dbTx
+func (_m *StorageVirtualBatchInterface) GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx) (*entities.VirtualBatch, error) {
+	ret := _m.Called(ctx, rollupID, batchNumber, dbTx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetVirtualBatchByBatchNumber")
@@ -92,19 +93,19 @@ func (_m *StorageVirtualBatchInterface) GetVirtualBatchByBatchNumber(ctx context
 
 	var r0 *entities.VirtualBatch
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) (*entities.VirtualBatch, error)); ok {
-		return rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) (*entities.VirtualBatch, error)); ok {
+		return rf(ctx, rollupID, batchNumber, dbTx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, entities.Tx) *entities.VirtualBatch); ok {
-		r0 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64, entities.Tx) *entities.VirtualBatch); ok {
+		r0 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entities.VirtualBatch)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, entities.Tx) error); ok {
-		r1 = rf(ctx, batchNumber, dbTx)
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64, entities.Tx) error); ok {
+		r1 = rf(ctx, rollupID, batchNumber, dbTx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -119,15 +120,16 @@ type StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call struct {
 
 // GetVirtualBatchByBatchNumber is a helper method to define mock.On call
 //   - ctx context.Context
+//   - rollupID uint64
 //   - batchNumber uint64
 //   - dbTx entities.Tx
-func (_e *StorageVirtualBatchInterface_Expecter) GetVirtualBatchByBatchNumber(ctx interface{}, batchNumber interface{}, dbTx interface{}) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
-	return &StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call{Call: _e.mock.On("GetVirtualBatchByBatchNumber", ctx, batchNumber, dbTx)}
+func (_e *StorageVirtualBatchInterface_Expecter) GetVirtualBatchByBatchNumber(ctx interface{}, rollupID interface{}, batchNumber interface{}, dbTx interface{}) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
+	return &StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call{Call: _e.mock.On("GetVirtualBatchByBatchNumber", ctx, rollupID, batchNumber, dbTx)}
 }
 
-func (_c *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call) Run(run func(ctx context.Context, batchNumber uint64, dbTx entities.Tx)) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
+func (_c *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call) Run(run func(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx entities.Tx)) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(entities.Tx))
+		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64), args[3].(entities.Tx))
 	})
 	return _c
 }
@@ -137,7 +139,7 @@ func (_c *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call) Return
 	return _c
 }
 
-func (_c *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, entities.Tx) (*entities.VirtualBatch, error)) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
+func (_c *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call) RunAndReturn(run func(context.Context, uint64, uint64, entities.Tx) (*entities.VirtualBatch, error)) *StorageVirtualBatchInterface_GetVirtualBatchByBatchNumber_Call {
 	_c.Call.Return(run)
 	return _c
 }
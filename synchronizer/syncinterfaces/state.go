@@ -13,22 +13,64 @@ type StateTxProvider interface {
 }
 
 type StateForkidQuerier interface {
-	GetForkIDByBatchNumber(ctx context.Context, batchNumber uint64, dbTx stateTxType) uint64
-	GetForkIDByBlockNumber(ctx context.Context, blockNumber uint64, dbTx stateTxType) uint64
+	GetForkIDByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx stateTxType) uint64
+	GetForkIDByBlockNumber(ctx context.Context, rollupID uint64, blockNumber uint64, dbTx stateTxType) uint64
 }
 
 type stateOnSequencedBatchesManager interface {
 	OnSequencedBatchesOnL1(ctx context.Context, seq model.SequenceOfBatches, dbTx stateTxType) error
 }
 
+type stateForcedBatchManager interface {
+	AddForcedBatch(ctx context.Context, forcedBatch *entities.ForcedBatch, dbTx stateTxType) error
+	OnSequencedForceBatchesOnL1(ctx context.Context, sequencedForceBatches []*entities.SequencedForceBatch, dbTx stateTxType) error
+}
+
+type stateVerifiedBatchManager interface {
+	AddVerifiedBatch(ctx context.Context, verifiedBatch *entities.VerifiedBatch, dbTx stateTxType) error
+}
+
+type stateGlobalExitRootManager interface {
+	AddGlobalExitRoot(ctx context.Context, globalExitRoot *entities.GlobalExitRoot, dbTx stateTxType) error
+}
+
+type stateGovernanceEventManager interface {
+	AddGovernanceEvent(ctx context.Context, governanceEvent *entities.GovernanceEvent, dbTx stateTxType) error
+}
+
+type stateRollupRegistryManager interface {
+	AddRollupType(ctx context.Context, rollupType *entities.RollupType, dbTx stateTxType) error
+	SetRollupTypeObsolete(ctx context.Context, rollupTypeID uint64, blockNumber uint64, dbTx stateTxType) error
+	AddRollup(ctx context.Context, rollup *entities.Rollup, dbTx stateTxType) error
+	UpdateRollup(ctx context.Context, rollupUpdate *entities.RollupUpdate, dbTx stateTxType) error
+}
+
+type stateAuditEventManager interface {
+	AddAuditEvent(ctx context.Context, auditEvent *entities.AuditEvent, dbTx stateTxType) error
+}
+
+type statePendingStateManager interface {
+	AddPendingState(ctx context.Context, pendingState *entities.PendingState, dbTx stateTxType) error
+	ConsolidatePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, blockNumber uint64, dbTx stateTxType) error
+	OverridePendingStates(ctx context.Context, rollupID uint64, batchNumber uint64, stateRoot common.Hash, blockNumber uint64, dbTx stateTxType) error
+	SetPendingStateNonDeterministic(ctx context.Context, storedStateRoot common.Hash, provedStateRoot common.Hash, blockNumber uint64, dbTx stateTxType) error
+}
+
 type stateReorgManager interface {
 	AddOnReorgCallback(f model.ReorgCallbackType)
 	ExecuteReorg(ctx context.Context, reorgRequest model.ReorgRequest, dbTx stateTxType) model.ReorgExecutionResult
+	PreviewReorg(ctx context.Context, firstL1BlockNumberToKeep uint64, dbTx stateTxType) (*entities.ReorgImpact, error)
+}
+
+// StatePreRollupSyncStatusManager stores the completion of the synchronization previous to the rollup genesis
+type StatePreRollupSyncStatusManager interface {
+	GetPreRollupSyncStatus(ctx context.Context, dbTx stateTxType) (*entities.PreRollupSyncStatus, error)
+	SetPreRollupSyncStatus(ctx context.Context, status entities.PreRollupSyncStatus, dbTx stateTxType) error
 }
 
 type StateForkIdQuerier interface {
-	GetForkIDByBatchNumber(ctx context.Context, batchNumber uint64, dbTx stateTxType) uint64
-	GetForkIDByBlockNumber(ctx context.Context, blockNumber uint64, dbTx stateTxType) uint64
+	GetForkIDByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx stateTxType) uint64
+	GetForkIDByBlockNumber(ctx context.Context, rollupID uint64, blockNumber uint64, dbTx stateTxType) uint64
 }
 
 type StateInterface interface {
@@ -44,8 +86,17 @@ type StateInterface interface {
 	StateForkidQuerier
 	StateTxProvider
 	stateOnSequencedBatchesManager
+	stateVerifiedBatchManager
+	stateForcedBatchManager
+	stateGlobalExitRootManager
+	stateGovernanceEventManager
+	stateRollupRegistryManager
+	stateAuditEventManager
+	statePendingStateManager
 	StorageBlockReaderInterface
+	StorageBlockWriterInterface
 	stateReorgManager
+	StatePreRollupSyncStatusManager
 }
 
 type StateStorageCompatibilityCheckerInterface interface {
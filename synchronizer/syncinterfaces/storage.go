@@ -2,6 +2,7 @@ package syncinterfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/entities"
 	"github.com/0xPolygonHermez/zkevm-synchronizer-l1/state/storage/pgstorage"
@@ -19,11 +20,12 @@ type StorageBlockReaderInterface interface {
 	GetPreviousBlock(ctx context.Context, offset uint64, dbTx stateTxType) (*entities.L1Block, error)
 	GetFirstUncheckedBlock(ctx context.Context, fromBlockNumber uint64, dbTx stateTxType) (*entities.L1Block, error)
 	GetBlockByNumber(ctx context.Context, blockNumber uint64, dbTx stateTxType) (*entities.L1Block, error)
+	GetUncheckedBlocks(ctx context.Context, fromBlockNumber uint64, toBlockNumber uint64, dbTx stateTxType) (*[]entities.L1Block, error)
 }
 
 type StorageForkIDInterface interface {
 	AddForkID(ctx context.Context, forkID pgstorage.ForkIDInterval, dbTx stateTxType) error
-	GetForkIDs(ctx context.Context, dbTx stateTxType) ([]pgstorage.ForkIDInterval, error)
+	GetForkIDs(ctx context.Context, rollupID uint64, dbTx stateTxType) ([]pgstorage.ForkIDInterval, error)
 	UpdateForkID(ctx context.Context, forkID pgstorage.ForkIDInterval, dbTx stateTxType) error
 }
 
@@ -36,13 +38,21 @@ type StorageL1InfoTreeInterface interface {
 }
 
 type StorageVirtualBatchInterface interface {
-	GetVirtualBatchByBatchNumber(ctx context.Context, batchNumber uint64, dbTx stateTxType) (*pgstorage.VirtualBatch, error)
-	GetLastestVirtualBatchNumber(ctx context.Context, constrains *pgstorage.VirtualBatchConstraints, dbTx stateTxType) (uint64, error)
+	GetVirtualBatchByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx stateTxType) (*pgstorage.VirtualBatch, error)
+	GetLastestVirtualBatchNumber(ctx context.Context, rollupID uint64, constrains *pgstorage.VirtualBatchConstraints, dbTx stateTxType) (uint64, error)
 }
 
 type StorageSequenceBatchesInterface interface {
 	AddSequencedBatches(ctx context.Context, sequence *pgstorage.SequencedBatches, dbTx stateTxType) error
-	GetSequenceByBatchNumber(ctx context.Context, batchNumber uint64, dbTx stateTxType) (*pgstorage.SequencedBatches, error)
+	GetSequenceByBatchNumber(ctx context.Context, rollupID uint64, batchNumber uint64, dbTx stateTxType) (*pgstorage.SequencedBatches, error)
+}
+
+type StorageSyncStatusInterface interface {
+	GetUncheckedBlocksCount(ctx context.Context, dbTx stateTxType) (uint64, error)
+}
+
+type StorageReorgLogInterface interface {
+	GetReorgLogs(ctx context.Context, fromTimestamp time.Time, limit uint64, dbTx stateTxType) ([]entities.ReorgLogEntry, error)
 }
 
 type StorageInterface interface {
@@ -51,4 +61,7 @@ type StorageInterface interface {
 	StorageForkIDInterface
 	StorageL1InfoTreeInterface
 	StorageSequenceBatchesInterface
+	StorageVirtualBatchInterface
+	StorageSyncStatusInterface
+	StorageReorgLogInterface
 }